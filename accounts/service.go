@@ -0,0 +1,415 @@
+package accounts
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/invoices"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/decred/dcrlnd/routing"
+)
+
+// Config holds the dependencies of the account service.
+type Config struct {
+	// Store is the persistent storage of the accounts.
+	Store *channeldb.AccountStore
+
+	// SubscribePayment subscribes to the updates of the payment with the
+	// given hash.
+	SubscribePayment func(lntypes.Hash) (*routing.ControlTowerSubscriber,
+		error)
+
+	// SubscribeInvoices subscribes to the invoices that are settled from
+	// now on.
+	SubscribeInvoices func() (*invoices.InvoiceSubscription, error)
+
+	// LookupInvoice returns the invoice with the given payment hash.
+	LookupInvoice func(lntypes.Hash) (channeldb.Invoice, error)
+}
+
+// Service enforces the balances of the accounts macaroons can be bound to.
+// Payments made with an account macaroon are debited from the account when
+// they are dispatched and settled once their outcome is known. Invoices added
+// with an account macaroon are credited to the account once they are settled.
+type Service struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// invoiceAccounts maps the payment hashes of the pending invoices of
+	// all accounts to the account they were added with.
+	invoiceAccounts map[lntypes.Hash]channeldb.AccountID
+	invoiceMtx      sync.Mutex
+
+	invoiceSub *invoices.InvoiceSubscription
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewService creates a new account service.
+func NewService(cfg *Config) *Service {
+	return &Service{
+		cfg:             cfg,
+		invoiceAccounts: make(map[lntypes.Hash]channeldb.AccountID),
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start resolves the payments and invoices of the accounts that completed
+// while the service was down and starts tracking the pending ones.
+func (s *Service) Start() error {
+	var err error
+	s.started.Do(func() {
+		err = s.start()
+	})
+	return err
+}
+
+func (s *Service) start() error {
+	log.Info("Account service starting")
+
+	// We subscribe to settled invoices before looking up the pending ones,
+	// so we don't miss a settlement in between. Settling an invoice twice
+	// is harmless, as the store only credits pending invoices.
+	invoiceSub, err := s.cfg.SubscribeInvoices()
+	if err != nil {
+		return err
+	}
+	s.invoiceSub = invoiceSub
+
+	accounts, err := s.cfg.Store.Accounts()
+	if err != nil {
+		invoiceSub.Cancel()
+		return err
+	}
+
+	for _, account := range accounts {
+		for hash := range account.Invoices {
+			err := s.resolveInvoice(account.ID, hash)
+			if err != nil {
+				invoiceSub.Cancel()
+				return err
+			}
+		}
+
+		for hash := range account.Payments {
+			s.trackPayment(account.ID, hash)
+		}
+	}
+
+	log.Infof("Tracking %v accounts", len(accounts))
+
+	s.wg.Add(1)
+	go s.invoiceLoop()
+
+	return nil
+}
+
+// Stop stops tracking the payments and invoices of the accounts.
+func (s *Service) Stop() {
+	s.stopped.Do(func() {
+		log.Info("Account service shutting down")
+
+		if s.invoiceSub != nil {
+			s.invoiceSub.Cancel()
+		}
+
+		close(s.quit)
+		s.wg.Wait()
+	})
+}
+
+// NewAccount creates a new account with the given balance. A zero expiration
+// date creates an account that never expires.
+func (s *Service) NewAccount(balance lnwire.MilliAtom,
+	expirationDate time.Time) (*channeldb.Account, error) {
+
+	return s.cfg.Store.NewAccount(balance, expirationDate)
+}
+
+// Accounts returns all accounts.
+func (s *Service) Accounts() ([]*channeldb.Account, error) {
+	return s.cfg.Store.Accounts()
+}
+
+// RemoveAccount removes the account with the given ID. Macaroons bound to the
+// account can't make any payments afterwards.
+func (s *Service) RemoveAccount(id channeldb.AccountID) error {
+	if err := s.cfg.Store.RemoveAccount(id); err != nil {
+		return err
+	}
+
+	s.invoiceMtx.Lock()
+	for hash, accountID := range s.invoiceAccounts {
+		if accountID == id {
+			delete(s.invoiceAccounts, hash)
+		}
+	}
+	s.invoiceMtx.Unlock()
+
+	return nil
+}
+
+// ReservePayment debits the maximum amount a payment can cost from the
+// account the macaroon of the call is bound to. A nil reservation is returned
+// if the macaroon isn't bound to an account, so calls made with such a
+// macaroon are never restricted. The outcome of the payment must be reported
+// to the reservation.
+func (s *Service) ReservePayment(ctx context.Context, hash lntypes.Hash,
+	amt lnwire.MilliAtom) (*Reservation, error) {
+
+	id, ok, err := AccountFromContext(ctx)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	if err := s.cfg.Store.ReservePayment(id, hash, amt); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Reserved %v for payment %v from account %x", amt, hash,
+		id[:])
+
+	return &Reservation{
+		service:   s,
+		accountID: id,
+		hash:      hash,
+	}, nil
+}
+
+// AddInvoice records an invoice that was added with the macaroon of the call,
+// so it is credited to the account the macaroon is bound to once it is
+// settled. Invoices added with a macaroon that isn't bound to an account are
+// ignored.
+func (s *Service) AddInvoice(ctx context.Context, hash lntypes.Hash) error {
+	id, ok, err := AccountFromContext(ctx)
+	if err != nil || !ok {
+		return err
+	}
+
+	if err := s.cfg.Store.AddInvoice(id, hash); err != nil {
+		return err
+	}
+
+	s.invoiceMtx.Lock()
+	s.invoiceAccounts[hash] = id
+	s.invoiceMtx.Unlock()
+
+	return nil
+}
+
+// resolveInvoice credits a pending invoice of an account if it was settled
+// while the service was down and starts tracking it otherwise.
+func (s *Service) resolveInvoice(id channeldb.AccountID,
+	hash lntypes.Hash) error {
+
+	invoice, err := s.cfg.LookupInvoice(hash)
+	switch {
+	// An invoice that no longer exists can't be settled anymore.
+	case err == channeldb.ErrInvoiceNotFound:
+		return s.cfg.Store.RemoveInvoice(id, hash)
+
+	case err != nil:
+		return err
+	}
+
+	switch invoice.State {
+	case channeldb.ContractSettled:
+		return s.cfg.Store.SettleInvoice(id, hash, invoice.AmtPaid)
+
+	case channeldb.ContractCanceled:
+		return s.cfg.Store.RemoveInvoice(id, hash)
+	}
+
+	s.invoiceMtx.Lock()
+	s.invoiceAccounts[hash] = id
+	s.invoiceMtx.Unlock()
+
+	return nil
+}
+
+// invoiceLoop credits the settled invoices of the accounts.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *Service) invoiceLoop() {
+	defer s.wg.Done()
+
+	for {
+		select {
+		// New invoices must be consumed so the subscription isn't
+		// blocked, but they are recorded by AddInvoice already.
+		case <-s.invoiceSub.NewInvoices:
+
+		case invoice := <-s.invoiceSub.SettledInvoices:
+			// Invoices without a preimage are AMP invoices, which
+			// can't be added with an account.
+			if invoice.Terms.PaymentPreimage == nil {
+				continue
+			}
+			hash := invoice.Terms.PaymentPreimage.Hash()
+
+			s.invoiceMtx.Lock()
+			id, ok := s.invoiceAccounts[hash]
+			delete(s.invoiceAccounts, hash)
+			s.invoiceMtx.Unlock()
+
+			if !ok {
+				continue
+			}
+
+			err := s.cfg.Store.SettleInvoice(
+				id, hash, invoice.AmtPaid,
+			)
+			if err != nil {
+				log.Errorf("Unable to credit invoice %v to "+
+					"account %x: %v", hash, id[:], err)
+				continue
+			}
+
+			log.Debugf("Credited %v of invoice %v to account %x",
+				invoice.AmtPaid, hash, id[:])
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// trackPayment waits for the outcome of a pending payment of an account in
+// the background and settles it with the account.
+func (s *Service) trackPayment(id channeldb.AccountID, hash lntypes.Hash) {
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		sub, err := s.cfg.SubscribePayment(hash)
+		switch {
+		// The payment was never dispatched, so it didn't cost
+		// anything.
+		case err == channeldb.ErrPaymentNotInitiated:
+			s.releasePayment(id, hash)
+			return
+
+		case err != nil:
+			log.Errorf("Unable to track payment %v of account "+
+				"%x: %v", hash, id[:], err)
+			return
+		}
+		defer sub.Close()
+
+		for {
+			select {
+			case item, ok := <-sub.Updates:
+				if !ok {
+					return
+				}
+
+				payment := item.(*channeldb.MPPayment)
+				switch payment.Status {
+				case channeldb.StatusSucceeded:
+					sent, fees := payment.SentAmt()
+					s.settlePayment(id, hash, sent+fees)
+					return
+
+				case channeldb.StatusFailed:
+					s.releasePayment(id, hash)
+					return
+				}
+
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+}
+
+// settlePayment debits the final cost of a succeeded payment from an account.
+func (s *Service) settlePayment(id channeldb.AccountID, hash lntypes.Hash,
+	amtPaid lnwire.MilliAtom) {
+
+	if err := s.cfg.Store.SettlePayment(id, hash, amtPaid); err != nil {
+		log.Errorf("Unable to settle payment %v of account %x: %v",
+			hash, id[:], err)
+		return
+	}
+
+	log.Debugf("Settled payment %v of account %x for %v", hash, id[:],
+		amtPaid)
+}
+
+// releasePayment credits the reserved amount of a failed payment back to an
+// account.
+func (s *Service) releasePayment(id channeldb.AccountID, hash lntypes.Hash) {
+	if err := s.cfg.Store.ReleasePayment(id, hash); err != nil {
+		log.Errorf("Unable to release payment %v of account %x: %v",
+			hash, id[:], err)
+		return
+	}
+
+	log.Debugf("Released payment %v of account %x", hash, id[:])
+}
+
+// Reservation is the amount that was reserved from an account for a single
+// payment. It must be resolved with the outcome of the payment once that is
+// known. All methods can be called on a nil reservation, which is returned
+// for calls that aren't bound to an account.
+type Reservation struct {
+	service   *Service
+	accountID channeldb.AccountID
+	hash      lntypes.Hash
+}
+
+// Settle debits the final cost of the succeeded payment, including fees,
+// from the account and credits the rest of the reserved amount back to it.
+func (r *Reservation) Settle(amtPaid lnwire.MilliAtom) {
+	if r == nil {
+		return
+	}
+
+	r.service.settlePayment(r.accountID, r.hash, amtPaid)
+}
+
+// Release credits the full reserved amount of the failed payment back to the
+// account.
+func (r *Reservation) Release() {
+	if r == nil {
+		return
+	}
+
+	r.service.releasePayment(r.accountID, r.hash)
+}
+
+// Track settles the payment with the account once it completes. It is used
+// for payments that are dispatched asynchronously.
+func (r *Reservation) Track() {
+	if r == nil {
+		return
+	}
+
+	r.service.trackPayment(r.accountID, r.hash)
+}
+
+// AccountFromContext returns the ID of the account the macaroon of an RPC
+// call is bound to. If the call wasn't made with an account macaroon, false
+// is returned.
+func AccountFromContext(ctx context.Context) (channeldb.AccountID, bool,
+	error) {
+
+	mac, _, err := macaroons.MacaroonFromContext(ctx)
+	if err != nil {
+		return channeldb.AccountID{}, false, err
+	}
+
+	id, ok, err := macaroons.AccountIDFromMacaroon(mac)
+	if err != nil || !ok {
+		return channeldb.AccountID{}, false, err
+	}
+
+	return channeldb.AccountID(id), true, nil
+}
@@ -0,0 +1,144 @@
+package accounts
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/decred/dcrlnd/routing"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon.v2"
+)
+
+// accountContext returns a context that carries a macaroon bound to the
+// account with the given ID.
+func accountContext(t *testing.T, id channeldb.AccountID) context.Context {
+	mac, err := macaroon.New(
+		[]byte("root-key"), []byte("id"), "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	constraint := macaroons.AccountConstraint(id)
+	require.NoError(t, constraint(mac))
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	md := metadata.Pairs("macaroon", hex.EncodeToString(macBytes))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// newTestService creates an account service backed by a fresh database along
+// with an account with the given balance.
+func newTestService(t *testing.T,
+	balance lnwire.MilliAtom) (*Service, *channeldb.Account, func()) {
+
+	db, cleanup, err := channeldb.MakeTestDB()
+	require.NoError(t, err)
+
+	s := NewService(&Config{
+		Store: channeldb.NewAccountStore(db),
+		SubscribePayment: func(lntypes.Hash) (
+			*routing.ControlTowerSubscriber, error) {
+
+			return nil, channeldb.ErrPaymentNotInitiated
+		},
+		LookupInvoice: func(lntypes.Hash) (channeldb.Invoice, error) {
+			return channeldb.Invoice{
+				State:   channeldb.ContractSettled,
+				AmtPaid: 1000,
+			}, nil
+		},
+	})
+
+	account, err := s.NewAccount(balance, time.Time{})
+	require.NoError(t, err)
+
+	return s, account, cleanup
+}
+
+// assertBalance asserts the current balance of the account.
+func assertBalance(t *testing.T, s *Service, id channeldb.AccountID,
+	expected lnwire.MilliAtom) {
+
+	account, err := s.cfg.Store.Account(id)
+	require.NoError(t, err)
+	require.Equal(t, expected, account.CurrentBalance)
+}
+
+// TestReservePayment tests that payments made with an account macaroon are
+// limited to the balance of the account, while payments made with other
+// macaroons aren't restricted.
+func TestReservePayment(t *testing.T) {
+	t.Parallel()
+
+	s, account, cleanup := newTestService(t, 10000)
+	defer cleanup()
+	defer s.Stop()
+
+	// A call without a macaroon isn't bound to an account.
+	reservation, err := s.ReservePayment(
+		context.Background(), lntypes.Hash{1}, 100000,
+	)
+	require.NoError(t, err)
+	require.Nil(t, reservation)
+	reservation.Settle(100000)
+
+	ctx := accountContext(t, account.ID)
+	_, err = s.ReservePayment(ctx, lntypes.Hash{1}, 10001)
+	require.Equal(t, channeldb.ErrAccountBalanceInsufficient, err)
+
+	// A succeeded payment is debited with its final cost.
+	reservation, err = s.ReservePayment(ctx, lntypes.Hash{1}, 5000)
+	require.NoError(t, err)
+	assertBalance(t, s, account.ID, 5000)
+	reservation.Settle(4000)
+	assertBalance(t, s, account.ID, 6000)
+
+	// A failed payment is credited back in full.
+	reservation, err = s.ReservePayment(ctx, lntypes.Hash{2}, 5000)
+	require.NoError(t, err)
+	assertBalance(t, s, account.ID, 1000)
+	reservation.Release()
+	assertBalance(t, s, account.ID, 6000)
+
+	// A tracked payment that was never dispatched is credited back once
+	// the tracking finds out about it.
+	reservation, err = s.ReservePayment(ctx, lntypes.Hash{3}, 5000)
+	require.NoError(t, err)
+	reservation.Track()
+	s.wg.Wait()
+	assertBalance(t, s, account.ID, 6000)
+
+	// Payments from a removed account are refused.
+	require.NoError(t, s.RemoveAccount(account.ID))
+	_, err = s.ReservePayment(ctx, lntypes.Hash{4}, 1)
+	require.Equal(t, channeldb.ErrAccountNotFound, err)
+}
+
+// TestResolveInvoice tests that an invoice that was settled while the service
+// was down is credited to its account.
+func TestResolveInvoice(t *testing.T) {
+	t.Parallel()
+
+	s, account, cleanup := newTestService(t, 0)
+	defer cleanup()
+	defer s.Stop()
+
+	ctx := accountContext(t, account.ID)
+	hash := lntypes.Hash{1}
+	require.NoError(t, s.AddInvoice(ctx, hash))
+
+	require.NoError(t, s.resolveInvoice(account.ID, hash))
+	assertBalance(t, s, account.ID, 1000)
+
+	// The invoice must only be credited once.
+	require.NoError(t, s.resolveInvoice(account.ID, hash))
+	assertBalance(t, s, account.ID, 1000)
+}
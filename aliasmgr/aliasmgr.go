@@ -0,0 +1,84 @@
+package aliasmgr
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+const (
+	// StartingBlockHeight is the first block height of the range of short
+	// channel ids that are reserved for aliases. It is far enough in the
+	// future that no real short channel id will be using it for decades.
+	StartingBlockHeight = 16_000_000
+
+	// EndBlockHeight is the block height right after the range of short
+	// channel ids that are reserved for aliases.
+	EndBlockHeight = 16_250_000
+
+	// ZeroConfEndBlockHeight splits the alias range in two. Aliases of
+	// zero-conf channels are derived below it, and aliases of private
+	// channels are handed out sequentially from it, so the two kinds of
+	// aliases never collide.
+	ZeroConfEndBlockHeight = 16_125_000
+
+	// maxTxIndex is the largest transaction index that fits into a short
+	// channel id.
+	maxTxIndex = 1<<24 - 1
+)
+
+// IsAlias returns true if the given short channel id is within the range that
+// is reserved for aliases.
+func IsAlias(scid lnwire.ShortChannelID) bool {
+	return scid.BlockHeight >= StartingBlockHeight &&
+		scid.BlockHeight < EndBlockHeight
+}
+
+// ZeroConfAlias derives the alias short channel id of a zero-conf channel
+// from its channel id. Both parties of the channel derive the same alias, so
+// the channel can be referenced by it before the funding transaction confirms
+// without any further negotiation. The channel id is hashed so the alias
+// doesn't reveal the funding outpoint of the channel.
+func ZeroConfAlias(chanID lnwire.ChannelID) lnwire.ShortChannelID {
+	h := sha256.Sum256(chanID[:])
+
+	heightOffset := binary.BigEndian.Uint32(h[0:4]) %
+		(ZeroConfEndBlockHeight - StartingBlockHeight)
+
+	return lnwire.ShortChannelID{
+		BlockHeight: StartingBlockHeight + heightOffset,
+		TxIndex:     binary.BigEndian.Uint32(h[4:8]) & maxTxIndex,
+		TxPosition:  binary.BigEndian.Uint16(h[8:10]),
+	}
+}
+
+// FirstAlias is the first alias that is handed out to a private channel.
+var FirstAlias = lnwire.ShortChannelID{
+	BlockHeight: ZeroConfEndBlockHeight,
+}
+
+// NextAlias returns the alias that is handed out after the given alias. Aliases
+// of private channels are handed out sequentially, starting at FirstAlias. If
+// the given alias lies below FirstAlias, within the range of zero-conf
+// aliases, FirstAlias is returned.
+func NextAlias(alias lnwire.ShortChannelID) lnwire.ShortChannelID {
+	if alias.BlockHeight < FirstAlias.BlockHeight {
+		return FirstAlias
+	}
+
+	alias.TxPosition++
+	if alias.TxPosition != 0 {
+		return alias
+	}
+
+	alias.TxIndex++
+	if alias.TxIndex <= maxTxIndex {
+		return alias
+	}
+
+	alias.TxIndex = 0
+	alias.BlockHeight++
+
+	return alias
+}
@@ -0,0 +1,74 @@
+package aliasmgr
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/stretchr/testify/require"
+)
+
+// TestZeroConfAlias tests that the aliases derived for zero-conf channels are
+// deterministic and within the range reserved for zero-conf aliases.
+func TestZeroConfAlias(t *testing.T) {
+	t.Parallel()
+
+	for i := 0; i < 100; i++ {
+		var chanID lnwire.ChannelID
+		chanID[0] = byte(i)
+		chanID[31] = byte(i * 7)
+
+		alias := ZeroConfAlias(chanID)
+		require.True(t, IsAlias(alias))
+		require.Less(t, alias.BlockHeight,
+			uint32(ZeroConfEndBlockHeight))
+		require.Equal(t, alias, ZeroConfAlias(chanID))
+	}
+
+	// Real short channel ids must not be considered aliases.
+	require.False(t, IsAlias(lnwire.ShortChannelID{BlockHeight: 600_000}))
+	require.False(t, IsAlias(lnwire.ShortChannelID{
+		BlockHeight: EndBlockHeight,
+	}))
+}
+
+// TestNextAlias tests that aliases are handed out sequentially and remain
+// within the reserved alias range, above the zero-conf aliases.
+func TestNextAlias(t *testing.T) {
+	t.Parallel()
+
+	alias := NextAlias(FirstAlias)
+	require.True(t, IsAlias(alias))
+	require.Equal(t, lnwire.ShortChannelID{
+		BlockHeight: ZeroConfEndBlockHeight,
+		TxPosition:  1,
+	}, alias)
+
+	// The tx position overflows into the tx index.
+	alias = NextAlias(lnwire.ShortChannelID{
+		BlockHeight: ZeroConfEndBlockHeight,
+		TxIndex:     5,
+		TxPosition:  1<<16 - 1,
+	})
+	require.Equal(t, lnwire.ShortChannelID{
+		BlockHeight: ZeroConfEndBlockHeight,
+		TxIndex:     6,
+	}, alias)
+
+	// The tx index overflows into the block height.
+	alias = NextAlias(lnwire.ShortChannelID{
+		BlockHeight: ZeroConfEndBlockHeight,
+		TxIndex:     maxTxIndex,
+		TxPosition:  1<<16 - 1,
+	})
+	require.Equal(t, lnwire.ShortChannelID{
+		BlockHeight: ZeroConfEndBlockHeight + 1,
+	}, alias)
+	require.True(t, IsAlias(alias))
+
+	// Aliases within the zero-conf range are skipped.
+	alias = NextAlias(lnwire.ShortChannelID{
+		BlockHeight: StartingBlockHeight,
+		TxPosition:  3,
+	})
+	require.Equal(t, FirstAlias, alias)
+}
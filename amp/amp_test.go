@@ -0,0 +1,98 @@
+package amp_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/decred/dcrlnd/amp"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/shards"
+)
+
+// TestAMPShardTracker asserts that the shares handed out by the ShardTracker
+// always reconstruct the root share, even after some shards are canceled and
+// their shares returned to the tracker, and that the receiver derives the same
+// child hashes as the sender.
+func TestAMPShardTracker(t *testing.T) {
+	var root, setID, payAddr [32]byte
+	if _, err := rand.Read(root[:]); err != nil {
+		t.Fatalf("unable to read random root: %v", err)
+	}
+	if _, err := rand.Read(setID[:]); err != nil {
+		t.Fatalf("unable to read random set id: %v", err)
+	}
+	if _, err := rand.Read(payAddr[:]); err != nil {
+		t.Fatalf("unable to read random pay addr: %v", err)
+	}
+
+	const totalAmt = lnwire.MilliAtom(3000)
+	tracker := amp.NewShardTracker(root, setID, payAddr, totalAmt)
+
+	// Launch three shards, then fail the second one. Its share should be
+	// handed back to the tracker.
+	active := make(map[uint64]shards.PaymentShard)
+	for id := uint64(0); id < 3; id++ {
+		shard, err := tracker.NewShard(id, false)
+		if err != nil {
+			t.Fatalf("unable to create shard: %v", err)
+		}
+		active[id] = shard
+	}
+
+	if err := tracker.CancelShard(1); err != nil {
+		t.Fatalf("unable to cancel shard: %v", err)
+	}
+	delete(active, 1)
+
+	if _, err := tracker.GetHash(1); err == nil {
+		t.Fatalf("expected error for canceled shard")
+	}
+
+	// Finally, launch the last shard, which should pick up all remaining
+	// shares including the one returned by the canceled shard.
+	shard, err := tracker.NewShard(3, true)
+	if err != nil {
+		t.Fatalf("unable to create shard: %v", err)
+	}
+	active[3] = shard
+
+	// Using only the information carried in the onion, the receiver must
+	// be able to reconstruct all child hashes.
+	var (
+		ids   []uint64
+		descs []amp.ChildDesc
+	)
+	for id, shard := range active {
+		if shard.MPP() == nil || shard.AMP() == nil {
+			t.Fatalf("shard %d missing mpp or amp record", id)
+		}
+		if shard.MPP().TotalMAtoms() != totalAmt {
+			t.Fatalf("incorrect total amount")
+		}
+		if shard.AMP().SetID() != setID {
+			t.Fatalf("incorrect set id")
+		}
+
+		ids = append(ids, id)
+		descs = append(descs, amp.ChildDesc{
+			Share: shard.AMP().RootShare(),
+			Index: shard.AMP().ChildIndex(),
+		})
+	}
+
+	children := amp.ReconstructChildren(descs...)
+	for i, child := range children {
+		hash, err := tracker.GetHash(ids[i])
+		if err != nil {
+			t.Fatalf("unable to get hash: %v", err)
+		}
+		if child.Hash != hash {
+			t.Fatalf("shard %d: reconstructed hash mismatch, "+
+				"want %v, got %v", ids[i], hash, child.Hash)
+		}
+		if child.Preimage.Hash() != child.Hash {
+			t.Fatalf("shard %d: preimage does not match hash",
+				ids[i])
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package amp
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/record"
+	"github.com/decred/dcrlnd/routing/shards"
+)
+
+// Shard is an implementation of the shards.PaymentShards interface specific
+// to AMP payments.
+type Shard struct {
+	child *Child
+	mpp   *record.MPP
+	amp   *record.AMP
+}
+
+// A compile time check to ensure Shard implements the shards.PaymentShard
+// interface.
+var _ shards.PaymentShard = (*Shard)(nil)
+
+// Hash returns the hash used for the HTLC representing this AMP shard.
+func (s *Shard) Hash() lntypes.Hash {
+	return s.child.Hash
+}
+
+// MPP returns any extra MPP records that should be set for the final hop on
+// the route used by this shard.
+func (s *Shard) MPP() *record.MPP {
+	return s.mpp
+}
+
+// AMP returns any extra AMP records that should be set for the final hop on
+// the route used by this shard.
+func (s *Shard) AMP() *record.AMP {
+	return s.amp
+}
+
+// ShardTracker is an implementation of the shards.ShardTracker interface that
+// is able to generate payment shards according to the AMP splitting algorithm.
+// It can be used to generate new hashes to use for HTLCs, and also cancel
+// shares used for failed payment shards.
+type ShardTracker struct {
+	setID       [32]byte
+	paymentAddr [32]byte
+	totalAmt    lnwire.MilliAtom
+
+	sharer    Sharer
+	nextIndex uint32
+
+	shards map[uint64]*Child
+	sync.Mutex
+}
+
+// A compile time check to ensure ShardTracker implements the
+// shards.ShardTracker interface.
+var _ shards.ShardTracker = (*ShardTracker)(nil)
+
+// NewShardTracker creates a new shard tracker to use for AMP payments. The
+// root shard, setID, payment address and total amount must be correctly set
+// in order for the TLV options to include with each shard to be created
+// correctly.
+func NewShardTracker(root, setID, payAddr [32]byte,
+	totalAmt lnwire.MilliAtom) *ShardTracker {
+
+	// Create a new seed sharer from this root.
+	rootShare := Share(root)
+	sharer := SeedSharerFromRoot(&rootShare)
+
+	return &ShardTracker{
+		setID:       setID,
+		paymentAddr: payAddr,
+		totalAmt:    totalAmt,
+		sharer:      sharer,
+		shards:      make(map[uint64]*Child),
+	}
+}
+
+// NewShard registers a new attempt with the ShardTracker and returns a new
+// shard representing this attempt. This attempt's shard should be canceled if
+// it ends up not being used by the overall payment, i.e. if the attempt fails.
+func (s *ShardTracker) NewShard(pid uint64, last bool) (shards.PaymentShard,
+	error) {
+
+	s.Lock()
+	defer s.Unlock()
+
+	// Use a fresh child index for every shard, ensuring that no two HTLCs
+	// of the same set carry the same payment hash.
+	childIndex := s.nextIndex
+	s.nextIndex++
+
+	// Depending on whether we are requesting the last shard or not, either
+	// split the current share into two, or get a Sharer holding all the
+	// remaining shares available.
+	var sharer Sharer
+	if last {
+		sharer = s.sharer
+		s.sharer = s.sharer.Zero()
+	} else {
+		left, right, err := s.sharer.Split()
+		if err != nil {
+			return nil, err
+		}
+
+		sharer = left
+		s.sharer = right
+	}
+
+	// Now that we have a sharer for this shard, derive the child hash and
+	// preimage that the HTLC will use.
+	child := sharer.Child(childIndex)
+
+	// Track the new child so we can return its share to the remaining
+	// share in case the attempt fails.
+	s.shards[pid] = child
+
+	mpp := record.NewMPP(s.totalAmt, s.paymentAddr)
+	amp := record.NewAMP(child.ChildDesc.Share, s.setID, child.ChildDesc.Index)
+
+	return &Shard{
+		child: child,
+		mpp:   mpp,
+		amp:   amp,
+	}, nil
+}
+
+// CancelShard cancel's the shard corresponding to the given attempt ID. The
+// share used by the shard is merged back into the remaining share of the
+// payment.
+func (s *ShardTracker) CancelShard(pid uint64) error {
+	s.Lock()
+	defer s.Unlock()
+
+	c, ok := s.shards[pid]
+	if !ok {
+		return fmt.Errorf("child with id %v not found", pid)
+	}
+
+	// Now that we are canceling this shard, we XOR the share back into
+	// our remaining share.
+	sharer := initSeedSharer(&Share{}, &c.Share)
+	s.sharer = s.sharer.Merge(sharer)
+
+	// Remove the child from our map of shards.
+	delete(s.shards, pid)
+
+	return nil
+}
+
+// GetHash retrieves the hash used by the shard of the given attempt ID. This
+// will return an error if the attempt ID is unknown.
+func (s *ShardTracker) GetHash(pid uint64) (lntypes.Hash, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	c, ok := s.shards[pid]
+	if !ok {
+		return lntypes.Hash{}, fmt.Errorf("AMP shard for attempt %v "+
+			"not found", pid)
+	}
+
+	return c.Hash, nil
+}
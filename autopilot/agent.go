@@ -11,7 +11,9 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/ticker"
 )
 
 // Config couples all the items that an autopilot agent needs to function.
@@ -58,8 +60,26 @@ type Config struct {
 	// when opening channels.
 	Constraints AgentConstraints
 
-	// TODO(roasbeef): add additional signals from fee rates and revenue of
-	// currently opened channels
+	// EvaluationTicker triggers the periodic evaluation of the existing
+	// channels if the Heuristic implements the ChannelEvaluator
+	// interface. If nil, channels are never evaluated.
+	EvaluationTicker ticker.Ticker
+
+	// CloseScore is the channel score below which evaluated channels are
+	// closed.
+	CloseScore float64
+
+	// DownsizeScore is the channel score below which evaluated channels
+	// are downsized. A channel is downsized by closing it and opening a
+	// channel to the same node whose capacity is reduced in proportion to
+	// the score. It must not be lower than CloseScore.
+	DownsizeScore float64
+
+	// IsLocalFunded returns true if the channel with the given funding
+	// outpoint was funded by the local node. Only such channels are
+	// evaluated, so channels funded by the remote node are never closed or
+	// downsized. If nil, no channels are evaluated.
+	IsLocalFunded func(wire.OutPoint) (bool, error)
 }
 
 // channelState is a type that represents the set of active channels of the
@@ -164,7 +184,16 @@ type Agent struct {
 	// This state is required as otherwise, we may go over our allotted
 	// channel limit, or open multiple channels to the same node.
 	pendingOpens map[NodeID]Channel
-	pendingMtx   sync.Mutex
+
+	// pendingResizes maps the nodes whose channels are being closed in
+	// order to downsize them to the capacity of the channel that should be
+	// opened instead once the old channel has closed.
+	pendingResizes map[NodeID]dcrutil.Amount
+
+	// closedNodes lists nodes whose channels we've closed after
+	// evaluating them, so we don't open channels to them again.
+	closedNodes map[NodeID]struct{}
+	pendingMtx  sync.Mutex
 
 	quit chan struct{}
 	wg   sync.WaitGroup
@@ -188,6 +217,8 @@ func New(cfg Config, initialState []Channel) (*Agent, error) {
 		failedNodes:        make(map[NodeID]struct{}),
 		pendingConns:       make(map[NodeID]struct{}),
 		pendingOpens:       make(map[NodeID]Channel),
+		pendingResizes:     make(map[NodeID]dcrutil.Amount),
+		closedNodes:        make(map[NodeID]struct{}),
 	}
 
 	for _, c := range initialState {
@@ -211,6 +242,10 @@ func (a *Agent) start() error {
 	rand.Seed(time.Now().Unix())
 	log.Infof("Autopilot Agent starting")
 
+	if a.cfg.EvaluationTicker != nil {
+		a.cfg.EvaluationTicker.Resume()
+	}
+
 	a.wg.Add(1)
 	go a.controller()
 
@@ -233,6 +268,12 @@ func (a *Agent) stop() error {
 	close(a.quit)
 	a.wg.Wait()
 
+	// The ticker is only paused, since the manager may start a new agent
+	// with the same config.
+	if a.cfg.EvaluationTicker != nil {
+		a.cfg.EvaluationTicker.Pause()
+	}
+
 	return nil
 }
 
@@ -417,6 +458,11 @@ func (a *Agent) controller() {
 		a.totalBalance = newBalance
 	}
 
+	var evaluationTicks <-chan time.Time
+	if a.cfg.EvaluationTicker != nil {
+		evaluationTicks = a.cfg.EvaluationTicker.Ticks()
+	}
+
 	// TODO(roasbeef): add 10-minute wake up timer
 	for {
 		select {
@@ -495,6 +541,15 @@ func (a *Agent) controller() {
 			log.Debugf("Heuristic %v updated, assessing need for "+
 				"more channels", upd.heuristic.Name())
 
+		// It's time to evaluate our existing channels. Closing them
+		// only frees up funds once the closes confirm, so there's no
+		// need to assess the need for more channels yet.
+		case <-evaluationTicks:
+			log.Debugf("Evaluating existing channels")
+
+			a.evaluateChans()
+			continue
+
 		// The agent has been signalled to exit, so we'll bail out
 		// immediately.
 		case <-a.quit:
@@ -584,8 +639,19 @@ func (a *Agent) openChans(availableFunds dcrutil.Amount, numChans uint32,
 		log.Tracef("Skipping failed node %v", nID[:])
 	}
 
+	for nID := range a.closedNodes {
+		log.Tracef("Skipping node %x with closed channel", nID[:])
+	}
+
+	resizeNodes := make(map[NodeID]struct{}, len(a.pendingResizes))
+	for nID := range a.pendingResizes {
+		log.Tracef("Skipping node %x with pending resize", nID[:])
+		resizeNodes[nID] = struct{}{}
+	}
+
 	nodesToSkip := mergeNodeMaps(a.pendingOpens,
 		a.pendingConns, connectedNodes, a.failedNodes,
+		a.closedNodes, resizeNodes,
 	)
 
 	a.pendingMtx.Unlock()
@@ -629,6 +695,12 @@ func (a *Agent) openChans(availableFunds dcrutil.Amount, numChans uint32,
 		return fmt.Errorf("unable to get graph nodes: %v", err)
 	}
 
+	// Channels that were closed in order to be downsized are replaced
+	// before any new candidates are considered.
+	chanCandidates := a.resizeDirectives(
+		connectedNodes, addresses, &availableFunds, &numChans,
+	)
+
 	// Use the heuristic to calculate a score for each node in the
 	// graph.
 	log.Debugf("Scoring %d nodes for chan_size=%v", len(nodes), chanSize)
@@ -649,7 +721,6 @@ func (a *Agent) openChans(availableFunds dcrutil.Amount, numChans uint32,
 			err)
 	}
 
-	chanCandidates := make(map[NodeID]*AttachmentDirective)
 	for nID := range scores {
 		log.Tracef("Creating attachment directive for chosen node %x",
 			nID[:])
@@ -718,6 +789,150 @@ func (a *Agent) openChans(availableFunds dcrutil.Amount, numChans uint32,
 	return nil
 }
 
+// resizeDirectives returns the attachment directives that replace the
+// channels that were closed in order to downsize them, once they have closed
+// and enough funds are available. The funds and number of channels used by the
+// directives are subtracted from the given budget.
+func (a *Agent) resizeDirectives(connectedNodes map[NodeID]struct{},
+	addresses map[NodeID][]net.Addr, availableFunds *dcrutil.Amount,
+	numChans *uint32) map[NodeID]*AttachmentDirective {
+
+	a.pendingMtx.Lock()
+	defer a.pendingMtx.Unlock()
+
+	directives := make(map[NodeID]*AttachmentDirective)
+	for nID, chanAmt := range a.pendingResizes {
+		if *numChans == 0 {
+			break
+		}
+
+		// The old channel hasn't closed yet, or the funds it held
+		// aren't available yet.
+		if _, ok := connectedNodes[nID]; ok {
+			continue
+		}
+		if chanAmt > *availableFunds {
+			continue
+		}
+
+		// Without any known addresses the node can't be reached, so
+		// we give up on it.
+		addrs, ok := addresses[nID]
+		if !ok {
+			log.Debugf("Dropping resize of channel with node %x "+
+				"without known addresses", nID[:])
+			delete(a.pendingResizes, nID)
+			continue
+		}
+
+		log.Tracef("Creating resize directive for node %x with "+
+			"chan_size=%v", nID[:], chanAmt)
+
+		directives[nID] = &AttachmentDirective{
+			NodeID:  nID,
+			ChanAmt: chanAmt,
+			Addrs:   addrs,
+		}
+		delete(a.pendingResizes, nID)
+
+		*availableFunds -= chanAmt
+		*numChans--
+	}
+
+	return directives
+}
+
+// evaluateChans scores the existing channels that we funded if the heuristic
+// implements the ChannelEvaluator interface, and closes or downsizes the
+// channels that score below the configured thresholds. Closes that don't
+// complete, e.g. because the peer is offline, are retried on the next
+// evaluation.
+func (a *Agent) evaluateChans() {
+	evaluator, ok := a.cfg.Heuristic.(ChannelEvaluator)
+	if !ok || a.cfg.IsLocalFunded == nil {
+		return
+	}
+
+	a.chanStateMtx.Lock()
+	allChans := a.chanState.Channels()
+	a.chanStateMtx.Unlock()
+
+	// Closing a channel the remote node funded would give up inbound
+	// liquidity we didn't pay for, and downsizing it would replace it with
+	// a channel funded by us, so those channels are left alone.
+	chans := make([]Channel, 0, len(allChans))
+	for _, c := range allChans {
+		localFunded, err := a.cfg.IsLocalFunded(c.ChanPoint)
+		if err != nil {
+			log.Debugf("Skipping evaluation of channel %v: %v",
+				c.ChanID, err)
+			continue
+		}
+		if !localFunded {
+			continue
+		}
+
+		chans = append(chans, c)
+	}
+
+	scores, err := evaluator.ChannelScores(chans)
+	if err != nil {
+		log.Errorf("Unable to evaluate channels: %v", err)
+		return
+	}
+
+	minChanSize := a.cfg.Constraints.MinChanSize()
+
+	a.pendingMtx.Lock()
+	defer a.pendingMtx.Unlock()
+
+	for _, c := range chans {
+		score, ok := scores[c.ChanID]
+		if !ok || (score >= a.cfg.CloseScore &&
+			score >= a.cfg.DownsizeScore) {
+
+			continue
+		}
+
+		// Channels scoring between the close and downsize scores are
+		// replaced by a channel that is smaller in proportion to the
+		// score, unless it wouldn't be much smaller.
+		var newSize dcrutil.Amount
+		if score >= a.cfg.CloseScore {
+			ratio := score / a.cfg.DownsizeScore
+			newSize = dcrutil.Amount(float64(c.Capacity) * ratio)
+			if newSize < minChanSize {
+				newSize = minChanSize
+			}
+			if c.Capacity-newSize < minChanSize {
+				continue
+			}
+		}
+
+		chanPoint := c.ChanPoint
+		err := a.cfg.ChanController.CloseChannel(&chanPoint)
+		if err != nil {
+			log.Warnf("Unable to close channel %v with node %x: %v",
+				c.ChanID, c.Node[:], err)
+			continue
+		}
+
+		if newSize == 0 {
+			log.Infof("Closing channel %v with node %x, score=%v",
+				c.ChanID, c.Node[:], score)
+
+			a.closedNodes[c.Node] = struct{}{}
+			continue
+		}
+
+		log.Infof("Downsizing channel %v with node %x from %v to %v, "+
+			"score=%v", c.ChanID, c.Node[:], c.Capacity, newSize,
+			score)
+
+		a.pendingResizes[c.Node] = newSize
+	}
+}
+
 // executeDirective attempts to connect to the channel candidate specified by
 // the given attachment directive, and open a channel of the given size.
 //
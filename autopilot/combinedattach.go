@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/lnwire"
 )
 
 // WeightedHeuristic is a tuple that associates a weight to an
@@ -44,9 +45,10 @@ func NewWeightedCombAttachment(h ...*WeightedHeuristic) (
 }
 
 // A compile time assertion to ensure WeightedCombAttachment meets the
-// AttachmentHeuristic and ScoreSettable interfaces.
+// AttachmentHeuristic, ScoreSettable and ChannelEvaluator interfaces.
 var _ AttachmentHeuristic = (*WeightedCombAttachment)(nil)
 var _ ScoreSettable = (*WeightedCombAttachment)(nil)
+var _ ChannelEvaluator = (*WeightedCombAttachment)(nil)
 
 // Name returns the name of this heuristic.
 //
@@ -172,3 +174,53 @@ func (c *WeightedCombAttachment) SetNodeScores(targetHeuristic string,
 
 	return found, nil
 }
+
+// ChannelScores scores the given channels of the local node in the range
+// [0, 1.0], where 0 indicates a channel that contributes nothing, while 1.0
+// indicates a channel that performs as well as possible.
+//
+// The scores are determined by querying the sub-heuristics that implement the
+// ChannelEvaluator interface, then combining their scores according to their
+// weights relative to each other. Channels that none of them scored are left
+// out of the returned map.
+//
+// NOTE: This is a part of the ChannelEvaluator interface.
+func (c *WeightedCombAttachment) ChannelScores(chans []Channel) (
+	map[lnwire.ShortChannelID]float64, error) {
+
+	var (
+		sums    = make(map[lnwire.ShortChannelID]float64)
+		weights = make(map[lnwire.ShortChannelID]float64)
+	)
+	for _, h := range c.heuristics {
+		e, ok := h.AttachmentHeuristic.(ChannelEvaluator)
+		if !ok {
+			continue
+		}
+
+		log.Tracef("Getting channel scores from sub heuristic %v",
+			h.Name())
+
+		subScores, err := e.ChannelScores(chans)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get sub channel "+
+				"scores: %v", err)
+		}
+
+		for chanID, score := range subScores {
+			sums[chanID] += h.Weight * score
+			weights[chanID] += h.Weight
+		}
+	}
+
+	scores := make(map[lnwire.ShortChannelID]float64, len(sums))
+	for chanID, sum := range sums {
+		if weights[chanID] == 0 {
+			continue
+		}
+
+		scores[chanID] = sum / weights[chanID]
+	}
+
+	return scores, nil
+}
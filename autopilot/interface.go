@@ -56,6 +56,11 @@ type Channel struct {
 	// Node is the peer that this channel has been established with.
 	Node NodeID
 
+	// ChanPoint is the outpoint of the channel's funding transaction. It
+	// is only known for the channels of the local node, and is used to
+	// close them.
+	ChanPoint wire.OutPoint
+
 	// TODO(roasbeef): also add other traits?
 	//  * fee, timelock, etc
 }
@@ -147,6 +152,22 @@ type AttachmentHeuristic interface {
 		map[NodeID]*NodeScore, error)
 }
 
+// ChannelEvaluator is an interface that may be implemented by an
+// AttachmentHeuristic that is able to judge how well the existing channels of
+// the local node perform. The agent periodically closes or downsizes the
+// channels that score low.
+type ChannelEvaluator interface {
+	// ChannelScores scores the given channels of the local node in the
+	// range [0, 1.0], where 0 indicates a channel that contributes
+	// nothing, while 1.0 indicates a channel that performs as well as
+	// possible.
+	//
+	// NOTE: Channels that can't be judged yet, e.g. because they haven't
+	// been open for long enough, are left out of the returned map.
+	ChannelScores(chans []Channel) (map[lnwire.ShortChannelID]float64,
+		error)
+}
+
 // NodeMetric is a common interface for all graph metrics that are not
 // directly used as autopilot node scores but may be used in compositional
 // heuristics or statistical information exposed to users.
@@ -186,6 +207,7 @@ var (
 		NewPrefAttachment(),
 		NewExternalScoreAttachment(),
 		NewTopCentrality(),
+		NewPerformanceAttachment(),
 	}
 
 	// AvailableHeuristics is a map that holds the name of available
@@ -201,9 +201,10 @@ func (m *Manager) StartAgent() error {
 						edgeUpdate.ChanID,
 					)
 					edge := Channel{
-						ChanID:   chanID,
-						Capacity: edgeUpdate.Capacity,
-						Node:     chanNode,
+						ChanID:    chanID,
+						Capacity:  edgeUpdate.Capacity,
+						Node:      chanNode,
+						ChanPoint: edgeUpdate.ChanPoint,
 					}
 					pilot.OnChannelOpen(edge)
 				}
@@ -0,0 +1,290 @@
+package autopilot
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+const (
+	// revenueWeight is the weight of the forwarding revenue earned through
+	// a node within its performance score.
+	revenueWeight = 0.5
+
+	// fitnessWeight is the weight of the fitness of a node, which reflects
+	// its uptime, within its performance score.
+	fitnessWeight = 0.25
+
+	// successWeight is the weight of the success rate of the payment
+	// attempts routed through a node within its performance score.
+	successWeight = 0.25
+)
+
+// NodeFitness describes how reliable a node the local node has open channels
+// with has been.
+type NodeFitness struct {
+	// Score is the fitness score of the node in the range [0, 1.0], which
+	// reflects the fraction of the time it was online.
+	Score float64
+
+	// Lifespan is the longest time any of the open channels with the node
+	// has been monitored.
+	Lifespan time.Duration
+}
+
+// PerformanceConfig houses the signals the PerformanceAttachment scores nodes
+// by, along with the parameters of the scoring.
+type PerformanceConfig struct {
+	// Revenue returns the routing fees that were earned since the given
+	// time through the open and closed channels of the local node, grouped
+	// by the channels' remote nodes.
+	Revenue func(since time.Time) (map[NodeID]lnwire.MilliAtom, error)
+
+	// Fitness returns the fitness of the nodes the local node has open
+	// channels with.
+	Fitness func() (map[NodeID]*NodeFitness, error)
+
+	// SuccessRates returns the fraction of the payment attempts routed
+	// through each node that succeeded, in the range [0, 1.0]. Nodes that
+	// no payment attempts were routed through are left out.
+	SuccessRates func() (map[NodeID]float64, error)
+
+	// RevenueWindow is the time before the present over which the
+	// forwarding revenue is considered.
+	RevenueWindow time.Duration
+
+	// MinChanAge is the minimum time the channels with a node must have
+	// been monitored before they are judged.
+	MinChanAge time.Duration
+}
+
+// PerformanceAttachment is an implementation of the AttachmentHeuristic and
+// ChannelEvaluator interfaces that scores nodes by how they have performed
+// for the local node: the forwarding revenue earned through channels with
+// them, their fitness and the success rate of payments routed through them.
+// Unlike the other heuristics it doesn't consider the shape of the graph, so
+// it only scores nodes there are signals for.
+type PerformanceAttachment struct {
+	cfg *PerformanceConfig
+
+	sync.Mutex
+}
+
+// NewPerformanceAttachment creates a new instance of a PerformanceAttachment.
+// It doesn't score any nodes until its signals are set through SetConfig.
+func NewPerformanceAttachment() *PerformanceAttachment {
+	return &PerformanceAttachment{}
+}
+
+// A compile time assertion to ensure PerformanceAttachment meets the
+// AttachmentHeuristic and ChannelEvaluator interfaces.
+var _ AttachmentHeuristic = (*PerformanceAttachment)(nil)
+var _ ChannelEvaluator = (*PerformanceAttachment)(nil)
+
+// Name returns the name of this heuristic.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (p *PerformanceAttachment) Name() string {
+	return "performance"
+}
+
+// SetConfig sets the signals the heuristic scores nodes by.
+func (p *PerformanceAttachment) SetConfig(cfg *PerformanceConfig) {
+	p.Lock()
+	defer p.Unlock()
+
+	p.cfg = cfg
+}
+
+// performanceSignals is a snapshot of the signals of a PerformanceConfig.
+type performanceSignals struct {
+	revenue      map[NodeID]lnwire.MilliAtom
+	maxRevenue   lnwire.MilliAtom
+	fitness      map[NodeID]*NodeFitness
+	successRates map[NodeID]float64
+}
+
+// fetchSignals queries the signals of the given config.
+func fetchSignals(cfg *PerformanceConfig) (*performanceSignals, error) {
+	signals := &performanceSignals{}
+
+	var err error
+	if cfg.Revenue != nil {
+		since := time.Now().Add(-cfg.RevenueWindow)
+		signals.revenue, err = cfg.Revenue(since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, revenue := range signals.revenue {
+			if revenue > signals.maxRevenue {
+				signals.maxRevenue = revenue
+			}
+		}
+	}
+
+	if cfg.Fitness != nil {
+		signals.fitness, err = cfg.Fitness()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if cfg.SuccessRates != nil {
+		signals.successRates, err = cfg.SuccessRates()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return signals, nil
+}
+
+// score returns the performance score of a node in the range [0, 1.0], which
+// is the weighted mean of the signals known for it. The revenue is scored on
+// a logarithmic scale relative to the node that earned the most. The returned
+// boolean is false if no signal is known for the node.
+func (s *performanceSignals) score(nID NodeID) (float64, bool) {
+	var sum, weights float64
+
+	if revenue, ok := s.revenue[nID]; ok {
+		var revenueScore float64
+		if s.maxRevenue > 0 {
+			revenueScore = math.Log1p(float64(revenue)) /
+				math.Log1p(float64(s.maxRevenue))
+		}
+
+		sum += revenueWeight * revenueScore
+		weights += revenueWeight
+	}
+
+	if fitness, ok := s.fitness[nID]; ok {
+		sum += fitnessWeight * fitness.Score
+		weights += fitnessWeight
+	}
+
+	if rate, ok := s.successRates[nID]; ok {
+		sum += successWeight * rate
+		weights += successWeight
+	}
+
+	if weights == 0 {
+		return 0, false
+	}
+
+	return sum / weights, true
+}
+
+// NodeScores is a method that given the current channel graph and current set
+// of local channels, scores the given nodes according to the preference of
+// opening a channel of the given size with them. The returned channel
+// candidates maps the NodeID to a NodeScore for the node.
+//
+// The returned scores will be in the range [0, 1.0], where 0 indicates no
+// improvement in connectivity if a channel is opened to this node, while 1.0
+// is the maximum possible improvement in connectivity.
+//
+// The scores are determined by the revenue earned through past channels with
+// the nodes and the success rate of the payments routed through them. Nodes
+// without any signal aren't scored.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (p *PerformanceAttachment) NodeScores(g ChannelGraph, chans []Channel,
+	chanSize dcrutil.Amount, nodes map[NodeID]struct{}) (
+	map[NodeID]*NodeScore, error) {
+
+	p.Lock()
+	cfg := p.cfg
+	p.Unlock()
+
+	candidates := make(map[NodeID]*NodeScore)
+	if cfg == nil {
+		return candidates, nil
+	}
+
+	signals, err := fetchSignals(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	existingPeers := make(map[NodeID]struct{})
+	for _, c := range chans {
+		existingPeers[c.Node] = struct{}{}
+	}
+
+	for nID := range nodes {
+		// If the node is among our existing channel peers, we don't
+		// need another channel.
+		if _, ok := existingPeers[nID]; ok {
+			continue
+		}
+
+		score, ok := signals.score(nID)
+		if !ok || score == 0 {
+			continue
+		}
+
+		log.Tracef("Performance score %v given to node %x", score,
+			nID[:])
+
+		candidates[nID] = &NodeScore{
+			NodeID: nID,
+			Score:  score,
+		}
+	}
+
+	return candidates, nil
+}
+
+// ChannelScores scores the given channels of the local node in the range
+// [0, 1.0], where 0 indicates a channel that contributes nothing, while 1.0
+// indicates a channel that performs as well as possible.
+//
+// Each channel is given the performance score of its remote node. Channels
+// with nodes whose channels haven't been monitored for MinChanAge aren't
+// scored, since they didn't have the chance to earn revenue yet.
+//
+// NOTE: This is a part of the ChannelEvaluator interface.
+func (p *PerformanceAttachment) ChannelScores(chans []Channel) (
+	map[lnwire.ShortChannelID]float64, error) {
+
+	p.Lock()
+	cfg := p.cfg
+	p.Unlock()
+
+	scores := make(map[lnwire.ShortChannelID]float64)
+	if cfg == nil {
+		return scores, nil
+	}
+
+	signals, err := fetchSignals(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range chans {
+		fitness, ok := signals.fitness[c.Node]
+		if !ok || fitness.Lifespan < cfg.MinChanAge {
+			continue
+		}
+
+		// Channels that haven't earned anything are scored as such,
+		// rather than being judged by their fitness alone.
+		_, ok = signals.revenue[c.Node]
+		if !ok && signals.revenue != nil {
+			signals.revenue[c.Node] = 0
+		}
+
+		score, _ := signals.score(c.Node)
+
+		log.Tracef("Performance score %v given to channel %v with "+
+			"node %x", score, c.ChanID, c.Node[:])
+
+		scores[c.ChanID] = score
+	}
+
+	return scores, nil
+}
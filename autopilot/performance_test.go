@@ -0,0 +1,238 @@
+package autopilot
+
+import (
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestPerformanceAttachment tests the scoring of candidate nodes and existing
+// channels by the performance heuristic.
+func TestPerformanceAttachment(t *testing.T) {
+	var (
+		earner    = NodeID{1}
+		idle      = NodeID{2}
+		young     = NodeID{3}
+		reliable  = NodeID{4}
+		unknown   = NodeID{5}
+		untracked = NodeID{6}
+	)
+
+	day := 24 * time.Hour
+	heuristic := NewPerformanceAttachment()
+
+	// Without signals, no nodes are scored.
+	scores, err := heuristic.NodeScores(
+		nil, nil, 0, map[NodeID]struct{}{reliable: {}},
+	)
+	if err != nil {
+		t.Fatalf("unable to score nodes: %v", err)
+	}
+	if len(scores) != 0 {
+		t.Fatalf("expected no scores without signals, got %v",
+			len(scores))
+	}
+
+	heuristic.SetConfig(&PerformanceConfig{
+		Revenue: func(since time.Time) (map[NodeID]lnwire.MilliAtom,
+			error) {
+
+			return map[NodeID]lnwire.MilliAtom{
+				earner: 1000,
+				idle:   0,
+			}, nil
+		},
+		Fitness: func() (map[NodeID]*NodeFitness, error) {
+			return map[NodeID]*NodeFitness{
+				earner: {Score: 1, Lifespan: 60 * day},
+				idle:   {Score: 0.8, Lifespan: 60 * day},
+				young:  {Score: 0.5, Lifespan: day},
+			}, nil
+		},
+		SuccessRates: func() (map[NodeID]float64, error) {
+			return map[NodeID]float64{
+				earner:   1,
+				reliable: 0.8,
+			}, nil
+		},
+		RevenueWindow: 30 * day,
+		MinChanAge:    30 * day,
+	})
+
+	chans := []Channel{
+		{ChanID: lnwire.NewShortChanIDFromInt(1), Node: earner},
+		{ChanID: lnwire.NewShortChanIDFromInt(2), Node: idle},
+		{ChanID: lnwire.NewShortChanIDFromInt(3), Node: young},
+		{ChanID: lnwire.NewShortChanIDFromInt(4), Node: untracked},
+	}
+
+	// Existing peers and nodes without signals aren't candidates.
+	candidates := map[NodeID]struct{}{
+		earner:   {},
+		idle:     {},
+		reliable: {},
+		unknown:  {},
+	}
+	scores, err = heuristic.NodeScores(nil, chans[:1], 0, candidates)
+	if err != nil {
+		t.Fatalf("unable to score nodes: %v", err)
+	}
+	if len(scores) != 2 {
+		t.Fatalf("expected 2 candidates, got %v", len(scores))
+	}
+	if scores[reliable].Score != 0.8 {
+		t.Fatalf("expected score 0.8, got %v", scores[reliable].Score)
+	}
+
+	// Only channels that are old enough are scored, and channels that
+	// didn't earn anything are scored as such.
+	chanScores, err := heuristic.ChannelScores(chans)
+	if err != nil {
+		t.Fatalf("unable to score channels: %v", err)
+	}
+	if len(chanScores) != 2 {
+		t.Fatalf("expected 2 channel scores, got %v", len(chanScores))
+	}
+	if chanScores[chans[0].ChanID] != 1 {
+		t.Fatalf("expected score 1 for earning channel, got %v",
+			chanScores[chans[0].ChanID])
+	}
+	if math.Abs(chanScores[chans[1].ChanID]-0.8/3) > 1e-9 {
+		t.Fatalf("expected score %v for idle channel, got %v", 0.8/3,
+			chanScores[chans[1].ChanID])
+	}
+}
+
+// mockEvaluator is an AttachmentHeuristic that gives fixed scores to the
+// existing channels.
+type mockEvaluator struct {
+	mockHeuristic
+
+	scores map[lnwire.ShortChannelID]float64
+}
+
+func (m *mockEvaluator) ChannelScores(chans []Channel) (
+	map[lnwire.ShortChannelID]float64, error) {
+
+	return m.scores, nil
+}
+
+var _ ChannelEvaluator = (*mockEvaluator)(nil)
+
+// mockClosingChanController records the channels it is asked to close.
+type mockClosingChanController struct {
+	mockChanController
+
+	closed []wire.OutPoint
+}
+
+func (m *mockClosingChanController) CloseChannel(
+	chanPoint *wire.OutPoint) error {
+
+	m.closed = append(m.closed, *chanPoint)
+	return nil
+}
+
+// TestAgentEvaluateChans tests that the agent closes channels scoring below
+// the close score, and replaces channels scoring below the downsize score by
+// smaller ones once they have closed.
+func TestAgentEvaluateChans(t *testing.T) {
+	var (
+		good      = Channel{ChanID: lnwire.NewShortChanIDFromInt(1)}
+		bad       = Channel{ChanID: lnwire.NewShortChanIDFromInt(2)}
+		mediocre  = Channel{ChanID: lnwire.NewShortChanIDFromInt(3)}
+		small     = Channel{ChanID: lnwire.NewShortChanIDFromInt(4)}
+		unscored  = Channel{ChanID: lnwire.NewShortChanIDFromInt(5)}
+		remote    = Channel{ChanID: lnwire.NewShortChanIDFromInt(6)}
+		evaluator = &mockEvaluator{
+			scores: map[lnwire.ShortChannelID]float64{
+				good.ChanID:     0.9,
+				bad.ChanID:      0.1,
+				mediocre.ChanID: 0.25,
+				small.ChanID:    0.4,
+				remote.ChanID:   0.1,
+			},
+		}
+	)
+	allChans := []*Channel{
+		&good, &bad, &mediocre, &small, &unscored, &remote,
+	}
+	for i, c := range allChans {
+		c.Node = NodeID{byte(i + 1)}
+		c.ChanPoint = wire.OutPoint{Index: uint32(i + 1)}
+		c.Capacity = 1e8
+	}
+	small.Capacity = 2e7
+
+	controller := &mockClosingChanController{}
+	agent, err := New(Config{
+		Heuristic:      evaluator,
+		ChanController: controller,
+		Constraints: &mockConstraints{
+			quit: make(chan struct{}),
+		},
+		CloseScore:    0.2,
+		DownsizeScore: 0.5,
+		IsLocalFunded: func(chanPoint wire.OutPoint) (bool, error) {
+			return chanPoint != remote.ChanPoint, nil
+		},
+	}, []Channel{good, bad, mediocre, small, unscored, remote})
+	if err != nil {
+		t.Fatalf("unable to create agent: %v", err)
+	}
+
+	agent.evaluateChans()
+
+	// The small channel wouldn't be downsized by much and the channel
+	// funded by the remote node is left alone, so only the bad and the
+	// mediocre channel are closed.
+	if len(controller.closed) != 2 {
+		t.Fatalf("expected 2 closed channels, got %v",
+			len(controller.closed))
+	}
+	if _, ok := agent.closedNodes[bad.Node]; !ok {
+		t.Fatalf("expected node of bad channel to be skipped")
+	}
+	newSize, ok := agent.pendingResizes[mediocre.Node]
+	if !ok || newSize != 5e7 {
+		t.Fatalf("expected mediocre channel to be resized to %v, "+
+			"got %v", dcrutil.Amount(5e7), newSize)
+	}
+
+	// The channel is only replaced once it has closed and the funds are
+	// available.
+	addresses := map[NodeID][]net.Addr{
+		mediocre.Node: {&net.TCPAddr{}},
+	}
+	connected := map[NodeID]struct{}{mediocre.Node: {}}
+	availableFunds := dcrutil.Amount(1e8)
+	numChans := uint32(2)
+
+	directives := agent.resizeDirectives(
+		connected, addresses, &availableFunds, &numChans,
+	)
+	if len(directives) != 0 {
+		t.Fatalf("expected no directives while channel is open")
+	}
+
+	directives = agent.resizeDirectives(
+		map[NodeID]struct{}{}, addresses, &availableFunds, &numChans,
+	)
+	directive, ok := directives[mediocre.Node]
+	if !ok || directive.ChanAmt != 5e7 {
+		t.Fatalf("expected resize directive of %v, got %v",
+			dcrutil.Amount(5e7), directives)
+	}
+	if availableFunds != 5e7 || numChans != 1 {
+		t.Fatalf("expected budget to be reduced, got %v funds and %v "+
+			"channels", availableFunds, numChans)
+	}
+	if len(agent.pendingResizes) != 0 {
+		t.Fatalf("expected resize to be consumed")
+	}
+}
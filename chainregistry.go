@@ -22,6 +22,7 @@ import (
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/keychain"
+	"github.com/decred/dcrlnd/lncfg"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwallet/chainfee"
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
@@ -137,6 +138,8 @@ type chainControl struct {
 
 	feeEstimator chainfee.Estimator
 
+	feeEstimators *chainfee.EstimatorSwitch
+
 	signer input.Signer
 
 	keyRing keychain.SecretKeyRing
@@ -156,6 +159,86 @@ type chainControl struct {
 	minHtlcIn lnwire.MilliAtom
 }
 
+// newFeeEstimators creates the switch between the fee estimators available to
+// the node: the static estimator, the dcrd estimator if one is given, a web
+// estimator for each configured fee service and the median of the latter
+// ones if there are several of them. The estimator selected in the
+// configuration is made active.
+func newFeeEstimators(cfg *lncfg.Fee, staticEstimator chainfee.Estimator,
+	dcrdEstimator *chainfee.DcrdEstimator) (*chainfee.EstimatorSwitch,
+	error) {
+
+	feeEstimators := chainfee.NewEstimatorSwitch()
+	err := feeEstimators.AddEstimator("static", staticEstimator)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		liveEstimators []chainfee.Estimator
+		liveName       string
+	)
+	if dcrdEstimator != nil {
+		liveName = "dcrd"
+		err := feeEstimators.AddEstimator(liveName, dcrdEstimator)
+		if err != nil {
+			return nil, err
+		}
+		liveEstimators = append(liveEstimators, dcrdEstimator)
+	}
+
+	for i, url := range cfg.URLs {
+		feeSource := chainfee.JSONFeeSource{
+			URL:        url,
+			FeesPath:   cfg.FeesPath,
+			TargetKey:  cfg.TargetKey,
+			FeeKey:     cfg.FeeKey,
+			Multiplier: cfg.Multiplier,
+		}
+		webEstimator := chainfee.NewWebAPIEstimator(
+			feeSource, defaultDecredStaticFeePerKB,
+		)
+
+		liveName = fmt.Sprintf("web%d", i+1)
+		err := feeEstimators.AddEstimator(liveName, webEstimator)
+		if err != nil {
+			return nil, err
+		}
+		liveEstimators = append(liveEstimators, webEstimator)
+	}
+
+	// Unless configured otherwise, we'll prefer the median of several
+	// estimators over any single one of them, and any live estimator over
+	// the static one.
+	active := cfg.Estimator
+	if len(liveEstimators) > 1 {
+		err := feeEstimators.AddEstimator(
+			"median", chainfee.NewMedianEstimator(liveEstimators...),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if active == "" {
+			active = "median"
+		}
+	}
+	if active == "" && len(liveEstimators) == 1 {
+		active = liveName
+	}
+
+	if active != "" {
+		if err := feeEstimators.SetActive(active); err != nil {
+			return nil, err
+		}
+	}
+
+	ltndLog.Infof("Using fee estimator %v out of %v",
+		feeEstimators.Active(), feeEstimators.Names())
+
+	return feeEstimators, nil
+}
+
 // newChainControlFromConfig attempts to create a chainControl instance
 // according to the parameters in the passed lnd configuration. Currently only
 // one chainControl instance exists: one backed by a running dcrd full-node.
@@ -287,7 +370,10 @@ func newChainControlFromConfig(cfg *Config, localDB, remoteDB *channeldb.DB,
 		}
 	}
 
-	var secretKeyRing keychain.SecretKeyRing
+	var (
+		secretKeyRing keychain.SecretKeyRing
+		dcrdEstimator *chainfee.DcrdEstimator
+	)
 
 	// Initialize the appopriate wallet controller (either the embedded
 	// dcrwallet or a remote one).
@@ -434,15 +520,12 @@ func newChainControlFromConfig(cfg *Config, localDB, remoteDB *channeldb.DB,
 				// TODO(decred) Review if fallbackFeeRate should be higher than
 				// the default relay fee.
 				fallBackFeeRate := chainfee.AtomPerKByte(1e4)
-				cc.feeEstimator, err = chainfee.NewDcrdEstimator(
+				dcrdEstimator, err = chainfee.NewDcrdEstimator(
 					*rpcConfig, fallBackFeeRate,
 				)
 				if err != nil {
 					return nil, err
 				}
-				if err := cc.feeEstimator.Start(); err != nil {
-					return nil, err
-				}
 			}
 		}
 
@@ -453,6 +536,20 @@ func newChainControlFromConfig(cfg *Config, localDB, remoteDB *channeldb.DB,
 		cc.keyRing = wc
 	}
 
+	// With the backend fee estimator in place, we'll set up the switch
+	// between all fee estimators available to the node, which allows the
+	// estimator to be changed at runtime.
+	cc.feeEstimators, err = newFeeEstimators(
+		cfg.Fee, cc.feeEstimator, dcrdEstimator,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := cc.feeEstimators.Start(); err != nil {
+		return nil, err
+	}
+	cc.feeEstimator = cc.feeEstimators
+
 	// Select the default channel constraints for the primary chain.
 	channelConstraints := defaultDcrChannelConstraints
 
@@ -36,7 +36,7 @@ func acceptAndIncrementCtr(rpc ChannelAcceptor, req *ChannelAcceptRequest,
 	ctr *uint32, success chan struct{}) {
 
 	result := rpc.Accept(req)
-	if !result {
+	if !result.Accept {
 		return
 	}
 
@@ -87,7 +87,9 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 
 	// demultiplexReq is a closure used to abstract the RPCAcceptor's request
 	// and response logic.
-	demultiplexReq := func(req *ChannelAcceptRequest) bool {
+	demultiplexReq := func(req *ChannelAcceptRequest) *ChannelAcceptResponse {
+		reject := &ChannelAcceptResponse{}
+
 		respChan := make(chan *lnrpc.ChannelAcceptResponse, 1)
 
 		newRequest := &requestInfo{
@@ -99,7 +101,7 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 		select {
 		case requests <- newRequest:
 		case <-quit:
-			return false
+			return reject
 		}
 
 		// Receive the response and verify that the PendingChanId matches
@@ -110,15 +112,18 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 			pendingID := req.OpenChanMsg.PendingChannelID
 			if !bytes.Equal(pendingID[:], resp.PendingChanId) {
 				errChan <- struct{}{}
-				return false
+				return reject
 			}
 
-			return resp.Accept
+			return &ChannelAcceptResponse{
+				Accept:   resp.Accept,
+				ZeroConf: resp.ZeroConf,
+			}
 		case <-time.After(defaultAcceptTimeout):
 			errChan <- struct{}{}
-			return false
+			return reject
 		case <-quit:
-			return false
+			return reject
 		}
 	}
 
@@ -154,3 +159,59 @@ func TestRPCMultipleAcceptClients(t *testing.T) {
 		}
 	}
 }
+
+// TestChainedAcceptorZeroConf tests that the ChainedAcceptor only approves a
+// zero-conf channel if all of its acceptors approve it.
+func TestChainedAcceptorZeroConf(t *testing.T) {
+	req := &ChannelAcceptRequest{
+		Node: randKey(t),
+		OpenChanMsg: &lnwire.OpenChannel{
+			ChannelType: lnwire.NewChannelType(
+				lnwire.ZeroConfRequired,
+			),
+		},
+	}
+	if !req.WantsZeroConf() {
+		t.Fatalf("request should want a zero-conf channel")
+	}
+
+	newAcceptor := func(accept, zeroConf bool) ChannelAcceptor {
+		return NewRPCAcceptor(
+			func(*ChannelAcceptRequest) *ChannelAcceptResponse {
+				return &ChannelAcceptResponse{
+					Accept:   accept,
+					ZeroConf: zeroConf,
+				}
+			},
+		)
+	}
+
+	// Without any acceptors the channel is accepted, but a zero-conf
+	// channel must be explicitly approved.
+	chained := NewChainedAcceptor()
+	resp := chained.Accept(req)
+	if !resp.Accept || resp.ZeroConf {
+		t.Fatalf("unexpected response without acceptors: %v", resp)
+	}
+
+	chained.AddAcceptor(newAcceptor(true, true))
+	resp = chained.Accept(req)
+	if !resp.Accept || !resp.ZeroConf {
+		t.Fatalf("expected zero-conf channel to be approved: %v", resp)
+	}
+
+	// A single acceptor not approving zero-conf is enough to refuse it.
+	id := chained.AddAcceptor(newAcceptor(true, false))
+	resp = chained.Accept(req)
+	if !resp.Accept || resp.ZeroConf {
+		t.Fatalf("expected zero-conf channel to be refused: %v", resp)
+	}
+
+	// A rejected channel is never approved as zero-conf channel.
+	chained.RemoveAcceptor(id)
+	chained.AddAcceptor(newAcceptor(false, true))
+	resp = chained.Accept(req)
+	if resp.Accept || resp.ZeroConf {
+		t.Fatalf("expected channel to be rejected: %v", resp)
+	}
+}
@@ -43,20 +43,31 @@ func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
 }
 
 // Accept evaluates the results of all ChannelAcceptors in the acceptors map
-// and returns the conjunction of all these predicates.
+// and returns the conjunction of all these predicates. A zero-conf channel is
+// only approved if there's at least one acceptor and all of them approve it.
 //
 // NOTE: Part of the ChannelAcceptor interface.
-func (c *ChainedAcceptor) Accept(req *ChannelAcceptRequest) bool {
-	result := true
+func (c *ChainedAcceptor) Accept(
+	req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
+	result := &ChannelAcceptResponse{
+		Accept: true,
+	}
 
 	c.acceptorsMtx.RLock()
+	result.ZeroConf = len(c.acceptors) > 0
 	for _, acceptor := range c.acceptors {
 		// We call Accept first in case any acceptor (perhaps an RPCAcceptor)
 		// wishes to be notified about ChannelAcceptRequest.
-		result = acceptor.Accept(req) && result
+		resp := acceptor.Accept(req)
+		result.Accept = resp.Accept && result.Accept
+		result.ZeroConf = resp.ZeroConf && result.ZeroConf
 	}
 	c.acceptorsMtx.RUnlock()
 
+	// A rejected channel can't be approved as zero-conf channel.
+	result.ZeroConf = result.ZeroConf && result.Accept
+
 	return result
 }
 
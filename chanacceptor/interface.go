@@ -18,8 +18,27 @@ type ChannelAcceptRequest struct {
 	OpenChanMsg *lnwire.OpenChannel
 }
 
+// WantsZeroConf returns true if the requesting node asked for a zero-conf
+// channel that can be used before its funding transaction confirms.
+func (r *ChannelAcceptRequest) WantsZeroConf() bool {
+	channelType := r.OpenChanMsg.ChannelType
+	return channelType != nil && channelType.IsSet(lnwire.ZeroConfRequired)
+}
+
+// ChannelAcceptResponse is the decision of a ChannelAcceptor on a
+// ChannelAcceptRequest.
+type ChannelAcceptResponse struct {
+	// Accept is true if the channel should be accepted.
+	Accept bool
+
+	// ZeroConf is true if the acceptor approves using the channel before
+	// its funding transaction confirms. It is only taken into account if
+	// the requesting node asked for a zero-conf channel.
+	ZeroConf bool
+}
+
 // ChannelAcceptor is an interface that represents a predicate on the data
 // contained in ChannelAcceptRequest.
 type ChannelAcceptor interface {
-	Accept(req *ChannelAcceptRequest) bool
+	Accept(req *ChannelAcceptRequest) *ChannelAcceptResponse
 }
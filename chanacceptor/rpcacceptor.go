@@ -3,7 +3,7 @@ package chanacceptor
 // RPCAcceptor represents the RPC-controlled variant of the ChannelAcceptor.
 // One RPCAcceptor allows one RPC client.
 type RPCAcceptor struct {
-	acceptClosure func(req *ChannelAcceptRequest) bool
+	acceptClosure func(req *ChannelAcceptRequest) *ChannelAcceptResponse
 }
 
 // Accept is a predicate on the ChannelAcceptRequest which is sent to the RPC
@@ -11,12 +11,16 @@ type RPCAcceptor struct {
 // closure has been specified during creation.
 //
 // NOTE: Part of the ChannelAcceptor interface.
-func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) bool {
+func (r *RPCAcceptor) Accept(
+	req *ChannelAcceptRequest) *ChannelAcceptResponse {
+
 	return r.acceptClosure(req)
 }
 
 // NewRPCAcceptor creates and returns an instance of the RPCAcceptor.
-func NewRPCAcceptor(closure func(*ChannelAcceptRequest) bool) *RPCAcceptor {
+func NewRPCAcceptor(
+	closure func(*ChannelAcceptRequest) *ChannelAcceptResponse) *RPCAcceptor {
+
 	return &RPCAcceptor{
 		acceptClosure: closure,
 	}
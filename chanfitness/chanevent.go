@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/routing/route"
 )
 
@@ -51,13 +52,32 @@ type chanEventLog struct {
 	now func() time.Time
 
 	// openedAt tracks the first time this channel was seen. This is not
-	// necessarily the time that it confirmed on chain because channel events
-	// are not persisted at present.
+	// necessarily the time that it confirmed on chain because channels are
+	// only monitored once the event store learns about them.
 	openedAt time.Time
 
 	// closedAt is the time that the channel was closed. If the channel has not
 	// been closed yet, it is zero.
 	closedAt time.Time
+
+	// flapCount is the number of times the remote peer went offline while
+	// the channel was open.
+	flapCount uint32
+
+	// priorUptime is the uptime that was recorded for the channel between
+	// openedAt and flushedAt. It is no longer tracked by events, since the
+	// history up to flushedAt has been persisted.
+	priorUptime time.Duration
+
+	// priorLifespan is the time the channel was monitored between openedAt
+	// and flushedAt. It excludes the time our node was offline, so that
+	// our own downtime is not held against the peer.
+	priorLifespan time.Duration
+
+	// flushedAt is the time up to which the channel's history has been
+	// persisted or restored from disk. Events are only held for the time
+	// after it. It is zero if the channel has no persisted history.
+	flushedAt time.Time
 }
 
 // newEventLog creates an event log for a channel with the openedAt time set.
@@ -88,6 +108,13 @@ func (e *chanEventLog) add(eventType eventType) {
 		return
 	}
 
+	// The peer flapped if it goes offline after having been online.
+	if eventType == peerOfflineEvent && len(e.events) > 0 &&
+		e.events[len(e.events)-1].eventType == peerOnlineEvent {
+
+		e.flapCount++
+	}
+
 	// Add the event to the eventLog with the current timestamp.
 	event := &channelEvent{
 		timestamp: e.now(),
@@ -172,9 +199,135 @@ func (e *chanEventLog) getOnlinePeriods() []*onlinePeriod {
 	})
 }
 
+// restore sets the opening time, flap count, uptime and monitored lifespan of
+// the event log to the history that was previously recorded for the channel.
+// The time between the last record and the restore, during which our node was
+// offline, is not monitored and therefore counts towards neither the
+// channel's uptime nor its lifespan.
+func (e *chanEventLog) restore(history *channeldb.ChannelUptime) {
+	e.openedAt = history.OpenedAt
+	e.flapCount = history.FlapCount
+	e.priorUptime = history.Uptime
+	e.priorLifespan = history.Monitored
+	e.flushedAt = e.now()
+}
+
+// lifespan returns the time the channel was monitored up to the given end
+// time, which excludes the time our node was offline.
+func (e *chanEventLog) lifespan(end time.Time) time.Duration {
+	monitoredSince := e.flushedAt
+	if monitoredSince.IsZero() {
+		monitoredSince = e.openedAt
+	}
+
+	return e.priorLifespan + end.Sub(monitoredSince)
+}
+
+// recordEnd returns the time up to which the channel's history can be
+// recorded, which is the present or its closing time if it has been closed.
+func (e *chanEventLog) recordEnd() time.Time {
+	if !e.closedAt.IsZero() {
+		return e.closedAt
+	}
+
+	return e.now()
+}
+
+// unflushedPeriods returns the online periods that were recorded since the
+// history was last flushed, up to the given end time.
+func (e *chanEventLog) unflushedPeriods(end time.Time) []*onlinePeriod {
+	var periods []*onlinePeriod
+	for _, p := range e.getOnlinePeriods() {
+		if p.start.After(end) {
+			break
+		}
+		if p.end.After(end) {
+			p.end = end
+		}
+
+		periods = append(periods, p)
+	}
+
+	return periods
+}
+
+// markFlushed records that the given online periods, which were returned by
+// unflushedPeriods for the same end time, have been persisted. Their uptime
+// is added to the prior uptime and the events are replaced by the peer's
+// current state, so that memory use doesn't grow with the channel's lifetime.
+func (e *chanEventLog) markFlushed(end time.Time, periods []*onlinePeriod) {
+	e.priorLifespan = e.lifespan(end)
+	for _, p := range periods {
+		e.priorUptime += p.end.Sub(p.start)
+	}
+
+	online := len(e.events) > 0 &&
+		e.events[len(e.events)-1].eventType == peerOnlineEvent
+
+	e.events = nil
+	if online && e.closedAt.IsZero() {
+		e.events = []*channelEvent{{
+			timestamp: end,
+			eventType: peerOnlineEvent,
+		}}
+	}
+
+	e.flushedAt = end
+}
+
+// coversHistory returns true if the given range covers the channel's whole
+// persisted history, in which case the prior uptime counts towards the range.
+func (e *chanEventLog) coversHistory(start, end time.Time) bool {
+	return !e.flushedAt.IsZero() && !start.After(e.openedAt) &&
+		!end.Before(e.flushedAt)
+}
+
+// record returns a summary of the channel's uptime history up to the given
+// end time.
+func (e *chanEventLog) record(end time.Time) (*channeldb.ChannelUptime,
+	error) {
+
+	uptime, err := e.uptime(e.openedAt, end)
+	if err != nil {
+		return nil, err
+	}
+
+	return &channeldb.ChannelUptime{
+		Peer:       e.peer,
+		OpenedAt:   e.openedAt,
+		ClosedAt:   e.closedAt,
+		Uptime:     uptime,
+		FlapCount:  e.flapCount,
+		RecordedAt: end,
+		Monitored:  e.lifespan(end),
+	}, nil
+}
+
+// fitness returns the fitness of the channel over its monitored lifespan,
+// which ends at the present or at its closing time if it has been closed.
+func (e *chanEventLog) fitness() (*ChannelFitness, error) {
+	record, err := e.record(e.recordEnd())
+	if err != nil {
+		return nil, err
+	}
+
+	lifespan := record.Monitored
+
+	return &ChannelFitness{
+		ChannelPoint: e.channelPoint,
+		Peer:         e.peer,
+		Lifespan:     lifespan,
+		Uptime:       record.Uptime,
+		FlapCount:    e.flapCount,
+		Score:        fitnessScore(lifespan, record.Uptime, e.flapCount),
+	}, nil
+}
+
 // uptime calculates the total uptime we have recorded for a channel over the
 // inclusive range specified. An error is returned if the end of the range is
-// before the start or a zero end time is returned.
+// before the start or a zero end time is returned. The uptime before the
+// history was last flushed is only known as a total, so it is only included
+// if the range covers the channel's whole persisted history.
 func (e *chanEventLog) uptime(start, end time.Time) (time.Duration, error) {
 	// Error if we are provided with an invalid range to calculate uptime for.
 	if end.Before(start) {
@@ -187,6 +340,10 @@ func (e *chanEventLog) uptime(start, end time.Time) (time.Duration, error) {
 
 	var uptime time.Duration
 
+	if e.coversHistory(start, end) {
+		uptime = e.priorUptime
+	}
+
 	for _, p := range e.getOnlinePeriods() {
 		// The online period ends before the range we're looking at, so we can
 		// skip over it.
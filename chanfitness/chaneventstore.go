@@ -3,15 +3,25 @@
 // an event store which tracks events for each channel.
 //
 // Lifespan: the period that the channel has been known to the scoring system.
-// Note that lifespan may not equal the channel's full lifetime because data is
-// not currently persisted.
+// Note that lifespan may not equal the channel's full lifetime for channels
+// that were opened before the scoring system was introduced.
 //
 // Uptime: the total time within a given period that the channel's remote peer
 // has been online.
+//
+// Flap: the remote peer going offline after having been online.
+//
+// Fitness score: a score between 0 and 1 that rates the reliability of a
+// channel or peer based on its uptime and flap rate.
+//
+// The uptime history of channels and the flap statistics of peers are
+// persisted, so that they survive restarts.
 package chanfitness
 
 import (
+	"bytes"
 	"errors"
+	"sort"
 	"sync"
 	"time"
 
@@ -21,8 +31,13 @@ import (
 	"github.com/decred/dcrlnd/peernotifier"
 	"github.com/decred/dcrlnd/routing/route"
 	"github.com/decred/dcrlnd/subscribe"
+	"github.com/decred/dcrlnd/ticker"
 )
 
+// compactionInterval is the interval at which the persisted online periods of
+// channels are compacted.
+const compactionInterval = 24 * time.Hour
+
 var (
 	// errShuttingDown is returned when the store cannot respond to a query because
 	// it has received the shutdown signal.
@@ -31,6 +46,11 @@ var (
 	// ErrChannelNotFound is returned when a query is made for a channel that
 	// the event store does not have knowledge of.
 	ErrChannelNotFound = errors.New("channel not found in event store")
+
+	// ErrPeerNotFound is returned when the fitness of a peer is queried
+	// that we don't have any open channels with.
+	ErrPeerNotFound = errors.New("no open channels with peer in event " +
+		"store")
 )
 
 // ChannelEventStore maintains a set of event logs for the node's channels to
@@ -45,12 +65,31 @@ type ChannelEventStore struct {
 	// events.
 	peers map[route.Vertex]bool
 
+	// flaps tracks how often the peers we have channels with go offline
+	// and for how long.
+	flaps map[route.Vertex]*peerFlaps
+
+	// closeRequested holds the channels that have been handed to
+	// CloseChannel because they were deemed unfit.
+	closeRequested map[wire.OutPoint]struct{}
+
+	// fitnessEvents notifies subscribers of peer events along with the
+	// peer's fitness. It is created once the store is started.
+	fitnessEvents *subscribe.Server
+
+	// lastCompaction is the last time the persisted online periods were
+	// compacted.
+	lastCompaction time.Time
+
 	// lifespanRequests serves requests for the lifespan of channels.
 	lifespanRequests chan lifespanRequest
 
 	// uptimeRequests serves requests for the uptime of channels.
 	uptimeRequests chan uptimeRequest
 
+	// fitnessRequests serves requests for the fitness of peers.
+	fitnessRequests chan fitnessRequest
+
 	quit chan struct{}
 
 	wg sync.WaitGroup
@@ -71,6 +110,80 @@ type Config struct {
 	// GetOpenChannels provides a list of existing open channels which is used
 	// to populate the ChannelEventStore with a set of channels on startup.
 	GetOpenChannels func() ([]*channeldb.OpenChannel, error)
+
+	// FitnessDB persists the uptime history of channels and the flap
+	// statistics of peers. If nil, they are only kept in memory.
+	FitnessDB FitnessDB
+
+	// FlushTicker determines how often the recorded history is persisted
+	// and channels are checked for their fitness. It may be nil if there
+	// is no FitnessDB and no CloseChannel function.
+	FlushTicker ticker.Ticker
+
+	// CloseChannel, if set, is used to close open channels whose fitness
+	// score is below MinFitnessScore once they have been monitored for at
+	// least MinCloseLifespan. The force flag is set if the channel's peer
+	// is offline. It must not block.
+	CloseChannel func(chanPoint wire.OutPoint, force bool) error
+
+	// ForceCloseOffline allows unfit channels to be force closed if their
+	// peer is offline. Otherwise, they are only closed cooperatively once
+	// the peer comes back online.
+	ForceCloseOffline bool
+
+	// MinFitnessScore is the fitness score below which channels are closed
+	// through CloseChannel.
+	MinFitnessScore float64
+
+	// MinCloseLifespan is the minimum time a channel must have been
+	// monitored before it may be closed through CloseChannel.
+	MinCloseLifespan time.Duration
+
+	// UptimeRetention is the time for which the persisted online periods
+	// of channels are retained. Uptime can't be queried for time windows
+	// that start before the retention period, unless they cover the
+	// channel's whole history. If zero, online periods are retained for
+	// the lifetime of the channel.
+	UptimeRetention time.Duration
+
+	// CompactionGap is the maximum gap between persisted online periods
+	// that are merged into a single period during compaction. The gaps
+	// count as online time, so this bounds the loss of precision.
+	CompactionGap time.Duration
+}
+
+// FitnessDB persists the uptime history of channels and the flap statistics
+// of peers across restarts.
+type FitnessDB interface {
+	// FetchChannelUptimes returns the uptime history of all channels.
+	FetchChannelUptimes() (map[wire.OutPoint]*channeldb.ChannelUptime,
+		error)
+
+	// PutChannelUptimes stores the uptime history of the given channels.
+	PutChannelUptimes(map[wire.OutPoint]*channeldb.ChannelUptime) error
+
+	// DeleteChannelUptimes removes the uptime history of the given
+	// channels.
+	DeleteChannelUptimes([]wire.OutPoint) error
+
+	// FetchPeerFlapStats returns the flap statistics of all peers.
+	FetchPeerFlapStats() (map[route.Vertex]*channeldb.PeerFlapStats, error)
+
+	// PutPeerFlapStats stores the flap statistics of the given peers.
+	PutPeerFlapStats(map[route.Vertex]*channeldb.PeerFlapStats) error
+
+	// AddOnlinePeriods appends online periods to the history of the given
+	// channels.
+	AddOnlinePeriods(map[wire.OutPoint][]channeldb.OnlinePeriod) error
+
+	// FetchOnlinePeriods returns the online periods of a channel that
+	// overlap with the given range.
+	FetchOnlinePeriods(chanPoint wire.OutPoint, start,
+		end time.Time) ([]channeldb.OnlinePeriod, error)
+
+	// CompactOnlinePeriods removes the online periods that ended before
+	// retainAfter, and merges periods separated by at most maxGap.
+	CompactOnlinePeriods(retainAfter time.Time, maxGap time.Duration) error
 }
 
 // lifespanRequest contains the channel ID required to query the store for a
@@ -105,6 +218,21 @@ type uptimeResponse struct {
 	err    error
 }
 
+// fitnessRequest contains the peer to query the store for the fitness of and a
+// blocking response channel on which the result is sent. If peer is nil, the
+// fitness of all peers we have open channels with is queried.
+type fitnessRequest struct {
+	peer         *route.Vertex
+	responseChan chan fitnessResponse
+}
+
+// fitnessResponse contains the response to a fitnessRequest and an error if
+// one occurred.
+type fitnessResponse struct {
+	peers []*PeerFitness
+	err   error
+}
+
 // NewChannelEventStore initializes an event store with the config provided.
 // Note that this function does not start the main event loop, Start() must be
 // called.
@@ -113,8 +241,11 @@ func NewChannelEventStore(config *Config) *ChannelEventStore {
 		cfg:              config,
 		channels:         make(map[wire.OutPoint]*chanEventLog),
 		peers:            make(map[route.Vertex]bool),
+		flaps:            make(map[route.Vertex]*peerFlaps),
+		closeRequested:   make(map[wire.OutPoint]struct{}),
 		lifespanRequests: make(chan lifespanRequest),
 		uptimeRequests:   make(chan uptimeRequest),
+		fitnessRequests:  make(chan fitnessRequest),
 		quit:             make(chan struct{}),
 	}
 
@@ -155,6 +286,27 @@ func (c *ChannelEventStore) Start() error {
 		return err
 	}
 
+	// Fetch the history that was recorded before the last shutdown, so
+	// that it can be restored for the channels that are still open.
+	var history map[wire.OutPoint]*channeldb.ChannelUptime
+	if c.cfg.FitnessDB != nil {
+		history, err = c.cfg.FitnessDB.FetchChannelUptimes()
+		if err != nil {
+			cancel()
+			return err
+		}
+
+		flapStats, err := c.cfg.FitnessDB.FetchPeerFlapStats()
+		if err != nil {
+			cancel()
+			return err
+		}
+
+		for peer, stats := range flapStats {
+			c.flaps[peer] = &peerFlaps{PeerFlapStats: *stats}
+		}
+	}
+
 	log.Infof("Adding %v channels to event store", len(channels))
 
 	for _, ch := range channels {
@@ -169,6 +321,36 @@ func (c *ChannelEventStore) Start() error {
 		// Add existing channels to the channel store with an initial peer
 		// online or offline event.
 		c.addChannel(ch.FundingOutpoint, peerKey)
+
+		if h, ok := history[ch.FundingOutpoint]; ok {
+			c.channels[ch.FundingOutpoint].restore(h)
+			delete(history, ch.FundingOutpoint)
+		}
+	}
+
+	// The remaining history belongs to channels that have been closed, so
+	// it is pruned.
+	if len(history) > 0 {
+		closed := make([]wire.OutPoint, 0, len(history))
+		for chanPoint := range history {
+			closed = append(closed, chanPoint)
+		}
+
+		err := c.cfg.FitnessDB.DeleteChannelUptimes(closed)
+		if err != nil {
+			cancel()
+			return err
+		}
+	}
+
+	c.fitnessEvents = subscribe.NewServer()
+	if err := c.fitnessEvents.Start(); err != nil {
+		cancel()
+		return err
+	}
+
+	if c.cfg.FlushTicker != nil {
+		c.cfg.FlushTicker.Resume()
 	}
 
 	// Start a goroutine that consumes events from all subscriptions.
@@ -190,6 +372,21 @@ func (c *ChannelEventStore) Stop() {
 	close(c.quit)
 
 	c.wg.Wait()
+
+	if c.cfg.FlushTicker != nil {
+		c.cfg.FlushTicker.Stop()
+	}
+
+	if c.fitnessEvents != nil {
+		if err := c.fitnessEvents.Stop(); err != nil {
+			log.Errorf("Unable to stop fitness event server: %v",
+				err)
+		}
+	}
+
+	// Persist the history recorded since the last flush now that the
+	// consume goroutine has exited.
+	c.flush()
 }
 
 // addChannel adds a new channel to the ChannelEventStore's map of channels with
@@ -233,16 +430,337 @@ func (c *ChannelEventStore) closeChannel(channelPoint wire.OutPoint) {
 }
 
 // peerEvent adds a peer online or offline event to all channels we currently
-// have open with a peer.
+// have open with a peer, records whether the peer flapped and notifies the
+// subscribers of the store's peer events.
 func (c *ChannelEventStore) peerEvent(peer route.Vertex, event eventType) {
 	// Track current online status of peers in the channelEventStore.
+	wasOnline := c.peers[peer]
 	c.peers[peer] = event == peerOnlineEvent
 
+	var hasChannels bool
 	for _, eventLog := range c.channels {
 		if eventLog.peer == peer {
 			eventLog.add(event)
+			hasChannels = hasChannels || eventLog.closedAt.IsZero()
 		}
 	}
+
+	// Flaps are only tracked for peers we have open channels with, so that
+	// other peers can't make us store data at no cost.
+	if hasChannels {
+		flaps, ok := c.flaps[peer]
+		if !ok {
+			flaps = &peerFlaps{}
+			c.flaps[peer] = flaps
+		}
+
+		flaps.add(event, wasOnline, time.Now())
+	}
+
+	c.notifyPeerEvent(peer, event)
+}
+
+// notifyPeerEvent sends a peer event along with the peer's fitness to the
+// subscribers of the store's peer events.
+func (c *ChannelEventStore) notifyPeerEvent(peer route.Vertex,
+	event eventType) {
+
+	// There are no subscribers if the store hasn't been started.
+	if c.fitnessEvents == nil {
+		return
+	}
+
+	update := PeerFitnessEvent{
+		Peer:   peer,
+		Online: event == peerOnlineEvent,
+	}
+
+	fitness, err := c.peerFitness(peer)
+	switch err {
+	case nil:
+		update.Fitness = fitness
+
+	case ErrPeerNotFound:
+
+	default:
+		log.Errorf("Unable to get fitness of peer %v: %v", peer, err)
+	}
+
+	if err := c.fitnessEvents.SendUpdate(update); err != nil {
+		log.Errorf("Unable to send peer event: %v", err)
+	}
+}
+
+// peerFitness returns the fitness of a peer and its open channels, or
+// ErrPeerNotFound if we don't have any open channels with the peer.
+func (c *ChannelEventStore) peerFitness(peer route.Vertex) (*PeerFitness,
+	error) {
+
+	fitness := &PeerFitness{
+		Peer:   peer,
+		Online: c.peers[peer],
+	}
+
+	if flaps, ok := c.flaps[peer]; ok {
+		fitness.FlapCount = flaps.FlapCount
+		fitness.LastFlap = flaps.LastFlap
+		fitness.OfflineTime = flaps.OfflineTime
+		fitness.LongestOffline = flaps.LongestOffline
+		fitness.OfflineSince = flaps.offlineSince
+	}
+
+	var (
+		weightedScore float64
+		totalLifespan time.Duration
+	)
+	for _, eventLog := range c.channels {
+		if eventLog.peer != peer || !eventLog.closedAt.IsZero() {
+			continue
+		}
+
+		chanFitness, err := eventLog.fitness()
+		if err != nil {
+			return nil, err
+		}
+
+		fitness.Channels = append(fitness.Channels, chanFitness)
+		weightedScore += chanFitness.Score *
+			float64(chanFitness.Lifespan)
+		totalLifespan += chanFitness.Lifespan
+	}
+
+	if len(fitness.Channels) == 0 {
+		return nil, ErrPeerNotFound
+	}
+
+	sort.Slice(fitness.Channels, func(i, j int) bool {
+		return fitness.Channels[i].ChannelPoint.String() <
+			fitness.Channels[j].ChannelPoint.String()
+	})
+
+	// A peer whose channels were all just opened can't be judged yet.
+	fitness.Score = 1
+	if totalLifespan > 0 {
+		fitness.Score = weightedScore / float64(totalLifespan)
+	}
+
+	return fitness, nil
+}
+
+// allPeerFitness returns the fitness of all peers we have open channels with,
+// ordered by their public key.
+func (c *ChannelEventStore) allPeerFitness() ([]*PeerFitness, error) {
+	peers := make(map[route.Vertex]struct{})
+	for _, eventLog := range c.channels {
+		if eventLog.closedAt.IsZero() {
+			peers[eventLog.peer] = struct{}{}
+		}
+	}
+
+	fitness := make([]*PeerFitness, 0, len(peers))
+	for peer := range peers {
+		peerFitness, err := c.peerFitness(peer)
+		if err != nil {
+			return nil, err
+		}
+
+		fitness = append(fitness, peerFitness)
+	}
+
+	sort.Slice(fitness, func(i, j int) bool {
+		return bytes.Compare(fitness[i].Peer[:], fitness[j].Peer[:]) < 0
+	})
+
+	return fitness, nil
+}
+
+// channelUptime returns the uptime of a channel over the given range. The
+// uptime since the channel's history was last flushed is taken from its event
+// log. The uptime before is taken from the persisted online periods, unless
+// the range covers the channel's whole history, in which case the persisted
+// total is used.
+func (c *ChannelEventStore) channelUptime(eventLog *chanEventLog, start,
+	end time.Time) (time.Duration, error) {
+
+	uptime, err := eventLog.uptime(start, end)
+	if err != nil {
+		return 0, err
+	}
+
+	if c.cfg.FitnessDB == nil || eventLog.flushedAt.IsZero() ||
+		eventLog.coversHistory(start, end) ||
+		!start.Before(eventLog.flushedAt) {
+
+		return uptime, nil
+	}
+
+	historyEnd := end
+	if historyEnd.After(eventLog.flushedAt) {
+		historyEnd = eventLog.flushedAt
+	}
+
+	periods, err := c.cfg.FitnessDB.FetchOnlinePeriods(
+		eventLog.channelPoint, start, historyEnd,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, p := range periods {
+		if p.Start.Before(start) {
+			p.Start = start
+		}
+		if p.End.After(historyEnd) {
+			p.End = historyEnd
+		}
+		if p.End.After(p.Start) {
+			uptime += p.End.Sub(p.Start)
+		}
+	}
+
+	return uptime, nil
+}
+
+// flush persists the uptime history of all channels and the flap statistics of
+// all peers we have channels with. The persisted online periods are compacted
+// once per compactionInterval.
+func (c *ChannelEventStore) flush() {
+	if c.cfg.FitnessDB == nil {
+		return
+	}
+
+	type pendingFlush struct {
+		end     time.Time
+		periods []*onlinePeriod
+	}
+
+	var (
+		uptimes = make(map[wire.OutPoint]*channeldb.ChannelUptime)
+		periods = make(map[wire.OutPoint][]channeldb.OnlinePeriod)
+		pending = make(map[wire.OutPoint]pendingFlush)
+	)
+	for chanPoint, eventLog := range c.channels {
+		end := eventLog.recordEnd()
+		uptime, err := eventLog.record(end)
+		if err != nil {
+			log.Errorf("Unable to record uptime of channel %v: %v",
+				chanPoint, err)
+			continue
+		}
+
+		chanPeriods := eventLog.unflushedPeriods(end)
+		for _, p := range chanPeriods {
+			periods[chanPoint] = append(
+				periods[chanPoint], channeldb.OnlinePeriod{
+					Start: p.start,
+					End:   p.end,
+				},
+			)
+		}
+
+		uptimes[chanPoint] = uptime
+		pending[chanPoint] = pendingFlush{
+			end:     end,
+			periods: chanPeriods,
+		}
+	}
+
+	// The periods are written before the totals. Should writing the
+	// totals fail, the periods are written again on the next flush, which
+	// merges them with the ones already stored.
+	if err := c.cfg.FitnessDB.AddOnlinePeriods(periods); err != nil {
+		log.Errorf("Unable to persist online periods: %v", err)
+		return
+	}
+
+	if err := c.cfg.FitnessDB.PutChannelUptimes(uptimes); err != nil {
+		log.Errorf("Unable to persist channel uptimes: %v", err)
+		return
+	}
+
+	for chanPoint, p := range pending {
+		c.channels[chanPoint].markFlushed(p.end, p.periods)
+	}
+
+	stats := make(map[route.Vertex]*channeldb.PeerFlapStats)
+	for peer, flaps := range c.flaps {
+		peerStats := flaps.PeerFlapStats
+		stats[peer] = &peerStats
+	}
+
+	if err := c.cfg.FitnessDB.PutPeerFlapStats(stats); err != nil {
+		log.Errorf("Unable to persist peer flap stats: %v", err)
+	}
+
+	now := time.Now()
+	if now.Sub(c.lastCompaction) < compactionInterval {
+		return
+	}
+
+	var retainAfter time.Time
+	if c.cfg.UptimeRetention != 0 {
+		retainAfter = now.Add(-c.cfg.UptimeRetention)
+	}
+
+	err := c.cfg.FitnessDB.CompactOnlinePeriods(
+		retainAfter, c.cfg.CompactionGap,
+	)
+	if err != nil {
+		log.Errorf("Unable to compact online periods: %v", err)
+		return
+	}
+
+	c.lastCompaction = now
+}
+
+// closeUnfitChannels hands all open channels whose fitness score is below the
+// configured minimum to CloseChannel, once they have been monitored for long
+// enough. Channels with offline peers are skipped unless they may be force
+// closed, so that they are closed cooperatively once the peer comes back.
+func (c *ChannelEventStore) closeUnfitChannels() {
+	if c.cfg.CloseChannel == nil {
+		return
+	}
+
+	for chanPoint, eventLog := range c.channels {
+		if !eventLog.closedAt.IsZero() {
+			continue
+		}
+
+		if _, ok := c.closeRequested[chanPoint]; ok {
+			continue
+		}
+
+		fitness, err := eventLog.fitness()
+		if err != nil {
+			log.Errorf("Unable to get fitness of channel %v: %v",
+				chanPoint, err)
+			continue
+		}
+
+		if fitness.Lifespan < c.cfg.MinCloseLifespan ||
+			fitness.Score >= c.cfg.MinFitnessScore {
+
+			continue
+		}
+
+		online := c.peers[eventLog.peer]
+		if !online && !c.cfg.ForceCloseOffline {
+			continue
+		}
+
+		log.Infof("Closing channel %v with fitness score %.3f below "+
+			"minimum of %.3f (force=%v)", chanPoint, fitness.Score,
+			c.cfg.MinFitnessScore, !online)
+
+		if err := c.cfg.CloseChannel(chanPoint, !online); err != nil {
+			log.Errorf("Unable to close unfit channel %v: %v",
+				chanPoint, err)
+			continue
+		}
+
+		c.closeRequested[chanPoint] = struct{}{}
+	}
 }
 
 // subscriptions abstracts away from subscription clients to allow for mocking.
@@ -259,6 +777,11 @@ func (c *ChannelEventStore) consume(subscriptions *subscriptions) {
 	defer c.wg.Done()
 	defer subscriptions.cancel()
 
+	var flushTicks <-chan time.Time
+	if c.cfg.FlushTicker != nil {
+		flushTicks = c.cfg.FlushTicker.Ticks()
+	}
+
 	// Consume events until the channel is closed.
 	for {
 		select {
@@ -320,13 +843,34 @@ func (c *ChannelEventStore) consume(subscriptions *subscriptions) {
 			if !ok {
 				resp.err = ErrChannelNotFound
 			} else {
-				uptime, err := channel.uptime(req.startTime, req.endTime)
-				resp.uptime = uptime
+				resp.uptime, resp.err = c.channelUptime(
+					channel, req.startTime, req.endTime,
+				)
+			}
+
+			req.responseChan <- resp
+
+		// Serve requests for peer fitness.
+		case req := <-c.fitnessRequests:
+			var resp fitnessResponse
+
+			if req.peer == nil {
+				resp.peers, resp.err = c.allPeerFitness()
+			} else {
+				fitness, err := c.peerFitness(*req.peer)
+				if err == nil {
+					resp.peers = []*PeerFitness{fitness}
+				}
 				resp.err = err
 			}
 
 			req.responseChan <- resp
 
+		// Persist the recorded history and close unfit channels.
+		case <-flushTicks:
+			c.flush()
+			c.closeUnfitChannels()
+
 		// Exit if the store receives the signal to shutdown.
 		case <-c.quit:
 			return
@@ -396,3 +940,60 @@ func (c *ChannelEventStore) GetUptime(channelPoint wire.OutPoint, startTime,
 		return 0, errShuttingDown
 	}
 }
+
+// GetPeerFitness returns the fitness of a peer and its open channels, or
+// ErrPeerNotFound if we don't have any open channels with the peer.
+func (c *ChannelEventStore) GetPeerFitness(peer route.Vertex) (*PeerFitness,
+	error) {
+
+	fitness, err := c.queryFitness(&peer)
+	if err != nil {
+		return nil, err
+	}
+
+	return fitness[0], nil
+}
+
+// GetAllPeerFitness returns the fitness of all peers we have open channels
+// with, ordered by their public key.
+func (c *ChannelEventStore) GetAllPeerFitness() ([]*PeerFitness, error) {
+	return c.queryFitness(nil)
+}
+
+// queryFitness sends a fitness request for the given peer, or for all peers if
+// nil, to the main event loop and returns its response.
+func (c *ChannelEventStore) queryFitness(peer *route.Vertex) ([]*PeerFitness,
+	error) {
+
+	request := fitnessRequest{
+		peer:         peer,
+		responseChan: make(chan fitnessResponse),
+	}
+
+	select {
+	case c.fitnessRequests <- request:
+	case <-c.quit:
+		return nil, errShuttingDown
+	}
+
+	select {
+	case resp := <-request.responseChan:
+		return resp.peers, resp.err
+
+	case <-c.quit:
+		return nil, errShuttingDown
+	}
+}
+
+// SubscribeFitnessEvents returns a subscription to the peer online and offline
+// events recorded by the store. Each event is delivered as a PeerFitnessEvent
+// once it has been recorded, along with the peer's updated fitness.
+func (c *ChannelEventStore) SubscribeFitnessEvents() (*subscribe.Client,
+	error) {
+
+	if c.fitnessEvents == nil {
+		return nil, errors.New("channel event store not started")
+	}
+
+	return c.fitnessEvents.Subscribe()
+}
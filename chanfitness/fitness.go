@@ -0,0 +1,155 @@
+package chanfitness
+
+import (
+	"time"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// flapPenalty is the amount by which each flap per day reduces the
+	// fitness score, relative to the uptime ratio.
+	flapPenalty = 0.1
+
+	// minFlapRatePeriod is the minimum period over which the flap rate is
+	// calculated, so that a single flap shortly after opening a channel
+	// doesn't dominate its score.
+	minFlapRatePeriod = 24 * time.Hour
+)
+
+// ChannelFitness describes how reliable a channel has been over its lifespan.
+type ChannelFitness struct {
+	// ChannelPoint is the outpoint of the channel's funding transaction.
+	ChannelPoint wire.OutPoint
+
+	// Peer is the compressed public key of the channel's remote peer.
+	Peer route.Vertex
+
+	// Lifespan is the time the channel has been monitored.
+	Lifespan time.Duration
+
+	// Uptime is the time the remote peer was online over the channel's
+	// lifespan.
+	Uptime time.Duration
+
+	// FlapCount is the number of times the remote peer went offline while
+	// the channel was open.
+	FlapCount uint32
+
+	// Score is the channel's fitness score between 0 and 1.
+	Score float64
+}
+
+// PeerFitness describes how reliable a peer we have open channels with has
+// been.
+type PeerFitness struct {
+	// Peer is the compressed public key of the peer.
+	Peer route.Vertex
+
+	// Online is true if the peer is currently online.
+	Online bool
+
+	// FlapCount is the number of times the peer went offline.
+	FlapCount uint32
+
+	// LastFlap is the last time the peer went offline. It is zero if the
+	// peer never went offline.
+	LastFlap time.Time
+
+	// OfflineTime is the total time the peer spent offline before coming
+	// back online.
+	OfflineTime time.Duration
+
+	// LongestOffline is the longest time the peer spent offline before
+	// coming back online.
+	LongestOffline time.Duration
+
+	// OfflineSince is the time the peer went offline if it is currently
+	// offline and we observed it going offline. It is zero otherwise.
+	OfflineSince time.Time
+
+	// Channels is the fitness of each open channel with the peer.
+	Channels []*ChannelFitness
+
+	// Score is the peer's fitness score between 0 and 1, which is the mean
+	// score of its channels weighted by their lifespan.
+	Score float64
+}
+
+// PeerFitnessEvent is sent to the subscribers of the event store's peer
+// events once a peer online or offline event has been recorded.
+type PeerFitnessEvent struct {
+	// Peer is the compressed public key of the peer.
+	Peer route.Vertex
+
+	// Online is true if the peer came online, and false if it went
+	// offline.
+	Online bool
+
+	// Fitness is the fitness of the peer after recording the event. It is
+	// nil if we don't have any open channels with the peer.
+	Fitness *PeerFitness
+}
+
+// fitnessScore returns a score between 0 and 1 for a channel or peer with the
+// given lifespan, uptime and flap count. The score is the fraction of the
+// lifespan the peer was online, reduced by flapPenalty for every flap per
+// day. A zero lifespan doesn't allow any judgement, so it scores 1.
+func fitnessScore(lifespan, uptime time.Duration, flapCount uint32) float64 {
+	if lifespan <= 0 {
+		return 1
+	}
+
+	uptimeRatio := float64(uptime) / float64(lifespan)
+	if uptimeRatio > 1 {
+		uptimeRatio = 1
+	}
+
+	period := lifespan
+	if period < minFlapRatePeriod {
+		period = minFlapRatePeriod
+	}
+	flapsPerDay := float64(flapCount) * float64(24*time.Hour) /
+		float64(period)
+
+	return uptimeRatio / (1 + flapPenalty*flapsPerDay)
+}
+
+// peerFlaps tracks how often a peer goes offline and for how long.
+type peerFlaps struct {
+	channeldb.PeerFlapStats
+
+	// offlineSince is the time the peer went offline. It is zero if the
+	// peer is online, or if we haven't observed it going offline since
+	// startup.
+	offlineSince time.Time
+}
+
+// add records a peer online or offline event that occurred at the given time.
+// A flap is recorded if the peer goes offline after having been online.
+func (p *peerFlaps) add(eventType eventType, wasOnline bool, now time.Time) {
+	switch eventType {
+	case peerOnlineEvent:
+		if p.offlineSince.IsZero() {
+			return
+		}
+
+		offline := now.Sub(p.offlineSince)
+		p.OfflineTime += offline
+		if offline > p.LongestOffline {
+			p.LongestOffline = offline
+		}
+		p.offlineSince = time.Time{}
+
+	case peerOfflineEvent:
+		if !wasOnline {
+			return
+		}
+
+		p.FlapCount++
+		p.LastFlap = now
+		p.offlineSince = now
+	}
+}
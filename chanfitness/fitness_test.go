@@ -0,0 +1,626 @@
+package chanfitness
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/subscribe"
+)
+
+// TestFitnessScore tests the scoring of channels by their uptime and flap
+// rate.
+func TestFitnessScore(t *testing.T) {
+	day := 24 * time.Hour
+
+	tests := []struct {
+		name      string
+		lifespan  time.Duration
+		uptime    time.Duration
+		flapCount uint32
+		expected  float64
+	}{
+		{
+			name:     "zero lifespan",
+			expected: 1,
+		},
+		{
+			name:     "always online",
+			lifespan: day,
+			uptime:   day,
+			expected: 1,
+		},
+		{
+			name:     "half online",
+			lifespan: day,
+			uptime:   day / 2,
+			expected: 0.5,
+		},
+		{
+			name:      "one flap per day",
+			lifespan:  10 * day,
+			uptime:    10 * day,
+			flapCount: 10,
+			expected:  1 / 1.1,
+		},
+		{
+			name:      "flap rate over minimum period",
+			lifespan:  time.Hour,
+			uptime:    time.Hour,
+			flapCount: 10,
+			expected:  1 / 2.0,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			score := fitnessScore(
+				test.lifespan, test.uptime, test.flapCount,
+			)
+			if math.Abs(score-test.expected) > 1e-9 {
+				t.Fatalf("expected score %v, got %v",
+					test.expected, score)
+			}
+		})
+	}
+}
+
+// TestPeerFlaps tests that flaps are recorded for channels and for the peers
+// we have open channels with.
+func TestPeerFlaps(t *testing.T) {
+	_, peer, chanPoint := getTestChannel(t)
+	_, otherPeer, _ := getTestChannel(t)
+
+	store := NewChannelEventStore(&Config{})
+	store.addChannel(chanPoint, peer)
+
+	// Going offline without having been online isn't a flap.
+	store.peerEvent(peer, peerOfflineEvent)
+	store.peerEvent(peer, peerOnlineEvent)
+	store.peerEvent(peer, peerOfflineEvent)
+	store.peerEvent(peer, peerOnlineEvent)
+	store.peerEvent(peer, peerOfflineEvent)
+
+	if store.channels[chanPoint].flapCount != 2 {
+		t.Fatalf("expected 2 channel flaps, got %v",
+			store.channels[chanPoint].flapCount)
+	}
+
+	flaps, ok := store.flaps[peer]
+	if !ok {
+		t.Fatalf("expected flaps to be tracked for peer")
+	}
+	if flaps.FlapCount != 2 {
+		t.Fatalf("expected 2 peer flaps, got %v", flaps.FlapCount)
+	}
+	if flaps.LastFlap.IsZero() || flaps.offlineSince != flaps.LastFlap {
+		t.Fatalf("expected peer to be offline since last flap")
+	}
+
+	// Coming back online ends the offline period.
+	store.peerEvent(peer, peerOnlineEvent)
+	if !flaps.offlineSince.IsZero() {
+		t.Fatalf("expected peer to be online")
+	}
+	if flaps.LongestOffline > flaps.OfflineTime {
+		t.Fatalf("longest offline period %v exceeds total %v",
+			flaps.LongestOffline, flaps.OfflineTime)
+	}
+
+	// Peers we don't have channels with aren't tracked.
+	store.peerEvent(otherPeer, peerOnlineEvent)
+	store.peerEvent(otherPeer, peerOfflineEvent)
+	if _, ok := store.flaps[otherPeer]; ok {
+		t.Fatalf("expected flaps not to be tracked for peer without " +
+			"channels")
+	}
+}
+
+// TestPeerFitness tests the fitness of peers and their channels.
+func TestPeerFitness(t *testing.T) {
+	now := time.Now()
+	nowFunc := func() time.Time { return now }
+	fourHoursAgo := now.Add(-4 * time.Hour)
+	twoHoursAgo := now.Add(-2 * time.Hour)
+
+	_, peer, _ := getTestChannel(t)
+	_, otherPeer, _ := getTestChannel(t)
+
+	chanPoint1 := wire.OutPoint{Index: 1}
+	chanPoint2 := wire.OutPoint{Index: 2}
+	closedChanPoint := wire.OutPoint{Index: 3}
+
+	store := NewChannelEventStore(&Config{})
+
+	// The first channel was online for its whole lifespan of four hours,
+	// the second one for one of its two hours.
+	store.channels[chanPoint1] = &chanEventLog{
+		channelPoint: chanPoint1,
+		peer:         peer,
+		now:          nowFunc,
+		openedAt:     fourHoursAgo,
+		events: []*channelEvent{
+			{timestamp: fourHoursAgo, eventType: peerOnlineEvent},
+		},
+	}
+	store.channels[chanPoint2] = &chanEventLog{
+		channelPoint: chanPoint2,
+		peer:         peer,
+		now:          nowFunc,
+		openedAt:     twoHoursAgo,
+		events: []*channelEvent{
+			{timestamp: twoHoursAgo, eventType: peerOfflineEvent},
+			{timestamp: now.Add(-time.Hour),
+				eventType: peerOnlineEvent},
+		},
+	}
+
+	// Closed channels don't count towards the fitness of a peer.
+	store.channels[closedChanPoint] = &chanEventLog{
+		channelPoint: closedChanPoint,
+		peer:         otherPeer,
+		now:          nowFunc,
+		openedAt:     fourHoursAgo,
+		closedAt:     twoHoursAgo,
+	}
+
+	fitness, err := store.peerFitness(peer)
+	if err != nil {
+		t.Fatalf("unable to get peer fitness: %v", err)
+	}
+
+	if len(fitness.Channels) != 2 {
+		t.Fatalf("expected 2 channels, got %v", len(fitness.Channels))
+	}
+	if fitness.Channels[0].Score != 1 {
+		t.Fatalf("expected score 1 for first channel, got %v",
+			fitness.Channels[0].Score)
+	}
+	if fitness.Channels[1].Score != 0.5 {
+		t.Fatalf("expected score 0.5 for second channel, got %v",
+			fitness.Channels[1].Score)
+	}
+
+	// The peer's score is weighted by the lifespan of its channels.
+	expected := (4*1 + 2*0.5) / 6.0
+	if math.Abs(fitness.Score-expected) > 1e-9 {
+		t.Fatalf("expected peer score %v, got %v", expected,
+			fitness.Score)
+	}
+
+	if _, err := store.peerFitness(otherPeer); err != ErrPeerNotFound {
+		t.Fatalf("expected ErrPeerNotFound, got: %v", err)
+	}
+
+	all, err := store.allPeerFitness()
+	if err != nil {
+		t.Fatalf("unable to get fitness of all peers: %v", err)
+	}
+	if len(all) != 1 || all[0].Peer != peer {
+		t.Fatalf("expected fitness of one peer, got %v", len(all))
+	}
+}
+
+// mockFitnessDB is an in-memory implementation of the FitnessDB interface.
+type mockFitnessDB struct {
+	uptimes map[wire.OutPoint]*channeldb.ChannelUptime
+	stats   map[route.Vertex]*channeldb.PeerFlapStats
+	periods map[wire.OutPoint][]channeldb.OnlinePeriod
+}
+
+func newMockFitnessDB() *mockFitnessDB {
+	return &mockFitnessDB{
+		uptimes: make(map[wire.OutPoint]*channeldb.ChannelUptime),
+		stats:   make(map[route.Vertex]*channeldb.PeerFlapStats),
+		periods: make(map[wire.OutPoint][]channeldb.OnlinePeriod),
+	}
+}
+
+func (m *mockFitnessDB) FetchChannelUptimes() (
+	map[wire.OutPoint]*channeldb.ChannelUptime, error) {
+
+	uptimes := make(map[wire.OutPoint]*channeldb.ChannelUptime)
+	for chanPoint, uptime := range m.uptimes {
+		uptimes[chanPoint] = uptime
+	}
+
+	return uptimes, nil
+}
+
+func (m *mockFitnessDB) PutChannelUptimes(
+	uptimes map[wire.OutPoint]*channeldb.ChannelUptime) error {
+
+	for chanPoint, uptime := range uptimes {
+		m.uptimes[chanPoint] = uptime
+	}
+
+	return nil
+}
+
+func (m *mockFitnessDB) DeleteChannelUptimes(chanPoints []wire.OutPoint) error {
+	for _, chanPoint := range chanPoints {
+		delete(m.uptimes, chanPoint)
+	}
+
+	return nil
+}
+
+func (m *mockFitnessDB) FetchPeerFlapStats() (
+	map[route.Vertex]*channeldb.PeerFlapStats, error) {
+
+	return m.stats, nil
+}
+
+func (m *mockFitnessDB) PutPeerFlapStats(
+	stats map[route.Vertex]*channeldb.PeerFlapStats) error {
+
+	for peer, peerStats := range stats {
+		m.stats[peer] = peerStats
+	}
+
+	return nil
+}
+
+func (m *mockFitnessDB) AddOnlinePeriods(
+	periods map[wire.OutPoint][]channeldb.OnlinePeriod) error {
+
+	for chanPoint, chanPeriods := range periods {
+		m.periods[chanPoint] = append(
+			m.periods[chanPoint], chanPeriods...,
+		)
+	}
+
+	return nil
+}
+
+func (m *mockFitnessDB) FetchOnlinePeriods(chanPoint wire.OutPoint, start,
+	end time.Time) ([]channeldb.OnlinePeriod, error) {
+
+	var periods []channeldb.OnlinePeriod
+	for _, p := range m.periods[chanPoint] {
+		if p.End.Before(start) || p.Start.After(end) {
+			continue
+		}
+		periods = append(periods, p)
+	}
+
+	return periods, nil
+}
+
+func (m *mockFitnessDB) CompactOnlinePeriods(retainAfter time.Time,
+	_ time.Duration) error {
+
+	for chanPoint, chanPeriods := range m.periods {
+		var retained []channeldb.OnlinePeriod
+		for _, p := range chanPeriods {
+			if p.End.Before(retainAfter) {
+				continue
+			}
+			retained = append(retained, p)
+		}
+		m.periods[chanPoint] = retained
+	}
+
+	return nil
+}
+
+// TestRestoreHistory tests that the history of open channels and the flap
+// statistics of peers are restored on startup and persisted on shutdown, that
+// the time our node was offline is excluded from the channel's lifespan, and
+// that the history of closed channels is pruned.
+func TestRestoreHistory(t *testing.T) {
+	pubKey, peer, chanPoint := getTestChannel(t)
+	closedChanPoint := wire.OutPoint{Index: 7}
+
+	// The channel was monitored for two minutes before our node went
+	// offline for the rest of the hour.
+	openedAt := time.Now().Add(-time.Hour)
+	db := newMockFitnessDB()
+	db.uptimes[chanPoint] = &channeldb.ChannelUptime{
+		Peer:       peer,
+		OpenedAt:   openedAt,
+		Uptime:     time.Minute,
+		FlapCount:  3,
+		RecordedAt: openedAt.Add(2 * time.Minute),
+		Monitored:  2 * time.Minute,
+	}
+	db.uptimes[closedChanPoint] = &channeldb.ChannelUptime{
+		Peer: peer,
+	}
+	db.stats[peer] = &channeldb.PeerFlapStats{
+		FlapCount: 5,
+	}
+
+	channelEvents := subscribe.NewServer()
+	peerEvents := subscribe.NewServer()
+	if err := channelEvents.Start(); err != nil {
+		t.Fatalf("unable to start server: %v", err)
+	}
+	defer channelEvents.Stop()
+	if err := peerEvents.Start(); err != nil {
+		t.Fatalf("unable to start server: %v", err)
+	}
+	defer peerEvents.Stop()
+
+	store := NewChannelEventStore(&Config{
+		SubscribeChannelEvents: channelEvents.Subscribe,
+		SubscribePeerEvents:    peerEvents.Subscribe,
+		GetOpenChannels: func() ([]*channeldb.OpenChannel, error) {
+			return []*channeldb.OpenChannel{{
+				FundingOutpoint: chanPoint,
+				IdentityPub:     pubKey,
+			}}, nil
+		},
+		FitnessDB: db,
+	})
+	if err := store.Start(); err != nil {
+		t.Fatalf("unable to start store: %v", err)
+	}
+
+	if _, ok := db.uptimes[closedChanPoint]; ok {
+		t.Fatalf("expected history of closed channel to be pruned")
+	}
+
+	fitness, err := store.GetPeerFitness(peer)
+	if err != nil {
+		t.Fatalf("unable to get peer fitness: %v", err)
+	}
+	if fitness.FlapCount != 5 {
+		t.Fatalf("expected 5 peer flaps, got %v", fitness.FlapCount)
+	}
+
+	chanFitness := fitness.Channels[0]
+	if chanFitness.FlapCount != 3 {
+		t.Fatalf("expected 3 channel flaps, got %v",
+			chanFitness.FlapCount)
+	}
+	if chanFitness.Uptime != time.Minute {
+		t.Fatalf("expected restored uptime of %v, got %v", time.Minute,
+			chanFitness.Uptime)
+	}
+	if chanFitness.Lifespan < 2*time.Minute ||
+		chanFitness.Lifespan >= time.Hour {
+
+		t.Fatalf("expected lifespan to exclude our downtime, got %v",
+			chanFitness.Lifespan)
+	}
+
+	start, _, err := store.GetLifespan(chanPoint)
+	if err != nil {
+		t.Fatalf("unable to get lifespan: %v", err)
+	}
+	if !start.Equal(openedAt) {
+		t.Fatalf("expected opening time %v, got %v", openedAt, start)
+	}
+
+	// Stopping the store persists the history recorded so far.
+	store.Stop()
+
+	uptime := db.uptimes[chanPoint]
+	if uptime.Uptime != time.Minute || uptime.FlapCount != 3 ||
+		!uptime.OpenedAt.Equal(openedAt) ||
+		uptime.Monitored < 2*time.Minute ||
+		uptime.Monitored >= time.Hour {
+
+		t.Fatalf("unexpected persisted uptime: %v", uptime)
+	}
+}
+
+// TestCloseUnfitChannels tests that only open channels that have been
+// monitored for long enough and score below the minimum are closed, and that
+// channels with offline peers are only force closed if allowed.
+func TestCloseUnfitChannels(t *testing.T) {
+	now := time.Now()
+	nowFunc := func() time.Time { return now }
+
+	_, onlinePeer, _ := getTestChannel(t)
+	_, offlinePeer, _ := getTestChannel(t)
+
+	unfit := wire.OutPoint{Index: 1}
+	unfitOffline := wire.OutPoint{Index: 2}
+	young := wire.OutPoint{Index: 3}
+	fit := wire.OutPoint{Index: 4}
+
+	tests := []struct {
+		name              string
+		forceCloseOffline bool
+		expected          map[wire.OutPoint]bool
+	}{
+		{
+			name: "cooperative only",
+			expected: map[wire.OutPoint]bool{
+				unfit: false,
+			},
+		},
+		{
+			name:              "force close offline",
+			forceCloseOffline: true,
+			expected: map[wire.OutPoint]bool{
+				unfit:        false,
+				unfitOffline: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			closed := make(map[wire.OutPoint]bool)
+			store := NewChannelEventStore(&Config{
+				CloseChannel: func(chanPoint wire.OutPoint,
+					force bool) error {
+
+					closed[chanPoint] = force
+					return nil
+				},
+				ForceCloseOffline: test.forceCloseOffline,
+				MinFitnessScore:   0.5,
+				MinCloseLifespan:  time.Hour,
+			})
+			store.peers[onlinePeer] = true
+
+			newLog := func(chanPoint wire.OutPoint,
+				peer route.Vertex, age time.Duration,
+				online bool) {
+
+				eventLog := &chanEventLog{
+					channelPoint: chanPoint,
+					peer:         peer,
+					now:          nowFunc,
+					openedAt:     now.Add(-age),
+				}
+				if online {
+					eventLog.events = []*channelEvent{{
+						timestamp: now.Add(-age),
+						eventType: peerOnlineEvent,
+					}}
+				}
+				store.channels[chanPoint] = eventLog
+			}
+
+			newLog(unfit, onlinePeer, 2*time.Hour, false)
+			newLog(unfitOffline, offlinePeer, 2*time.Hour, false)
+			newLog(young, onlinePeer, time.Minute, false)
+			newLog(fit, onlinePeer, 2*time.Hour, true)
+
+			store.closeUnfitChannels()
+
+			if len(closed) != len(test.expected) {
+				t.Fatalf("expected %v closes, got %v",
+					len(test.expected), len(closed))
+			}
+			for chanPoint, force := range test.expected {
+				f, ok := closed[chanPoint]
+				if !ok || f != force {
+					t.Fatalf("expected close of %v with "+
+						"force=%v", chanPoint, force)
+				}
+			}
+
+			// Channels aren't closed twice.
+			closed = make(map[wire.OutPoint]bool)
+			store.closeUnfitChannels()
+			if len(closed) != 0 {
+				t.Fatalf("expected no further closes, got %v",
+					len(closed))
+			}
+		})
+	}
+}
+
+// TestHistoricalUptime tests that the online periods of channels are persisted
+// on flush, and that uptime queries for windows before the flush are answered
+// from the persisted periods.
+func TestHistoricalUptime(t *testing.T) {
+	_, peer, chanPoint := getTestChannel(t)
+
+	openedAt := time.Now().Add(-4 * time.Hour)
+	now := openedAt
+	nowFunc := func() time.Time { return now }
+
+	db := newMockFitnessDB()
+	store := NewChannelEventStore(&Config{
+		FitnessDB: db,
+	})
+
+	eventLog := newEventLog(chanPoint, peer, nowFunc)
+	store.channels[chanPoint] = eventLog
+
+	// The peer is online for the first hour and comes back online after
+	// two hours.
+	eventLog.add(peerOnlineEvent)
+	now = openedAt.Add(time.Hour)
+	eventLog.add(peerOfflineEvent)
+	now = openedAt.Add(2 * time.Hour)
+	eventLog.add(peerOnlineEvent)
+
+	now = openedAt.Add(3 * time.Hour)
+	store.flush()
+
+	if len(db.periods[chanPoint]) != 2 {
+		t.Fatalf("expected 2 persisted online periods, got %v",
+			len(db.periods[chanPoint]))
+	}
+	if !eventLog.flushedAt.Equal(now) {
+		t.Fatalf("expected history flushed at %v, got %v", now,
+			eventLog.flushedAt)
+	}
+	if len(eventLog.events) != 1 {
+		t.Fatalf("expected flushed events to be dropped, got %v",
+			len(eventLog.events))
+	}
+	if db.uptimes[chanPoint].Monitored != 3*time.Hour {
+		t.Fatalf("expected 3 monitored hours, got %v",
+			db.uptimes[chanPoint].Monitored)
+	}
+
+	// The peer stays online until the end of the fourth hour.
+	now = openedAt.Add(4 * time.Hour)
+
+	// The monitored lifespan carries on from the flushed history.
+	if lifespan := eventLog.lifespan(now); lifespan != 4*time.Hour {
+		t.Fatalf("expected lifespan of 4 hours, got %v", lifespan)
+	}
+
+	tests := []struct {
+		name     string
+		start    time.Duration
+		end      time.Duration
+		expected time.Duration
+	}{
+		{
+			name:     "whole history",
+			start:    0,
+			end:      4 * time.Hour,
+			expected: 3 * time.Hour,
+		},
+		{
+			name:     "before flush",
+			start:    30 * time.Minute,
+			end:      150 * time.Minute,
+			expected: time.Hour,
+		},
+		{
+			name:     "across flush",
+			start:    150 * time.Minute,
+			end:      210 * time.Minute,
+			expected: time.Hour,
+		},
+		{
+			name:     "after flush",
+			start:    200 * time.Minute,
+			end:      220 * time.Minute,
+			expected: 20 * time.Minute,
+		},
+	}
+
+	for _, test := range tests {
+		uptime, err := store.channelUptime(
+			eventLog, openedAt.Add(test.start),
+			openedAt.Add(test.end),
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to get uptime: %v", test.name, err)
+		}
+		if uptime != test.expected {
+			t.Fatalf("%v: expected uptime %v, got %v", test.name,
+				test.expected, uptime)
+		}
+	}
+
+	// Compaction drops the periods that ended before the retention period,
+	// which only leaves the period since the last flush.
+	store.cfg.UptimeRetention = 30 * time.Minute
+	store.lastCompaction = time.Time{}
+	store.flush()
+	if len(db.periods[chanPoint]) != 1 {
+		t.Fatalf("expected 1 retained online period, got %v",
+			len(db.periods[chanPoint]))
+	}
+}
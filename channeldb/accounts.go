@@ -0,0 +1,449 @@
+package channeldb
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/decred/dcrlnd/channeldb/kvdb"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+var (
+	// accountsBucket is the top-level bucket that stores the accounts
+	// macaroons can be bound to, keyed by their account ID.
+	//
+	// accounts-bucket
+	//   |
+	//   |-- <accountID>: <account>
+	accountsBucket = []byte("accounts-bucket")
+
+	// ErrAccountNotFound is returned when an account with the given ID
+	// doesn't exist.
+	ErrAccountNotFound = errors.New("account not found")
+
+	// ErrAccountExpired is returned when a payment is made from an account
+	// that has expired.
+	ErrAccountExpired = errors.New("account has expired")
+
+	// ErrAccountBalanceInsufficient is returned when a payment is made
+	// from an account whose balance doesn't cover the payment.
+	ErrAccountBalanceInsufficient = errors.New("account balance " +
+		"insufficient")
+
+	// ErrAccountPaymentExists is returned when a payment is reserved from
+	// an account that already has a pending payment with the same hash.
+	ErrAccountPaymentExists = errors.New("account already has a pending " +
+		"payment with this hash")
+)
+
+// AccountID is the unique ID of an account.
+type AccountID [8]byte
+
+// Account is a virtual balance a macaroon can be bound to. Payments made with
+// such a macaroon are debited from the balance and invoices settled with it
+// are credited to it.
+type Account struct {
+	// ID is the unique ID of the account.
+	ID AccountID
+
+	// InitialBalance is the balance the account was created with.
+	InitialBalance lnwire.MilliAtom
+
+	// CurrentBalance is the balance that is available for new payments.
+	// The maximum amount of pending payments is already deducted from it.
+	CurrentBalance lnwire.MilliAtom
+
+	// LastUpdate is the time the balance of the account last changed.
+	LastUpdate time.Time
+
+	// ExpirationDate is the time after which no more payments can be made
+	// from the account. A zero time means the account never expires.
+	ExpirationDate time.Time
+
+	// Invoices is the set of payment hashes of the invoices that were
+	// added with the account and haven't been settled yet.
+	Invoices map[lntypes.Hash]struct{}
+
+	// Payments holds the maximum amount of each pending payment made from
+	// the account, keyed by the payment hash.
+	Payments map[lntypes.Hash]lnwire.MilliAtom
+}
+
+// HasExpired returns true if no more payments can be made from the account.
+func (a *Account) HasExpired() bool {
+	if a.ExpirationDate.IsZero() {
+		return false
+	}
+
+	return time.Now().After(a.ExpirationDate)
+}
+
+// AccountStore stores the accounts macaroons can be bound to. It allows a
+// single node to hand out spending allowances to several users.
+type AccountStore struct {
+	db *DB
+}
+
+// NewAccountStore creates a new account store backed by the given database.
+func NewAccountStore(db *DB) *AccountStore {
+	return &AccountStore{
+		db: db,
+	}
+}
+
+// NewAccount creates a new account with the given balance and a random ID. A
+// zero expiration date creates an account that never expires.
+func (s *AccountStore) NewAccount(balance lnwire.MilliAtom,
+	expirationDate time.Time) (*Account, error) {
+
+	account := &Account{
+		InitialBalance: balance,
+		CurrentBalance: balance,
+		LastUpdate:     time.Now(),
+		ExpirationDate: expirationDate,
+		Invoices:       make(map[lntypes.Hash]struct{}),
+		Payments:       make(map[lntypes.Hash]lnwire.MilliAtom),
+	}
+
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		accounts, err := tx.CreateTopLevelBucket(accountsBucket)
+		if err != nil {
+			return err
+		}
+
+		// Draw random IDs until we find one that isn't taken yet.
+		for {
+			if _, err := rand.Read(account.ID[:]); err != nil {
+				return err
+			}
+
+			if accounts.Get(account.ID[:]) == nil {
+				break
+			}
+		}
+
+		return putAccount(accounts, account)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// Account returns the account with the given ID. If there is none,
+// ErrAccountNotFound is returned.
+func (s *AccountStore) Account(id AccountID) (*Account, error) {
+	var account *Account
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		accounts := tx.ReadBucket(accountsBucket)
+		if accounts == nil {
+			return ErrAccountNotFound
+		}
+
+		accountBytes := accounts.Get(id[:])
+		if accountBytes == nil {
+			return ErrAccountNotFound
+		}
+
+		var err error
+		account, err = deserializeAccount(id, accountBytes)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// Accounts returns all stored accounts.
+func (s *AccountStore) Accounts() ([]*Account, error) {
+	var accounts []*Account
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		accountsBkt := tx.ReadBucket(accountsBucket)
+		if accountsBkt == nil {
+			return nil
+		}
+
+		return accountsBkt.ForEach(func(k, v []byte) error {
+			var id AccountID
+			copy(id[:], k)
+
+			account, err := deserializeAccount(id, v)
+			if err != nil {
+				return err
+			}
+
+			accounts = append(accounts, account)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return accounts, nil
+}
+
+// RemoveAccount deletes the account with the given ID. If there is none,
+// ErrAccountNotFound is returned.
+func (s *AccountStore) RemoveAccount(id AccountID) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		accounts := tx.ReadWriteBucket(accountsBucket)
+		if accounts == nil || accounts.Get(id[:]) == nil {
+			return ErrAccountNotFound
+		}
+
+		return accounts.Delete(id[:])
+	})
+}
+
+// ReservePayment debits the maximum amount a payment can cost from the
+// balance of the account and records the payment as pending. An error is
+// returned if the account has expired or its balance doesn't cover the
+// amount.
+func (s *AccountStore) ReservePayment(id AccountID, hash lntypes.Hash,
+	amt lnwire.MilliAtom) error {
+
+	return s.updateAccount(id, func(account *Account) error {
+		if account.HasExpired() {
+			return ErrAccountExpired
+		}
+
+		if _, ok := account.Payments[hash]; ok {
+			return ErrAccountPaymentExists
+		}
+
+		if account.CurrentBalance < amt {
+			return ErrAccountBalanceInsufficient
+		}
+
+		account.CurrentBalance -= amt
+		account.Payments[hash] = amt
+
+		return nil
+	})
+}
+
+// SettlePayment resolves a pending payment of the account that succeeded.
+// The difference between the reserved amount and the amount the payment
+// actually cost, including fees, is credited back to the account.
+func (s *AccountStore) SettlePayment(id AccountID, hash lntypes.Hash,
+	amtPaid lnwire.MilliAtom) error {
+
+	return s.updateAccount(id, func(account *Account) error {
+		reserved, ok := account.Payments[hash]
+		if !ok {
+			return nil
+		}
+		delete(account.Payments, hash)
+
+		// The balance can't go negative, so we only debit what is left
+		// if the payment cost more than was reserved for it.
+		switch {
+		case amtPaid <= reserved:
+			account.CurrentBalance += reserved - amtPaid
+
+		case amtPaid-reserved > account.CurrentBalance:
+			account.CurrentBalance = 0
+
+		default:
+			account.CurrentBalance -= amtPaid - reserved
+		}
+
+		return nil
+	})
+}
+
+// ReleasePayment resolves a pending payment of the account that failed. The
+// full reserved amount is credited back to the account.
+func (s *AccountStore) ReleasePayment(id AccountID, hash lntypes.Hash) error {
+	return s.updateAccount(id, func(account *Account) error {
+		reserved, ok := account.Payments[hash]
+		if !ok {
+			return nil
+		}
+		delete(account.Payments, hash)
+
+		account.CurrentBalance += reserved
+
+		return nil
+	})
+}
+
+// AddInvoice records an invoice that was added with the account, so it is
+// credited to the account once it is settled.
+func (s *AccountStore) AddInvoice(id AccountID, hash lntypes.Hash) error {
+	return s.updateAccount(id, func(account *Account) error {
+		account.Invoices[hash] = struct{}{}
+		return nil
+	})
+}
+
+// SettleInvoice credits the amount paid to a settled invoice of the account.
+// Invoices that aren't pending for the account are ignored, so an invoice is
+// never credited twice.
+func (s *AccountStore) SettleInvoice(id AccountID, hash lntypes.Hash,
+	amtPaid lnwire.MilliAtom) error {
+
+	return s.updateAccount(id, func(account *Account) error {
+		if _, ok := account.Invoices[hash]; !ok {
+			return nil
+		}
+		delete(account.Invoices, hash)
+
+		account.CurrentBalance += amtPaid
+
+		return nil
+	})
+}
+
+// RemoveInvoice stops tracking an invoice of the account without crediting
+// it, which is done once the invoice is canceled.
+func (s *AccountStore) RemoveInvoice(id AccountID, hash lntypes.Hash) error {
+	return s.updateAccount(id, func(account *Account) error {
+		delete(account.Invoices, hash)
+		return nil
+	})
+}
+
+// updateAccount applies the given modification to the account with the given
+// ID in a single transaction.
+func (s *AccountStore) updateAccount(id AccountID,
+	modify func(*Account) error) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		accounts := tx.ReadWriteBucket(accountsBucket)
+		if accounts == nil {
+			return ErrAccountNotFound
+		}
+
+		accountBytes := accounts.Get(id[:])
+		if accountBytes == nil {
+			return ErrAccountNotFound
+		}
+
+		account, err := deserializeAccount(id, accountBytes)
+		if err != nil {
+			return err
+		}
+
+		balance := account.CurrentBalance
+		if err := modify(account); err != nil {
+			return err
+		}
+
+		if account.CurrentBalance != balance {
+			account.LastUpdate = time.Now()
+		}
+
+		return putAccount(accounts, account)
+	})
+}
+
+// putAccount serializes the account and stores it in the accounts bucket.
+func putAccount(accounts kvdb.RwBucket, account *Account) error {
+	var b bytes.Buffer
+	if err := serializeAccount(&b, account); err != nil {
+		return err
+	}
+
+	return accounts.Put(account.ID[:], b.Bytes())
+}
+
+// serializeAccount writes the account to w. The ID of the account is not
+// written, as it is the key the account is stored under.
+func serializeAccount(w io.Writer, account *Account) error {
+	var expirationDate int64
+	if !account.ExpirationDate.IsZero() {
+		expirationDate = account.ExpirationDate.UnixNano()
+	}
+
+	err := WriteElements(
+		w, account.InitialBalance, account.CurrentBalance,
+		account.LastUpdate.UnixNano(), expirationDate,
+		uint32(len(account.Invoices)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for hash := range account.Invoices {
+		if err := WriteElement(w, [32]byte(hash)); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElement(w, uint32(len(account.Payments))); err != nil {
+		return err
+	}
+
+	for hash, amt := range account.Payments {
+		if err := WriteElements(w, [32]byte(hash), amt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeAccount reads an account that was serialized by
+// serializeAccount.
+func deserializeAccount(id AccountID, b []byte) (*Account, error) {
+	r := bytes.NewReader(b)
+
+	var (
+		lastUpdate, expirationDate int64
+		numInvoices, numPayments   uint32
+	)
+	account := &Account{
+		ID:       id,
+		Invoices: make(map[lntypes.Hash]struct{}),
+		Payments: make(map[lntypes.Hash]lnwire.MilliAtom),
+	}
+
+	err := ReadElements(
+		r, &account.InitialBalance, &account.CurrentBalance,
+		&lastUpdate, &expirationDate, &numInvoices,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	account.LastUpdate = time.Unix(0, lastUpdate)
+	if expirationDate != 0 {
+		account.ExpirationDate = time.Unix(0, expirationDate)
+	}
+
+	for i := uint32(0); i < numInvoices; i++ {
+		var hash [32]byte
+		if err := ReadElement(r, &hash); err != nil {
+			return nil, err
+		}
+
+		account.Invoices[hash] = struct{}{}
+	}
+
+	if err := ReadElement(r, &numPayments); err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numPayments; i++ {
+		var (
+			hash [32]byte
+			amt  lnwire.MilliAtom
+		)
+		if err := ReadElements(r, &hash, &amt); err != nil {
+			return nil, err
+		}
+
+		account.Payments[hash] = amt
+	}
+
+	return account, nil
+}
@@ -0,0 +1,118 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lntypes"
+)
+
+// TestAccountStore tests that payments and invoices of an account debit and
+// credit its balance.
+func TestAccountStore(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("failed to make test database: %s", err)
+	}
+	defer cleanup()
+
+	store := NewAccountStore(db)
+
+	if _, err := store.Account(AccountID{1}); err != ErrAccountNotFound {
+		t.Fatalf("expected ErrAccountNotFound, got: %v", err)
+	}
+
+	account, err := store.NewAccount(10000, time.Time{})
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+
+	assertBalance := func(expected uint64) {
+		t.Helper()
+
+		account, err := store.Account(account.ID)
+		if err != nil {
+			t.Fatalf("unable to fetch account: %v", err)
+		}
+		if uint64(account.CurrentBalance) != expected {
+			t.Fatalf("expected balance %v, got %v", expected,
+				account.CurrentBalance)
+		}
+	}
+
+	// A payment that exceeds the balance must be refused.
+	hash1 := lntypes.Hash{1}
+	err = store.ReservePayment(account.ID, hash1, 10001)
+	if err != ErrAccountBalanceInsufficient {
+		t.Fatalf("expected ErrAccountBalanceInsufficient, got: %v", err)
+	}
+	assertBalance(10000)
+
+	// Reserving a payment debits the maximum amount it can cost, which is
+	// partially credited back once it succeeds for less.
+	if err := store.ReservePayment(account.ID, hash1, 6000); err != nil {
+		t.Fatalf("unable to reserve payment: %v", err)
+	}
+	assertBalance(4000)
+
+	err = store.ReservePayment(account.ID, hash1, 1000)
+	if err != ErrAccountPaymentExists {
+		t.Fatalf("expected ErrAccountPaymentExists, got: %v", err)
+	}
+
+	if err := store.SettlePayment(account.ID, hash1, 5000); err != nil {
+		t.Fatalf("unable to settle payment: %v", err)
+	}
+	assertBalance(5000)
+
+	// A failed payment is credited back in full.
+	hash2 := lntypes.Hash{2}
+	if err := store.ReservePayment(account.ID, hash2, 3000); err != nil {
+		t.Fatalf("unable to reserve payment: %v", err)
+	}
+	assertBalance(2000)
+	if err := store.ReleasePayment(account.ID, hash2); err != nil {
+		t.Fatalf("unable to release payment: %v", err)
+	}
+	assertBalance(5000)
+
+	// Settled invoices of the account are credited exactly once.
+	hash3 := lntypes.Hash{3}
+	if err := store.AddInvoice(account.ID, hash3); err != nil {
+		t.Fatalf("unable to add invoice: %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		err := store.SettleInvoice(account.ID, hash3, 1500)
+		if err != nil {
+			t.Fatalf("unable to settle invoice: %v", err)
+		}
+	}
+	assertBalance(6500)
+
+	// Payments from an expired account must be refused.
+	expired, err := store.NewAccount(1000, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatalf("unable to create account: %v", err)
+	}
+	err = store.ReservePayment(expired.ID, hash1, 1)
+	if err != ErrAccountExpired {
+		t.Fatalf("expected ErrAccountExpired, got: %v", err)
+	}
+
+	accounts, err := store.Accounts()
+	if err != nil {
+		t.Fatalf("unable to fetch accounts: %v", err)
+	}
+	if len(accounts) != 2 {
+		t.Fatalf("expected 2 accounts, got %d", len(accounts))
+	}
+
+	if err := store.RemoveAccount(account.ID); err != nil {
+		t.Fatalf("unable to remove account: %v", err)
+	}
+	if _, err := store.Account(account.ID); err != ErrAccountNotFound {
+		t.Fatalf("expected ErrAccountNotFound, got: %v", err)
+	}
+}
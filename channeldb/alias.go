@@ -0,0 +1,198 @@
+package channeldb
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"github.com/decred/dcrlnd/aliasmgr"
+	"github.com/decred/dcrlnd/channeldb/kvdb"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+var (
+	// aliasBucket is the top-level bucket that stores the alias short
+	// channel ids of private channels. It holds a sub-bucket for each
+	// channel, keyed by its channel id, and the last alias that was handed
+	// out.
+	//
+	// alias-bucket
+	//   |
+	//   |-- last-alias-key: <alias>
+	//   |
+	//   |-- <chanID>
+	//         |
+	//         |-- local-alias-key: <alias>
+	//         |-- peer-alias-key: <alias>
+	aliasBucket = []byte("alias-bucket")
+
+	// lastAliasKey is the key of the last alias that was handed out to a
+	// private channel.
+	lastAliasKey = []byte("last-alias-key")
+
+	// localAliasKey is the key of the alias we handed out for a channel,
+	// which our peer uses to refer to the channel, for instance in the
+	// route hints of its invoices.
+	localAliasKey = []byte("local-alias-key")
+
+	// peerAliasKey is the key of the alias our peer handed out for a
+	// channel, which we use to refer to the channel in the route hints of
+	// our invoices.
+	peerAliasKey = []byte("peer-alias-key")
+
+	// ErrNoAlias is returned when a channel doesn't have the requested
+	// alias.
+	ErrNoAlias = errors.New("no alias found for channel")
+)
+
+// AliasStore stores the alias short channel ids of private channels. Aliases
+// are exchanged in the FundingLocked message, so private channels can be
+// referred to without revealing their funding outpoint.
+type AliasStore struct {
+	db *DB
+}
+
+// NewAliasStore creates a new alias store backed by the given database.
+func NewAliasStore(db *DB) *AliasStore {
+	return &AliasStore{
+		db: db,
+	}
+}
+
+// NewLocalAlias returns the alias we handed out for the given channel. If the
+// channel doesn't have an alias yet, a new one is allocated and stored.
+func (s *AliasStore) NewLocalAlias(
+	chanID lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+
+	var alias lnwire.ShortChannelID
+	err := kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		aliases, err := tx.CreateTopLevelBucket(aliasBucket)
+		if err != nil {
+			return err
+		}
+
+		chanBucket, err := aliases.CreateBucketIfNotExists(chanID[:])
+		if err != nil {
+			return err
+		}
+
+		// If the channel already has an alias, we return it so the
+		// peer always learns the same alias.
+		localAlias := chanBucket.Get(localAliasKey)
+		if localAlias != nil {
+			alias = decodeAlias(localAlias)
+			return nil
+		}
+
+		alias = aliasmgr.FirstAlias
+		if lastAlias := aliases.Get(lastAliasKey); lastAlias != nil {
+			alias = aliasmgr.NextAlias(decodeAlias(lastAlias))
+		}
+
+		aliasBytes := encodeAlias(alias)
+		if err := aliases.Put(lastAliasKey, aliasBytes); err != nil {
+			return err
+		}
+
+		return chanBucket.Put(localAliasKey, aliasBytes)
+	})
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	return alias, nil
+}
+
+// LocalAlias returns the alias we handed out for the given channel. If there
+// is none, ErrNoAlias is returned.
+func (s *AliasStore) LocalAlias(
+	chanID lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+
+	return s.fetchAlias(chanID, localAliasKey)
+}
+
+// PutPeerAlias stores the alias our peer handed out for the given channel.
+func (s *AliasStore) PutPeerAlias(chanID lnwire.ChannelID,
+	alias lnwire.ShortChannelID) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		aliases, err := tx.CreateTopLevelBucket(aliasBucket)
+		if err != nil {
+			return err
+		}
+
+		chanBucket, err := aliases.CreateBucketIfNotExists(chanID[:])
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(peerAliasKey, encodeAlias(alias))
+	})
+}
+
+// PeerAlias returns the alias our peer handed out for the given channel. If
+// there is none, ErrNoAlias is returned.
+func (s *AliasStore) PeerAlias(
+	chanID lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+
+	return s.fetchAlias(chanID, peerAliasKey)
+}
+
+// DeleteAliases removes all aliases of the given channel.
+func (s *AliasStore) DeleteAliases(chanID lnwire.ChannelID) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		aliases := tx.ReadWriteBucket(aliasBucket)
+		if aliases == nil {
+			return nil
+		}
+
+		err := aliases.DeleteNestedBucket(chanID[:])
+		if err != nil && err != kvdb.ErrBucketNotFound {
+			return err
+		}
+
+		return nil
+	})
+}
+
+// fetchAlias returns the alias stored under the given key for the channel.
+func (s *AliasStore) fetchAlias(chanID lnwire.ChannelID,
+	key []byte) (lnwire.ShortChannelID, error) {
+
+	var alias lnwire.ShortChannelID
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		aliases := tx.ReadBucket(aliasBucket)
+		if aliases == nil {
+			return ErrNoAlias
+		}
+
+		chanBucket := aliases.NestedReadBucket(chanID[:])
+		if chanBucket == nil {
+			return ErrNoAlias
+		}
+
+		aliasBytes := chanBucket.Get(key)
+		if aliasBytes == nil {
+			return ErrNoAlias
+		}
+
+		alias = decodeAlias(aliasBytes)
+		return nil
+	})
+	if err != nil {
+		return lnwire.ShortChannelID{}, err
+	}
+
+	return alias, nil
+}
+
+// encodeAlias serializes the alias as a big endian uint64.
+func encodeAlias(alias lnwire.ShortChannelID) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], alias.ToUint64())
+	return b[:]
+}
+
+// decodeAlias deserializes an alias that was serialized by encodeAlias.
+func decodeAlias(b []byte) lnwire.ShortChannelID {
+	return lnwire.NewShortChanIDFromInt(binary.BigEndian.Uint64(b))
+}
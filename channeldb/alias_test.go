@@ -0,0 +1,102 @@
+package channeldb
+
+import (
+	"testing"
+
+	"github.com/decred/dcrlnd/aliasmgr"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// TestAliasStore tests that aliases are allocated, stored and deleted per
+// channel.
+func TestAliasStore(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("failed to make test database: %s", err)
+	}
+	defer cleanup()
+
+	store := NewAliasStore(db)
+
+	chanID1 := lnwire.ChannelID{1}
+	chanID2 := lnwire.ChannelID{2}
+
+	if _, err := store.LocalAlias(chanID1); err != ErrNoAlias {
+		t.Fatalf("expected ErrNoAlias, got: %v", err)
+	}
+
+	// The first channel gets the first alias, and asking again must
+	// return the very same alias.
+	alias1, err := store.NewLocalAlias(chanID1)
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	if alias1 != aliasmgr.FirstAlias {
+		t.Fatalf("expected alias %v, got %v", aliasmgr.FirstAlias,
+			alias1)
+	}
+	alias, err := store.NewLocalAlias(chanID1)
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	if alias != alias1 {
+		t.Fatalf("expected alias %v, got %v", alias1, alias)
+	}
+
+	// The second channel gets the next alias.
+	alias2, err := store.NewLocalAlias(chanID2)
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	if alias2 != aliasmgr.NextAlias(alias1) {
+		t.Fatalf("expected alias %v, got %v",
+			aliasmgr.NextAlias(alias1), alias2)
+	}
+
+	alias, err = store.LocalAlias(chanID2)
+	if err != nil {
+		t.Fatalf("unable to fetch alias: %v", err)
+	}
+	if alias != alias2 {
+		t.Fatalf("expected alias %v, got %v", alias2, alias)
+	}
+
+	// Store and fetch an alias of our peer.
+	if _, err := store.PeerAlias(chanID1); err != ErrNoAlias {
+		t.Fatalf("expected ErrNoAlias, got: %v", err)
+	}
+	peerAlias := lnwire.NewShortChanIDFromInt(1234)
+	if err := store.PutPeerAlias(chanID1, peerAlias); err != nil {
+		t.Fatalf("unable to store peer alias: %v", err)
+	}
+	alias, err = store.PeerAlias(chanID1)
+	if err != nil {
+		t.Fatalf("unable to fetch peer alias: %v", err)
+	}
+	if alias != peerAlias {
+		t.Fatalf("expected peer alias %v, got %v", peerAlias, alias)
+	}
+
+	// Once deleted, the channel has no aliases anymore, but the deleted
+	// alias isn't handed out again.
+	if err := store.DeleteAliases(chanID1); err != nil {
+		t.Fatalf("unable to delete aliases: %v", err)
+	}
+	if _, err := store.LocalAlias(chanID1); err != ErrNoAlias {
+		t.Fatalf("expected ErrNoAlias, got: %v", err)
+	}
+	if _, err := store.PeerAlias(chanID1); err != ErrNoAlias {
+		t.Fatalf("expected ErrNoAlias, got: %v", err)
+	}
+
+	alias, err = store.NewLocalAlias(chanID1)
+	if err != nil {
+		t.Fatalf("unable to allocate alias: %v", err)
+	}
+	if alias != aliasmgr.NextAlias(alias2) {
+		t.Fatalf("expected alias %v, got %v",
+			aliasmgr.NextAlias(alias2), alias)
+	}
+}
@@ -66,6 +66,12 @@ var (
 	// shutdown script for the remote peer.
 	remoteUpfrontShutdownKey = []byte("remote-upfront-shutdown-key")
 
+	// confirmedScidKey can be accessed within the bucket for a channel
+	// (identified by its chanPoint). This key stores the real short
+	// channel id of a zero-conf channel once its funding transaction
+	// confirmed.
+	confirmedScidKey = []byte("confirmed-scid-key")
+
 	// chanCommitmentKey can be accessed within the sub-bucket for a
 	// particular channel. This key stores the up to date commitment state
 	// for a particular channel party. Appending a 0 to the end of this key
@@ -213,6 +219,11 @@ const (
 	// that only the responder can decide to cooperatively close the
 	// channel.
 	FrozenBit ChannelType = 1 << 4
+
+	// ZeroConfBit indicates that the channel is a zero-conf channel, meaning
+	// that it can be used before its funding transaction confirms. Until
+	// it confirms, the channel is identified by an alias short channel id.
+	ZeroConfBit ChannelType = 1 << 5
 )
 
 // IsSingleFunder returns true if the channel type if one of the known single
@@ -251,6 +262,11 @@ func (c ChannelType) IsFrozen() bool {
 	return c&FrozenBit == FrozenBit
 }
 
+// IsZeroConf returns true if the channel is a zero-conf channel.
+func (c ChannelType) IsZeroConf() bool {
+	return c&ZeroConfBit == ZeroConfBit
+}
+
 // ChannelConstraints represents a set of constraints meant to allow a node to
 // limit their exposure, enact flow control and ensure that all HTLCs are
 // economically relevant. This struct will be mirrored for both sides of the
@@ -552,8 +568,17 @@ type OpenChannel struct {
 	// ShortChannelID encodes the exact location in the chain in which the
 	// channel was initially confirmed. This includes: the block height,
 	// transaction index, and the output within the target transaction.
+	//
+	// NOTE: For zero-conf channels this is the alias short channel id the
+	// channel was opened with. It remains unchanged once the funding
+	// transaction confirms, see ZeroConfRealScid.
 	ShortChannelID lnwire.ShortChannelID
 
+	// confirmedScid is the real short channel id of a zero-conf channel.
+	// It is only set once the funding transaction of the channel
+	// confirmed.
+	confirmedScid lnwire.ShortChannelID
+
 	// IsPending indicates whether a channel's funding transaction has been
 	// confirmed.
 	IsPending bool
@@ -685,6 +710,61 @@ func (c *OpenChannel) ShortChanID() lnwire.ShortChannelID {
 	return c.ShortChannelID
 }
 
+// ZeroConfConfirmed returns true if the channel is a zero-conf channel whose
+// funding transaction has confirmed.
+func (c *OpenChannel) ZeroConfConfirmed() bool {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.ChanType.IsZeroConf() &&
+		c.confirmedScid != lnwire.ShortChannelID{}
+}
+
+// ZeroConfRealScid returns the real short channel id of a zero-conf channel.
+// The returned short channel id is only valid if ZeroConfConfirmed returns
+// true.
+func (c *OpenChannel) ZeroConfRealScid() lnwire.ShortChannelID {
+	c.RLock()
+	defer c.RUnlock()
+
+	return c.confirmedScid
+}
+
+// MarkRealScid stores the real short channel id of a zero-conf channel once
+// its funding transaction confirmed. The ShortChannelID of the channel is
+// left untouched, so the channel can still be referenced by its alias.
+func (c *OpenChannel) MarkRealScid(realScid lnwire.ShortChannelID) error {
+	c.Lock()
+	defer c.Unlock()
+
+	if !c.ChanType.IsZeroConf() {
+		return fmt.Errorf("channel %v is not a zero-conf channel",
+			c.FundingOutpoint)
+	}
+
+	var b bytes.Buffer
+	if err := WriteElement(&b, realScid); err != nil {
+		return err
+	}
+
+	if err := kvdb.Update(c.Db, func(tx kvdb.RwTx) error {
+		chanBucket, err := fetchChanBucketRw(
+			tx, c.IdentityPub, &c.FundingOutpoint, c.ChainHash,
+		)
+		if err != nil {
+			return err
+		}
+
+		return chanBucket.Put(confirmedScidKey, b.Bytes())
+	}); err != nil {
+		return err
+	}
+
+	c.confirmedScid = realScid
+
+	return nil
+}
+
 // ChanStatus returns the current ChannelStatus of this channel.
 func (c *OpenChannel) ChanStatus() ChannelStatus {
 	c.RLock()
@@ -3297,6 +3377,18 @@ func fetchChanInfo(chanBucket kvdb.RBucket, channel *OpenChannel) error {
 
 	channel.Packager = NewChannelPackager(channel.ShortChannelID)
 
+	// Zero-conf channels may have their real short channel id stored once
+	// their funding transaction confirmed.
+	if channel.ChanType.IsZeroConf() {
+		if bs := chanBucket.Get(confirmedScidKey); bs != nil {
+			r := bytes.NewReader(bs)
+			err := ReadElement(r, &channel.confirmedScid)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	// Finally, read the optional shutdown scripts.
 	if err := getOptionalUpfrontShutdownScript(
 		chanBucket, localUpfrontShutdownKey, &channel.LocalShutdownScript,
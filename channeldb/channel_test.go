@@ -193,6 +193,13 @@ var channelIDOption = func(chanID lnwire.ShortChannelID) testChannelOption {
 	}
 }
 
+// zeroConfOption is an option which marks the channel as a zero-conf channel.
+func zeroConfOption() testChannelOption {
+	return func(params *testChannelParams) {
+		params.channel.ChanType |= ZeroConfBit
+	}
+}
+
 // createTestChannel writes a test channel to the database. It takes a set of
 // functional options which can be used to overwrite the default of creating
 // a pending channel that was broadcast at height 100.
@@ -1258,6 +1265,83 @@ func TestRefreshShortChanID(t *testing.T) {
 	}
 }
 
+// TestZeroConfRealScid tests that the real short channel id of a zero-conf
+// channel is persisted without replacing the alias it was opened with.
+func TestZeroConfRealScid(t *testing.T) {
+	t.Parallel()
+
+	cdb, cleanUp, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+	defer cleanUp()
+
+	alias := lnwire.ShortChannelID{
+		BlockHeight: 16_000_000,
+		TxIndex:     1,
+	}
+	state := createTestChannel(
+		t, cdb, zeroConfOption(), openChannelOption(),
+		channelIDOption(alias),
+	)
+
+	if state.ZeroConfConfirmed() {
+		t.Fatalf("channel should not be confirmed yet")
+	}
+
+	// A channel that isn't a zero-conf channel can't have a real short
+	// channel id stored.
+	legacyState := createTestChannel(
+		t, cdb, fundingPointOption(wire.OutPoint{Index: 99}),
+	)
+	realScid := lnwire.ShortChannelID{
+		BlockHeight: 105,
+		TxIndex:     10,
+		TxPosition:  15,
+	}
+	if err := legacyState.MarkRealScid(realScid); err == nil {
+		t.Fatalf("expected error marking real scid of non zero-conf " +
+			"channel")
+	}
+
+	if err := state.MarkRealScid(realScid); err != nil {
+		t.Fatalf("unable to mark real scid: %v", err)
+	}
+	if !state.ZeroConfConfirmed() {
+		t.Fatalf("channel should be confirmed")
+	}
+
+	// The real short channel id must survive a reload from disk while the
+	// channel is still referenced by its alias.
+	channels, err := cdb.FetchOpenChannels(state.IdentityPub)
+	if err != nil {
+		t.Fatalf("unable to fetch open channels: %v", err)
+	}
+
+	var dbChannel *OpenChannel
+	for _, channel := range channels {
+		if channel.FundingOutpoint == state.FundingOutpoint {
+			dbChannel = channel
+			break
+		}
+	}
+	if dbChannel == nil {
+		t.Fatalf("unable to find channel %v", state.FundingOutpoint)
+	}
+
+	if !dbChannel.ZeroConfConfirmed() {
+		t.Fatalf("channel should be confirmed after reload")
+	}
+	if dbChannel.ZeroConfRealScid() != realScid {
+		t.Fatalf("wrong real scid: want %v, got %v", realScid,
+			dbChannel.ZeroConfRealScid())
+	}
+	if dbChannel.ShortChanID() != alias {
+		t.Fatalf("wrong short chan id: want %v, got %v", alias,
+			dbChannel.ShortChanID())
+	}
+}
+
 // TestCloseInitiator tests the setting of close initiator statuses for
 // cooperative closes and local force closes.
 func TestCloseInitiator(t *testing.T) {
@@ -285,6 +285,10 @@ var topLevelBuckets = [][]byte{
 	graphMetaBucket,
 	metaBucket,
 	closeSummaryBucket,
+	aliasBucket,
+	accountsBucket,
+	fitnessBucket,
+	feeOverrideBucket,
 }
 
 // Wipe completely deletes all saved state within all used buckets within the
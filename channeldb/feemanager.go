@@ -0,0 +1,294 @@
+package channeldb
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb/kvdb"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+var (
+	// feeOverrideBucket is the top-level bucket that stores the overrides
+	// of the automated fee manager, so that channels that were excluded
+	// from fee management or pinned to fixed fees stay that way across
+	// restarts.
+	//
+	// fee-override-bucket
+	//   |
+	//   |-- <chanPoint>: <fee override>
+	feeOverrideBucket = []byte("fee-override-bucket")
+
+	// feeHoldBucket is the top-level bucket that stores the times until
+	// which the automated fee manager leaves channels alone whose policy
+	// was updated manually, so that manual updates aren't overwritten
+	// after a restart.
+	//
+	// fee-hold-bucket
+	//   |
+	//   |-- <chanPoint>: <held until>
+	feeHoldBucket = []byte("fee-hold-bucket")
+)
+
+// FeeOverrideMode describes how the automated fee manager treats a channel
+// that has been overridden.
+type FeeOverrideMode uint8
+
+const (
+	// FeeOverrideExclude excludes a channel from automated fee management.
+	// Its fees are left as they are.
+	FeeOverrideExclude FeeOverrideMode = 1
+
+	// FeeOverridePin pins the fees of a channel to fixed values, which the
+	// fee manager applies instead of the fees it would have chosen.
+	FeeOverridePin FeeOverrideMode = 2
+)
+
+// String returns a human readable representation of the override mode.
+func (m FeeOverrideMode) String() string {
+	switch m {
+	case FeeOverrideExclude:
+		return "exclude"
+
+	case FeeOverridePin:
+		return "pin"
+
+	default:
+		return "unknown"
+	}
+}
+
+// FeeOverride overrides the decisions of the automated fee manager for a
+// channel.
+type FeeOverride struct {
+	// Mode describes how the channel is treated.
+	Mode FeeOverrideMode
+
+	// BaseFee is the base fee the channel is pinned to. It is only used
+	// in FeeOverridePin mode.
+	BaseFee lnwire.MilliAtom
+
+	// FeeRate is the fee rate in parts per million the channel is pinned
+	// to. It is only used in FeeOverridePin mode.
+	FeeRate uint32
+
+	// CreatedAt is the time the override was created.
+	CreatedAt time.Time
+}
+
+// FeeManagerStore persists the overrides and holds of the automated fee
+// manager.
+type FeeManagerStore struct {
+	db *DB
+}
+
+// NewFeeManagerStore creates a new fee manager store backed by the given
+// database.
+func NewFeeManagerStore(db *DB) *FeeManagerStore {
+	return &FeeManagerStore{
+		db: db,
+	}
+}
+
+// PutFeeOverride stores the override of the given channel, replacing any
+// override that was previously stored for it.
+func (s *FeeManagerStore) PutFeeOverride(chanPoint wire.OutPoint,
+	override *FeeOverride) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(feeOverrideBucket)
+		if err != nil {
+			return err
+		}
+
+		var k bytes.Buffer
+		if err := writeOutpoint(&k, &chanPoint); err != nil {
+			return err
+		}
+
+		var v bytes.Buffer
+		if err := serializeFeeOverride(&v, override); err != nil {
+			return err
+		}
+
+		return bucket.Put(k.Bytes(), v.Bytes())
+	})
+}
+
+// DeleteFeeOverrides removes the overrides of the given channels. Channels
+// without an override are ignored.
+func (s *FeeManagerStore) DeleteFeeOverrides(chanPoints []wire.OutPoint) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(feeOverrideBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		for _, chanPoint := range chanPoints {
+			var k bytes.Buffer
+			if err := writeOutpoint(&k, &chanPoint); err != nil {
+				return err
+			}
+
+			if err := bucket.Delete(k.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchFeeOverrides returns the overrides of all channels.
+func (s *FeeManagerStore) FetchFeeOverrides() (map[wire.OutPoint]*FeeOverride,
+	error) {
+
+	overrides := make(map[wire.OutPoint]*FeeOverride)
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(feeOverrideBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+
+			override, err := deserializeFeeOverride(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			overrides[chanPoint] = override
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return overrides, nil
+}
+
+// PutFeeHolds stores the time until which the given channels are held,
+// replacing any hold that was previously stored for them.
+func (s *FeeManagerStore) PutFeeHolds(chanPoints []wire.OutPoint,
+	heldUntil time.Time) error {
+
+	var v [8]byte
+	binary.BigEndian.PutUint64(v[:], uint64(unixNanoTime(heldUntil)))
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket, err := tx.CreateTopLevelBucket(feeHoldBucket)
+		if err != nil {
+			return err
+		}
+
+		for _, chanPoint := range chanPoints {
+			var k bytes.Buffer
+			if err := writeOutpoint(&k, &chanPoint); err != nil {
+				return err
+			}
+
+			if err := bucket.Put(k.Bytes(), v[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// DeleteFeeHolds removes the holds of the given channels. Channels without a
+// hold are ignored.
+func (s *FeeManagerStore) DeleteFeeHolds(chanPoints []wire.OutPoint) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(feeHoldBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		for _, chanPoint := range chanPoints {
+			var k bytes.Buffer
+			if err := writeOutpoint(&k, &chanPoint); err != nil {
+				return err
+			}
+
+			if err := bucket.Delete(k.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchFeeHolds returns the times until which channels are held.
+func (s *FeeManagerStore) FetchFeeHolds() (map[wire.OutPoint]time.Time, error) {
+	holds := make(map[wire.OutPoint]time.Time)
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(feeHoldBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+
+			if len(v) != 8 {
+				return io.ErrUnexpectedEOF
+			}
+
+			holds[chanPoint] = timeFromUnixNano(
+				int64(binary.BigEndian.Uint64(v)),
+			)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return holds, nil
+}
+
+// serializeFeeOverride writes the fee override to w.
+func serializeFeeOverride(w io.Writer, override *FeeOverride) error {
+	return WriteElements(
+		w, uint8(override.Mode), override.BaseFee, override.FeeRate,
+		unixNanoTime(override.CreatedAt),
+	)
+}
+
+// deserializeFeeOverride reads a fee override that was written by
+// serializeFeeOverride.
+func deserializeFeeOverride(r io.Reader) (*FeeOverride, error) {
+	var (
+		override  FeeOverride
+		mode      uint8
+		createdAt int64
+	)
+	err := ReadElements(
+		r, &mode, &override.BaseFee, &override.FeeRate, &createdAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	override.Mode = FeeOverrideMode(mode)
+	override.CreatedAt = timeFromUnixNano(createdAt)
+
+	return &override, nil
+}
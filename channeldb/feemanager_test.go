@@ -0,0 +1,161 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// TestFeeManagerStore tests that fee overrides are stored, replaced and
+// deleted.
+func TestFeeManagerStore(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("failed to make test database: %s", err)
+	}
+	defer cleanup()
+
+	store := NewFeeManagerStore(db)
+
+	// Nothing is stored in a fresh database, and deleting from it is a
+	// no-op.
+	overrides, err := store.FetchFeeOverrides()
+	if err != nil {
+		t.Fatalf("unable to fetch overrides: %v", err)
+	}
+	if len(overrides) != 0 {
+		t.Fatalf("expected no overrides, got %v", len(overrides))
+	}
+
+	chanPoint1 := wire.OutPoint{Hash: [32]byte{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: [32]byte{2}, Tree: 1}
+
+	err = store.DeleteFeeOverrides([]wire.OutPoint{chanPoint1})
+	if err != nil {
+		t.Fatalf("unable to delete overrides: %v", err)
+	}
+
+	now := time.Unix(0, time.Now().UnixNano())
+	exclude := &FeeOverride{
+		Mode:      FeeOverrideExclude,
+		CreatedAt: now,
+	}
+	pin := &FeeOverride{
+		Mode:      FeeOverridePin,
+		BaseFee:   1000,
+		FeeRate:   250,
+		CreatedAt: now,
+	}
+	if err := store.PutFeeOverride(chanPoint1, exclude); err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+	if err := store.PutFeeOverride(chanPoint2, exclude); err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+
+	// Replace the override of the second channel.
+	if err := store.PutFeeOverride(chanPoint2, pin); err != nil {
+		t.Fatalf("unable to store override: %v", err)
+	}
+
+	overrides, err = store.FetchFeeOverrides()
+	if err != nil {
+		t.Fatalf("unable to fetch overrides: %v", err)
+	}
+	expected := map[wire.OutPoint]*FeeOverride{
+		chanPoint1: exclude,
+		chanPoint2: pin,
+	}
+	if !reflect.DeepEqual(overrides, expected) {
+		t.Fatalf("expected overrides %v, got %v", expected, overrides)
+	}
+
+	err = store.DeleteFeeOverrides([]wire.OutPoint{chanPoint1})
+	if err != nil {
+		t.Fatalf("unable to delete overrides: %v", err)
+	}
+	overrides, err = store.FetchFeeOverrides()
+	if err != nil {
+		t.Fatalf("unable to fetch overrides: %v", err)
+	}
+	delete(expected, chanPoint1)
+	if !reflect.DeepEqual(overrides, expected) {
+		t.Fatalf("expected overrides %v, got %v", expected, overrides)
+	}
+}
+
+// TestFeeManagerStoreHolds tests that the holds of channels are stored,
+// replaced and deleted.
+func TestFeeManagerStoreHolds(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("failed to make test database: %s", err)
+	}
+	defer cleanup()
+
+	store := NewFeeManagerStore(db)
+
+	// Nothing is stored in a fresh database, and deleting from it is a
+	// no-op.
+	holds, err := store.FetchFeeHolds()
+	if err != nil {
+		t.Fatalf("unable to fetch holds: %v", err)
+	}
+	if len(holds) != 0 {
+		t.Fatalf("expected no holds, got %v", len(holds))
+	}
+
+	chanPoint1 := wire.OutPoint{Hash: [32]byte{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: [32]byte{2}, Tree: 1}
+
+	err = store.DeleteFeeHolds([]wire.OutPoint{chanPoint1})
+	if err != nil {
+		t.Fatalf("unable to delete holds: %v", err)
+	}
+
+	heldUntil1 := time.Unix(0, time.Now().UnixNano())
+	heldUntil2 := heldUntil1.Add(time.Hour)
+	err = store.PutFeeHolds(
+		[]wire.OutPoint{chanPoint1, chanPoint2}, heldUntil1,
+	)
+	if err != nil {
+		t.Fatalf("unable to store holds: %v", err)
+	}
+
+	// Replace the hold of the second channel.
+	err = store.PutFeeHolds([]wire.OutPoint{chanPoint2}, heldUntil2)
+	if err != nil {
+		t.Fatalf("unable to store holds: %v", err)
+	}
+
+	holds, err = store.FetchFeeHolds()
+	if err != nil {
+		t.Fatalf("unable to fetch holds: %v", err)
+	}
+	expected := map[wire.OutPoint]time.Time{
+		chanPoint1: heldUntil1,
+		chanPoint2: heldUntil2,
+	}
+	if !reflect.DeepEqual(holds, expected) {
+		t.Fatalf("expected holds %v, got %v", expected, holds)
+	}
+
+	err = store.DeleteFeeHolds([]wire.OutPoint{chanPoint1})
+	if err != nil {
+		t.Fatalf("unable to delete holds: %v", err)
+	}
+	holds, err = store.FetchFeeHolds()
+	if err != nil {
+		t.Fatalf("unable to fetch holds: %v", err)
+	}
+	delete(expected, chanPoint1)
+	if !reflect.DeepEqual(holds, expected) {
+		t.Fatalf("expected holds %v, got %v", expected, holds)
+	}
+}
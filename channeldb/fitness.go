@@ -0,0 +1,644 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb/kvdb"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+var (
+	// fitnessBucket is the top-level bucket that stores the uptime history
+	// of our channels and the flap statistics of their peers, so that the
+	// fitness of channels can be judged across restarts.
+	//
+	// fitness-bucket
+	//   |
+	//   |-- channel-uptime-bucket
+	//   |     |
+	//   |     |-- <chanPoint>: <channel uptime>
+	//   |
+	//   |-- peer-flap-bucket
+	//   |     |
+	//   |     |-- <pubkey>: <peer flap stats>
+	//   |
+	//   |-- online-periods-bucket
+	//         |
+	//         |-- <chanPoint>
+	//               |
+	//               |-- <start>: <end>
+	fitnessBucket = []byte("fitness-bucket")
+
+	// channelUptimeBucket is the sub-bucket of the fitness bucket that
+	// holds the uptime history of each channel, keyed by its outpoint.
+	channelUptimeBucket = []byte("channel-uptime-bucket")
+
+	// peerFlapBucket is the sub-bucket of the fitness bucket that holds
+	// the flap statistics of each peer, keyed by its public key.
+	peerFlapBucket = []byte("peer-flap-bucket")
+
+	// onlinePeriodsBucket is the sub-bucket of the fitness bucket that
+	// holds a bucket of online periods for each channel, keyed by its
+	// outpoint. Each period is stored under its start time in big endian
+	// unix seconds, with its end time in the same format as value.
+	onlinePeriodsBucket = []byte("online-periods-bucket")
+)
+
+// ChannelUptime summarizes the uptime history of a channel.
+type ChannelUptime struct {
+	// Peer is the compressed public key of the channel's remote peer.
+	Peer route.Vertex
+
+	// OpenedAt is the time the channel was first monitored.
+	OpenedAt time.Time
+
+	// ClosedAt is the time the channel was closed. It is zero if the
+	// channel is still open.
+	ClosedAt time.Time
+
+	// Uptime is the total time the remote peer was online between
+	// OpenedAt and RecordedAt.
+	Uptime time.Duration
+
+	// FlapCount is the number of times the remote peer went offline while
+	// the channel was open.
+	FlapCount uint32
+
+	// RecordedAt is the time up to which the uptime was recorded.
+	RecordedAt time.Time
+
+	// Monitored is the time between OpenedAt and RecordedAt during which
+	// the channel was monitored. It excludes the time our node was
+	// offline, during which the peer's uptime could not be observed.
+	Monitored time.Duration
+}
+
+// PeerFlapStats summarizes how often a peer went offline and for how long.
+type PeerFlapStats struct {
+	// FlapCount is the number of times the peer went offline.
+	FlapCount uint32
+
+	// LastFlap is the last time the peer went offline. It is zero if the
+	// peer never went offline.
+	LastFlap time.Time
+
+	// OfflineTime is the total time the peer spent offline before coming
+	// back online.
+	OfflineTime time.Duration
+
+	// LongestOffline is the longest time the peer spent offline before
+	// coming back online.
+	LongestOffline time.Duration
+}
+
+// OnlinePeriod is a period during which the remote peer of a channel was
+// online. Periods are stored with a resolution of one second.
+type OnlinePeriod struct {
+	// Start is the time the period started.
+	Start time.Time
+
+	// End is the time the period ended.
+	End time.Time
+}
+
+// FitnessStore persists the uptime history of channels and the flap
+// statistics of peers that are recorded by the channel event store.
+type FitnessStore struct {
+	db *DB
+}
+
+// NewFitnessStore creates a new fitness store backed by the given database.
+func NewFitnessStore(db *DB) *FitnessStore {
+	return &FitnessStore{
+		db: db,
+	}
+}
+
+// PutChannelUptimes stores the uptime history of the given channels,
+// replacing any history that was previously stored for them.
+func (s *FitnessStore) PutChannelUptimes(
+	uptimes map[wire.OutPoint]*ChannelUptime) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket, err := createFitnessBucket(tx, channelUptimeBucket)
+		if err != nil {
+			return err
+		}
+
+		for chanPoint, uptime := range uptimes {
+			var k bytes.Buffer
+			if err := writeOutpoint(&k, &chanPoint); err != nil {
+				return err
+			}
+
+			var v bytes.Buffer
+			err := serializeChannelUptime(&v, uptime)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(k.Bytes(), v.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchChannelUptimes returns the uptime history of all channels.
+func (s *FitnessStore) FetchChannelUptimes() (map[wire.OutPoint]*ChannelUptime,
+	error) {
+
+	uptimes := make(map[wire.OutPoint]*ChannelUptime)
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := fetchFitnessBucket(tx, channelUptimeBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			var chanPoint wire.OutPoint
+			err := readOutpoint(bytes.NewReader(k), &chanPoint)
+			if err != nil {
+				return err
+			}
+
+			uptime, err := deserializeChannelUptime(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			uptimes[chanPoint] = uptime
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return uptimes, nil
+}
+
+// DeleteChannelUptimes removes the uptime history of the given channels,
+// including their online periods.
+func (s *FitnessStore) DeleteChannelUptimes(chanPoints []wire.OutPoint) error {
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		fitness := tx.ReadWriteBucket(fitnessBucket)
+		if fitness == nil {
+			return nil
+		}
+
+		uptimes := fitness.NestedReadWriteBucket(channelUptimeBucket)
+		periods := fitness.NestedReadWriteBucket(onlinePeriodsBucket)
+
+		for _, chanPoint := range chanPoints {
+			var k bytes.Buffer
+			if err := writeOutpoint(&k, &chanPoint); err != nil {
+				return err
+			}
+
+			if uptimes != nil {
+				err := uptimes.Delete(k.Bytes())
+				if err != nil {
+					return err
+				}
+			}
+
+			if periods == nil {
+				continue
+			}
+
+			err := periods.DeleteNestedBucket(k.Bytes())
+			if err != nil && err != kvdb.ErrBucketNotFound {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// AddOnlinePeriods appends online periods to the history of the given
+// channels. The periods of each channel must be ordered, and must not start
+// before the periods that were previously added. A period that starts before
+// the end of the last stored period is merged into it, so that a period that
+// is added in several parts is stored as a single one.
+func (s *FitnessStore) AddOnlinePeriods(
+	periods map[wire.OutPoint][]OnlinePeriod) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket, err := createFitnessBucket(tx, onlinePeriodsBucket)
+		if err != nil {
+			return err
+		}
+
+		for chanPoint, chanPeriods := range periods {
+			if len(chanPeriods) == 0 {
+				continue
+			}
+
+			var k bytes.Buffer
+			if err := writeOutpoint(&k, &chanPoint); err != nil {
+				return err
+			}
+
+			chanBucket, err := bucket.CreateBucketIfNotExists(
+				k.Bytes(),
+			)
+			if err != nil {
+				return err
+			}
+
+			for _, period := range chanPeriods {
+				err := addOnlinePeriod(
+					chanBucket, period.Start.Unix(),
+					period.End.Unix(),
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchOnlinePeriods returns the stored online periods of a channel that
+// overlap with the given range, ordered by their start time. The periods are
+// not clipped to the range.
+func (s *FitnessStore) FetchOnlinePeriods(chanPoint wire.OutPoint, start,
+	end time.Time) ([]OnlinePeriod, error) {
+
+	var k bytes.Buffer
+	if err := writeOutpoint(&k, &chanPoint); err != nil {
+		return nil, err
+	}
+
+	var periods []OnlinePeriod
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := fetchFitnessBucket(tx, onlinePeriodsBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		chanBucket := bucket.NestedReadBucket(k.Bytes())
+		if chanBucket == nil {
+			return nil
+		}
+
+		cursor := chanBucket.ReadCursor()
+		for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
+			periodStart := int64(byteOrder.Uint64(k))
+			periodEnd := int64(byteOrder.Uint64(v))
+
+			if periodStart > end.Unix() {
+				break
+			}
+			if periodEnd < start.Unix() {
+				continue
+			}
+
+			periods = append(periods, OnlinePeriod{
+				Start: time.Unix(periodStart, 0),
+				End:   time.Unix(periodEnd, 0),
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// CompactOnlinePeriods removes the online periods of all channels that ended
+// before retainAfter, and merges consecutive periods that are separated by at
+// most maxGap. Merging counts the gaps as online time, trading a bounded loss
+// of precision for fewer records.
+func (s *FitnessStore) CompactOnlinePeriods(retainAfter time.Time,
+	maxGap time.Duration) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		fitness := tx.ReadWriteBucket(fitnessBucket)
+		if fitness == nil {
+			return nil
+		}
+
+		bucket := fitness.NestedReadWriteBucket(onlinePeriodsBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		// Collect the channel buckets first, since buckets can't be
+		// modified while iterating over them.
+		var chanKeys [][]byte
+		err := bucket.ForEach(func(k, _ []byte) error {
+			chanKeys = append(chanKeys, append([]byte(nil), k...))
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, chanKey := range chanKeys {
+			err := compactChannelPeriods(
+				bucket, chanKey, retainAfter.Unix(),
+				int64(maxGap.Seconds()),
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// compactChannelPeriods rewrites the online periods of a single channel as
+// described by CompactOnlinePeriods. The channel's bucket is removed if none
+// of its periods are retained.
+func compactChannelPeriods(bucket kvdb.RwBucket, chanKey []byte,
+	retainAfter, maxGap int64) error {
+
+	chanBucket := bucket.NestedReadWriteBucket(chanKey)
+	if chanBucket == nil {
+		return nil
+	}
+
+	type period struct {
+		start, end int64
+	}
+
+	var (
+		numStored int
+		compacted []period
+	)
+	err := chanBucket.ForEach(func(k, v []byte) error {
+		numStored++
+
+		p := period{
+			start: int64(byteOrder.Uint64(k)),
+			end:   int64(byteOrder.Uint64(v)),
+		}
+		if p.end < retainAfter {
+			return nil
+		}
+
+		last := len(compacted) - 1
+		if last >= 0 && p.start-compacted[last].end <= maxGap {
+			if p.end > compacted[last].end {
+				compacted[last].end = p.end
+			}
+			return nil
+		}
+
+		compacted = append(compacted, p)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(compacted) == numStored {
+		return nil
+	}
+
+	if err := bucket.DeleteNestedBucket(chanKey); err != nil {
+		return err
+	}
+
+	if len(compacted) == 0 {
+		return nil
+	}
+
+	chanBucket, err = bucket.CreateBucket(chanKey)
+	if err != nil {
+		return err
+	}
+
+	for _, p := range compacted {
+		err := chanBucket.Put(encodeUnix(p.start), encodeUnix(p.end))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// addOnlinePeriod adds a period given in unix seconds to the bucket of a
+// channel's online periods, merging it into the last stored period if they
+// overlap or adjoin.
+func addOnlinePeriod(chanBucket kvdb.RwBucket, start, end int64) error {
+	if end <= start {
+		return nil
+	}
+
+	lastKey, lastValue := chanBucket.ReadWriteCursor().Last()
+	if lastKey != nil {
+		lastEnd := int64(byteOrder.Uint64(lastValue))
+		if lastEnd >= start {
+			if end <= lastEnd {
+				return nil
+			}
+
+			key := append([]byte(nil), lastKey...)
+			return chanBucket.Put(key, encodeUnix(end))
+		}
+	}
+
+	return chanBucket.Put(encodeUnix(start), encodeUnix(end))
+}
+
+// encodeUnix encodes unix seconds as big endian, so that they sort by time.
+func encodeUnix(t int64) []byte {
+	var b [8]byte
+	byteOrder.PutUint64(b[:], uint64(t))
+	return b[:]
+}
+
+// PutPeerFlapStats stores the flap statistics of the given peers, replacing
+// any statistics that were previously stored for them.
+func (s *FitnessStore) PutPeerFlapStats(
+	stats map[route.Vertex]*PeerFlapStats) error {
+
+	return kvdb.Update(s.db, func(tx kvdb.RwTx) error {
+		bucket, err := createFitnessBucket(tx, peerFlapBucket)
+		if err != nil {
+			return err
+		}
+
+		for peer, peerStats := range stats {
+			var v bytes.Buffer
+			err := serializePeerFlapStats(&v, peerStats)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(peer[:], v.Bytes()); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// FetchPeerFlapStats returns the flap statistics of all peers.
+func (s *FitnessStore) FetchPeerFlapStats() (map[route.Vertex]*PeerFlapStats,
+	error) {
+
+	stats := make(map[route.Vertex]*PeerFlapStats)
+	err := kvdb.View(s.db, func(tx kvdb.RTx) error {
+		bucket := fetchFitnessBucket(tx, peerFlapBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			peer, err := route.NewVertexFromBytes(k)
+			if err != nil {
+				return err
+			}
+
+			peerStats, err := deserializePeerFlapStats(
+				bytes.NewReader(v),
+			)
+			if err != nil {
+				return err
+			}
+
+			stats[peer] = peerStats
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// createFitnessBucket returns the given sub-bucket of the fitness bucket,
+// creating both if they don't exist yet.
+func createFitnessBucket(tx kvdb.RwTx, name []byte) (kvdb.RwBucket, error) {
+	fitness, err := tx.CreateTopLevelBucket(fitnessBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return fitness.CreateBucketIfNotExists(name)
+}
+
+// fetchFitnessBucket returns the given sub-bucket of the fitness bucket, or nil
+// if it doesn't exist.
+func fetchFitnessBucket(tx kvdb.RTx, name []byte) kvdb.RBucket {
+	fitness := tx.ReadBucket(fitnessBucket)
+	if fitness == nil {
+		return nil
+	}
+
+	return fitness.NestedReadBucket(name)
+}
+
+// unixNanoTime converts a time to unix nanoseconds, keeping the zero time at
+// zero.
+func unixNanoTime(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano()
+}
+
+// timeFromUnixNano converts unix nanoseconds written by unixNanoTime back
+// to a time.
+func timeFromUnixNano(ns int64) time.Time {
+	if ns == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, ns)
+}
+
+// serializeChannelUptime writes the channel uptime to w.
+func serializeChannelUptime(w io.Writer, uptime *ChannelUptime) error {
+	if _, err := w.Write(uptime.Peer[:]); err != nil {
+		return err
+	}
+
+	return WriteElements(
+		w, unixNanoTime(uptime.OpenedAt),
+		unixNanoTime(uptime.ClosedAt), int64(uptime.Uptime),
+		uptime.FlapCount, unixNanoTime(uptime.RecordedAt),
+		int64(uptime.Monitored),
+	)
+}
+
+// deserializeChannelUptime reads a channel uptime that was written by
+// serializeChannelUptime.
+func deserializeChannelUptime(r io.Reader) (*ChannelUptime, error) {
+	var (
+		uptime                         ChannelUptime
+		openedAt, closedAt, recordedAt int64
+		duration, monitored            int64
+	)
+	if _, err := io.ReadFull(r, uptime.Peer[:]); err != nil {
+		return nil, err
+	}
+
+	err := ReadElements(
+		r, &openedAt, &closedAt, &duration, &uptime.FlapCount,
+		&recordedAt, &monitored,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	uptime.OpenedAt = timeFromUnixNano(openedAt)
+	uptime.ClosedAt = timeFromUnixNano(closedAt)
+	uptime.Uptime = time.Duration(duration)
+	uptime.RecordedAt = timeFromUnixNano(recordedAt)
+	uptime.Monitored = time.Duration(monitored)
+
+	return &uptime, nil
+}
+
+// serializePeerFlapStats writes the peer flap statistics to w.
+func serializePeerFlapStats(w io.Writer, stats *PeerFlapStats) error {
+	return WriteElements(
+		w, stats.FlapCount, unixNanoTime(stats.LastFlap),
+		int64(stats.OfflineTime), int64(stats.LongestOffline),
+	)
+}
+
+// deserializePeerFlapStats reads peer flap statistics that were written by
+// serializePeerFlapStats.
+func deserializePeerFlapStats(r io.Reader) (*PeerFlapStats, error) {
+	var (
+		stats                       PeerFlapStats
+		lastFlap                    int64
+		offlineTime, longestOffline int64
+	)
+	err := ReadElements(
+		r, &stats.FlapCount, &lastFlap, &offlineTime, &longestOffline,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	stats.LastFlap = timeFromUnixNano(lastFlap)
+	stats.OfflineTime = time.Duration(offlineTime)
+	stats.LongestOffline = time.Duration(longestOffline)
+
+	return &stats, nil
+}
@@ -0,0 +1,198 @@
+package channeldb
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// TestFitnessStore tests that channel uptimes and peer flap statistics are
+// stored, replaced and deleted.
+func TestFitnessStore(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("failed to make test database: %s", err)
+	}
+	defer cleanup()
+
+	store := NewFitnessStore(db)
+
+	// Nothing is stored in a fresh database.
+	uptimes, err := store.FetchChannelUptimes()
+	if err != nil {
+		t.Fatalf("unable to fetch uptimes: %v", err)
+	}
+	if len(uptimes) != 0 {
+		t.Fatalf("expected no uptimes, got %v", len(uptimes))
+	}
+
+	now := time.Unix(0, time.Now().UnixNano())
+	chanPoint1 := wire.OutPoint{Hash: [32]byte{1}, Index: 1}
+	chanPoint2 := wire.OutPoint{Hash: [32]byte{2}, Tree: 1}
+	peer := route.Vertex{2, 3}
+
+	uptime1 := &ChannelUptime{
+		Peer:       peer,
+		OpenedAt:   now.Add(-time.Hour),
+		Uptime:     time.Minute,
+		FlapCount:  3,
+		RecordedAt: now,
+		Monitored:  30 * time.Minute,
+	}
+	uptime2 := &ChannelUptime{
+		Peer:       peer,
+		OpenedAt:   now.Add(-2 * time.Hour),
+		ClosedAt:   now,
+		Uptime:     time.Hour,
+		RecordedAt: now,
+		Monitored:  2 * time.Hour,
+	}
+	err = store.PutChannelUptimes(map[wire.OutPoint]*ChannelUptime{
+		chanPoint1: uptime1,
+		chanPoint2: uptime2,
+	})
+	if err != nil {
+		t.Fatalf("unable to store uptimes: %v", err)
+	}
+
+	// Replace the uptime of the first channel.
+	uptime1.Uptime = 2 * time.Minute
+	err = store.PutChannelUptimes(map[wire.OutPoint]*ChannelUptime{
+		chanPoint1: uptime1,
+	})
+	if err != nil {
+		t.Fatalf("unable to store uptimes: %v", err)
+	}
+
+	uptimes, err = store.FetchChannelUptimes()
+	if err != nil {
+		t.Fatalf("unable to fetch uptimes: %v", err)
+	}
+	expected := map[wire.OutPoint]*ChannelUptime{
+		chanPoint1: uptime1,
+		chanPoint2: uptime2,
+	}
+	if !reflect.DeepEqual(uptimes, expected) {
+		t.Fatalf("expected uptimes %v, got %v", expected, uptimes)
+	}
+
+	err = store.DeleteChannelUptimes([]wire.OutPoint{chanPoint2})
+	if err != nil {
+		t.Fatalf("unable to delete uptimes: %v", err)
+	}
+	uptimes, err = store.FetchChannelUptimes()
+	if err != nil {
+		t.Fatalf("unable to fetch uptimes: %v", err)
+	}
+	delete(expected, chanPoint2)
+	if !reflect.DeepEqual(uptimes, expected) {
+		t.Fatalf("expected uptimes %v, got %v", expected, uptimes)
+	}
+
+	// Store the flap statistics of a peer that never came back online
+	// and one that has been flapping.
+	stats := map[route.Vertex]*PeerFlapStats{
+		peer: {},
+		{4}: {
+			FlapCount:      7,
+			LastFlap:       now,
+			OfflineTime:    time.Hour,
+			LongestOffline: time.Minute,
+		},
+	}
+	if err := store.PutPeerFlapStats(stats); err != nil {
+		t.Fatalf("unable to store flap stats: %v", err)
+	}
+
+	fetched, err := store.FetchPeerFlapStats()
+	if err != nil {
+		t.Fatalf("unable to fetch flap stats: %v", err)
+	}
+	if !reflect.DeepEqual(fetched, stats) {
+		t.Fatalf("expected flap stats %v, got %v", stats, fetched)
+	}
+}
+
+// TestOnlinePeriods tests that online periods are merged when added in parts,
+// fetched by range, compacted and deleted along with a channel's history.
+func TestOnlinePeriods(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	if err != nil {
+		t.Fatalf("failed to make test database: %s", err)
+	}
+	defer cleanup()
+
+	store := NewFitnessStore(db)
+
+	chanPoint := wire.OutPoint{Hash: [32]byte{1}, Index: 1}
+	at := func(secs int64) time.Time {
+		return time.Unix(1600000000+secs, 0)
+	}
+	period := func(start, end int64) OnlinePeriod {
+		return OnlinePeriod{Start: at(start), End: at(end)}
+	}
+
+	// The second period continues the first one, so both are merged.
+	err = store.AddOnlinePeriods(map[wire.OutPoint][]OnlinePeriod{
+		chanPoint: {period(0, 10), period(10, 20), period(30, 40)},
+	})
+	if err != nil {
+		t.Fatalf("unable to add periods: %v", err)
+	}
+	err = store.AddOnlinePeriods(map[wire.OutPoint][]OnlinePeriod{
+		chanPoint: {period(40, 50), period(55, 60), period(100, 200)},
+	})
+	if err != nil {
+		t.Fatalf("unable to add periods: %v", err)
+	}
+
+	fetch := func(start, end int64) []OnlinePeriod {
+		periods, err := store.FetchOnlinePeriods(
+			chanPoint, at(start), at(end),
+		)
+		if err != nil {
+			t.Fatalf("unable to fetch periods: %v", err)
+		}
+		return periods
+	}
+
+	expected := []OnlinePeriod{
+		period(0, 20), period(30, 50), period(55, 60),
+		period(100, 200),
+	}
+	if periods := fetch(0, 1000); !reflect.DeepEqual(periods, expected) {
+		t.Fatalf("expected periods %v, got %v", expected, periods)
+	}
+
+	// Only overlapping periods are returned for a range.
+	expected = []OnlinePeriod{period(30, 50), period(55, 60)}
+	if periods := fetch(45, 57); !reflect.DeepEqual(periods, expected) {
+		t.Fatalf("expected periods %v, got %v", expected, periods)
+	}
+
+	// Compaction drops the first period and merges the two that are only
+	// separated by five seconds.
+	err = store.CompactOnlinePeriods(at(25), 5*time.Second)
+	if err != nil {
+		t.Fatalf("unable to compact periods: %v", err)
+	}
+	expected = []OnlinePeriod{period(30, 60), period(100, 200)}
+	if periods := fetch(0, 1000); !reflect.DeepEqual(periods, expected) {
+		t.Fatalf("expected periods %v, got %v", expected, periods)
+	}
+
+	err = store.DeleteChannelUptimes([]wire.OutPoint{chanPoint})
+	if err != nil {
+		t.Fatalf("unable to delete history: %v", err)
+	}
+	if periods := fetch(0, 1000); len(periods) != 0 {
+		t.Fatalf("expected no periods, got %v", periods)
+	}
+}
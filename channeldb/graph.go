@@ -2843,6 +2843,13 @@ func (c *ChannelEdgePolicy) ComputeFee(
 	return c.FeeBaseMAtoms + (amt*c.FeeProportionalMillionths)/feeRateParts
 }
 
+// InboundFee returns the inbound fee that the node that announced the policy
+// charges for HTLCs that arrive over the channel. It is carried in the extra
+// opaque data of the policy. If no inbound fee is announced, nil is returned.
+func (c *ChannelEdgePolicy) InboundFee() (*lnwire.InboundFee, error) {
+	return lnwire.ExtractInboundFee(c.ExtraOpaqueData)
+}
+
 // divideCeil divides dividend by factor and rounds the result up.
 func divideCeil(dividend, factor lnwire.MilliAtom) lnwire.MilliAtom {
 	return (dividend + factor - 1) / factor
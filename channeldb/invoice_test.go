@@ -1185,12 +1185,181 @@ func TestInvoiceRef(t *testing.T) {
 	// An InvoiceRef by hash should return the provided hash and a nil
 	// payment addr.
 	refByHash := InvoiceRefByHash(payHash)
-	require.Equal(t, payHash, refByHash.PayHash())
+	require.Equal(t, &payHash, refByHash.PayHash())
 	require.Equal(t, (*[32]byte)(nil), refByHash.PayAddr())
 
 	// An InvoiceRef by hash and addr should return the payment hash and
 	// payment addr passed to the constructor.
 	refByHashAndAddr := InvoiceRefByHashAndAddr(payHash, payAddr)
-	require.Equal(t, payHash, refByHashAndAddr.PayHash())
+	require.Equal(t, &payHash, refByHashAndAddr.PayHash())
 	require.Equal(t, &payAddr, refByHashAndAddr.PayAddr())
+
+	// An InvoiceRef by addr should return a nil payment hash and the
+	// payment addr passed to the constructor.
+	refByAddr := InvoiceRefByAddr(payAddr)
+	require.Equal(t, (*lntypes.Hash)(nil), refByAddr.PayHash())
+	require.Equal(t, &payAddr, refByAddr.PayAddr())
+}
+
+// TestSettleAMPSet asserts that settling an AMP set only settles the htlcs of
+// that set, leaves the invoice open to be paid again and records the state of
+// the set on the invoice.
+func TestSettleAMPSet(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := MakeTestDB(OptionClock(testClock))
+	defer cleanUp()
+	require.NoError(t, err, "unable to make test db")
+
+	// AMP invoices are created without a preimage, and are only indexed
+	// by a random payment hash and their payment address.
+	var payAddr [32]byte
+	_, err = rand.Read(payAddr[:])
+	require.NoError(t, err)
+
+	var paymentHash lntypes.Hash
+	_, err = rand.Read(paymentHash[:])
+	require.NoError(t, err)
+
+	ampFeatures := lnwire.NewFeatureVector(
+		lnwire.NewRawFeatureVector(
+			lnwire.TLVOnionPayloadOptional,
+			lnwire.PaymentAddrOptional,
+			lnwire.AMPRequired,
+		), lnwire.Features,
+	)
+	testInvoice := &Invoice{
+		Htlcs: map[CircuitKey]*InvoiceHTLC{},
+		Terms: ContractTerm{
+			Value:       1000,
+			PaymentAddr: payAddr,
+			Features:    ampFeatures,
+		},
+	}
+
+	_, err = db.AddInvoice(testInvoice, paymentHash)
+	require.NoError(t, err, "unable to add invoice")
+
+	// Accept two htlcs belonging to the same set, and one belonging to a
+	// different set.
+	var (
+		setID1    = SetID{0x01}
+		setID2    = SetID{0x02}
+		preimages = make(map[CircuitKey]lntypes.Preimage)
+		set1      = make(map[CircuitKey]lntypes.Preimage)
+		set2      = make(map[CircuitKey]lntypes.Preimage)
+		addHtlcs  = make(map[CircuitKey]*HtlcAcceptDesc)
+	)
+	for i := uint64(0); i < 3; i++ {
+		key := CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(1),
+			HtlcID: i,
+		}
+
+		setID := setID1
+		if i == 2 {
+			setID = setID2
+		}
+
+		preimage := lntypes.Preimage{byte(i + 1)}
+		preimages[key] = preimage
+		if setID == setID1 {
+			set1[key] = preimage
+		} else {
+			set2[key] = preimage
+		}
+
+		addHtlcs[key] = &HtlcAcceptDesc{
+			Amt:           500,
+			MppTotalAmt:   1000,
+			CustomRecords: make(record.CustomSet),
+			AMP: &InvoiceHtlcAMPData{
+				Record: *record.NewAMP(
+					[32]byte{}, setID, uint32(i),
+				),
+				Hash: preimage.Hash(),
+			},
+		}
+	}
+
+	ref := InvoiceRefByAddr(payAddr)
+	_, err = db.UpdateInvoice(ref,
+		func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+			return &InvoiceUpdateDesc{
+				AddHtlcs: addHtlcs,
+			}, nil
+		},
+	)
+	require.NoError(t, err, "unable to add htlcs")
+
+	// Settling the set with a mismatched preimage should fail.
+	badPreimages := map[CircuitKey]lntypes.Preimage{}
+	for key := range set1 {
+		badPreimages[key] = lntypes.Preimage{0xff}
+	}
+	settleSet := func(setID SetID,
+		htlcPreimages map[CircuitKey]lntypes.Preimage) (*Invoice,
+		error) {
+
+		return db.UpdateInvoice(ref,
+			func(invoice *Invoice) (*InvoiceUpdateDesc, error) {
+				return &InvoiceUpdateDesc{
+					State: &InvoiceStateUpdateDesc{
+						NewState:      ContractSettled,
+						SetID:         &setID,
+						HTLCPreimages: htlcPreimages,
+					},
+				}, nil
+			},
+		)
+	}
+	_, err = settleSet(setID1, badPreimages)
+	require.Equal(t, ErrHTLCPreimageMismatch, err)
+
+	// Now settle the first set with the correct preimages.
+	invoice, err := settleSet(setID1, set1)
+	require.NoError(t, err, "unable to settle set")
+
+	require.Equal(t, ContractOpen, invoice.State)
+	require.Equal(t, lnwire.MilliAtom(1000), invoice.AmtPaid)
+	require.Equal(t, uint64(1), invoice.SettleIndex)
+	require.Equal(t, HtlcStateSettled, invoice.AMPState[setID1].State)
+	require.Equal(t, uint64(1), invoice.AMPState[setID1].SettleIndex)
+	require.Equal(t, lnwire.MilliAtom(1000), invoice.AMPState[setID1].AmtPaid)
+
+	for key, htlc := range invoice.Htlcs {
+		if _, ok := set1[key]; !ok {
+			require.Equal(t, HtlcStateAccepted, htlc.State)
+			continue
+		}
+
+		require.Equal(t, HtlcStateSettled, htlc.State)
+		require.Equal(t, preimages[key], *htlc.AMP.Preimage)
+	}
+
+	// Settling the same set again is not allowed.
+	_, err = settleSet(setID1, set1)
+	require.Equal(t, ErrAMPSetAlreadySettled, err)
+
+	// Settle the second set, which should receive the next settle index.
+	invoice, err = settleSet(setID2, set2)
+	require.NoError(t, err, "unable to settle set")
+
+	require.Equal(t, ContractOpen, invoice.State)
+	require.Equal(t, lnwire.MilliAtom(1500), invoice.AmtPaid)
+	require.Equal(t, uint64(2), invoice.AMPState[setID2].SettleIndex)
+
+	// The state should survive a round trip through the database.
+	dbInvoice, err := db.LookupInvoice(ref)
+	require.NoError(t, err, "unable to lookup invoice")
+	require.Equal(t, invoice.AMPState, dbInvoice.AMPState)
+	for key, htlc := range dbInvoice.Htlcs {
+		require.Equal(t, invoice.Htlcs[key].AMP, htlc.AMP)
+	}
+
+	// Each settled set has its own entry in the settle index, so querying
+	// past the first settle event should return the invoice once more.
+	settled, err := db.InvoicesSettledSince(1)
+	require.NoError(t, err)
+	require.Len(t, settled, 1)
 }
@@ -106,6 +106,19 @@ var (
 	// ErrInvoicePreimageMismatch is returned when the preimage doesn't
 	// match the invoice hash.
 	ErrInvoicePreimageMismatch = errors.New("preimage does not match")
+
+	// ErrHTLCPreimageMissing is returned when trying to accept/settle an
+	// AMP HTLC but the HTLC-level preimage has not been set.
+	ErrHTLCPreimageMissing = errors.New("AMP htlc missing preimage")
+
+	// ErrHTLCPreimageMismatch is returned when trying to accept/settle an
+	// AMP HTLC but the HTLC-level preimage does not satisfying the
+	// HTLC-level payment hash.
+	ErrHTLCPreimageMismatch = errors.New("htlc preimage mismatch")
+
+	// ErrAMPSetAlreadySettled is returned when an attempt is made to
+	// settle an AMP set that was already settled.
+	ErrAMPSetAlreadySettled = errors.New("AMP set already settled")
 )
 
 const (
@@ -135,6 +148,9 @@ const (
 	expiryHeightType tlv.Type = 13
 	htlcStateType    tlv.Type = 15
 	mppTotalAmtType  tlv.Type = 17
+	htlcAMPType      tlv.Type = 19
+	htlcHashType     tlv.Type = 21
+	htlcPreimageType tlv.Type = 23
 
 	// A set of tlv type definitions used to serialize invoice bodiees.
 	//
@@ -157,6 +173,7 @@ const (
 	invStateType    tlv.Type = 12
 	amtPaidType     tlv.Type = 13
 	hodlInvoiceType tlv.Type = 14
+	ampStateType    tlv.Type = 15
 )
 
 // InvoiceRef is a composite identifier for invoices. Invoices can be referenced
@@ -168,7 +185,10 @@ type InvoiceRef struct {
 	// payHash is the payment hash of the target invoice. All invoices are
 	// currently indexed by payment hash. This value will be used as a
 	// fallback when no payment address is known.
-	payHash lntypes.Hash
+	//
+	// NOTE: This value may be nil when referencing AMP invoices, which are
+	// only known by their payment address.
+	payHash *lntypes.Hash
 
 	// payAddr is the payment addr of the target invoice. Newer invoices
 	// (0.11 and up) are indexed by payment address in addition to payment
@@ -182,7 +202,7 @@ type InvoiceRef struct {
 // its payment hash.
 func InvoiceRefByHash(payHash lntypes.Hash) InvoiceRef {
 	return InvoiceRef{
-		payHash: payHash,
+		payHash: &payHash,
 	}
 }
 
@@ -193,14 +213,30 @@ func InvoiceRefByHashAndAddr(payHash lntypes.Hash,
 	payAddr [32]byte) InvoiceRef {
 
 	return InvoiceRef{
-		payHash: payHash,
+		payHash: &payHash,
 		payAddr: &payAddr,
 	}
 }
 
-// PayHash returns the target invoice's payment hash.
-func (r InvoiceRef) PayHash() lntypes.Hash {
-	return r.payHash
+// InvoiceRefByAddr creates an InvoiceRef that queries the payment address
+// index for an invoice with the provided payment address. This is used to
+// reference AMP invoices, since the HTLCs paying to them carry distinct
+// payment hashes.
+func InvoiceRefByAddr(payAddr [32]byte) InvoiceRef {
+	return InvoiceRef{
+		payAddr: &payAddr,
+	}
+}
+
+// PayHash returns the optional payment hash of the target invoice.
+//
+// NOTE: This value may be nil.
+func (r InvoiceRef) PayHash() *lntypes.Hash {
+	if r.payHash != nil {
+		hash := *r.payHash
+		return &hash
+	}
+	return nil
 }
 
 // PayAddr returns the optional payment address of the target invoice.
@@ -216,10 +252,17 @@ func (r InvoiceRef) PayAddr() *[32]byte {
 
 // String returns a human-readable representation of an InvoiceRef.
 func (r InvoiceRef) String() string {
-	if r.payAddr != nil {
-		return fmt.Sprintf("(pay_hash=%v, pay_addr=%x)", r.payHash, *r.payAddr)
+	switch {
+	case r.payHash != nil && r.payAddr != nil:
+		return fmt.Sprintf("(pay_hash=%v, pay_addr=%x)", *r.payHash,
+			*r.payAddr)
+
+	case r.payHash != nil:
+		return fmt.Sprintf("(pay_hash=%v)", *r.payHash)
+
+	default:
+		return fmt.Sprintf("(pay_addr=%x)", *r.payAddr)
 	}
-	return fmt.Sprintf("(pay_hash=%v)", r.payHash)
 }
 
 // ContractState describes the state the invoice is in.
@@ -357,8 +400,53 @@ type Invoice struct {
 	// HodlInvoice indicates whether the invoice should be held in the
 	// Accepted state or be settled right away.
 	HodlInvoice bool
+
+	// AMPState tracks the state of each AMP set that paid to this invoice.
+	// AMP invoices remain open after a set is settled so that they can be
+	// paid repeatedly, so the per-set state is recorded here instead.
+	AMPState AMPInvoiceState
 }
 
+// IsAMP returns true if the invoice requires payment using atomic multi-path
+// payments.
+func (i *Invoice) IsAMP() bool {
+	if i.Terms.Features == nil {
+		return false
+	}
+
+	return i.Terms.Features.HasFeature(lnwire.AMPRequired)
+}
+
+// SetID is the extra unique tuple item for AMP invoices. In addition to
+// setting a payment address, each repeated payment to an AMP invoice will
+// also contain a set ID as well.
+type SetID [32]byte
+
+// InvoiceStateAMP is a struct that associates the current state of an AMP
+// invoice identified by its set ID along with the set of invoices identified
+// by the circuit key. This allows callers to easily look up the latest state
+// of an AMP "sub-invoice" and also look up the invoice HLTCs themselves in the
+// greater HTLC map index.
+type InvoiceStateAMP struct {
+	// State is the state of this sub-AMP invoice.
+	State HtlcState
+
+	// SettleIndex indicates the location in the settle index that
+	// references this instance of InvoiceStateAMP, but only if this value
+	// is set (non-zero), and State is HtlcStateSettled.
+	SettleIndex uint64
+
+	// SettleDate is the date that the setID was settled.
+	SettleDate time.Time
+
+	// AmtPaid is the total amount that was paid in the AMP sub-invoice.
+	AmtPaid lnwire.MilliAtom
+}
+
+// AMPInvoiceState represents a type that stores metadata related to the set
+// of settled AMP "sub-invoices".
+type AMPInvoiceState map[SetID]InvoiceStateAMP
+
 // HtlcState defines the states an htlc paying to an invoice can be in.
 type HtlcState uint8
 
@@ -407,6 +495,63 @@ type InvoiceHTLC struct {
 	// CustomRecords contains the custom key/value pairs that accompanied
 	// the htlc.
 	CustomRecords record.CustomSet
+
+	// AMP encapsulates additional data relevant to AMP HTLCs. This
+	// includes the AMP onion record, in addition to the HTLC's payment
+	// hash and preimage since these are unique to each AMP HTLC, and not
+	// the invoice as a whole.
+	//
+	// NOTE: This value will only be set for AMP HTLCs.
+	AMP *InvoiceHtlcAMPData
+}
+
+// IsAMP returns true if the htlc is an AMP htlc.
+func (h *InvoiceHTLC) IsAMP() bool {
+	return h.AMP != nil
+}
+
+// InvoiceHtlcAMPData is a struct holding the additional metadata stored for
+// each received AMP HTLC. This includes the AMP onion record, in addition to
+// the HTLC's payment hash and preimage.
+type InvoiceHtlcAMPData struct {
+	// AMP is a copy of the AMP record presented in the onion payload
+	// containing the information necessary to correlate and settle a
+	// spontaneous HTLC set.
+	Record record.AMP
+
+	// Hash is an HTLC-level payment hash that is stored only for AMP
+	// payments. This is done because an AMP HTLC will carry a different
+	// payment hash from the invoice it might be satisfying, so we track
+	// the payment hashes individually to able to compute whether or not
+	// the reconstructed preimage correctly matches the HTLC's hash.
+	Hash lntypes.Hash
+
+	// Preimage is an HTLC-level preimage that satisfies the AMP HTLC's
+	// Hash. The preimage is derived from secret share reconstruction of
+	// the shares in the AMP payloads of the set.
+	//
+	// NOTE: Preimage will only be present once the HTLC is in
+	// HtlcStateSettled.
+	Preimage *lntypes.Preimage
+}
+
+// Copy returns a deep copy of the InvoiceHtlcAMPData.
+func (d *InvoiceHtlcAMPData) Copy() *InvoiceHtlcAMPData {
+	if d == nil {
+		return nil
+	}
+
+	var preimage *lntypes.Preimage
+	if d.Preimage != nil {
+		pimg := *d.Preimage
+		preimage = &pimg
+	}
+
+	return &InvoiceHtlcAMPData{
+		Record:   d.Record,
+		Hash:     d.Hash,
+		Preimage: preimage,
+	}
 }
 
 // HtlcAcceptDesc describes the details of a newly accepted htlc.
@@ -427,6 +572,14 @@ type HtlcAcceptDesc struct {
 	// CustomRecords contains the custom key/value pairs that accompanied
 	// the htlc.
 	CustomRecords record.CustomSet
+
+	// AMP encapsulates additional data relevant to AMP HTLCs. This
+	// includes the AMP onion record, in addition to the HTLC's payment
+	// hash and preimage since these are unique to each AMP HTLC, and not
+	// the invoice as a whole.
+	//
+	// NOTE: This value will only be set for AMP HTLCs.
+	AMP *InvoiceHtlcAMPData
 }
 
 // InvoiceUpdateDesc describes the changes that should be applied to the
@@ -451,6 +604,16 @@ type InvoiceStateUpdateDesc struct {
 
 	// Preimage must be set to the preimage when NewState is settled.
 	Preimage *lntypes.Preimage
+
+	// HTLCPreimages set the HTLC-level preimages stored for AMP HTLCs.
+	// These are only learned when settling the invoice as a whole. Must
+	// be set when settling an invoice with non-nil SetID.
+	HTLCPreimages map[CircuitKey]lntypes.Preimage
+
+	// SetID identifies a specific set of HTLCs destined for the same
+	// invoice as part of a larger AMP payment. When set, only the HTLCs of
+	// this set are settled and the invoice itself remains open.
+	SetID *SetID
 }
 
 // InvoiceUpdateCallback is a callback used in the db transaction to update the
@@ -476,6 +639,19 @@ func validateInvoice(i *Invoice, paymentHash lntypes.Hash) error {
 		return errors.New("invoice must have a feature vector")
 	}
 
+	if i.IsAMP() {
+		// AMP invoices derive a distinct preimage for every htlc, so
+		// they can't be paired with a single preimage or be held.
+		if i.Terms.PaymentPreimage != nil {
+			return errors.New("AMP invoices cannot have a preimage")
+		}
+		if i.HodlInvoice {
+			return errors.New("AMP invoices cannot be hodl invoices")
+		}
+
+		return nil
+	}
+
 	if i.Terms.PaymentPreimage == nil && !i.HodlInvoice {
 		return errors.New("non-hodl invoices must have a preimage")
 	}
@@ -688,9 +864,12 @@ func fetchInvoiceNumByRef(invoiceIndex, payAddrIndex kvdb.RBucket,
 	payAddr := ref.PayAddr()
 
 	var (
-		invoiceNumByHash = invoiceIndex.Get(payHash[:])
+		invoiceNumByHash []byte
 		invoiceNumByAddr []byte
 	)
+	if payHash != nil {
+		invoiceNumByHash = invoiceIndex.Get(payHash[:])
+	}
 	if payAddr != nil {
 		// Only allow lookups for payment address if it is not a blank
 		// payment address, which is a special-cased value for legacy
@@ -717,6 +896,11 @@ func fetchInvoiceNumByRef(invoiceIndex, payAddrIndex kvdb.RBucket,
 	case invoiceNumByHash != nil:
 		return invoiceNumByHash, nil
 
+	// If the invoice was referenced solely by its payment address, as is
+	// done for AMP invoices, return the corresponding invoice number.
+	case payHash == nil && invoiceNumByAddr != nil:
+		return invoiceNumByAddr, nil
+
 	// Otherwise we don't know of the target invoice.
 	default:
 		return nil, ErrInvoiceNotFound
@@ -1150,6 +1334,12 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		hodlInvoice = 1
 	}
 
+	var ab bytes.Buffer
+	if err := serializeAMPState(&ab, i.AMPState); err != nil {
+		return err
+	}
+	ampState := ab.Bytes()
+
 	tlvStream, err := tlv.NewStream(
 		// Memo and payreq.
 		tlv.MakePrimitiveRecord(memoType, &i.Memo),
@@ -1174,6 +1364,9 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
 
 		tlv.MakePrimitiveRecord(hodlInvoiceType, &hodlInvoice),
+
+		// AMP set state.
+		tlv.MakePrimitiveRecord(ampStateType, &ampState),
 	)
 	if err != nil {
 		return err
@@ -1196,6 +1389,86 @@ func serializeInvoice(w io.Writer, i *Invoice) error {
 	return serializeHtlcs(w, i.Htlcs)
 }
 
+// serializeAMPState serializes the state of the AMP sets of an invoice. Each
+// set is written as its set id, followed by its state, settle index, settle
+// date and amount paid.
+func serializeAMPState(w io.Writer, ampState AMPInvoiceState) error {
+	// Non-AMP invoices don't carry any state, so we avoid writing an
+	// empty set.
+	if len(ampState) == 0 {
+		return nil
+	}
+
+	if err := WriteElements(w, uint32(len(ampState))); err != nil {
+		return err
+	}
+
+	for setID, state := range ampState {
+		setID := setID
+		if _, err := w.Write(setID[:]); err != nil {
+			return err
+		}
+
+		err := WriteElements(
+			w, uint8(state.State), state.SettleIndex,
+			uint64(state.SettleDate.UnixNano()),
+			uint64(state.AmtPaid),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deserializeAMPState reads the state of the AMP sets of an invoice as written
+// by serializeAMPState. An empty reader, as is the case for non-AMP invoices,
+// yields a nil state.
+func deserializeAMPState(r io.Reader) (AMPInvoiceState, error) {
+	var numSets uint32
+	err := ReadElements(r, &numSets)
+	switch {
+	case err == io.EOF:
+		return nil, nil
+
+	case err != nil:
+		return nil, err
+
+	case numSets == 0:
+		return nil, nil
+	}
+
+	ampState := make(AMPInvoiceState, numSets)
+	for i := uint32(0); i < numSets; i++ {
+		var (
+			setID               SetID
+			state               uint8
+			settleIndex         uint64
+			settleDate, amtPaid uint64
+		)
+		if _, err := io.ReadFull(r, setID[:]); err != nil {
+			return nil, err
+		}
+
+		err := ReadElements(
+			r, &state, &settleIndex, &settleDate, &amtPaid,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		ampState[setID] = InvoiceStateAMP{
+			State:       HtlcState(state),
+			SettleIndex: settleIndex,
+			SettleDate:  time.Unix(0, int64(settleDate)),
+			AmtPaid:     lnwire.MilliAtom(amtPaid),
+		}
+	}
+
+	return ampState, nil
+}
+
 // serializeHtlcs serializes a map containing circuit keys and invoice htlcs to
 // a writer.
 func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
@@ -1223,6 +1496,29 @@ func serializeHtlcs(w io.Writer, htlcs map[CircuitKey]*InvoiceHTLC) error {
 			tlv.MakePrimitiveRecord(mppTotalAmtType, &mppTotalAmt),
 		)
 
+		// Add the AMP specific fields if this is an AMP htlc. The
+		// preimage is only known once the htlc is settled.
+		if htlc.AMP != nil {
+			ampData := htlc.AMP.Copy()
+			hash := [32]byte(ampData.Hash)
+
+			records = append(records,
+				tlv.MakeDynamicRecord(
+					htlcAMPType, &ampData.Record,
+					ampData.Record.PayloadSize,
+					record.AMPEncoder, record.AMPDecoder,
+				),
+				tlv.MakePrimitiveRecord(htlcHashType, &hash),
+			)
+
+			if ampData.Preimage != nil {
+				preimage := [32]byte(*ampData.Preimage)
+				records = append(records, tlv.MakePrimitiveRecord(
+					htlcPreimageType, &preimage,
+				))
+			}
+		}
+
 		// Convert the custom records to tlv.Record types that are ready
 		// for serialization.
 		customRecords := tlv.MapToRecords(htlc.CustomRecords)
@@ -1280,6 +1576,7 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		creationDateBytes []byte
 		settleDateBytes   []byte
 		featureBytes      []byte
+		ampStateBytes     []byte
 	)
 
 	var i Invoice
@@ -1307,6 +1604,9 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		tlv.MakePrimitiveRecord(amtPaidType, &amtPaid),
 
 		tlv.MakePrimitiveRecord(hodlInvoiceType, &hodlInvoice),
+
+		// AMP set state.
+		tlv.MakePrimitiveRecord(ampStateType, &ampStateBytes),
 	)
 	if err != nil {
 		return i, err
@@ -1360,6 +1660,11 @@ func deserializeInvoice(r io.Reader) (Invoice, error) {
 		rawFeatures, lnwire.Features,
 	)
 
+	i.AMPState, err = deserializeAMPState(bytes.NewReader(ampStateBytes))
+	if err != nil {
+		return i, err
+	}
+
 	i.Htlcs, err = deserializeHtlcs(r)
 	return i, err
 }
@@ -1392,6 +1697,8 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			state                   uint8
 			acceptTime, resolveTime uint64
 			amt, mppTotalAmt        uint64
+			amp                     record.AMP
+			hash, preimage          [32]byte
 		)
 		tlvStream, err := tlv.NewStream(
 			tlv.MakePrimitiveRecord(chanIDType, &chanID),
@@ -1405,6 +1712,12 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 			tlv.MakePrimitiveRecord(expiryHeightType, &htlc.Expiry),
 			tlv.MakePrimitiveRecord(htlcStateType, &state),
 			tlv.MakePrimitiveRecord(mppTotalAmtType, &mppTotalAmt),
+			tlv.MakeDynamicRecord(
+				htlcAMPType, &amp, amp.PayloadSize,
+				record.AMPEncoder, record.AMPDecoder,
+			),
+			tlv.MakePrimitiveRecord(htlcHashType, &hash),
+			tlv.MakePrimitiveRecord(htlcPreimageType, &preimage),
 		)
 		if err != nil {
 			return nil, err
@@ -1422,6 +1735,20 @@ func deserializeHtlcs(r io.Reader) (map[CircuitKey]*InvoiceHTLC, error) {
 		htlc.Amt = lnwire.MilliAtom(amt)
 		htlc.MppTotalAmt = lnwire.MilliAtom(mppTotalAmt)
 
+		// If the AMP record was present, this is an AMP htlc and we'll
+		// restore the htlc-level hash and, if known, preimage.
+		if _, ok := parsedTypes[htlcAMPType]; ok {
+			htlc.AMP = &InvoiceHtlcAMPData{
+				Record: amp,
+				Hash:   lntypes.Hash(hash),
+			}
+
+			if _, ok := parsedTypes[htlcPreimageType]; ok {
+				pimg := lntypes.Preimage(preimage)
+				htlc.AMP.Preimage = &pimg
+			}
+		}
+
 		// Reconstruct the custom records fields from the parsed types
 		// map return from the tlv parser.
 		htlc.CustomRecords = hop.NewCustomRecords(parsedTypes)
@@ -1449,6 +1776,8 @@ func copyInvoiceHTLC(src *InvoiceHTLC) *InvoiceHTLC {
 		result.CustomRecords[k] = v
 	}
 
+	result.AMP = src.AMP.Copy()
+
 	return &result
 }
 
@@ -1481,13 +1810,21 @@ func copyInvoice(src *Invoice) *Invoice {
 		dest.Htlcs[k] = copyInvoiceHTLC(v)
 	}
 
+	if src.AMPState != nil {
+		dest.AMPState = make(AMPInvoiceState, len(src.AMPState))
+		for setID, state := range src.AMPState {
+			dest.AMPState[setID] = state
+		}
+	}
+
 	return &dest
 }
 
 // updateInvoice fetches the invoice, obtains the update descriptor from the
 // callback and applies the updates in a single db transaction.
-func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex kvdb.RwBucket,
-	invoiceNum []byte, callback InvoiceUpdateCallback) (*Invoice, error) {
+func (d *DB) updateInvoice(hash *lntypes.Hash, invoices,
+	settleIndex kvdb.RwBucket, invoiceNum []byte,
+	callback InvoiceUpdateCallback) (*Invoice, error) {
 
 	invoice, err := fetchInvoice(invoiceNum, invoices)
 	if err != nil {
@@ -1512,8 +1849,10 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex kvdb.RwBucke
 	now := d.clock.Now()
 
 	// Update invoice state if the update descriptor indicates an invoice
-	// state change.
-	if update.State != nil {
+	// state change. Settling an AMP set only affects the htlcs of that
+	// set, so it is handled separately once all htlc additions have been
+	// applied.
+	if update.State != nil && update.State.SetID == nil {
 		err := updateInvoiceState(&invoice, hash, *update.State)
 		if err != nil {
 			return nil, err
@@ -1549,11 +1888,23 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex kvdb.RwBucke
 			AcceptTime:    now,
 			State:         HtlcStateAccepted,
 			CustomRecords: htlcUpdate.CustomRecords,
+			AMP:           htlcUpdate.AMP.Copy(),
 		}
 
 		invoice.Htlcs[key] = htlc
 	}
 
+	// Settle the htlcs of an AMP set if the update descriptor indicates
+	// so.
+	if update.State != nil && update.State.SetID != nil {
+		err := settleAMPSet(
+			settleIndex, invoiceNum, &invoice, *update.State, now,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Align htlc states with invoice state and recalculate amount paid.
 	var (
 		amtPaid     lnwire.MilliAtom
@@ -1592,10 +1943,14 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex kvdb.RwBucke
 		}
 
 		// Update the running amount paid to this invoice. We don't
-		// include accepted htlcs when the invoice is still open.
-		if invoice.State != ContractOpen &&
-			(htlc.State == HtlcStateAccepted ||
-				htlc.State == HtlcStateSettled) {
+		// include accepted htlcs when the invoice is still open, but
+		// settled htlcs of AMP sets are always included.
+		switch {
+		case htlc.State == HtlcStateSettled:
+			amtPaid += htlc.Amt
+
+		case htlc.State == HtlcStateAccepted &&
+			invoice.State != ContractOpen:
 
 			amtPaid += htlc.Amt
 		}
@@ -1622,7 +1977,7 @@ func (d *DB) updateInvoice(hash lntypes.Hash, invoices, settleIndex kvdb.RwBucke
 }
 
 // updateInvoiceState validates and processes an invoice state update.
-func updateInvoiceState(invoice *Invoice, hash lntypes.Hash,
+func updateInvoiceState(invoice *Invoice, hash *lntypes.Hash,
 	update InvoiceStateUpdateDesc) error {
 
 	// Returning to open is never allowed from any state.
@@ -1651,7 +2006,11 @@ func updateInvoiceState(invoice *Invoice, hash lntypes.Hash,
 			// Validate preimage.
 			switch {
 			case update.Preimage != nil:
-				if update.Preimage.Hash() != hash {
+				if hash == nil {
+					return errors.New("payment hash " +
+						"required to validate preimage")
+				}
+				if update.Preimage.Hash() != *hash {
 					return ErrInvoicePreimageMismatch
 				}
 				invoice.Terms.PaymentPreimage = update.Preimage
@@ -1720,12 +2079,19 @@ func updateHtlc(resolveTime time.Time, htlc *InvoiceHTLC,
 			htlc.ResolveTime = resolveTime
 
 		case HtlcStateSettled:
+			// The htlcs of settled AMP sets remain settled when
+			// the AMP invoice is canceled.
+			if htlc.IsAMP() {
+				return nil
+			}
+
 			return fmt.Errorf("cannot have a settled htlc with " +
 				"invoice in state canceled")
 		}
 
 	case ContractOpen, ContractAccepted:
-		if htlc.State == HtlcStateSettled {
+		// AMP invoices remain open after their sets are settled.
+		if htlc.State == HtlcStateSettled && !htlc.IsAMP() {
 			return fmt.Errorf("cannot have a settled htlc with "+
 				"invoice in state %v", invState)
 		}
@@ -1737,6 +2103,78 @@ func updateHtlc(resolveTime time.Time, htlc *InvoiceHTLC,
 	return nil
 }
 
+// settleAMPSet settles all accepted htlcs of the AMP set identified in the
+// update, using the htlc-level preimages provided. The invoice itself remains
+// open so that it can be paid again, while the settlement of the set is
+// recorded in the invoice's AMP state and the settle index.
+func settleAMPSet(settleIndex kvdb.RwBucket, invoiceNum []byte,
+	invoice *Invoice, update InvoiceStateUpdateDesc, now time.Time) error {
+
+	setID := *update.SetID
+
+	switch {
+	case update.NewState != ContractSettled:
+		return fmt.Errorf("unsupported state %v for AMP set %x",
+			update.NewState, setID[:])
+
+	case !invoice.IsAMP():
+		return fmt.Errorf("cannot settle AMP set %x of non-AMP "+
+			"invoice", setID[:])
+
+	case invoice.State != ContractOpen:
+		return fmt.Errorf("cannot settle AMP set %x of invoice in "+
+			"state %v", setID[:], invoice.State)
+	}
+
+	if ampState, ok := invoice.AMPState[setID]; ok &&
+		ampState.State == HtlcStateSettled {
+
+		return ErrAMPSetAlreadySettled
+	}
+
+	var setAmt lnwire.MilliAtom
+	for key, htlc := range invoice.Htlcs {
+		if !htlc.IsAMP() || htlc.State != HtlcStateAccepted ||
+			htlc.AMP.Record.SetID() != setID {
+
+			continue
+		}
+
+		preimage, ok := update.HTLCPreimages[key]
+		if !ok {
+			return ErrHTLCPreimageMissing
+		}
+		if preimage.Hash() != htlc.AMP.Hash {
+			return ErrHTLCPreimageMismatch
+		}
+
+		htlc.AMP.Preimage = &preimage
+		htlc.State = HtlcStateSettled
+		htlc.ResolveTime = now
+
+		setAmt += htlc.Amt
+	}
+
+	// Allocate a settle index for this set, such that subscribers are
+	// notified of every settled set of the invoice.
+	err := setSettleMetaFields(settleIndex, invoiceNum, invoice, now)
+	if err != nil {
+		return err
+	}
+
+	if invoice.AMPState == nil {
+		invoice.AMPState = make(AMPInvoiceState)
+	}
+	invoice.AMPState[setID] = InvoiceStateAMP{
+		State:       HtlcStateSettled,
+		SettleIndex: invoice.SettleIndex,
+		SettleDate:  now,
+		AmtPaid:     setAmt,
+	}
+
+	return nil
+}
+
 // setSettleMetaFields updates the metadata associated with settlement of an
 // invoice.
 func setSettleMetaFields(settleIndex kvdb.RwBucket, invoiceNum []byte,
@@ -558,6 +558,49 @@ func (p *PaymentControl) FetchPayment(paymentHash lntypes.Hash) (
 	return payment, nil
 }
 
+// DeletePayment removes the payment with the given hash, along with its index
+// entries, from the database. In-flight payments cannot be deleted, as their
+// attempts may still be resolved.
+func (p *PaymentControl) DeletePayment(paymentHash lntypes.Hash) error {
+	return kvdb.Update(p.db, func(tx kvdb.RwTx) error {
+		bucket, err := fetchPaymentBucketUpdate(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		paymentStatus, err := fetchPaymentStatus(bucket)
+		if err != nil {
+			return err
+		}
+
+		if paymentStatus == StatusInFlight {
+			return ErrPaymentInFlight
+		}
+
+		// Get all the sequence numbers associated with the payment,
+		// including duplicates, so that their index entries can be
+		// deleted as well.
+		seqNrs, err := fetchSequenceNumbers(bucket)
+		if err != nil {
+			return err
+		}
+
+		payments := tx.ReadWriteBucket(paymentsRootBucket)
+		if err := payments.DeleteNestedBucket(paymentHash[:]); err != nil {
+			return err
+		}
+
+		indexBucket := tx.ReadWriteBucket(paymentsIndexBucket)
+		for _, k := range seqNrs {
+			if err := indexBucket.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // createPaymentBucket creates or fetches the sub-bucket assigned to this
 // payment hash.
 func createPaymentBucket(tx kvdb.RwTx, paymentHash lntypes.Hash) (
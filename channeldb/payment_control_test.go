@@ -507,6 +507,56 @@ func TestPaymentControlDeleteNonInFligt(t *testing.T) {
 	require.Equal(t, 1, indexCount)
 }
 
+// TestPaymentControlDeletePayment checks that a single payment and its index
+// are deleted, unless it is still in flight.
+func TestPaymentControlDeletePayment(t *testing.T) {
+	t.Parallel()
+
+	db, cleanup, err := MakeTestDB()
+	defer cleanup()
+	require.NoError(t, err)
+
+	pControl := NewPaymentControl(db)
+
+	info, attempt, _, err := genInfo()
+	require.NoError(t, err)
+
+	// Deleting an unknown payment fails.
+	err = pControl.DeletePayment(info.PaymentHash)
+	require.Equal(t, ErrPaymentNotInitiated, err)
+
+	err = pControl.InitPayment(info.PaymentHash, info)
+	require.NoError(t, err)
+	_, err = pControl.RegisterAttempt(info.PaymentHash, attempt)
+	require.NoError(t, err)
+
+	pmt, err := pControl.FetchPayment(info.PaymentHash)
+	require.NoError(t, err)
+	seqNr := pmt.SequenceNum
+
+	// The payment can't be deleted while it is in flight.
+	err = pControl.DeletePayment(info.PaymentHash)
+	require.Equal(t, ErrPaymentInFlight, err)
+	assertPaymentIndex(t, pControl, info.PaymentHash)
+
+	_, err = pControl.FailAttempt(
+		info.PaymentHash, attempt.AttemptID,
+		&HTLCFailInfo{Reason: HTLCFailUnreadable},
+	)
+	require.NoError(t, err)
+	_, err = pControl.Fail(info.PaymentHash, FailureReasonNoRoute)
+	require.NoError(t, err)
+
+	// Once failed, the payment and its index are deleted.
+	require.NoError(t, pControl.DeletePayment(info.PaymentHash))
+	assertPaymentStatus(t, pControl, info.PaymentHash, StatusUnknown)
+	assertNoIndex(t, pControl, seqNr)
+
+	// A new payment to the same hash can be made afterwards.
+	err = pControl.InitPayment(info.PaymentHash, info)
+	require.NoError(t, err)
+}
+
 // TestPaymentControlMultiShard checks the ability of payment control to
 // have multiple in-flight HTLCs for a single payment.
 func TestPaymentControlMultiShard(t *testing.T) {
@@ -32,6 +32,7 @@ var (
 			80001: []byte{},
 		},
 		MPP: record.NewMPP(32, [32]byte{0x42}),
+		AMP: record.NewAMP([32]byte{0x69}, [32]byte{0x96}, 1),
 	}
 
 	testHop2 = &route.Hop{
@@ -72,6 +73,9 @@ func makeFakeInfo() (*PaymentCreationInfo, *HTLCAttemptInfo) {
 		Route:       testRoute,
 		AttemptTime: time.Unix(100, 0),
 	}
+	hash := preimg.Hash()
+	a.Hash = &hash
+
 	return c, a
 }
 
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var createAccountCommand = cli.Command{
+	Name:      "createaccount",
+	Category:  "Macaroons",
+	Usage:     "Create an account macaroons can be bound to.",
+	ArgsUsage: "balance [expiration_date]",
+	Description: `
+	Create a new account with the given balance in milli-atoms. Macaroons
+	that are bound to the account with "lncli bakemacaroon --account_id"
+	can only make payments up to the balance of the account. Invoices added
+	with such a macaroon are credited to the account once they are settled.
+
+	An optional expiration date can be given as a unix timestamp in
+	seconds, after which no more payments can be made from the account.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "balance",
+			Usage: "the initial balance of the account in " +
+				"milli-atoms",
+		},
+		cli.Int64Flag{
+			Name: "expiration_date",
+			Usage: "the unix timestamp in seconds after " +
+				"which the account expires, zero means it " +
+				"never expires",
+		},
+	},
+	Action: actionDecorator(createAccount),
+}
+
+func createAccount(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		args           = ctx.Args()
+		balance        uint64
+		expirationDate int64
+		err            error
+	)
+
+	switch {
+	case ctx.IsSet("balance"):
+		balance = ctx.Uint64("balance")
+	case args.Present():
+		balance, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode balance: %v", err)
+		}
+		args = args.Tail()
+	default:
+		return cli.ShowCommandHelp(ctx, "createaccount")
+	}
+
+	switch {
+	case ctx.IsSet("expiration_date"):
+		expirationDate = ctx.Int64("expiration_date")
+	case args.Present():
+		expirationDate, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode expiration "+
+				"date: %v", err)
+		}
+	}
+
+	req := &lnrpc.CreateAccountRequest{
+		AccountBalanceMAtoms: balance,
+		ExpirationDate:       expirationDate,
+	}
+	resp, err := client.CreateAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listAccountsCommand = cli.Command{
+	Name:     "listaccounts",
+	Category: "Macaroons",
+	Usage:    "List all accounts and their balances.",
+	Action:   actionDecorator(listAccounts),
+}
+
+func listAccounts(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListAccountsRequest{}
+	resp, err := client.ListAccounts(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var removeAccountCommand = cli.Command{
+	Name:      "removeaccount",
+	Category:  "Macaroons",
+	Usage:     "Remove an account.",
+	ArgsUsage: "id",
+	Description: `
+	Remove the account with the given hex encoded ID. Macaroons bound to the
+	account can't make any payments afterwards.
+	`,
+	Action: actionDecorator(removeAccount),
+}
+
+func removeAccount(ctx *cli.Context) error {
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "removeaccount")
+	}
+
+	req := &lnrpc.RemoveAccountRequest{
+		Id: ctx.Args().First(),
+	}
+	resp, err := client.RemoveAccount(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
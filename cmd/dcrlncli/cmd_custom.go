@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var sendCustomCommand = cli.Command{
+	Name:     "sendcustom",
+	Category: "Peers",
+	Usage:    "Send a custom message to a connected peer.",
+	Description: `
+	Send a message within the custom message type range to a connected
+	peer. The message type must be odd and at least 32768, so peers that
+	don't understand the message ignore it.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "peer",
+			Usage: "the hex-encoded compressed public key of the " +
+				"peer to send the message to",
+		},
+		cli.Uint64Flag{
+			Name:  "type",
+			Usage: "the message type",
+		},
+		cli.StringFlag{
+			Name:  "data",
+			Usage: "the hex-encoded payload of the message",
+		},
+	},
+	Action: actionDecorator(sendCustom),
+}
+
+func sendCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	peer, err := hex.DecodeString(ctx.String("peer"))
+	if err != nil {
+		return fmt.Errorf("unable to decode peer public key: %v", err)
+	}
+
+	msgType := ctx.Uint64("type")
+
+	data, err := hex.DecodeString(ctx.String("data"))
+	if err != nil {
+		return fmt.Errorf("unable to decode data: %v", err)
+	}
+
+	resp, err := client.SendCustomMessage(
+		ctxb, &lnrpc.SendCustomMessageRequest{
+			Peer: peer,
+			Type: uint32(msgType),
+			Data: data,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var subscribeCustomCommand = cli.Command{
+	Name:     "subscribecustom",
+	Category: "Peers",
+	Usage:    "Subscribe to incoming custom messages.",
+	Action:   actionDecorator(subscribeCustom),
+}
+
+func subscribeCustom(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.SubscribeCustomMessages(
+		ctxb, &lnrpc.SubscribeCustomMessageRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Received %v bytes from peer %x with type %d: %x\n",
+			len(msg.Data), msg.Peer, msg.Type, msg.Data)
+	}
+}
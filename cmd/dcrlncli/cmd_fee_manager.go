@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/urfave/cli"
+)
+
+var feeManagerStatusCommand = cli.Command{
+	Name:     "feemanager",
+	Category: "Channels",
+	Usage:    "Show the decisions of the automated fee manager.",
+	Description: `
+	Show the current and target fees of each channel, as chosen by the
+	automated fee manager based on the channel's projected local balance.
+	Channels whose policy was updated manually are held for a while, and
+	channels may be excluded from fee management or pinned to fixed fees
+	using feemanageroverride.`,
+	Action: actionDecorator(feeManagerStatus),
+}
+
+func feeManagerStatus(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.FeeManagerStatusRequest{}
+	resp, err := client.FeeManagerStatus(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var feeManagerOverrideCommand = cli.Command{
+	Name:      "feemanageroverride",
+	Category:  "Channels",
+	Usage:     "Override the automated fee manager for a channel.",
+	ArgsUsage: "chan_point",
+	Description: `
+	Override the decisions of the automated fee manager for a channel. The
+	mode is one of:
+
+	  - exclude: the channel keeps its current fees.
+	  - pin: the channel is updated to the given fees right away, which
+	    are kept from then on.
+	  - auto: any override is removed, so the fees of the channel are
+	    managed automatically again.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel's outpoint in the format " +
+				"txid:index",
+		},
+		cli.StringFlag{
+			Name:  "mode",
+			Usage: "the override mode: exclude, pin or auto",
+		},
+		cli.Int64Flag{
+			Name: "base_fee_m_atoms",
+			Usage: "the base fee in milli-atoms the channel is " +
+				"pinned to",
+		},
+		cli.Int64Flag{
+			Name: "fee_per_mil",
+			Usage: "the fee rate in parts per million the " +
+				"channel is pinned to",
+		},
+	},
+	Action: actionDecorator(feeManagerOverride),
+}
+
+func feeManagerOverride(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var chanPointStr string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+	case ctx.Args().Present():
+		chanPointStr = ctx.Args().First()
+	default:
+		return fmt.Errorf("chan_point argument missing")
+	}
+
+	chanPoint, err := parseChanPoint(chanPointStr)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.FeeManagerOverrideRequest{
+		ChanPoint: chanPoint,
+	}
+
+	switch ctx.String("mode") {
+	case "auto":
+		req.Mode = lnrpc.FeeOverrideMode_FEE_OVERRIDE_AUTO
+
+	case "exclude":
+		req.Mode = lnrpc.FeeOverrideMode_FEE_OVERRIDE_EXCLUDE
+
+	case "pin":
+		if !ctx.IsSet("base_fee_m_atoms") || !ctx.IsSet("fee_per_mil") {
+			return fmt.Errorf("base_fee_m_atoms and fee_per_mil " +
+				"must be set to pin the fees")
+		}
+
+		req.Mode = lnrpc.FeeOverrideMode_FEE_OVERRIDE_PIN
+		req.BaseFeeMAtoms = ctx.Int64("base_fee_m_atoms")
+		req.FeePerMil = ctx.Int64("fee_per_mil")
+
+	default:
+		return fmt.Errorf("mode must be one of exclude, pin or auto")
+	}
+
+	resp, err := client.SetFeeManagerOverride(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/matheusd/protobuf-hex-display/jsonpb"
+	"github.com/urfave/cli"
+)
+
+var exportMissionControlCommand = cli.Command{
+	Name:     "exportmc",
+	Category: "Payments",
+	Usage:    "Export the pair histories of mission control.",
+	Description: `
+	Export the pair histories of mission control as JSON, which can be
+	imported into the mission control of another node with importmc.`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "days",
+			Usage: "only export the pairs with results within " +
+				"the given number of days",
+		},
+	},
+	Action: actionDecorator(exportMissionControl),
+}
+
+func exportMissionControl(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.XExportMissionControlRequest{}
+	if ctx.IsSet("days") {
+		since := time.Now().Add(
+			-time.Duration(ctx.Uint64("days")) * 24 * time.Hour,
+		)
+		req.Since = since.Unix()
+	}
+
+	rpcCtx := context.Background()
+	resp, err := client.XExportMissionControl(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var importMissionControlCommand = cli.Command{
+	Name:      "importmc",
+	Category:  "Payments",
+	Usage:     "Import the pair histories exported by another node.",
+	ArgsUsage: "export-file",
+	Description: `
+	Merge the pair histories in the given file, as exported by exportmc,
+	into the mission control state. By default, the imported results only
+	replace known results if they are more recent. The imported results are
+	persisted and survive a restart.`,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "force",
+			Usage: "replace the known results of a pair by the " +
+				"imported ones, even if they are older",
+		},
+	},
+	Action: actionDecorator(importMissionControl),
+}
+
+func importMissionControl(ctx *cli.Context) error {
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "importmc")
+	}
+
+	exportJSON, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to read export file: %v", err)
+	}
+
+	export := &routerrpc.XExportMissionControlResponse{}
+	err = jsonpb.UnmarshalString(string(exportJSON), export)
+	if err != nil {
+		return fmt.Errorf("unable to parse export file: %v", err)
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.XImportMissionControlRequest{
+		Pairs: export.Pairs,
+		Force: ctx.Bool("force"),
+	}
+	rpcCtx := context.Background()
+	resp, err := client.XImportMissionControl(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
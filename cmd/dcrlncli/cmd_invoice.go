@@ -69,6 +69,11 @@ var addInvoiceCommand = cli.Command{
 				"in directly connected channels and create the " +
 				"invoice anyway.",
 		},
+		cli.BoolFlag{
+			Name: "amp",
+			Usage: "Creates an AMP invoice. If true, preimage " +
+				"should not be set.",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -122,6 +127,7 @@ func addInvoice(ctx *cli.Context) error {
 		Expiry:              ctx.Int64("expiry"),
 		Private:             ctx.Bool("private"),
 		IgnoreMaxInboundAmt: ctx.Bool("ignore_max_inbound_amt"),
+		IsAmp:               ctx.Bool("amp"),
 	}
 
 	resp, err := client.AddInvoice(context.Background(), invoice)
@@ -23,7 +23,9 @@ var bakeMacaroonCommand = cli.Command{
 	Category: "Macaroons",
 	Usage: "Bakes a new macaroon with the provided list of permissions " +
 		"and restrictions.",
-	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] permissions...",
+	ArgsUsage: "[--save_to=] [--timeout=] [--ip_address=] " +
+		"[--custom_caveat_name=] [--custom_caveat_condition=] " +
+		"[--account_id=] permissions...",
 	Description: `
 	Bake a new macaroon that grants the provided permissions and
 	optionally adds restrictions (timeout, IP address) to it.
@@ -49,6 +51,16 @@ var bakeMacaroonCommand = cli.Command{
 
 	To get a list of all available URIs and permissions, use the
 	"lncli listpermissions" command.
+
+	A custom caveat can be added with the --custom_caveat_name and
+	--custom_caveat_condition arguments. Custom caveats are not checked by
+	the daemon itself but by the RPC middleware that registered for the
+	caveat name.
+
+	The macaroon can be bound to an account created with the
+	"lncli createaccount" command using the --account_id argument.
+	Payments made with such a macaroon are limited to the balance of the
+	account.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -69,6 +81,23 @@ var bakeMacaroonCommand = cli.Command{
 			Name:  "root_key_id",
 			Usage: "the numerical root key ID used to create the macaroon",
 		},
+		cli.StringFlag{
+			Name: "custom_caveat_name",
+			Usage: "the name of the custom caveat to add, " +
+				"which is enforced by the RPC middleware " +
+				"that registered for it",
+		},
+		cli.StringFlag{
+			Name: "custom_caveat_condition",
+			Usage: "the condition of the custom caveat to " +
+				"add, can be empty if the caveat type " +
+				"doesn't require a condition",
+		},
+		cli.StringFlag{
+			Name: "account_id",
+			Usage: "the hex encoded ID of the account to bind " +
+				"the macaroon to",
+		},
 	},
 	Action: actionDecorator(bakeMacaroon),
 }
@@ -87,6 +116,9 @@ func bakeMacaroon(ctx *cli.Context) error {
 		savePath          string
 		timeout           int64
 		ipAddress         net.IP
+		customCaveatName  string
+		customCaveatCond  string
+		accountID         *[macaroons.AccountIDLen]byte
 		rootKeyID         uint64
 		parsedPermissions []*lnrpc.MacaroonPermission
 		err               error
@@ -115,6 +147,25 @@ func bakeMacaroon(ctx *cli.Context) error {
 		rootKeyID = ctx.Uint64("root_key_id")
 	}
 
+	if ctx.IsSet("custom_caveat_name") {
+		customCaveatName = ctx.String("custom_caveat_name")
+		customCaveatCond = ctx.String("custom_caveat_condition")
+	} else if ctx.IsSet("custom_caveat_condition") {
+		return fmt.Errorf("custom_caveat_condition requires " +
+			"custom_caveat_name")
+	}
+
+	if ctx.IsSet("account_id") {
+		idBytes, err := hex.DecodeString(ctx.String("account_id"))
+		if err != nil || len(idBytes) != macaroons.AccountIDLen {
+			return fmt.Errorf("invalid account_id %q",
+				ctx.String("account_id"))
+		}
+
+		accountID = &[macaroons.AccountIDLen]byte{}
+		copy(accountID[:], idBytes)
+	}
+
 	// A command line argument can't be an empty string. So we'll check each
 	// entry if it's a valid entity:action tuple. The content itself is
 	// validated server side. We just make sure we can parse it correctly.
@@ -180,6 +231,19 @@ func bakeMacaroon(ctx *cli.Context) error {
 			macaroons.IPLockConstraint(ipAddress.String()),
 		)
 	}
+	if customCaveatName != "" {
+		macConstraints = append(
+			macConstraints,
+			macaroons.CustomConstraint(
+				customCaveatName, customCaveatCond,
+			),
+		)
+	}
+	if accountID != nil {
+		macConstraints = append(
+			macConstraints, macaroons.AccountConstraint(*accountID),
+		)
+	}
 	constrainedMac, err := macaroons.AddConstraints(
 		unmarshalMac, macConstraints...,
 	)
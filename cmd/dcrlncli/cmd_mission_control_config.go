@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/urfave/cli"
+)
+
+var getMissionControlConfigCommand = cli.Command{
+	Name:     "getmccfg",
+	Category: "Payments",
+	Usage:    "Display the probability estimator of mission control.",
+	Action:   actionDecorator(getMissionControlConfig),
+}
+
+func getMissionControlConfig(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	req := &routerrpc.GetMissionControlConfigRequest{}
+	rpcCtx := context.Background()
+	resp, err := client.GetMissionControlConfig(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setMissionControlConfigCommand = cli.Command{
+	Name:     "setmccfg",
+	Category: "Payments",
+	Usage:    "Switch the probability estimator of mission control.",
+	Description: `
+	Switch the probability estimator that mission control uses to estimate
+	the success probability of payment attempts, or update its parameters.
+	Parameters that aren't specified keep their current values.
+
+	The apriori estimator assumes a fixed probability for untried channels
+	and penalizes failed channels independent of the amount. The bimodal
+	estimator learns the liquidity bounds of channels from successes and
+	failures, which favors the amounts that are likely to succeed.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "estimator",
+			Usage: "the probability estimator to use, either " +
+				"'apriori' or 'bimodal'; defaults to the " +
+				"current estimator",
+		},
+		cli.Float64Flag{
+			Name: "hopprob",
+			Usage: "the assumed success probability of a hop " +
+				"in a route when no other information is " +
+				"available",
+		},
+		cli.StringFlag{
+			Name: "halflife",
+			Usage: "(apriori) the duration after which a " +
+				"penalized node or channel is back at 50% " +
+				"probability, e.g. 1h",
+		},
+		cli.Float64Flag{
+			Name: "weight",
+			Usage: "(apriori) the weight of the a priori " +
+				"probability, in the range [0, 1]",
+		},
+		cli.Uint64Flag{
+			Name: "scale",
+			Usage: "(bimodal) the scale of the liquidity " +
+				"distribution in milliatoms",
+		},
+		cli.Float64Flag{
+			Name: "nodeweight",
+			Usage: "(bimodal) the weight of the results of the " +
+				"other channels of a node, in the range [0, 1]",
+		},
+		cli.StringFlag{
+			Name: "decaytime",
+			Usage: "(bimodal) the duration after which the " +
+				"learned liquidity bounds have relaxed " +
+				"halfway, e.g. 168h",
+		},
+	},
+	Action: actionDecorator(setMissionControlConfig),
+}
+
+func setMissionControlConfig(ctx *cli.Context) error {
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	// Start out from the current config, so that unspecified parameters
+	// keep their values.
+	rpcCtx := context.Background()
+	resp, err := client.GetMissionControlConfig(
+		rpcCtx, &routerrpc.GetMissionControlConfigRequest{},
+	)
+	if err != nil {
+		return err
+	}
+	config := resp.Config
+
+	switch ctx.String("estimator") {
+	case "":
+	case "apriori":
+		config.Model = routerrpc.MissionControlConfig_APRIORI
+	case "bimodal":
+		config.Model = routerrpc.MissionControlConfig_BIMODAL
+	default:
+		return fmt.Errorf("unknown estimator %v",
+			ctx.String("estimator"))
+	}
+
+	switch config.Model {
+	case routerrpc.MissionControlConfig_APRIORI:
+		params := config.Apriori
+		if ctx.IsSet("hopprob") {
+			params.HopProbability = ctx.Float64("hopprob")
+		}
+		if ctx.IsSet("halflife") {
+			halfLife, err := time.ParseDuration(
+				ctx.String("halflife"),
+			)
+			if err != nil {
+				return fmt.Errorf("invalid halflife: %v", err)
+			}
+			params.HalfLifeSeconds = uint64(halfLife.Seconds())
+		}
+		if ctx.IsSet("weight") {
+			params.Weight = ctx.Float64("weight")
+		}
+
+	case routerrpc.MissionControlConfig_BIMODAL:
+		params := config.Bimodal
+		if ctx.IsSet("hopprob") {
+			params.HopProbability = ctx.Float64("hopprob")
+		}
+		if ctx.IsSet("scale") {
+			params.ScaleMAtoms = ctx.Uint64("scale")
+		}
+		if ctx.IsSet("nodeweight") {
+			params.NodeWeight = ctx.Float64("nodeweight")
+		}
+		if ctx.IsSet("decaytime") {
+			decayTime, err := time.ParseDuration(
+				ctx.String("decaytime"),
+			)
+			if err != nil {
+				return fmt.Errorf("invalid decaytime: %v", err)
+			}
+			params.DecayTimeSeconds = uint64(decayTime.Seconds())
+		}
+	}
+
+	_, err = client.SetMissionControlConfig(
+		rpcCtx, &routerrpc.SetMissionControlConfigRequest{
+			Config: config,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(config)
+
+	return nil
+}
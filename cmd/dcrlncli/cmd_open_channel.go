@@ -6,6 +6,7 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strconv"
@@ -123,6 +124,13 @@ var openChannelCommand = cli.Command{
 				"must be explicitly told about it to be able " +
 				"to route through it",
 		},
+		cli.BoolFlag{
+			Name: "zero_conf",
+			Usage: "Request a zero-conf channel that can be " +
+				"used before its funding transaction " +
+				"confirms. The remote node must explicitly " +
+				"accept zero-conf channels from us",
+		},
 		cli.Int64Flag{
 			Name: "min_htlc_m_atoms",
 			Usage: "The minimum value we will require " +
@@ -276,6 +284,7 @@ func openChannel(ctx *cli.Context) error {
 	}
 
 	req.Private = ctx.Bool("private")
+	req.ZeroConf = ctx.Bool("zero_conf")
 
 	// PSBT funding is a more involved, interactive process that is too
 	// large to also fit into this already long function.
@@ -317,6 +326,165 @@ func openChannel(ctx *cli.Context) error {
 	}
 }
 
+var batchOpenChannelCommand = cli.Command{
+	Name:     "batchopenchannel",
+	Category: "Channels",
+	Usage: "Open multiple channels to existing peers in a single " +
+		"transaction.",
+	Description: `
+	Attempt to open one or more new channels to existing peers with the
+	given node keys, using a single funding transaction. Either all channels
+	are opened or, if opening any of them fails, none of them are.
+
+	Example:
+	dcrlncli batchopenchannel --atoms_per_byte=10 '[{
+		"node_pubkey": "02abcdef...",
+		"local_funding_amount": 500000,
+		"private": true,
+		"close_address": "Ds..."
+	}, {
+		"node_pubkey": "03fedcba...",
+		"local_funding_amount": 200000,
+		"remote_csv_delay": 288
+	}]'
+
+	All nodes listed must already be connected peers, otherwise funding will
+	fail.
+
+	Each channel will be initialized with 'local_funding_amount' atoms
+	locally and 'push_atoms' atoms for the remote node. Note that specifying
+	'push_atoms' means you give that amount to the remote node as part of
+	the channel opening. Once the channels are pending, the 'channelPoint'
+	('txid:vout') of each funding output is returned.
+
+	If the remote peer supports the option upfront shutdown feature bit
+	(query 'listpeers' to see their supported feature bits), an address to
+	enforce payout of funds on cooperative close can optionally be provided.
+	Note that if you set this value, you will not be able to cooperatively
+	close out to another address.
+
+	One can manually set the fee to be used for the funding transaction via
+	either the '--conf_target' or '--atoms_per_byte' arguments. This is
+	optional.`,
+	ArgsUsage: "channels-json",
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "The number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation (optional)",
+		},
+		cli.Int64Flag{
+			Name: "atoms_per_byte",
+			Usage: "A manual fee expressed in " +
+				"atom/byte that should be used when crafting " +
+				"the transaction (optional)",
+		},
+		cli.Uint64Flag{
+			Name: "min_confs",
+			Usage: "The minimum number of confirmations " +
+				"each one of your outputs used for the funding " +
+				"transaction must satisfy (optional)",
+			Value: defaultUtxoMinConf,
+		},
+		cli.StringFlag{
+			Name: "label",
+			Usage: "An optional label for the batch " +
+				"transaction, limited to 500 characters",
+		},
+	},
+	Action: actionDecorator(batchOpenChannel),
+}
+
+// batchChannelJSON is the JSON representation of a single channel of the
+// batchopenchannel command.
+type batchChannelJSON struct {
+	NodePubkey         string `json:"node_pubkey,omitempty"`
+	LocalFundingAmount int64  `json:"local_funding_amount,omitempty"`
+	PushAtoms          int64  `json:"push_atoms,omitempty"`
+	Private            bool   `json:"private,omitempty"`
+	MinHtlcMAtoms      int64  `json:"min_htlc_m_atoms,omitempty"`
+	RemoteCsvDelay     uint32 `json:"remote_csv_delay,omitempty"`
+	CloseAddress       string `json:"close_address,omitempty"`
+	PendingChanID      string `json:"pending_chan_id,omitempty"`
+}
+
+func batchOpenChannel(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	// Show command help if no arguments provided
+	if ctx.NArg() == 0 {
+		_ = cli.ShowCommandHelp(ctx, "batchopenchannel")
+		return nil
+	}
+
+	minConfs := int32(ctx.Uint64("min_confs"))
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf:       int32(ctx.Int64("conf_target")),
+		AtomsPerByte:     ctx.Int64("atoms_per_byte"),
+		MinConfs:         minConfs,
+		SpendUnconfirmed: minConfs == 0,
+		Label:            ctx.String("label"),
+	}
+
+	var jsonChannels []*batchChannelJSON
+	err := json.Unmarshal([]byte(ctx.Args().First()), &jsonChannels)
+	if err != nil {
+		return fmt.Errorf("error parsing channels JSON: %v", err)
+	}
+
+	req.Channels = make([]*lnrpc.BatchOpenChannel, len(jsonChannels))
+	for idx, jsonChannel := range jsonChannels {
+		pubKeyBytes, err := hex.DecodeString(jsonChannel.NodePubkey)
+		if err != nil {
+			return fmt.Errorf("error parsing node pubkey hex: %v",
+				err)
+		}
+		pendingChanBytes, err := hex.DecodeString(
+			jsonChannel.PendingChanID,
+		)
+		if err != nil {
+			return fmt.Errorf("error parsing pending chan ID: %v",
+				err)
+		}
+
+		req.Channels[idx] = &lnrpc.BatchOpenChannel{
+			NodePubkey:         pubKeyBytes,
+			LocalFundingAmount: jsonChannel.LocalFundingAmount,
+			PushAtoms:          jsonChannel.PushAtoms,
+			Private:            jsonChannel.Private,
+			MinHtlcMAtoms:      jsonChannel.MinHtlcMAtoms,
+			RemoteCsvDelay:     jsonChannel.RemoteCsvDelay,
+			CloseAddress:       jsonChannel.CloseAddress,
+			PendingChanId:      pendingChanBytes,
+		}
+	}
+
+	resp, err := client.BatchOpenChannel(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for _, pending := range resp.PendingChannels {
+		txid, err := chainhash.NewHash(pending.Txid)
+		if err != nil {
+			return err
+		}
+
+		printJSON(struct {
+			FundingTxid string `json:"funding_txid"`
+			OutputIndex uint32 `json:"output_index"`
+		}{
+			FundingTxid: txid.String(),
+			OutputIndex: pending.OutputIndex,
+		})
+	}
+
+	return nil
+}
+
 // openChannelPsbt starts an interactive channel open protocol that uses a
 // partially signed bitcoin transaction (PSBT) to fund the channel output. The
 // protocol involves several steps between the RPC server and the CLI client:
@@ -153,6 +153,12 @@ var sendPaymentCommand = cli.Command{
 			Name:  "keysend",
 			Usage: "Will generate a pre-image and encode it in the sphinx packet, a dest must be set [experimental]",
 		},
+		cli.BoolFlag{
+			Name: "amp",
+			Usage: "If set to true, then AMP will be used to complete " +
+				"the payment. Either a dest or an AMP pay_req " +
+				"must be set [experimental]",
+		},
 	),
 	Action: sendPayment,
 }
@@ -211,6 +217,7 @@ func sendPayment(ctx *cli.Context) error {
 		req := &routerrpc.SendPaymentRequest{
 			PaymentRequest: ctx.String("pay_req"),
 			Amt:            ctx.Int64("amt"),
+			Amp:            ctx.Bool("amp"),
 		}
 
 		return sendPaymentRequest(ctx, req)
@@ -264,7 +271,21 @@ func sendPayment(ctx *cli.Context) error {
 
 	var rHash []byte
 
-	if ctx.Bool("keysend") {
+	switch {
+	case ctx.Bool("keysend") && ctx.Bool("amp"):
+		return errors.New("either keysend or amp may be set, but not " +
+			"both")
+
+	// AMP payments don't use a payment hash, the child hashes of every
+	// htlc are derived by the daemon.
+	case ctx.Bool("amp"):
+		if ctx.IsSet("payment_hash") {
+			return errors.New("cannot set payment hash when using " +
+				"amp")
+		}
+		req.Amp = true
+
+	case ctx.Bool("keysend"):
 		if ctx.IsSet("payment_hash") {
 			return errors.New("cannot set payment hash when using " +
 				"keysend")
@@ -281,7 +302,8 @@ func sendPayment(ctx *cli.Context) error {
 
 		hash := preimage.Hash()
 		rHash = hash[:]
-	} else {
+
+	default:
 		switch {
 		case ctx.IsSet("payment_hash"):
 			rHash, err = hex.DecodeString(ctx.String("payment_hash"))
@@ -296,11 +318,13 @@ func sendPayment(ctx *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	if len(rHash) != 32 {
-		return fmt.Errorf("payment hash must be exactly 32 "+
-			"bytes, is instead %v", len(rHash))
+	if !req.Amp {
+		if len(rHash) != 32 {
+			return fmt.Errorf("payment hash must be exactly 32 "+
+				"bytes, is instead %v", len(rHash))
+		}
+		req.PaymentHash = rHash
 	}
-	req.PaymentHash = rHash
 
 	switch {
 	case ctx.IsSet("final_cltv_delta"):
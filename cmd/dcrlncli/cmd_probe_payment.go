@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/lnrpc/routerrpc"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+	"github.com/urfave/cli"
+)
+
+var probePaymentCommand = cli.Command{
+	Name:      "probe",
+	Category:  "Payments",
+	Usage:     "Probe the liquidity towards a destination.",
+	ArgsUsage: "dest amt",
+	Description: `
+	Probe whether a payment of the given amount in atoms could reach the
+	destination, without ever settling it. HTLCs with a random payment hash
+	are sent along candidate routes until one of them reaches the
+	destination, which fails it because it doesn't know the payment hash.
+
+	The liquidity bounds learned about the channels of each probed route
+	are displayed, and the results are fed into mission control to benefit
+	subsequent payments. The probes aren't kept as failed payments.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in atoms when probing " +
+				"the payment",
+		},
+		cli.Int64Flag{
+			Name: "final_cltv_delta",
+			Usage: "number of blocks the last hop has to reveal " +
+				"the preimage",
+		},
+		cli.Uint64Flag{
+			Name:  "cltv_limit",
+			Usage: "the maximum time lock of the probed routes",
+		},
+		cli.Uint64Flag{
+			Name: "max_routes",
+			Usage: "the maximum number of routes to probe; " +
+				"defaults to 5",
+		},
+	},
+	Action: actionDecorator(probePayment),
+}
+
+func probePayment(ctx *cli.Context) error {
+	args := ctx.Args()
+
+	if len(args) != 2 {
+		return cli.ShowCommandHelp(ctx, "probe")
+	}
+
+	dest, err := route.NewVertexFromStr(args.Get(0))
+	if err != nil {
+		return fmt.Errorf("invalid destination key: %v", err)
+	}
+
+	amt, err := strconv.ParseInt(args.Get(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid amt: %v", err)
+	}
+
+	req := &routerrpc.ProbePaymentRequest{
+		Dest: dest[:],
+		AmtMAtoms: int64(lnwire.NewMAtomsFromAtoms(
+			dcrutil.Amount(amt),
+		)),
+		FinalCltvDelta: int32(ctx.Int64("final_cltv_delta")),
+		CltvLimit:      uint32(ctx.Uint64("cltv_limit")),
+		MaxRoutes:      uint32(ctx.Uint64("max_routes")),
+	}
+	if ctx.IsSet("fee_limit") {
+		req.FeeLimitMAtoms = int64(lnwire.NewMAtomsFromAtoms(
+			dcrutil.Amount(ctx.Int64("fee_limit")),
+		))
+	}
+
+	conn := getClientConn(ctx, false)
+	defer conn.Close()
+
+	client := routerrpc.NewRouterClient(conn)
+
+	rpcCtx := context.Background()
+	resp, err := client.ProbePayment(rpcCtx, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
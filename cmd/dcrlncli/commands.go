@@ -596,6 +596,10 @@ var closeChannelCommand = cli.Command{
 	'--atoms_per_byte' arguments. This will be the starting value used during
 	fee negotiation. This is optional.
 
+	If we are the initiator of the channel and thus pay the closing fee, the
+	'--max_fee_per_byte' argument caps the fee negotiated with the remote
+	peer. This is optional.
+
 	In the case of a cooperative closure, one can manually set the address
 	to deliver funds to upon closure. This is optional, and may only be used
 	if an upfront shutdown address has not already been set. If neither are
@@ -635,6 +639,12 @@ var closeChannelCommand = cli.Command{
 				"atom/byte that should be used when crafting " +
 				"the transaction (optional)",
 		},
+		cli.Int64Flag{
+			Name: "max_fee_per_byte",
+			Usage: "The maximum fee expressed in atom/byte " +
+				"that may be paid for a cooperative close " +
+				"transaction (optional)",
+		},
 		cli.StringFlag{
 			Name: "delivery_addr",
 			Usage: "An address to deliver funds " +
@@ -667,6 +677,7 @@ func closeChannel(ctx *cli.Context) error {
 		Force:           ctx.Bool("force"),
 		TargetConf:      int32(ctx.Int64("conf_target")),
 		AtomsPerByte:    ctx.Int64("atoms_per_byte"),
+		MaxFeePerByte:   ctx.Int64("max_fee_per_byte"),
 		DeliveryAddress: ctx.String("delivery_addr"),
 	}
 
@@ -1100,6 +1111,70 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+var peerFitnessCommand = cli.Command{
+	Name:     "peerfitness",
+	Category: "Peers",
+	Usage: "Show the fitness of the peers we have open channels " +
+		"with.",
+	ArgsUsage: "[pubkey]",
+	Description: `
+	Show how reliable the peers we have open channels with have been,
+	along with the fitness of each of their channels. The fitness score
+	between 0 and 1 is the fraction of the time the peer was online,
+	reduced by 10% for every flap per day.
+
+	If a pubkey is given, only the fitness of that peer is shown.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pubkey",
+			Usage: "the identity public key of the peer",
+		},
+	},
+	Action: actionDecorator(peerFitness),
+}
+
+func peerFitness(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var pubKeyStr string
+	switch {
+	case ctx.IsSet("pubkey"):
+		pubKeyStr = ctx.String("pubkey")
+	case ctx.Args().Present():
+		pubKeyStr = ctx.Args().First()
+	}
+
+	req := &lnrpc.PeerFitnessRequest{}
+	if pubKeyStr != "" {
+		pubKey, err := hex.DecodeString(pubKeyStr)
+		if err != nil {
+			return fmt.Errorf("unable to decode pubkey: %v", err)
+		}
+		req.PubKey = pubKey
+	}
+
+	resp, err := client.PeerFitness(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var statelessInitFlag = cli.BoolFlag{
+	Name: "stateless_init",
+	Usage: "do not create any macaroon files in the file " +
+		"system of the daemon",
+}
+
+var saveToFlag = cli.StringFlag{
+	Name:  "save_to",
+	Usage: "save returned admin macaroon to this file",
+}
+
 var createCommand = cli.Command{
 	Name:     "create",
 	Category: "Startup",
@@ -1128,6 +1203,11 @@ var createCommand = cli.Command{
 	Channel Backups. Only one of the three parameters will be accepted. See
 	the 'restorechanbackup' command for further details w.r.t the format
 	accepted.
+
+	If the --stateless_init flag is set, no macaroon files are created by
+	the daemon. Instead, the admin macaroon is returned by this command and
+	either printed or saved to the file given with --save_to. It is the
+	ONLY copy of the admin macaroon and must be stored safely.
 	`,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -1144,6 +1224,8 @@ var createCommand = cli.Command{
 			Name:  "multi_file",
 			Usage: "The path to a multi-channel back up file",
 		},
+		statelessInitFlag,
+		saveToFlag,
 	},
 	Action: actionDecorator(create),
 }
@@ -1436,13 +1518,40 @@ mnemonicCheck:
 		AezeedPassphrase:   aezeedPass,
 		RecoveryWindow:     recoveryWindow,
 		ChannelBackups:     chanBackups,
+		StatelessInit:      ctx.Bool(statelessInitFlag.Name),
 	}
-	if _, err := client.InitWallet(ctxb, req); err != nil {
+	resp, err := client.InitWallet(ctxb, req)
+	if err != nil {
 		return err
 	}
 
 	fmt.Println("\ndcrlnd successfully initialized!")
 
+	if req.StatelessInit {
+		return printOrSaveMacaroon(ctx, resp.AdminMacaroon)
+	}
+
+	return nil
+}
+
+// printOrSaveMacaroon saves the binary serialized admin macaroon returned by a
+// stateless init to the file given with --save_to or prints it hex encoded.
+func printOrSaveMacaroon(ctx *cli.Context, macBytes []byte) error {
+	macSavePath := cleanAndExpandPath(ctx.String(saveToFlag.Name))
+	if macSavePath == "" {
+		fmt.Printf("\nAdmin macaroon: %x\n", macBytes)
+		fmt.Println("\n!!!THIS IS THE ONLY COPY OF THE ADMIN " +
+			"MACAROON, STORE IT SAFELY!!!")
+		return nil
+	}
+
+	err := ioutil.WriteFile(macSavePath, macBytes, 0600)
+	if err != nil {
+		_ = os.Remove(macSavePath)
+		return err
+	}
+
+	fmt.Printf("Admin macaroon saved to %s\n", macSavePath)
 	return nil
 }
 
@@ -1522,6 +1631,7 @@ var unlockCommand = cli.Command{
 				"combination with some sort of password " +
 				"manager or secrets vault.",
 		},
+		statelessInitFlag,
 	},
 	Action: actionDecorator(unlock),
 }
@@ -1579,6 +1689,7 @@ func unlock(ctx *cli.Context) error {
 	req := &lnrpc.UnlockWalletRequest{
 		WalletPassword: pw,
 		RecoveryWindow: recoveryWindow,
+		StatelessInit:  ctx.Bool(statelessInitFlag.Name),
 	}
 	_, err = client.UnlockWallet(ctxb, req)
 	if err != nil {
@@ -1605,7 +1716,23 @@ var changePasswordCommand = cli.Command{
 	'--noseedbackup'), one must restart their daemon without
 	'--noseedbackup' and use this command. The "current password" field
 	should be left empty.
+
+	If the --new_mac_root_key flag is set, the macaroon root key is
+	rotated, which invalidates all macaroons that were created before.
+	Combined with --stateless_init, the only valid admin macaroon is the one
+	returned by this command, which allows removing all credentials from the
+	file system of the daemon.
 	`,
+	Flags: []cli.Flag{
+		statelessInitFlag,
+		saveToFlag,
+		cli.BoolFlag{
+			Name: "new_mac_root_key",
+			Usage: "rotate the macaroon root key resulting in " +
+				"all previously created macaroons to be " +
+				"invalidated",
+		},
+	},
 	Action: actionDecorator(changePassword),
 }
 
@@ -1640,15 +1767,21 @@ func changePassword(ctx *cli.Context) error {
 	}
 
 	req := &lnrpc.ChangePasswordRequest{
-		CurrentPassword: currentPw,
-		NewPassword:     newPw,
+		CurrentPassword:    currentPw,
+		NewPassword:        newPw,
+		StatelessInit:      ctx.Bool(statelessInitFlag.Name),
+		NewMacaroonRootKey: ctx.Bool("new_mac_root_key"),
 	}
 
-	_, err = client.ChangePassword(ctxb, req)
+	resp, err := client.ChangePassword(ctxb, req)
 	if err != nil {
 		return err
 	}
 
+	if req.StatelessInit {
+		return printOrSaveMacaroon(ctx, resp.AdminMacaroon)
+	}
+
 	return nil
 }
 
@@ -1892,6 +2025,90 @@ func closedChannels(ctx *cli.Context) error {
 	return nil
 }
 
+var channelUptimeCommand = cli.Command{
+	Name:      "channeluptime",
+	Category:  "Channels",
+	Usage:     "Show the uptime of a channel's peer over a time window.",
+	ArgsUsage: "chan_point",
+	Description: `
+	Show the number of seconds the remote peer of a channel was online
+	within a time window. The window is clipped to the channel's lifetime
+	and defaults to the whole lifetime. Windows may reach back as far as
+	the node's fitness.uptimeretention.
+
+	The window is either given by its unix start and end times, or as the
+	number of days before the present, e.g. --days=90.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "chan_point",
+			Usage: "the channel's outpoint in the format " +
+				"txid:index",
+		},
+		cli.Uint64Flag{
+			Name: "days",
+			Usage: "the number of days before the present the " +
+				"window starts",
+		},
+		cli.Int64Flag{
+			Name: "start",
+			Usage: "the unix timestamp in seconds of the " +
+				"window start",
+		},
+		cli.Int64Flag{
+			Name: "end",
+			Usage: "the unix timestamp in seconds of the " +
+				"window end",
+		},
+	},
+	Action: actionDecorator(channelUptime),
+}
+
+func channelUptime(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var chanPointStr string
+	switch {
+	case ctx.IsSet("chan_point"):
+		chanPointStr = ctx.String("chan_point")
+	case ctx.Args().Present():
+		chanPointStr = ctx.Args().First()
+	default:
+		return fmt.Errorf("chan_point argument missing")
+	}
+
+	chanPoint, err := parseChanPoint(chanPointStr)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.ChannelUptimeRequest{
+		ChanPoint: chanPoint,
+		StartTime: ctx.Int64("start"),
+		EndTime:   ctx.Int64("end"),
+	}
+
+	if ctx.IsSet("days") {
+		if ctx.IsSet("start") || ctx.IsSet("end") {
+			return fmt.Errorf("days cannot be combined with start " +
+				"or end")
+		}
+
+		window := time.Duration(ctx.Uint64("days")) * 24 * time.Hour
+		req.StartTime = time.Now().Add(-window).Unix()
+	}
+
+	resp, err := client.ChannelUptime(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
 var describeGraphCommand = cli.Command{
 	Name:     "describegraph",
 	Category: "Graph",
@@ -2569,6 +2786,23 @@ var updateChannelPolicyCommand = cli.Command{
 				"to all forwarded HTLCs. If unset, the max HTLC " +
 				"is left unchanged.",
 		},
+		cli.IntFlag{
+			Name: "inbound_base_fee_m_atoms",
+			Usage: "the inbound base fee in milli-atoms that " +
+				"will be charged for each HTLC arriving over " +
+				"the channel, on top of the fee of the " +
+				"outgoing channel. A negative value grants a " +
+				"discount on the outgoing fee. If unset, no " +
+				"inbound fee is charged",
+		},
+		cli.IntFlag{
+			Name: "inbound_fee_rate_ppm",
+			Usage: "the inbound fee rate in parts per million " +
+				"that will be charged for each HTLC arriving " +
+				"over the channel. A negative value grants a " +
+				"discount on the outgoing fee. If unset, no " +
+				"inbound fee is charged",
+		},
 		cli.StringFlag{
 			Name: "chan_point",
 			Usage: "The channel whose fee policy should be " +
@@ -2683,6 +2917,10 @@ func updateChannelPolicy(ctx *cli.Context) error {
 		FeeRate:       feeRate,
 		TimeLockDelta: uint32(timeLockDelta),
 		MaxHtlcMAtoms: ctx.Uint64("max_htlc_m_atoms"),
+		InboundBaseFeeMAtoms: int32(
+			ctx.Int("inbound_base_fee_m_atoms"),
+		),
+		InboundFeeRatePpm: int32(ctx.Int("inbound_fee_rate_ppm")),
 	}
 
 	if ctx.IsSet("min_htlc_m_atoms") {
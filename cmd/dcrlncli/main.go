@@ -284,10 +284,14 @@ func main() {
 		connectCommand,
 		disconnectCommand,
 		openChannelCommand,
+		batchOpenChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		abandonChannelCommand,
 		listPeersCommand,
+		peerFitnessCommand,
+		sendCustomCommand,
+		subscribeCustomCommand,
 		walletBalanceCommand,
 		channelBalanceCommand,
 		getInfoCommand,
@@ -301,6 +305,7 @@ func main() {
 		listInvoicesCommand,
 		listChannelsCommand,
 		closedChannelsCommand,
+		channelUptimeCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
 		getNodeMetricsCommand,
@@ -316,6 +321,8 @@ func main() {
 		verifyMessageCommand,
 		feeReportCommand,
 		updateChannelPolicyCommand,
+		feeManagerStatusCommand,
+		feeManagerOverrideCommand,
 		forwardingHistoryCommand,
 		exportChanBackupCommand,
 		verifyChanBackupCommand,
@@ -325,6 +332,9 @@ func main() {
 		deleteMacaroonIDCommand,
 		listPermissionsCommand,
 		printMacaroonCommand,
+		createAccountCommand,
+		listAccountsCommand,
+		removeAccountCommand,
 		trackPaymentCommand,
 		versionCommand,
 	}
@@ -8,6 +8,11 @@ func routerCommands() []cli.Command {
 		queryMissionControlCommand,
 		queryProbCommand,
 		resetMissionControlCommand,
+		exportMissionControlCommand,
+		importMissionControlCommand,
+		getMissionControlConfigCommand,
+		setMissionControlConfigCommand,
 		buildRouteCommand,
+		probePaymentCommand,
 	}
 }
@@ -4,6 +4,9 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
@@ -29,6 +32,9 @@ func walletCommands() []cli.Command {
 				bumpCloseFeeCommand,
 				listSweepsCommand,
 				labelTxCommand,
+				psbtCommand,
+				listFeeEstimatorsCommand,
+				setFeeEstimatorCommand,
 			},
 		},
 	}
@@ -396,3 +402,435 @@ func labelTransaction(ctx *cli.Context) error {
 
 	return nil
 }
+
+var listFeeEstimatorsCommand = cli.Command{
+	Name:      "estimators",
+	Usage:     "List the available fee estimators.",
+	ArgsUsage: "",
+	Description: `
+	List the names of all fee estimators available to the wallet, along
+	with the name of the one that is currently used.
+	`,
+	Action: actionDecorator(listFeeEstimators),
+}
+
+func listFeeEstimators(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.ListFeeEstimatorsRequest{}
+	resp, err := client.ListFeeEstimators(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setFeeEstimatorCommand = cli.Command{
+	Name:      "setestimator",
+	Usage:     "Switch the fee estimator used by the wallet.",
+	ArgsUsage: "name",
+	Description: `
+	Switch the fee estimator used by the wallet and all other subsystems
+	to the one with the given name. The names of the available estimators
+	are listed by the estimators command. The switch isn't persisted across
+	restarts, use the fee.estimator option for that.
+	`,
+	Action: actionDecorator(setFeeEstimator),
+}
+
+func setFeeEstimator(ctx *cli.Context) error {
+	// Display the command's help message if we do not have the expected
+	// number of arguments/flags.
+	if ctx.NArg() != 1 {
+		return cli.ShowCommandHelp(ctx, "setestimator")
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	name := ctx.Args().First()
+	req := &walletrpc.SetFeeEstimatorRequest{
+		Name: name,
+	}
+	if _, err := client.SetFeeEstimator(ctxb, req); err != nil {
+		return err
+	}
+
+	fmt.Printf("Switched to fee estimator %v\n", name)
+
+	return nil
+}
+
+// utxoLease contains JSON annotations for a lease on an unspent output.
+type utxoLease struct {
+	ID         string   `json:"id"`
+	OutPoint   OutPoint `json:"outpoint"`
+	Expiration uint64   `json:"expiration"`
+}
+
+// fundPsbtResponse is a struct that contains JSON annotations for nice result
+// serialization.
+type fundPsbtResponse struct {
+	Psbt              string       `json:"psbt"`
+	ChangeOutputIndex int32        `json:"change_output_index"`
+	Locks             []*utxoLease `json:"locks"`
+}
+
+var psbtCommand = cli.Command{
+	Name:  "psbt",
+	Usage: "Interact with partially signed transactions (PSBTs).",
+	Subcommands: []cli.Command{
+		fundPsbtCommand,
+		signPsbtCommand,
+		finalizePsbtCommand,
+	},
+}
+
+var fundPsbtCommand = cli.Command{
+	Name:  "fund",
+	Usage: "Fund a Partially Signed Transaction (PSBT).",
+	ArgsUsage: "[--template_psbt=T | [--outputs=O [--inputs=I]]] " +
+		"[--conf_target=C | --atoms_per_kb=S]",
+	Description: `
+	The fund command creates a fully populated PSBT that contains enough
+	inputs to fund the outputs specified in either the PSBT or the
+	--outputs flag.
+
+	If there are no inputs specified in the template, coin selection is
+	performed automatically. If inputs are specified, the wallet assumes
+	that full coin selection happened externally and it will not add any
+	additional inputs to the PSBT. If the specified inputs aren't enough to
+	fund the outputs with the given fee rate, an error is returned.
+
+	After either selecting or verifying the inputs, all input UTXOs are
+	locked with an internal app ID.
+
+	The --outputs flag decodes addresses and the amount to send respectively
+	in the following JSON format:
+
+	    --outputs='{"ExampleAddr": NumCoinsInAtoms, "SecondAddr": Atoms}'
+
+	The optional --inputs flag decodes a JSON list of UTXO outpoints as
+	returned by the listunspent command for example:
+
+	    --inputs='["<txid1>:<output-index1>","<txid2>:<output-index2>",...]'
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "template_psbt",
+			Usage: "the outputs to fund and optional inputs to " +
+				"spend provided in the base64 PSBT format",
+		},
+		cli.StringFlag{
+			Name: "outputs",
+			Usage: "a JSON compatible map of destination " +
+				"addresses to amounts to send, must not " +
+				"include a change address as that will be " +
+				"added automatically by the wallet",
+		},
+		cli.StringFlag{
+			Name: "inputs",
+			Usage: "an optional JSON compatible list of UTXO " +
+				"outpoints to use as the PSBT's inputs",
+		},
+		cli.Uint64Flag{
+			Name: "conf_target",
+			Usage: "the number of blocks that the transaction " +
+				"should be confirmed on-chain within",
+			Value: 6,
+		},
+		cli.Uint64Flag{
+			Name: "atoms_per_kb",
+			Usage: "a manual fee expressed in atom/kB that " +
+				"should be used when creating the transaction",
+		},
+		cli.IntFlag{
+			Name: "min_confs",
+			Usage: "the minimum number of confirmations each " +
+				"one of the selected inputs must have",
+			Value: 1,
+		},
+		cli.BoolFlag{
+			Name: "spend_unconfirmed",
+			Usage: "whether unconfirmed outputs can be used as " +
+				"inputs",
+		},
+	},
+	Action: actionDecorator(fundPsbt),
+}
+
+func fundPsbt(ctx *cli.Context) error {
+	// Display the command's help message if there aren't any flags
+	// specified.
+	if ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "fund")
+	}
+
+	req := &walletrpc.FundPsbtRequest{
+		MinConfs:         int32(ctx.Int("min_confs")),
+		SpendUnconfirmed: ctx.Bool("spend_unconfirmed"),
+	}
+
+	// Parse template flags.
+	switch {
+	// The PSBT flag is mutually exclusive with the outputs/inputs flags.
+	case ctx.IsSet("template_psbt") &&
+		(ctx.IsSet("inputs") || ctx.IsSet("outputs")):
+
+		return fmt.Errorf("cannot set template_psbt and inputs/" +
+			"outputs flags at the same time")
+
+	// Use a pre-existing PSBT as the transaction template.
+	case len(ctx.String("template_psbt")) > 0:
+		psbtBase64 := ctx.String("template_psbt")
+		psbtBytes, err := base64.StdEncoding.DecodeString(psbtBase64)
+		if err != nil {
+			return err
+		}
+
+		req.Template = &walletrpc.FundPsbtRequest_Psbt{
+			Psbt: psbtBytes,
+		}
+
+	// The user manually specified outputs and optional inputs in JSON
+	// format.
+	case len(ctx.String("outputs")) > 0:
+		var (
+			tpl          = &walletrpc.TxTemplate{}
+			amountToAddr map[string]uint64
+		)
+
+		// Parse the address to amount map as JSON now. At least one
+		// entry must be present.
+		jsonMap := []byte(ctx.String("outputs"))
+		if err := json.Unmarshal(jsonMap, &amountToAddr); err != nil {
+			return fmt.Errorf("error parsing outputs JSON: %v",
+				err)
+		}
+		if len(amountToAddr) == 0 {
+			return fmt.Errorf("at least one output must be " +
+				"specified")
+		}
+		tpl.Outputs = amountToAddr
+
+		// Inputs are optional.
+		if len(ctx.String("inputs")) > 0 {
+			var inputs []string
+
+			jsonList := []byte(ctx.String("inputs"))
+			if err := json.Unmarshal(jsonList, &inputs); err != nil {
+				return fmt.Errorf("error parsing inputs JSON: "+
+					"%v", err)
+			}
+
+			for idx, input := range inputs {
+				op, err := NewProtoOutPoint(input)
+				if err != nil {
+					return fmt.Errorf("error parsing "+
+						"UTXO outpoint %d: %v", idx,
+						err)
+				}
+				tpl.Inputs = append(tpl.Inputs, op)
+			}
+		}
+
+		req.Template = &walletrpc.FundPsbtRequest_Raw{
+			Raw: tpl,
+		}
+
+	default:
+		return fmt.Errorf("must specify either template_psbt or " +
+			"outputs flag")
+	}
+
+	// Parse fee flags.
+	switch {
+	case ctx.IsSet("conf_target") && ctx.IsSet("atoms_per_kb"):
+		return fmt.Errorf("cannot set conf_target and atoms_per_kb " +
+			"at the same time")
+
+	case ctx.Uint64("atoms_per_kb") > 0:
+		req.Fees = &walletrpc.FundPsbtRequest_AtomsPerKb{
+			AtomsPerKb: ctx.Uint64("atoms_per_kb"),
+		}
+
+	// Check conf_target last because it has a default value.
+	case ctx.Uint64("conf_target") > 0:
+		req.Fees = &walletrpc.FundPsbtRequest_TargetConf{
+			TargetConf: uint32(ctx.Uint64("conf_target")),
+		}
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	response, err := walletClient.FundPsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	jsonLocks := make([]*utxoLease, len(response.LockedUtxos))
+	for idx, lock := range response.LockedUtxos {
+		jsonLocks[idx] = &utxoLease{
+			ID:         hex.EncodeToString(lock.Id),
+			OutPoint:   NewOutPointFromProto(lock.Outpoint),
+			Expiration: lock.Expiration,
+		}
+	}
+
+	printJSON(&fundPsbtResponse{
+		Psbt: base64.StdEncoding.EncodeToString(
+			response.FundedPsbt,
+		),
+		ChangeOutputIndex: response.ChangeOutputIndex,
+		Locks:             jsonLocks,
+	})
+
+	return nil
+}
+
+// signPsbtResponse is a struct that contains JSON annotations for nice result
+// serialization.
+type signPsbtResponse struct {
+	Psbt         string   `json:"psbt"`
+	SignedInputs []uint32 `json:"signed_inputs"`
+}
+
+var signPsbtCommand = cli.Command{
+	Name:      "sign",
+	Usage:     "Sign the wallet's inputs of a Partially Signed Transaction.",
+	ArgsUsage: "funded_psbt",
+	Description: `
+	The sign command expects a partial transaction with all inputs and
+	outputs fully declared and tries to sign all inputs that belong to the
+	wallet. Inputs that don't belong to the wallet are left untouched, so
+	that other parties can add their signatures later on.
+
+	The signed PSBT and the indexes of the signed inputs are returned.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "funded_psbt",
+			Usage: "the base64 encoded PSBT to sign; the PSBT " +
+				"must contain all required inputs and outputs",
+		},
+	},
+	Action: actionDecorator(signPsbt),
+}
+
+func signPsbt(ctx *cli.Context) error {
+	psbtBytes, err := readPsbtArg(ctx, "sign")
+	if err != nil || psbtBytes == nil {
+		return err
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.SignPsbtRequest{
+		FundedPsbt: psbtBytes,
+	}
+	response, err := walletClient.SignPsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(&signPsbtResponse{
+		Psbt: base64.StdEncoding.EncodeToString(
+			response.SignedPsbt,
+		),
+		SignedInputs: response.SignedInputs,
+	})
+
+	return nil
+}
+
+// finalizePsbtResponse is a struct that contains JSON annotations for nice
+// result serialization.
+type finalizePsbtResponse struct {
+	Psbt    string `json:"psbt"`
+	FinalTx string `json:"final_tx"`
+}
+
+var finalizePsbtCommand = cli.Command{
+	Name:      "finalize",
+	Usage:     "Finalize a Partially Signed Transaction (PSBT).",
+	ArgsUsage: "funded_psbt",
+	Description: `
+	The finalize command expects a partial transaction with all inputs
+	and outputs fully declared and tries to sign all of its inputs that
+	belong to the wallet. Dcrlnd must be the last signer of the
+	transaction. That means, if there are any unsigned non-wallet inputs,
+	this command will fail. If no error is returned, the PSBT is ready to be
+	given to the next signer or to be published to the network.
+
+	Note that the transaction is NOT published by this command. It is the
+	responsibility of the caller to either publish the transaction or to
+	release the locks of its inputs with the releaseoutput command in case
+	of an error.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "funded_psbt",
+			Usage: "the base64 encoded PSBT to finalize; the PSBT " +
+				"must contain all required inputs, outputs " +
+				"and signatures of other parties",
+		},
+	},
+	Action: actionDecorator(finalizePsbt),
+}
+
+func finalizePsbt(ctx *cli.Context) error {
+	psbtBytes, err := readPsbtArg(ctx, "finalize")
+	if err != nil || psbtBytes == nil {
+		return err
+	}
+
+	walletClient, cleanUp := getWalletClient(ctx)
+	defer cleanUp()
+
+	req := &walletrpc.FinalizePsbtRequest{
+		FundedPsbt: psbtBytes,
+	}
+	response, err := walletClient.FinalizePsbt(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(&finalizePsbtResponse{
+		Psbt:    base64.StdEncoding.EncodeToString(response.SignedPsbt),
+		FinalTx: hex.EncodeToString(response.RawFinalTx),
+	})
+
+	return nil
+}
+
+// readPsbtArg reads the base64 encoded PSBT from either the funded_psbt flag
+// or the first positional argument. If neither is set, the help of the given
+// command is shown and nil is returned.
+func readPsbtArg(ctx *cli.Context, cmdName string) ([]byte, error) {
+	var psbtBase64 string
+	switch {
+	case ctx.IsSet("funded_psbt"):
+		psbtBase64 = ctx.String("funded_psbt")
+
+	case ctx.Args().Present():
+		psbtBase64 = ctx.Args().First()
+
+	default:
+		return nil, cli.ShowCommandHelp(ctx, cmdName)
+	}
+
+	psbtBytes, err := base64.StdEncoding.DecodeString(psbtBase64)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding PSBT: %v", err)
+	}
+
+	return psbtBytes, nil
+}
@@ -14,6 +14,11 @@ type PendingSweep struct {
 	RequestedAtomsPerByte uint32   `json:"requested_atoms_per_byte"`
 	RequestedConfTarget   uint32   `json:"requested_conf_target"`
 	Force                 bool     `json:"force"`
+	DeadlineHeight        uint32   `json:"deadline_height"`
+	BudgetAtoms           int64    `json:"budget_atoms"`
+	SweepTxid             string   `json:"sweep_txid"`
+	InMempool             bool     `json:"in_mempool"`
+	ConflictTxid          string   `json:"conflict_txid"`
 }
 
 // NewPendingSweepFromProto converts the walletrpc.PendingSweep proto type into
@@ -29,5 +34,10 @@ func NewPendingSweepFromProto(pendingSweep *walletrpc.PendingSweep) *PendingSwee
 		RequestedAtomsPerByte: pendingSweep.RequestedAtomsPerByte,
 		RequestedConfTarget:   pendingSweep.RequestedConfTarget,
 		Force:                 pendingSweep.Force,
+		DeadlineHeight:        pendingSweep.DeadlineHeight,
+		BudgetAtoms:           pendingSweep.BudgetAtoms,
+		SweepTxid:             pendingSweep.SweepTxid,
+		InMempool:             pendingSweep.InMempool,
+		ConflictTxid:          pendingSweep.ConflictTxid,
 	}
 }
@@ -4,6 +4,8 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 
 	"github.com/decred/dcrlnd/lnrpc/watchtowerrpc"
 	"github.com/urfave/cli"
@@ -17,6 +19,8 @@ func watchtowerCommands() []cli.Command {
 			Category: "Watchtower",
 			Subcommands: []cli.Command{
 				towerInfoCommand,
+				towerUsageCommand,
+				towerResetUsageCommand,
 			},
 		},
 	}
@@ -54,3 +58,122 @@ func towerInfo(ctx *cli.Context) error {
 
 	return nil
 }
+
+var towerUsageCommand = cli.Command{
+	Name: "usage",
+	Usage: "Returns the number of sessions and state updates a client " +
+		"has stored on the watchtower.",
+	ArgsUsage: "client_pubkey | --addr=<client_addr>",
+	Description: `
+	Returns the number of sessions and state updates the client with the
+	given public key has stored on the watchtower since its usage was last
+	reset, along with the quotas that apply to it.
+
+	When --addr is set, the usage accounted to the clients connecting from
+	the given IP address is returned instead. Clients connecting over IPv6
+	are identified by the /64 prefix of their address.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the IP address the clients connect from",
+		},
+	},
+	Action: actionDecorator(towerUsage),
+}
+
+// parseTowerClient returns the client key or client address identifying the
+// watchtower client targeted by the command.
+func parseTowerClient(ctx *cli.Context) ([]byte, string, error) {
+	if ctx.IsSet("addr") {
+		if ctx.NArg() != 0 {
+			return nil, "", fmt.Errorf("client pubkey and --addr " +
+				"cannot both be set")
+		}
+		return nil, ctx.String("addr"), nil
+	}
+
+	if ctx.NArg() != 1 {
+		return nil, "", fmt.Errorf("client pubkey or --addr must be set")
+	}
+
+	clientKey, err := hex.DecodeString(ctx.Args().First())
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid client pubkey: %v", err)
+	}
+
+	return clientKey, "", nil
+}
+
+func towerUsage(ctx *cli.Context) error {
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "usage")
+	}
+
+	clientKey, clientAddr, err := parseTowerClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.GetClientUsageRequest{
+		ClientKey:  clientKey,
+		ClientAddr: clientAddr,
+	}
+	resp, err := client.GetClientUsage(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var towerResetUsageCommand = cli.Command{
+	Name:      "resetusage",
+	Usage:     "Resets the usage a client has accounted on the watchtower.",
+	ArgsUsage: "client_pubkey | --addr=<client_addr>",
+	Description: `
+	Resets the usage of the client with the given public key, or of the
+	clients connecting from the IP address given with --addr, allowing it
+	to create sessions and store state updates up to its full quota again.
+	The sessions and state updates already stored for the client are kept.
+	`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "addr",
+			Usage: "the IP address the clients connect from",
+		},
+	},
+	Action: actionDecorator(towerResetUsage),
+}
+
+func towerResetUsage(ctx *cli.Context) error {
+	if ctx.NArg() == 0 && ctx.NumFlags() == 0 {
+		return cli.ShowCommandHelp(ctx, "resetusage")
+	}
+
+	clientKey, clientAddr, err := parseTowerClient(ctx)
+	if err != nil {
+		return err
+	}
+
+	client, cleanup := getWatchtowerClient(ctx)
+	defer cleanup()
+
+	req := &watchtowerrpc.ResetClientUsageRequest{
+		ClientKey:  clientKey,
+		ClientAddr: clientAddr,
+	}
+	resp, err := client.ResetClientUsage(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
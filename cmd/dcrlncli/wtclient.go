@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"strings"
 
 	"github.com/decred/dcrlnd/lnrpc/wtclientrpc"
@@ -25,6 +26,8 @@ func wtclientCommands() []cli.Command {
 				getTowerCommand,
 				statsCommand,
 				policyCommand,
+				exportSessionsCommand,
+				importSessionsCommand,
 			},
 		},
 	}
@@ -250,19 +253,45 @@ var policyCommand = cli.Command{
 	Name:   "policy",
 	Usage:  "Display the active watchtower client policy configuration.",
 	Action: actionDecorator(policy),
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name: "legacy",
+			Usage: "Retrieve the legacy tower client's current " +
+				"policy. (default)",
+		},
+		cli.BoolFlag{
+			Name: "anchor",
+			Usage: "Retrieve the anchor tower client's current " +
+				"policy.",
+		},
+	},
 }
 
 func policy(ctx *cli.Context) error {
 	// Display the command's help message if the number of arguments/flags
 	// is not what we expect.
-	if ctx.NArg() > 0 || ctx.NumFlags() > 0 {
+	if ctx.NArg() > 0 || ctx.NumFlags() > 1 {
 		return cli.ShowCommandHelp(ctx, "policy")
 	}
 
+	var policyType wtclientrpc.PolicyType
+	switch {
+	case ctx.Bool("anchor"):
+		policyType = wtclientrpc.PolicyType_ANCHOR
+	case ctx.Bool("legacy"):
+		policyType = wtclientrpc.PolicyType_LEGACY
+
+	// For backwards compatibility with original rpc behavior.
+	default:
+		policyType = wtclientrpc.PolicyType_LEGACY
+	}
+
 	client, cleanUp := getWtclient(ctx)
 	defer cleanUp()
 
-	req := &wtclientrpc.PolicyRequest{}
+	req := &wtclientrpc.PolicyRequest{
+		PolicyType: policyType,
+	}
 	resp, err := client.Policy(context.Background(), req)
 	if err != nil {
 		return err
@@ -271,3 +300,69 @@ func policy(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var exportSessionsCommand = cli.Command{
+	Name:  "exportsessions",
+	Usage: "Export an encrypted backup of the towers and sessions.",
+	Description: "The backup is written to the given file and can only " +
+		"be imported by a node restored from the same seed, which " +
+		"then keeps using the sessions negotiated by this node.",
+	ArgsUsage: "output_file",
+	Action:    actionDecorator(exportSessions),
+}
+
+func exportSessions(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "exportsessions")
+	}
+
+	client, cleanUp := getWtclient(ctx)
+	defer cleanUp()
+
+	req := &wtclientrpc.ExportSessionsRequest{}
+	resp, err := client.ExportSessions(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(ctx.Args().First(), resp.Backup, 0600)
+}
+
+var importSessionsCommand = cli.Command{
+	Name:  "importsessions",
+	Usage: "Import the towers and sessions of an encrypted backup.",
+	Description: "The backup must have been created with " +
+		"exportsessions by a node with the same seed. Sessions that " +
+		"are already known are skipped.",
+	ArgsUsage: "input_file",
+	Action:    actionDecorator(importSessions),
+}
+
+func importSessions(ctx *cli.Context) error {
+	// Display the command's help message if the number of arguments/flags
+	// is not what we expect.
+	if ctx.NArg() != 1 || ctx.NumFlags() > 0 {
+		return cli.ShowCommandHelp(ctx, "importsessions")
+	}
+
+	backup, err := ioutil.ReadFile(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("unable to read session backup: %v", err)
+	}
+
+	client, cleanUp := getWtclient(ctx)
+	defer cleanUp()
+
+	req := &wtclientrpc.ImportSessionsRequest{
+		Backup: backup,
+	}
+	resp, err := client.ImportSessions(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
@@ -271,6 +271,8 @@ type Config struct {
 
 	AcceptKeySend bool `long:"accept-keysend" description:"If true, spontaneous payments through keysend will be accepted. [experimental]"`
 
+	AcceptAMP bool `long:"accept-amp" description:"If true, spontaneous payments via AMP will be accepted. [experimental]"`
+
 	KeysendHoldTime time.Duration `long:"keysend-hold-time" description:"If non-zero, keysend payments are accepted but not immediately settled. If the payment isn't settled manually after the specified time, it is canceled automatically. [experimental]"`
 
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
@@ -279,6 +281,12 @@ type Config struct {
 
 	Caches *lncfg.Caches `group:"caches" namespace:"caches"`
 
+	Fee *lncfg.Fee `group:"fee" namespace:"fee"`
+
+	Fitness *lncfg.Fitness `group:"fitness" namespace:"fitness"`
+
+	FeeManager *lncfg.FeeManager `group:"feemanager" namespace:"feemanager"`
+
 	Prometheus lncfg.Prometheus `group:"prometheus" namespace:"prometheus"`
 
 	WtClient *lncfg.WtClient `group:"wtclient" namespace:"wtclient"`
@@ -293,6 +301,8 @@ type Config struct {
 
 	DB *lncfg.DB `group:"db" namespace:"db"`
 
+	RPCMiddleware *lncfg.RPCMiddleware `group:"rpcmiddleware" namespace:"rpcmiddleware"`
+
 	// LogWriter is the root logger that all of the daemon's subloggers are
 	// hooked up to.
 	LogWriter *build.RotatingLogWriter
@@ -342,12 +352,17 @@ func DefaultConfig() Config {
 			RouterRPC: routerrpc.DefaultConfig(),
 		},
 		Autopilot: &lncfg.AutoPilot{
-			MaxChannels:    5,
-			Allocation:     0.6,
-			MinChannelSize: int64(minChanFundingSize),
-			MaxChannelSize: int64(MaxFundingAmount),
-			MinConfs:       1,
-			ConfTarget:     autopilot.DefaultConfTarget,
+			MaxChannels:        5,
+			Allocation:         0.6,
+			MinChannelSize:     int64(minChanFundingSize),
+			MaxChannelSize:     int64(MaxFundingAmount),
+			MinConfs:           1,
+			ConfTarget:         autopilot.DefaultConfTarget,
+			EvaluationInterval: 6 * time.Hour,
+			CloseScore:         0.1,
+			DownsizeScore:      0.2,
+			RevenueWindow:      30 * 24 * time.Hour,
+			MinChanAge:         30 * 24 * time.Hour,
 			Heuristic: map[string]float64{
 				"preferential": 1.0,
 			},
@@ -380,6 +395,23 @@ func DefaultConfig() Config {
 			RejectCacheSize:  channeldb.DefaultRejectCacheSize,
 			ChannelCacheSize: channeldb.DefaultChannelCacheSize,
 		},
+		Fee: &lncfg.Fee{},
+		Fitness: &lncfg.Fitness{
+			FlushInterval:   lncfg.DefaultFitnessFlushInterval,
+			MinLifespan:     lncfg.DefaultFitnessMinLifespan,
+			UptimeRetention: lncfg.DefaultFitnessUptimeRetention,
+			CompactionGap:   lncfg.DefaultFitnessCompactionGap,
+		},
+		FeeManager: &lncfg.FeeManager{
+			Interval:        lncfg.DefaultFeeManagerInterval,
+			MaxBaseFee:      lncfg.DefaultFeeManagerMaxBaseFee,
+			MinFeeRate:      lncfg.DefaultFeeManagerMinFeeRate,
+			MaxFeeRate:      lncfg.DefaultFeeManagerMaxFeeRate,
+			FlowWindow:      lncfg.DefaultFeeManagerFlowWindow,
+			UpdateInterval:  lncfg.DefaultFeeManagerUpdateInterval,
+			ChangeThreshold: lncfg.DefaultFeeManagerChangeThreshold,
+			ManualHold:      lncfg.DefaultFeeManagerManualHold,
+		},
 		Prometheus: lncfg.DefaultPrometheus(),
 		Watchtower: &lncfg.Watchtower{
 			TowerDir: defaultTowerDir,
@@ -405,6 +437,7 @@ func DefaultConfig() Config {
 		MaxChannelFeeAllocation: htlcswitch.DefaultMaxLinkFeeAllocation,
 		LogWriter:               build.NewRotatingLogWriter(),
 		DB:                      lncfg.DefaultDB(),
+		RPCMiddleware:           lncfg.DefaultRPCMiddleware(),
 		registeredChains:        newChainRegistry(),
 	}
 }
@@ -587,6 +620,21 @@ func ValidateConfig(cfg Config, usageMessage string) (*Config, error) {
 		_, _ = fmt.Fprintln(os.Stderr, err)
 		return nil, err
 	}
+	if cfg.Autopilot.EvaluationInterval < 0 {
+		str := "%s: autopilot.evaluationinterval must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Autopilot.CloseScore < 0 || cfg.Autopilot.DownsizeScore > 1 ||
+		cfg.Autopilot.CloseScore > cfg.Autopilot.DownsizeScore {
+
+		str := "%s: autopilot.closescore and autopilot.downsizescore " +
+			"must satisfy 0 <= closescore <= downsizescore <= 1"
+		err := fmt.Errorf(str, funcName)
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
 
 	// Ensure that the specified values for the min and max channel size
 	// are within the bounds of the normal chan size constraints.
@@ -1084,9 +1132,13 @@ func ValidateConfig(cfg Config, usageMessage string) (*Config, error) {
 	err = lncfg.Validate(
 		cfg.Workers,
 		cfg.Caches,
+		cfg.Fee,
+		cfg.Fitness,
+		cfg.FeeManager,
 		cfg.WtClient,
 		cfg.DB,
 		cfg.HealthChecks,
+		cfg.RPCMiddleware,
 	)
 	if err != nil {
 		return nil, err
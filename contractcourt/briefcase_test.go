@@ -297,7 +297,6 @@ func TestContractInsertionRetrieval(t *testing.T) {
 		htlc: channeldb.HTLC{
 			RHash: testPreimage,
 		},
-		sweepTx: nil,
 	}
 	resolvers := []ContractResolver{
 		&timeoutResolver,
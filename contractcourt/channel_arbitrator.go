@@ -1046,8 +1046,11 @@ func (c *ChannelArbitrator) stateStep(
 }
 
 // sweepAnchors offers all given anchor resolutions to the sweeper. It requests
-// sweeping at the minimum fee rate. This fee rate can be upped manually by the
-// user via the BumpFee rpc.
+// sweeping at the minimum fee rate. If there are HTLCs on the commitment, the
+// earliest HTLC expiry is set as the deadline of the anchor sweeps, which
+// makes the sweeper pick a fee rate that should confirm them before the
+// expiry. The fee rate can also be upped manually by the user via the BumpFee
+// rpc.
 func (c *ChannelArbitrator) sweepAnchors(anchors []*lnwallet.AnchorResolution,
 	heightHint uint32) error {
 
@@ -1058,6 +1061,10 @@ func (c *ChannelArbitrator) sweepAnchors(anchors []*lnwallet.AnchorResolution,
 	// Retrieve the current minimum fee rate from the sweeper.
 	minFeeRate := c.cfg.Sweeper.RelayFeePerKB()
 
+	// Determine the deadline and budget of the anchor sweeps from the
+	// HTLCs that are at stake.
+	deadline, budget := c.htlcDeadline()
+
 	for _, anchor := range anchors {
 		log.Debugf("ChannelArbitrator(%v): pre-confirmation sweep of "+
 			"anchor of tx %v", c.cfg.ChanPoint, anchor.CommitAnchor)
@@ -1083,6 +1090,8 @@ func (c *ChannelArbitrator) sweepAnchors(anchors []*lnwallet.AnchorResolution,
 				},
 				Force:          true,
 				ExclusiveGroup: &exclusiveGroup,
+				DeadlineHeight: deadline,
+				Budget:         budget,
 			},
 		)
 		if err != nil {
@@ -1093,6 +1102,51 @@ func (c *ChannelArbitrator) sweepAnchors(anchors []*lnwallet.AnchorResolution,
 	return nil
 }
 
+// htlcDeadline returns the earliest expiry of the active HTLCs on any of the
+// commitments, by which a commitment must be confirmed for the HTLCs to be
+// resolved in time, along with a budget of DefaultBudgetRatio of the value of
+// those HTLCs. If there are no active HTLCs, a zero deadline is returned.
+func (c *ChannelArbitrator) htlcDeadline() (int32, dcrutil.Amount) {
+	var (
+		deadline uint32
+		htlcAmt  lnwire.MilliAtom
+	)
+
+	// The same HTLCs are usually found on several of the commitments, so
+	// we'll only count each of them once towards the budget.
+	type htlcKey struct {
+		incoming bool
+		index    uint64
+	}
+	seen := make(map[htlcKey]struct{})
+	addHtlc := func(htlc channeldb.HTLC) {
+		if deadline == 0 || htlc.RefundTimeout < deadline {
+			deadline = htlc.RefundTimeout
+		}
+
+		key := htlcKey{incoming: htlc.Incoming, index: htlc.HtlcIndex}
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		htlcAmt += htlc.Amt
+	}
+	for _, htlcs := range c.activeHTLCs {
+		for _, htlc := range htlcs.incomingHTLCs {
+			addHtlc(htlc)
+		}
+		for _, htlc := range htlcs.outgoingHTLCs {
+			addHtlc(htlc)
+		}
+	}
+
+	budget := dcrutil.Amount(
+		float64(htlcAmt.ToAtoms()) * sweep.DefaultBudgetRatio,
+	)
+
+	return int32(deadline), budget
+}
+
 // launchResolvers updates the activeResolvers list and starts the resolvers.
 func (c *ChannelArbitrator) launchResolvers(resolvers []ContractResolver) {
 	c.activeResolversLock.Lock()
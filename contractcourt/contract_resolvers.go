@@ -20,8 +20,28 @@ const (
 	// sweepConfTarget is the default number of blocks that we'll use as a
 	// confirmation target when sweeping.
 	sweepConfTarget = 6
+
+	// minSweepConfTarget is the lowest confirmation target that we'll use
+	// when sweeping an output that must confirm before a deadline.
+	minSweepConfTarget = 2
 )
 
+// deadlineConfTarget returns the confirmation target for a sweep that must
+// confirm before the given deadline height, as seen from the given height. The
+// target is kept between minSweepConfTarget and sweepConfTarget.
+func deadlineConfTarget(deadline, height uint32) uint32 {
+	if deadline < height+minSweepConfTarget {
+		return minSweepConfTarget
+	}
+
+	confTarget := deadline - height
+	if confTarget > sweepConfTarget {
+		return sweepConfTarget
+	}
+
+	return confTarget
+}
+
 // ContractResolver is an interface which packages a state machine which is
 // able to carry out the necessary steps required to fully resolve a Decred
 // contract on-chain. Resolvers are fully encodable to ensure callers are able
@@ -40,13 +40,6 @@ type htlcSuccessResolver struct {
 	// historical queries to the chain for spends/confirmations.
 	broadcastHeight uint32
 
-	// sweepTx will be non-nil if we've already crafted a transaction to
-	// sweep a direct HTLC output. This is only a concern if we're sweeping
-	// from the commitment transaction of the remote party.
-	//
-	// TODO(roasbeef): send off to utxobundler
-	sweepTx *wire.MsgTx
-
 	// htlc contains information on the htlc that we are resolving
 	// on-chain.
 	htlc channeldb.HTLC
@@ -106,99 +99,7 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	// If we don't have a success transaction, then this means that this is
 	// an output on the remote party's commitment transaction.
 	if h.htlcResolution.SignedSuccessTx == nil {
-		// If we don't already have the sweep transaction constructed,
-		// we'll do so and broadcast it.
-		if h.sweepTx == nil {
-			log.Infof("%T(%x): crafting sweep tx for "+
-				"incoming+remote htlc confirmed", h,
-				h.htlc.RHash[:])
-
-			// Before we can craft out sweeping transaction, we
-			// need to create an input which contains all the items
-			// required to add this input to a sweeping transaction,
-			// and generate a witness.
-			inp := input.MakeHtlcSucceedInput(
-				&h.htlcResolution.ClaimOutpoint,
-				&h.htlcResolution.SweepSignDesc,
-				h.htlcResolution.Preimage[:],
-				h.broadcastHeight,
-				h.htlcResolution.CsvDelay,
-			)
-
-			// With the input created, we can now generate the full
-			// sweep transaction, that we'll use to move these
-			// coins back into the backing wallet.
-			//
-			// TODO: Set tx lock time to current block height
-			// instead of zero. Will be taken care of once sweeper
-			// implementation is complete.
-			//
-			// TODO: Use time-based sweeper and result chan.
-			var err error
-			h.sweepTx, err = h.Sweeper.CreateSweepTx(
-				[]input.Input{&inp},
-				sweep.FeePreference{
-					ConfTarget: sweepConfTarget,
-				}, 0,
-			)
-			if err != nil {
-				return nil, err
-			}
-
-			log.Infof("%T(%x): crafted sweep tx=%v", h,
-				h.htlc.RHash[:], spew.Sdump(h.sweepTx))
-
-			// With the sweep transaction signed, we'll now
-			// Checkpoint our state.
-			if err := h.Checkpoint(h); err != nil {
-				log.Errorf("unable to Checkpoint: %v", err)
-				return nil, err
-			}
-		}
-
-		// Regardless of whether an existing transaction was found or newly
-		// constructed, we'll broadcast the sweep transaction to the
-		// network.
-		err := h.PublishTx(h.sweepTx, "")
-		if err != nil {
-			log.Infof("%T(%x): unable to publish tx: %v",
-				h, h.htlc.RHash[:], err)
-			return nil, err
-		}
-
-		// With the sweep transaction broadcast, we'll wait for its
-		// confirmation.
-		sweepTXID := h.sweepTx.TxHash()
-		sweepScript := h.sweepTx.TxOut[0].PkScript
-		confNtfn, err := h.Notifier.RegisterConfirmationsNtfn(
-			&sweepTXID, sweepScript, 1, h.broadcastHeight,
-		)
-		if err != nil {
-			return nil, err
-		}
-
-		log.Infof("%T(%x): waiting for sweep tx (txid=%v) to be "+
-			"confirmed", h, h.htlc.RHash[:], sweepTXID)
-
-		select {
-		case _, ok := <-confNtfn.Confirmed:
-			if !ok {
-				return nil, errResolverShuttingDown
-			}
-
-		case <-h.quit:
-			return nil, errResolverShuttingDown
-		}
-
-		// Once the transaction has received a sufficient number of
-		// confirmations, we'll mark ourselves as fully resolved and exit.
-		h.resolved = true
-
-		// Checkpoint the resolver, and write the outcome to disk.
-		return nil, h.checkpointClaim(
-			&sweepTXID,
-			channeldb.ResolverOutcomeClaimed,
-		)
+		return h.resolveRemoteCommitOutput()
 	}
 
 	log.Infof("%T(%x): broadcasting second-layer transition tx: %v",
@@ -268,6 +169,88 @@ func (h *htlcSuccessResolver) Resolve() (ContractResolver, error) {
 	)
 }
 
+// resolveRemoteCommitOutput sweeps the HTLC output on the remote party's
+// commitment directly using the preimage. The output is offered to the sweeper
+// with the HTLC's expiry as deadline, since the remote party can time out the
+// HTLC from then on, which makes the sweeper pick a fee rate that should
+// confirm the sweep before the expiry.
+func (h *htlcSuccessResolver) resolveRemoteCommitOutput() (ContractResolver,
+	error) {
+
+	log.Infof("%T(%x): offering incoming+remote htlc output to sweeper "+
+		"with deadline=%v", h, h.htlc.RHash[:], h.htlc.RefundTimeout)
+
+	// Before we can offer the output to the sweeper, we need to create an
+	// input which contains all the items required to add this input to a
+	// sweeping transaction, and generate a witness.
+	inp := input.MakeHtlcSucceedInput(
+		&h.htlcResolution.ClaimOutpoint,
+		&h.htlcResolution.SweepSignDesc,
+		h.htlcResolution.Preimage[:],
+		h.broadcastHeight,
+		h.htlcResolution.CsvDelay,
+	)
+
+	// The sweep is made at a fee rate that should confirm it before the
+	// expiry, but at least at the fee rate of our confirmation target.
+	// The sweeper spends at most the budget, which it uses up if the
+	// output is still unswept once the expiry is reached.
+	htlcValue := h.htlcResolution.SweepSignDesc.Output.Value
+	budget := dcrutil.Amount(float64(htlcValue) * sweep.DefaultBudgetRatio)
+	confTarget := deadlineConfTarget(
+		h.htlc.RefundTimeout, h.broadcastHeight,
+	)
+	resultChan, err := h.Sweeper.SweepInput(
+		&inp, sweep.Params{
+			Fee: sweep.FeePreference{
+				ConfTarget: confTarget,
+			},
+			DeadlineHeight: int32(h.htlc.RefundTimeout),
+			Budget:         budget,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sweeper will publish the sweep, possibly batched with other
+	// inputs, and signals us through the result channel once it
+	// confirmed.
+	outcome := channeldb.ResolverOutcomeClaimed
+	var sweepTxID chainhash.Hash
+	select {
+	case sweepResult := <-resultChan:
+		switch sweepResult.Err {
+		// If the remote party swept the output, they timed out the
+		// HTLC before our sweep confirmed.
+		case sweep.ErrRemoteSpend:
+			log.Warnf("%T(%x): htlc output was swept by remote "+
+				"party via %v", h, h.htlc.RHash[:],
+				sweepResult.Tx.TxHash())
+			outcome = channeldb.ResolverOutcomeTimeout
+
+		case nil:
+			log.Infof("%T(%x): htlc output swept by tx %v", h,
+				h.htlc.RHash[:], sweepResult.Tx.TxHash())
+
+		default:
+			log.Errorf("%T(%x): unable to sweep htlc output: %v",
+				h, h.htlc.RHash[:], sweepResult.Err)
+			return nil, sweepResult.Err
+		}
+
+		sweepTxID = sweepResult.Tx.TxHash()
+
+	case <-h.quit:
+		return nil, errResolverShuttingDown
+	}
+
+	// Once the sweep has confirmed, we'll mark ourselves as fully resolved
+	// and checkpoint the outcome to disk.
+	h.resolved = true
+	return nil, h.checkpointClaim(&sweepTxID, outcome)
+}
+
 // checkpointClaim checkpoints the success resolver with the reports it needs.
 // If this htlc was claimed two stages, it will write reports for both stages,
 // otherwise it will just write for the single htlc claim.
@@ -17,6 +17,7 @@ var testHtlcAmt = lnwire.MilliAtom(200000)
 type htlcSuccessResolverTestContext struct {
 	resolver           *htlcSuccessResolver
 	notifier           *mockNotifier
+	sweeper            *mockSweeper
 	resolverResultChan chan resolveResult
 	t                  *testing.T
 }
@@ -28,16 +29,20 @@ func newHtlcSuccessResolverTextContext(t *testing.T) *htlcSuccessResolverTestCon
 		confChan:  make(chan *chainntnfs.TxConfirmation),
 	}
 
+	sweeper := newMockSweeper()
+
 	checkPointChan := make(chan struct{}, 1)
 
 	testCtx := &htlcSuccessResolverTestContext{
 		notifier: notifier,
+		sweeper:  sweeper,
 		t:        t,
 	}
 
 	chainCfg := ChannelArbitratorConfig{
 		ChainArbitratorConfig: ChainArbitratorConfig{
 			Notifier: notifier,
+			Sweeper:  sweeper,
 			PublishTx: func(_ *wire.MsgTx, _ string) error {
 				return nil
 			},
@@ -113,12 +118,13 @@ func TestSingleStageSuccess(t *testing.T) {
 		ClaimOutpoint: htlcOutpoint,
 	}
 
-	// We send a confirmation for our sweep tx to indicate that our sweep
-	// succeeded.
+	// The htlc output is offered to the sweeper, which reports our sweep
+	// tx as confirmed.
 	resolve := func(ctx *htlcSuccessResolverTestContext) {
-		ctx.notifier.confChan <- &chainntnfs.TxConfirmation{
-			Tx:          ctx.resolver.sweepTx,
-			BlockHeight: testInitialBlockHeight - 1,
+		inp := <-ctx.sweeper.sweptInputs
+		if *inp.OutPoint() != htlcOutpoint {
+			t.Fatalf("expected htlc output %v to be swept, got %v",
+				htlcOutpoint, *inp.OutPoint())
 		}
 	}
 
@@ -220,10 +226,10 @@ func testHtlcSuccess(t *testing.T, resolution lnwallet.IncomingHtlcResolution,
 
 	ctx.resolver.htlcResolution = resolution
 
-	// We set the sweepTx to be non-nil and mark the output as already
-	// incubating so that we do not need to set test values for crafting
-	// our own sweep transaction.
-	ctx.resolver.sweepTx = sweepTx
+	// We let the sweeper report our sweep tx and mark the output as
+	// already incubating so that we do not need to set test values for
+	// crafting our own sweep transaction.
+	ctx.sweeper.sweepTx = sweepTx
 	ctx.resolver.outputIncubating = true
 
 	// Start the htlc success resolver.
@@ -14,6 +14,7 @@ import (
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
 
+	"github.com/decred/dcrlnd/aliasmgr"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lnpeer"
@@ -1494,6 +1495,12 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 
 	// isPremature *MUST* be called with the gossiper's lock held.
 	isPremature := func(chanID lnwire.ShortChannelID, delta uint32) bool {
+		// Aliases of zero-conf channels don't refer to a block, so
+		// they are never premature.
+		if aliasmgr.IsAlias(chanID) {
+			return false
+		}
+
 		// TODO(roasbeef) make height delta 6
 		//  * or configurable
 		return chanID.BlockHeight+delta > d.bestHeight
@@ -2502,7 +2509,7 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 			DecredKey1:      info.DecredKey1Bytes,
 			Features:        lnwire.NewRawFeatureVector(),
 			DecredKey2:      info.DecredKey2Bytes,
-			ExtraOpaqueData: edge.ExtraOpaqueData,
+			ExtraOpaqueData: info.ExtraOpaqueData,
 		}
 		chanAnn.NodeSig1, err = lnwire.NewSigFromRawSignature(
 			info.AuthProof.NodeSig1Bytes,
@@ -22,6 +22,7 @@ var defaultSetDesc = setDesc{
 		SetInit:         {}, // I
 		SetNodeAnn:      {}, // N
 		SetInvoice:      {}, // 9
+		SetInvoiceAmp:   {}, // 9A
 		SetLegacyGlobal: {},
 	},
 	lnwire.StaticRemoteKeyOptional: {
@@ -34,9 +35,10 @@ var defaultSetDesc = setDesc{
 		SetNodeAnn: {}, // N
 	},
 	lnwire.PaymentAddrOptional: {
-		SetInit:    {}, // I
-		SetNodeAnn: {}, // N
-		SetInvoice: {}, // 9
+		SetInit:       {}, // I
+		SetNodeAnn:    {}, // N
+		SetInvoice:    {}, // 9
+		SetInvoiceAmp: {}, // 9A
 	},
 	lnwire.MPPOptional: {
 		SetInit:    {}, // I
@@ -51,4 +53,19 @@ var defaultSetDesc = setDesc{
 		SetInit:    {}, // I
 		SetNodeAnn: {}, // N
 	},
+	lnwire.AMPOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.AMPRequired: {
+		SetInvoiceAmp: {}, // 9A
+	},
+	lnwire.ScidAliasOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
+	lnwire.ZeroConfOptional: {
+		SetInit:    {}, // I
+		SetNodeAnn: {}, // N
+	},
 }
@@ -22,6 +22,10 @@ const (
 	// SetInvoice identifies features that should be advertised on invoices
 	// generated by the daemon.
 	SetInvoice
+
+	// SetInvoiceAmp identifies the features that should be advertised on
+	// AMP invoices generated by the daemon.
+	SetInvoiceAmp
 )
 
 // String returns a human-readable description of a Set.
@@ -35,6 +39,8 @@ func (s Set) String() string {
 		return "SetNodeAnn"
 	case SetInvoice:
 		return "SetInvoice"
+	case SetInvoiceAmp:
+		return "SetInvoiceAmp"
 	default:
 		return "SetUnknown"
 	}
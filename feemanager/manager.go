@@ -0,0 +1,712 @@
+// Package feemanager automatically adjusts the forwarding fees of the node's
+// channels.
+//
+// Scarcity: the fraction of a channel's capacity that is projected to be on
+// the remote side. The projection assumes that the net flow of forwards out of
+// the channel during the last flow window continues for another window.
+//
+// The base fee and fee rate of each channel are interpolated linearly between
+// the configured bounds according to its scarcity, so that channels that are
+// drained are priced up and channels with plenty of local balance are priced
+// down. Updates are rate-limited per channel and skipped if the change is
+// insignificant, to avoid spamming the network with channel updates.
+//
+// Channels can be excluded from fee management or pinned to fixed fees
+// through overrides. Channels whose policy is updated manually are left alone
+// for a while. Both overrides and such holds are persisted.
+package feemanager
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing"
+	"github.com/decred/dcrlnd/ticker"
+)
+
+// maxForwardsPerQuery is the maximum number of forwarding events that are
+// fetched from the forwarding log at once.
+const maxForwardsPerQuery = 50000
+
+var (
+	// ErrChannelNotFound is returned when an override is set for a channel
+	// that isn't an open channel with a known policy.
+	ErrChannelNotFound = errors.New("channel not found")
+
+	// ErrInvalidOverride is returned when an override with an unknown mode
+	// is set.
+	ErrInvalidOverride = errors.New("invalid fee override mode")
+)
+
+// Store persists the overrides and holds of the fee manager.
+type Store interface {
+	// FetchFeeOverrides returns the overrides of all channels.
+	FetchFeeOverrides() (map[wire.OutPoint]*channeldb.FeeOverride, error)
+
+	// PutFeeOverride stores the override of the given channel.
+	PutFeeOverride(wire.OutPoint, *channeldb.FeeOverride) error
+
+	// DeleteFeeOverrides removes the overrides of the given channels.
+	DeleteFeeOverrides([]wire.OutPoint) error
+
+	// FetchFeeHolds returns the times until which channels are held.
+	FetchFeeHolds() (map[wire.OutPoint]time.Time, error)
+
+	// PutFeeHolds stores the time until which the given channels are
+	// held.
+	PutFeeHolds([]wire.OutPoint, time.Time) error
+
+	// DeleteFeeHolds removes the holds of the given channels.
+	DeleteFeeHolds([]wire.OutPoint) error
+}
+
+// Config provides the fee manager with the functions required to evaluate and
+// update the fees of the node's channels, along with the bounds within which
+// the fees are set.
+type Config struct {
+	// FetchChannels returns the node's open channels.
+	FetchChannels func() ([]*channeldb.OpenChannel, error)
+
+	// ForAllOutgoingChannels iterates over the node's channels along with
+	// their current policies.
+	ForAllOutgoingChannels func(cb func(*channeldb.ChannelEdgeInfo,
+		*channeldb.ChannelEdgePolicy) error) error
+
+	// QueryForwards queries the forwarding log for the forwards of a time
+	// slice.
+	QueryForwards func(channeldb.ForwardingEventQuery) (
+		channeldb.ForwardingLogTimeSlice, error)
+
+	// UpdatePolicy updates the policy of the given channels and broadcasts
+	// the resulting channel updates.
+	UpdatePolicy func(routing.ChannelPolicy, ...wire.OutPoint) error
+
+	// Store persists the overrides and holds of the fee manager.
+	Store Store
+
+	// Ticker determines how often the fees of all channels are evaluated.
+	Ticker ticker.Ticker
+
+	// Clock is the time source of the fee manager.
+	Clock clock.Clock
+
+	// MinBaseFee is the base fee of channels that have all of their
+	// capacity on the local side.
+	MinBaseFee lnwire.MilliAtom
+
+	// MaxBaseFee is the base fee of channels that are fully drained.
+	MaxBaseFee lnwire.MilliAtom
+
+	// MinFeeRate is the fee rate in parts per million of channels that
+	// have all of their capacity on the local side.
+	MinFeeRate uint32
+
+	// MaxFeeRate is the fee rate in parts per million of channels that
+	// are fully drained.
+	MaxFeeRate uint32
+
+	// FlowWindow is the period of forwarding history that is taken into
+	// account. If it is zero, only the current balances are considered.
+	FlowWindow time.Duration
+
+	// UpdateInterval is the minimum time between two updates of the same
+	// channel.
+	UpdateInterval time.Duration
+
+	// ChangeThreshold is the minimum relative change of a channel's base
+	// fee or fee rate that warrants an update.
+	ChangeThreshold float64
+
+	// ManualHold is the time during which channels are left alone after
+	// their policy has been updated manually.
+	ManualHold time.Duration
+}
+
+// ChannelStatus describes the fees of a channel and the decision of the fee
+// manager about them.
+type ChannelStatus struct {
+	// ChanPoint is the funding outpoint of the channel.
+	ChanPoint wire.OutPoint
+
+	// ChanID is the short channel id of the channel.
+	ChanID lnwire.ShortChannelID
+
+	// Capacity is the capacity of the channel.
+	Capacity dcrutil.Amount
+
+	// LocalBalance is the current local balance of the channel.
+	LocalBalance lnwire.MilliAtom
+
+	// ProjectedBalance is the local balance the channel is projected to
+	// have once the net flow of the last flow window continues for another
+	// window.
+	ProjectedBalance lnwire.MilliAtom
+
+	// BaseFee is the current base fee of the channel.
+	BaseFee lnwire.MilliAtom
+
+	// FeeRate is the current fee rate of the channel in parts per million.
+	FeeRate uint32
+
+	// TargetBaseFee is the base fee the fee manager aims for.
+	TargetBaseFee lnwire.MilliAtom
+
+	// TargetFeeRate is the fee rate the fee manager aims for.
+	TargetFeeRate uint32
+
+	// LastUpdate is the time the policy of the channel was last updated,
+	// whether by the fee manager or not.
+	LastUpdate time.Time
+
+	// Override is the override of the channel, or nil if the channel is
+	// managed automatically.
+	Override *channeldb.FeeOverride
+
+	// HeldUntil is the time until which the channel is left alone, because
+	// its policy was updated manually. It is zero if the channel isn't
+	// held.
+	HeldUntil time.Time
+
+	// UpdatePending is true if the target fees are applied at the next
+	// evaluation.
+	UpdatePending bool
+
+	// timeLockDelta and inboundFee are the parts of the current policy
+	// that are retained when the fees are updated.
+	timeLockDelta uint16
+	inboundFee    lnwire.InboundFee
+}
+
+// Manager periodically evaluates the fees of the node's channels and updates
+// those that are off target.
+type Manager struct {
+	started sync.Once
+	stopped sync.Once
+
+	cfg *Config
+
+	// mu guards the overrides and holds. It is held while the fee manager
+	// updates policies, so that its updates are serialized with manual
+	// ones.
+	mu sync.Mutex
+
+	// overrides holds the overrides of channels, keyed by their funding
+	// outpoint.
+	overrides map[wire.OutPoint]*channeldb.FeeOverride
+
+	// holds holds the times until which channels whose policy was updated
+	// manually are left alone.
+	holds map[wire.OutPoint]time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a new fee manager.
+func New(cfg *Config) *Manager {
+	return &Manager{
+		cfg:       cfg,
+		overrides: make(map[wire.OutPoint]*channeldb.FeeOverride),
+		holds:     make(map[wire.OutPoint]time.Time),
+		quit:      make(chan struct{}),
+	}
+}
+
+// Start loads the persisted overrides and holds and starts evaluating the fees
+// of the node's channels.
+func (m *Manager) Start() error {
+	var err error
+	m.started.Do(func() {
+		log.Info("Fee manager starting")
+
+		var overrides map[wire.OutPoint]*channeldb.FeeOverride
+		overrides, err = m.cfg.Store.FetchFeeOverrides()
+		if err != nil {
+			return
+		}
+
+		var holds map[wire.OutPoint]time.Time
+		holds, err = m.cfg.Store.FetchFeeHolds()
+		if err != nil {
+			return
+		}
+
+		m.mu.Lock()
+		m.overrides = overrides
+		m.holds = holds
+		m.mu.Unlock()
+
+		m.wg.Add(1)
+		go m.run()
+	})
+
+	return err
+}
+
+// Stop stops the fee manager.
+func (m *Manager) Stop() {
+	m.stopped.Do(func() {
+		log.Info("Fee manager shutting down")
+
+		close(m.quit)
+		m.wg.Wait()
+	})
+}
+
+// run evaluates the fees of all channels once on startup and at every tick
+// afterwards.
+func (m *Manager) run() {
+	defer m.wg.Done()
+
+	m.cfg.Ticker.Resume()
+	defer m.cfg.Ticker.Stop()
+
+	m.evaluate()
+
+	for {
+		select {
+		case <-m.cfg.Ticker.Ticks():
+			m.evaluate()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// evaluate updates the fees of all channels that have an update pending.
+func (m *Manager) evaluate() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses, err := m.channelStatuses()
+	if err != nil {
+		log.Errorf("Unable to evaluate channel fees: %v", err)
+		return
+	}
+
+	for _, status := range statuses {
+		if !status.UpdatePending {
+			continue
+		}
+
+		if err := m.applyTarget(status); err != nil {
+			log.Errorf("Unable to update fees of "+
+				"ChannelPoint(%v): %v", status.ChanPoint, err)
+		}
+	}
+}
+
+// applyTarget updates the fees of a channel to its target fees. The caller
+// must hold the mutex.
+func (m *Manager) applyTarget(status *ChannelStatus) error {
+	policy := routing.ChannelPolicy{
+		FeeSchema: routing.FeeSchema{
+			BaseFee:    status.TargetBaseFee,
+			FeeRate:    status.TargetFeeRate,
+			InboundFee: status.inboundFee,
+		},
+		TimeLockDelta: uint32(status.timeLockDelta),
+	}
+
+	err := m.cfg.UpdatePolicy(policy, status.ChanPoint)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Updated fees of ChannelPoint(%v) with projected local "+
+		"balance %v of %v: base_fee=%v->%v, fee_rate=%v->%v",
+		status.ChanPoint, status.ProjectedBalance, status.Capacity,
+		status.BaseFee, status.TargetBaseFee, status.FeeRate,
+		status.TargetFeeRate)
+
+	return nil
+}
+
+// ChannelStatuses returns the fees of all open channels along with the
+// decisions of the fee manager about them, ordered by channel id.
+func (m *Manager) ChannelStatuses() ([]*ChannelStatus, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.channelStatuses()
+}
+
+// SetOverride overrides the decisions of the fee manager for a channel. A
+// pinned channel is updated to its pinned fees right away. If the override is
+// nil, the channel's override is removed along with any hold from a manual
+// update, so that it is managed automatically again.
+func (m *Manager) SetOverride(chanPoint wire.OutPoint,
+	override *channeldb.FeeOverride) error {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if override == nil {
+		chanPoints := []wire.OutPoint{chanPoint}
+		err := m.cfg.Store.DeleteFeeOverrides(chanPoints)
+		if err != nil {
+			return err
+		}
+		if err := m.cfg.Store.DeleteFeeHolds(chanPoints); err != nil {
+			return err
+		}
+
+		delete(m.overrides, chanPoint)
+		delete(m.holds, chanPoint)
+
+		log.Infof("Resumed fee management of ChannelPoint(%v)",
+			chanPoint)
+
+		return nil
+	}
+
+	switch override.Mode {
+	case channeldb.FeeOverrideExclude, channeldb.FeeOverridePin:
+	default:
+		return ErrInvalidOverride
+	}
+
+	statuses, err := m.channelStatuses()
+	if err != nil {
+		return err
+	}
+
+	var status *ChannelStatus
+	for _, s := range statuses {
+		if s.ChanPoint == chanPoint {
+			status = s
+			break
+		}
+	}
+	if status == nil {
+		return ErrChannelNotFound
+	}
+
+	if err := m.cfg.Store.PutFeeOverride(chanPoint, override); err != nil {
+		return err
+	}
+	m.overrides[chanPoint] = override
+
+	log.Infof("Set fee override of ChannelPoint(%v) to %v",
+		chanPoint, override.Mode)
+
+	if override.Mode != channeldb.FeeOverridePin {
+		return nil
+	}
+
+	// The pinned fees are the explicit wish of the operator, so they are
+	// applied regardless of any rate limits.
+	err = m.cfg.Store.DeleteFeeHolds([]wire.OutPoint{chanPoint})
+	if err != nil {
+		return err
+	}
+	delete(m.holds, chanPoint)
+	status.TargetBaseFee = override.BaseFee
+	status.TargetFeeRate = override.FeeRate
+
+	return m.applyTarget(status)
+}
+
+// NotifyManualUpdate tells the fee manager that the policy of the given
+// channels is about to be updated manually, or of all channels if none are
+// given. The channels are left alone for the configured hold time. It must be
+// called before the manual update is carried out, so that the fee manager
+// doesn't overwrite it.
+func (m *Manager) NotifyManualUpdate(chanPoints ...wire.OutPoint) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.cfg.ManualHold == 0 {
+		return
+	}
+
+	if len(chanPoints) == 0 {
+		channels, err := m.cfg.FetchChannels()
+		if err != nil {
+			log.Errorf("Unable to fetch channels to hold: %v", err)
+			return
+		}
+
+		for _, channel := range channels {
+			chanPoints = append(chanPoints, channel.FundingOutpoint)
+		}
+	}
+
+	// The channels are held in memory even if the holds can't be
+	// persisted, so that at least the manual update isn't overwritten
+	// until the next restart.
+	heldUntil := m.cfg.Clock.Now().Add(m.cfg.ManualHold)
+	if err := m.cfg.Store.PutFeeHolds(chanPoints, heldUntil); err != nil {
+		log.Errorf("Unable to store holds: %v", err)
+	}
+	for _, chanPoint := range chanPoints {
+		m.holds[chanPoint] = heldUntil
+	}
+
+	log.Debugf("Holding %v channels until %v after manual policy update",
+		len(chanPoints), heldUntil)
+}
+
+// channelStatuses returns the status of all open channels with a known
+// policy. Overrides and holds of channels that are no longer open are removed.
+// The caller must hold the mutex.
+func (m *Manager) channelStatuses() ([]*ChannelStatus, error) {
+	now := m.cfg.Clock.Now()
+
+	channels, err := m.cfg.FetchChannels()
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(map[wire.OutPoint]*channeldb.ChannelEdgePolicy)
+	err = m.cfg.ForAllOutgoingChannels(func(
+		info *channeldb.ChannelEdgeInfo,
+		edge *channeldb.ChannelEdgePolicy) error {
+
+		if edge != nil {
+			policies[info.ChannelPoint] = edge
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	flows, err := m.netOutFlows(now)
+	if err != nil {
+		return nil, err
+	}
+
+	var expired []wire.OutPoint
+	open := make(map[wire.OutPoint]struct{}, len(channels))
+	statuses := make([]*ChannelStatus, 0, len(channels))
+	for _, channel := range channels {
+		chanPoint := channel.FundingOutpoint
+		open[chanPoint] = struct{}{}
+
+		policy, ok := policies[chanPoint]
+		if !ok {
+			continue
+		}
+
+		chanID := channel.ShortChanID()
+		status := &ChannelStatus{
+			ChanPoint:     chanPoint,
+			ChanID:        chanID,
+			Capacity:      channel.Capacity,
+			LocalBalance:  channel.LocalCommitment.LocalBalance,
+			BaseFee:       policy.FeeBaseMAtoms,
+			FeeRate:       uint32(policy.FeeProportionalMillionths),
+			LastUpdate:    policy.LastUpdate,
+			Override:      m.overrides[chanPoint],
+			timeLockDelta: policy.TimeLockDelta,
+		}
+
+		// Retain the inbound fee of the channel. Without it, an update
+		// would remove the inbound fee.
+		inboundFee, err := policy.InboundFee()
+		if err != nil {
+			log.Warnf("Unable to parse inbound fee of "+
+				"ChannelPoint(%v): %v", chanPoint, err)
+			continue
+		}
+		if inboundFee != nil {
+			status.inboundFee = *inboundFee
+		}
+
+		status.ProjectedBalance = projectBalance(
+			status.LocalBalance, flows[chanID.ToUint64()],
+			lnwire.NewMAtomsFromAtoms(status.Capacity),
+		)
+
+		if heldUntil, ok := m.holds[chanPoint]; ok {
+			if heldUntil.After(now) {
+				status.HeldUntil = heldUntil
+			} else {
+				expired = append(expired, chanPoint)
+			}
+		}
+
+		m.decide(status, now)
+
+		statuses = append(statuses, status)
+	}
+
+	// Forget about the channels that were closed.
+	var closed []wire.OutPoint
+	for chanPoint := range m.overrides {
+		if _, ok := open[chanPoint]; !ok {
+			closed = append(closed, chanPoint)
+		}
+	}
+	if len(closed) > 0 {
+		if err := m.cfg.Store.DeleteFeeOverrides(closed); err != nil {
+			return nil, err
+		}
+		for _, chanPoint := range closed {
+			delete(m.overrides, chanPoint)
+		}
+	}
+	for chanPoint := range m.holds {
+		if _, ok := open[chanPoint]; !ok {
+			expired = append(expired, chanPoint)
+		}
+	}
+	if len(expired) > 0 {
+		if err := m.cfg.Store.DeleteFeeHolds(expired); err != nil {
+			return nil, err
+		}
+		for _, chanPoint := range expired {
+			delete(m.holds, chanPoint)
+		}
+	}
+
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ChanID.ToUint64() <
+			statuses[j].ChanID.ToUint64()
+	})
+
+	return statuses, nil
+}
+
+// decide sets the target fees of a channel and whether they are applied at
+// the next evaluation.
+func (m *Manager) decide(status *ChannelStatus, now time.Time) {
+	override := status.Override
+	switch {
+	// Excluded channels keep their fees.
+	case override != nil && override.Mode == channeldb.FeeOverrideExclude:
+		status.TargetBaseFee = status.BaseFee
+		status.TargetFeeRate = status.FeeRate
+		return
+
+	// Pinned channels are kept at their pinned fees, even if the change
+	// is small.
+	case override != nil && override.Mode == channeldb.FeeOverridePin:
+		status.TargetBaseFee = override.BaseFee
+		status.TargetFeeRate = override.FeeRate
+		status.UpdatePending = status.BaseFee != override.BaseFee ||
+			status.FeeRate != override.FeeRate
+
+	default:
+		status.TargetBaseFee, status.TargetFeeRate = m.targetFees(
+			status.ProjectedBalance,
+			lnwire.NewMAtomsFromAtoms(status.Capacity),
+		)
+		status.UpdatePending = significantChange(
+			uint64(status.BaseFee), uint64(status.TargetBaseFee),
+			m.cfg.ChangeThreshold,
+		) || significantChange(
+			uint64(status.FeeRate), uint64(status.TargetFeeRate),
+			m.cfg.ChangeThreshold,
+		)
+	}
+
+	// Rate-limit the updates of the channel, and leave channels alone
+	// that were updated manually.
+	if now.Before(status.LastUpdate.Add(m.cfg.UpdateInterval)) ||
+		!status.HeldUntil.IsZero() {
+
+		status.UpdatePending = false
+	}
+}
+
+// targetFees returns the fees of a channel with the given projected local
+// balance, which are interpolated between the configured bounds according to
+// the channel's scarcity.
+func (m *Manager) targetFees(projected,
+	capacity lnwire.MilliAtom) (lnwire.MilliAtom, uint32) {
+
+	scarcity := 1.0
+	if capacity > 0 {
+		scarcity -= float64(projected) / float64(capacity)
+	}
+
+	baseFeeRange := float64(m.cfg.MaxBaseFee - m.cfg.MinBaseFee)
+	feeRateRange := float64(m.cfg.MaxFeeRate - m.cfg.MinFeeRate)
+
+	baseFee := m.cfg.MinBaseFee + lnwire.MilliAtom(
+		math.Round(scarcity*baseFeeRange),
+	)
+	feeRate := m.cfg.MinFeeRate + uint32(math.Round(scarcity*feeRateRange))
+
+	return baseFee, feeRate
+}
+
+// netOutFlows returns the net amount that flowed out of each channel through
+// forwards during the flow window, keyed by short channel id. Channels that
+// received more than they sent have a negative flow.
+func (m *Manager) netOutFlows(now time.Time) (map[uint64]int64, error) {
+	flows := make(map[uint64]int64)
+	if m.cfg.FlowWindow == 0 {
+		return flows, nil
+	}
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    now.Add(-m.cfg.FlowWindow),
+		EndTime:      now,
+		NumMaxEvents: maxForwardsPerQuery,
+	}
+	for {
+		timeSlice, err := m.cfg.QueryForwards(query)
+		if err == channeldb.ErrNoForwardingEvents {
+			return flows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			flows[event.OutgoingChanID.ToUint64()] +=
+				int64(event.AmtOut)
+			flows[event.IncomingChanID.ToUint64()] -=
+				int64(event.AmtIn)
+		}
+
+		if len(timeSlice.ForwardingEvents) < maxForwardsPerQuery {
+			return flows, nil
+		}
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+}
+
+// projectBalance returns the local balance of a channel after the given net
+// flow out of it, bounded by zero and the channel's capacity.
+func projectBalance(balance lnwire.MilliAtom, netOutFlow int64,
+	capacity lnwire.MilliAtom) lnwire.MilliAtom {
+
+	projected := int64(balance) - netOutFlow
+	switch {
+	case projected < 0:
+		return 0
+
+	case projected > int64(capacity):
+		return capacity
+
+	default:
+		return lnwire.MilliAtom(projected)
+	}
+}
+
+// significantChange returns true if the relative change from current to
+// target is at least the given threshold.
+func significantChange(current, target uint64, threshold float64) bool {
+	if current == target {
+		return false
+	}
+	if current == 0 {
+		return true
+	}
+
+	change := math.Abs(float64(target)-float64(current)) / float64(current)
+	return change >= threshold
+}
@@ -0,0 +1,504 @@
+package feemanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/clock"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing"
+	"github.com/decred/dcrlnd/ticker"
+)
+
+var (
+	testTime = time.Unix(1600000000, 0)
+
+	chanPoint1 = wire.OutPoint{Hash: [32]byte{1}}
+	chanPoint2 = wire.OutPoint{Hash: [32]byte{2}}
+	chanPoint3 = wire.OutPoint{Hash: [32]byte{3}}
+	chanPoint4 = wire.OutPoint{Hash: [32]byte{4}}
+
+	testInboundFee = lnwire.InboundFee{BaseFee: -10, FeeRate: -20}
+)
+
+// mockStore is an in-memory implementation of the Store interface.
+type mockStore struct {
+	overrides map[wire.OutPoint]*channeldb.FeeOverride
+	holds     map[wire.OutPoint]time.Time
+}
+
+func (s *mockStore) FetchFeeOverrides() (
+	map[wire.OutPoint]*channeldb.FeeOverride, error) {
+
+	overrides := make(map[wire.OutPoint]*channeldb.FeeOverride)
+	for chanPoint, override := range s.overrides {
+		overrides[chanPoint] = override
+	}
+
+	return overrides, nil
+}
+
+func (s *mockStore) PutFeeOverride(chanPoint wire.OutPoint,
+	override *channeldb.FeeOverride) error {
+
+	s.overrides[chanPoint] = override
+	return nil
+}
+
+func (s *mockStore) DeleteFeeOverrides(chanPoints []wire.OutPoint) error {
+	for _, chanPoint := range chanPoints {
+		delete(s.overrides, chanPoint)
+	}
+	return nil
+}
+
+func (s *mockStore) FetchFeeHolds() (map[wire.OutPoint]time.Time, error) {
+	holds := make(map[wire.OutPoint]time.Time)
+	for chanPoint, heldUntil := range s.holds {
+		holds[chanPoint] = heldUntil
+	}
+
+	return holds, nil
+}
+
+func (s *mockStore) PutFeeHolds(chanPoints []wire.OutPoint,
+	heldUntil time.Time) error {
+
+	for _, chanPoint := range chanPoints {
+		s.holds[chanPoint] = heldUntil
+	}
+	return nil
+}
+
+func (s *mockStore) DeleteFeeHolds(chanPoints []wire.OutPoint) error {
+	for _, chanPoint := range chanPoints {
+		delete(s.holds, chanPoint)
+	}
+	return nil
+}
+
+type testContext struct {
+	t        *testing.T
+	manager  *Manager
+	clock    *clock.TestClock
+	store    *mockStore
+	channels []*channeldb.OpenChannel
+	policies map[wire.OutPoint]*channeldb.ChannelEdgePolicy
+	forwards []channeldb.ForwardingEvent
+
+	// updates holds the policies the fee manager applied since the last
+	// call to assertUpdates.
+	updates map[wire.OutPoint]routing.ChannelPolicy
+}
+
+// newTestContext creates a fee manager for the following channels, all with a
+// capacity of 1M atoms:
+//
+// 1: half of the capacity is local, but a quarter of it was forwarded to
+// channel 2, so it is projected to have a quarter left.
+// 2: half of the capacity is local, and it received the quarter from channel
+// 1, which is close to its target fees.
+// 3: fully drained, but its policy was updated recently.
+// 4: no known policy.
+func newTestContext(t *testing.T) *testContext {
+	ctx := &testContext{
+		t:     t,
+		clock: clock.NewTestClock(testTime),
+		store: &mockStore{
+			overrides: make(
+				map[wire.OutPoint]*channeldb.FeeOverride,
+			),
+			holds: make(map[wire.OutPoint]time.Time),
+		},
+		policies: make(map[wire.OutPoint]*channeldb.ChannelEdgePolicy),
+		updates:  make(map[wire.OutPoint]routing.ChannelPolicy),
+	}
+
+	addChannel := func(chanPoint wire.OutPoint, chanID uint64,
+		localBalance lnwire.MilliAtom) {
+
+		ctx.channels = append(ctx.channels, &channeldb.OpenChannel{
+			FundingOutpoint: chanPoint,
+			ShortChannelID:  lnwire.NewShortChanIDFromInt(chanID),
+			Capacity:        1000000,
+			LocalCommitment: channeldb.ChannelCommitment{
+				LocalBalance: localBalance,
+			},
+		})
+	}
+	addChannel(chanPoint1, 1, 500000000)
+	addChannel(chanPoint2, 2, 500000000)
+	addChannel(chanPoint3, 3, 0)
+	addChannel(chanPoint4, 4, 0)
+
+	extraData, err := lnwire.SetInboundFee(nil, &testInboundFee)
+	if err != nil {
+		t.Fatalf("unable to set inbound fee: %v", err)
+	}
+	ctx.policies[chanPoint1] = &channeldb.ChannelEdgePolicy{
+		FeeBaseMAtoms:             1000,
+		FeeProportionalMillionths: 1,
+		TimeLockDelta:             40,
+		LastUpdate:                testTime.Add(-2 * time.Hour),
+		ExtraOpaqueData:           extraData,
+	}
+	ctx.policies[chanPoint2] = &channeldb.ChannelEdgePolicy{
+		FeeBaseMAtoms:             250,
+		FeeProportionalMillionths: 340,
+		TimeLockDelta:             40,
+		LastUpdate:                testTime.Add(-2 * time.Hour),
+	}
+	ctx.policies[chanPoint3] = &channeldb.ChannelEdgePolicy{
+		TimeLockDelta: 40,
+		LastUpdate:    testTime.Add(-30 * time.Minute),
+	}
+
+	ctx.forwards = []channeldb.ForwardingEvent{{
+		Timestamp:      testTime.Add(-time.Hour),
+		IncomingChanID: lnwire.NewShortChanIDFromInt(2),
+		OutgoingChanID: lnwire.NewShortChanIDFromInt(1),
+		AmtIn:          250001000,
+		AmtOut:         250000000,
+	}}
+
+	ctx.manager = New(&Config{
+		FetchChannels: func() ([]*channeldb.OpenChannel, error) {
+			return ctx.channels, nil
+		},
+		ForAllOutgoingChannels: ctx.forAllOutgoingChannels,
+		QueryForwards:          ctx.queryForwards,
+		UpdatePolicy:           ctx.updatePolicy,
+		Store:                  ctx.store,
+		Ticker:                 ticker.NewForce(time.Hour),
+		Clock:                  ctx.clock,
+		MinBaseFee:             0,
+		MaxBaseFee:             1000,
+		MinFeeRate:             100,
+		MaxFeeRate:             1100,
+		FlowWindow:             24 * time.Hour,
+		UpdateInterval:         time.Hour,
+		ChangeThreshold:        0.1,
+		ManualHold:             2 * time.Hour,
+	})
+
+	return ctx
+}
+
+func (c *testContext) forAllOutgoingChannels(cb func(*channeldb.ChannelEdgeInfo,
+	*channeldb.ChannelEdgePolicy) error) error {
+
+	for _, channel := range c.channels {
+		info := &channeldb.ChannelEdgeInfo{
+			ChannelPoint: channel.FundingOutpoint,
+		}
+		err := cb(info, c.policies[channel.FundingOutpoint])
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *testContext) queryForwards(q channeldb.ForwardingEventQuery) (
+	channeldb.ForwardingLogTimeSlice, error) {
+
+	now := c.clock.Now()
+	if !q.EndTime.Equal(now) || !q.StartTime.Equal(now.Add(-24*time.Hour)) {
+		c.t.Fatalf("unexpected forwarding query %v - %v", q.StartTime,
+			q.EndTime)
+	}
+
+	return channeldb.ForwardingLogTimeSlice{
+		ForwardingEventQuery: q,
+		ForwardingEvents:     c.forwards,
+		LastIndexOffset:      uint32(len(c.forwards)),
+	}, nil
+}
+
+// updatePolicy records the applied policy and updates the channel's policy
+// like the local channel manager would.
+func (c *testContext) updatePolicy(policy routing.ChannelPolicy,
+	chanPoints ...wire.OutPoint) error {
+
+	if len(chanPoints) != 1 {
+		c.t.Fatalf("expected policy update of a single channel, got %v",
+			len(chanPoints))
+	}
+
+	edge := c.policies[chanPoints[0]]
+	edge.FeeBaseMAtoms = policy.BaseFee
+	edge.FeeProportionalMillionths = lnwire.MilliAtom(policy.FeeRate)
+	edge.LastUpdate = c.clock.Now()
+
+	c.updates[chanPoints[0]] = policy
+	return nil
+}
+
+// assertUpdates asserts that the given policies were applied since the last
+// call.
+func (c *testContext) assertUpdates(
+	expected map[wire.OutPoint]routing.ChannelPolicy) {
+
+	c.t.Helper()
+
+	if len(c.updates) != len(expected) {
+		c.t.Fatalf("expected %v policy updates, got %v", len(expected),
+			len(c.updates))
+	}
+	for chanPoint, policy := range expected {
+		update, ok := c.updates[chanPoint]
+		if !ok {
+			c.t.Fatalf("expected policy update of %v", chanPoint)
+		}
+		if update.FeeSchema != policy.FeeSchema ||
+			update.TimeLockDelta != policy.TimeLockDelta {
+
+			c.t.Fatalf("expected policy %v for %v, got %v", policy,
+				chanPoint, update)
+		}
+	}
+
+	c.updates = make(map[wire.OutPoint]routing.ChannelPolicy)
+}
+
+// assertStatus asserts the target fees of a channel and whether an update is
+// pending.
+func (c *testContext) assertStatus(chanPoint wire.OutPoint,
+	targetBaseFee lnwire.MilliAtom, targetFeeRate uint32, pending bool) {
+
+	c.t.Helper()
+
+	statuses, err := c.manager.ChannelStatuses()
+	if err != nil {
+		c.t.Fatalf("unable to fetch statuses: %v", err)
+	}
+
+	for _, status := range statuses {
+		if status.ChanPoint != chanPoint {
+			continue
+		}
+
+		if status.TargetBaseFee != targetBaseFee ||
+			status.TargetFeeRate != targetFeeRate {
+
+			c.t.Fatalf("expected target fees %v/%v for %v, got "+
+				"%v/%v", targetBaseFee, targetFeeRate,
+				chanPoint, status.TargetBaseFee,
+				status.TargetFeeRate)
+		}
+		if status.UpdatePending != pending {
+			c.t.Fatalf("expected pending update %v for %v",
+				pending, chanPoint)
+		}
+		return
+	}
+
+	c.t.Fatalf("no status for %v", chanPoint)
+}
+
+// assertHeld asserts whether a channel is held after a manual update.
+func (c *testContext) assertHeld(chanPoint wire.OutPoint, held bool) {
+	c.t.Helper()
+
+	statuses, err := c.manager.ChannelStatuses()
+	if err != nil {
+		c.t.Fatalf("unable to fetch statuses: %v", err)
+	}
+
+	for _, status := range statuses {
+		if status.ChanPoint == chanPoint {
+			if status.HeldUntil.IsZero() == held {
+				c.t.Fatalf("expected held %v for %v, got "+
+					"held until %v", held, chanPoint,
+					status.HeldUntil)
+			}
+			return
+		}
+	}
+
+	c.t.Fatalf("no status for %v", chanPoint)
+}
+
+// TestFeeManagerEvaluate tests that the fees of channels are set according to
+// their projected balance, and that insignificant and too frequent updates
+// are skipped.
+func TestFeeManagerEvaluate(t *testing.T) {
+	ctx := newTestContext(t)
+
+	statuses, err := ctx.manager.ChannelStatuses()
+	if err != nil {
+		t.Fatalf("unable to fetch statuses: %v", err)
+	}
+	if len(statuses) != 3 {
+		t.Fatalf("expected 3 channels with a policy, got %v",
+			len(statuses))
+	}
+	if statuses[0].ProjectedBalance != 250000000 {
+		t.Fatalf("expected projected balance 250000000, got %v",
+			statuses[0].ProjectedBalance)
+	}
+
+	// The first channel is projected to have a quarter of its capacity
+	// left, so it is priced at three quarters of the fee range.
+	ctx.assertStatus(chanPoint1, 750, 850, true)
+
+	// The second channel is close enough to its target fees.
+	ctx.assertStatus(chanPoint2, 250, 350, false)
+
+	// The third channel is drained, but was updated too recently.
+	ctx.assertStatus(chanPoint3, 1000, 1100, false)
+
+	// Only the first channel is updated, retaining its inbound fee and
+	// time lock delta.
+	ctx.manager.evaluate()
+	ctx.assertUpdates(map[wire.OutPoint]routing.ChannelPolicy{
+		chanPoint1: {
+			FeeSchema: routing.FeeSchema{
+				BaseFee:    750,
+				FeeRate:    850,
+				InboundFee: testInboundFee,
+			},
+			TimeLockDelta: 40,
+		},
+	})
+	ctx.assertStatus(chanPoint1, 750, 850, false)
+
+	// Once the update interval has passed, the third channel is updated
+	// too.
+	ctx.clock.SetTime(testTime.Add(time.Hour))
+	ctx.manager.evaluate()
+	ctx.assertUpdates(map[wire.OutPoint]routing.ChannelPolicy{
+		chanPoint3: {
+			FeeSchema: routing.FeeSchema{
+				BaseFee: 1000,
+				FeeRate: 1100,
+			},
+			TimeLockDelta: 40,
+		},
+	})
+}
+
+// TestFeeManagerOverrides tests that overrides and manual updates take
+// precedence over the decisions of the fee manager.
+func TestFeeManagerOverrides(t *testing.T) {
+	ctx := newTestContext(t)
+
+	// Overriding an unknown channel fails.
+	err := ctx.manager.SetOverride(chanPoint4, &channeldb.FeeOverride{
+		Mode: channeldb.FeeOverrideExclude,
+	})
+	if err != ErrChannelNotFound {
+		t.Fatalf("expected channel not found, got %v", err)
+	}
+
+	// Excluded channels keep their fees.
+	err = ctx.manager.SetOverride(chanPoint1, &channeldb.FeeOverride{
+		Mode: channeldb.FeeOverrideExclude,
+	})
+	if err != nil {
+		t.Fatalf("unable to set override: %v", err)
+	}
+	ctx.assertStatus(chanPoint1, 1000, 1, false)
+
+	// Pinned fees are applied right away, regardless of the update
+	// interval.
+	err = ctx.manager.SetOverride(chanPoint3, &channeldb.FeeOverride{
+		Mode:    channeldb.FeeOverridePin,
+		BaseFee: 5,
+		FeeRate: 6,
+	})
+	if err != nil {
+		t.Fatalf("unable to set override: %v", err)
+	}
+	ctx.assertUpdates(map[wire.OutPoint]routing.ChannelPolicy{
+		chanPoint3: {
+			FeeSchema: routing.FeeSchema{
+				BaseFee: 5,
+				FeeRate: 6,
+			},
+			TimeLockDelta: 40,
+		},
+	})
+	ctx.assertStatus(chanPoint3, 5, 6, false)
+
+	if len(ctx.store.overrides) != 2 {
+		t.Fatalf("expected 2 stored overrides, got %v",
+			len(ctx.store.overrides))
+	}
+
+	// A manual update of the second channel holds it until the hold time
+	// has passed.
+	ctx.manager.NotifyManualUpdate(chanPoint2)
+	ctx.assertHeld(chanPoint2, true)
+	ctx.assertHeld(chanPoint1, false)
+
+	ctx.clock.SetTime(testTime.Add(3 * time.Hour))
+	ctx.assertHeld(chanPoint2, false)
+
+	// A global manual update holds all channels, which keeps the first
+	// channel from being updated after it is no longer excluded, until its
+	// override is removed.
+	ctx.manager.NotifyManualUpdate()
+	ctx.assertHeld(chanPoint1, true)
+	ctx.assertHeld(chanPoint2, true)
+
+	if err := ctx.manager.SetOverride(chanPoint1, nil); err != nil {
+		t.Fatalf("unable to remove override: %v", err)
+	}
+	ctx.assertHeld(chanPoint1, false)
+	ctx.assertStatus(chanPoint1, 750, 850, true)
+
+	ctx.manager.evaluate()
+	ctx.assertUpdates(map[wire.OutPoint]routing.ChannelPolicy{
+		chanPoint1: {
+			FeeSchema: routing.FeeSchema{
+				BaseFee:    750,
+				FeeRate:    850,
+				InboundFee: testInboundFee,
+			},
+			TimeLockDelta: 40,
+		},
+	})
+
+	// The override of a channel is removed once it is closed.
+	ctx.channels = ctx.channels[:2]
+	if _, err := ctx.manager.ChannelStatuses(); err != nil {
+		t.Fatalf("unable to fetch statuses: %v", err)
+	}
+	if len(ctx.store.overrides) != 0 {
+		t.Fatalf("expected no stored overrides, got %v",
+			len(ctx.store.overrides))
+	}
+}
+
+// TestFeeManagerHoldsPersisted tests that channels whose policy was updated
+// manually remain held after a restart, until the hold time has passed.
+func TestFeeManagerHoldsPersisted(t *testing.T) {
+	ctx := newTestContext(t)
+
+	ctx.manager.NotifyManualUpdate(chanPoint2)
+	if len(ctx.store.holds) != 1 {
+		t.Fatalf("expected 1 stored hold, got %v", len(ctx.store.holds))
+	}
+
+	// Restart the fee manager, which must load the hold from the store.
+	ctx.manager = New(ctx.manager.cfg)
+	if err := ctx.manager.Start(); err != nil {
+		t.Fatalf("unable to start fee manager: %v", err)
+	}
+	ctx.manager.Stop()
+
+	ctx.assertHeld(chanPoint2, true)
+	ctx.assertHeld(chanPoint1, false)
+
+	// Once the hold has expired, it is removed from the store as well.
+	ctx.clock.SetTime(testTime.Add(3 * time.Hour))
+	ctx.assertHeld(chanPoint2, false)
+	if len(ctx.store.holds) != 0 {
+		t.Fatalf("expected no stored holds, got %v",
+			len(ctx.store.holds))
+	}
+}
@@ -0,0 +1,517 @@
+package funding
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
+	"github.com/decred/dcrlnd/labels"
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/lnwallet/chainfee"
+	"github.com/decred/dcrlnd/sweep"
+)
+
+var (
+	// BatchLockID is the binary representation of the SHA256 of the string
+	// "dcrlnd-batch-funding-lock-id" and is used for UTXO lock leases to
+	// identify the outputs locked by the batch funding process.
+	BatchLockID = lnwallet.LockID{
+		0x3f, 0x58, 0xb2, 0x38, 0x3c, 0xdb, 0x1f, 0xec,
+		0x78, 0xc3, 0xbd, 0x87, 0xb1, 0x84, 0x6b, 0x82,
+		0xda, 0x1f, 0x27, 0x7c, 0x6e, 0xe3, 0x3f, 0xdb,
+		0x3e, 0xec, 0x58, 0x6c, 0x55, 0xc8, 0x1d, 0xde,
+	}
+
+	// ErrEmptyBatch is returned when a batch funding request doesn't
+	// contain any channels.
+	ErrEmptyBatch = errors.New("batch doesn't contain any channels")
+
+	// errShuttingDown is returned when the batch funding process is
+	// aborted because the daemon is shutting down.
+	errShuttingDown = errors.New("daemon shutting down")
+)
+
+// ChannelOpener starts the funding flow of a single channel as described by
+// the given open channel request. The returned channels deliver the status
+// updates and the final error of the funding flow. An error is returned if the
+// funding flow could not be started at all.
+type ChannelOpener func(*lnrpc.OpenChannelRequest) (chan *lnrpc.OpenStatusUpdate,
+	chan error, error)
+
+// ChannelAbandoner removes all state of a channel that was fully negotiated
+// with the remote peer but whose funding transaction was never published.
+type ChannelAbandoner func(*wire.OutPoint) error
+
+// Wallet is the subset of the wallet functionality the batch funding process
+// needs to fund, sign and publish the batch transaction and to drive the PSBT
+// funding intents of the individual channels.
+type Wallet interface {
+	// FundPsbt funds the given PSBT packet with inputs of the wallet and
+	// adds a change output if necessary.
+	FundPsbt(packet *psbt.Packet, minConfs int32,
+		feeRate chainfee.AtomPerKByte) (int32, error)
+
+	// FinalizePsbt signs and finalizes all inputs of the packet that
+	// belong to the wallet.
+	FinalizePsbt(packet *psbt.Packet) error
+
+	// LeaseOutput locks an output to the given ID, preventing it from
+	// being available for any future coin selection.
+	LeaseOutput(id lnwallet.LockID, op wire.OutPoint) (time.Time, error)
+
+	// ReleaseOutput unlocks an output, allowing it to be available for
+	// coin selection if it remains unspent.
+	ReleaseOutput(id lnwallet.LockID, op wire.OutPoint) error
+
+	// WithCoinSelectLock will execute the passed function closure in a
+	// synchronized manner preventing any coin selection operations from
+	// proceeding while the closure is executing.
+	WithCoinSelectLock(f func() error) error
+
+	// PsbtFundingVerify verifies the PSBT for the funding intent with the
+	// given pending channel ID.
+	PsbtFundingVerify(pid [32]byte, packet *psbt.Packet) error
+
+	// PsbtFundingFinalize finalizes the funding intent with the given
+	// pending channel ID, allowing the funding flow to continue.
+	PsbtFundingFinalize(pid [32]byte, packet *psbt.Packet,
+		rawTx *wire.MsgTx) error
+
+	// CancelFundingIntent cancels the funding intent with the given
+	// pending channel ID, which fails the funding flow with the remote
+	// peer.
+	CancelFundingIntent(pid [32]byte) error
+
+	// PublishTransaction performs cursory validation (dust checks, etc),
+	// then finally broadcasts the passed transaction to the Decred network.
+	PublishTransaction(tx *wire.MsgTx, label string) error
+}
+
+// BatchConfig is the configuration of a Batcher.
+type BatchConfig struct {
+	// ChannelOpener is used to start the funding flow of each channel of
+	// the batch.
+	ChannelOpener ChannelOpener
+
+	// ChannelAbandoner is used to remove channels that were already fully
+	// negotiated with the remote peer if the batch fails before the
+	// funding transaction is published.
+	ChannelAbandoner ChannelAbandoner
+
+	// Wallet is the wallet used to fund, sign and publish the batch
+	// transaction.
+	Wallet Wallet
+
+	// FeeEstimator is used to determine the fee rate of the batch
+	// transaction if a confirmation target is requested.
+	FeeEstimator chainfee.Estimator
+
+	// NetParams are the parameters of the network the channels are opened
+	// on.
+	NetParams *chaincfg.Params
+
+	// Quit is closed when the daemon is shutting down.
+	Quit <-chan struct{}
+}
+
+// batchChannel is a single channel of a batch funding process.
+type batchChannel struct {
+	// pendingChanID identifies the funding flow of the channel.
+	pendingChanID [32]byte
+
+	// fundingAmt is the amount the channel funding output must have.
+	fundingAmt int64
+
+	// updateChan and errChan deliver the status updates and the final
+	// error of the funding flow. They are nil if the funding flow was
+	// never started.
+	updateChan chan *lnrpc.OpenStatusUpdate
+	errChan    chan error
+
+	// fundingOutput is the channel funding output. It is known once the
+	// funding keys were negotiated with the remote peer.
+	fundingOutput *wire.TxOut
+
+	// chanPoint is the channel point of the channel. It is only set once
+	// the channel is pending.
+	chanPoint *wire.OutPoint
+
+	// isPending is true once the funding flow with the remote peer has
+	// completed and the channel only waits for the funding transaction to
+	// be published.
+	isPending bool
+}
+
+// processPsbtUpdate extracts the channel funding output from the update that
+// signals that the funding flow waits for the funding transaction.
+func (c *batchChannel) processPsbtUpdate(u *lnrpc.ReadyForPsbtFunding,
+	netParams *chaincfg.Params) error {
+
+	if u.FundingAmount != c.fundingAmt {
+		return fmt.Errorf("invalid funding amount %d, expected %d",
+			u.FundingAmount, c.fundingAmt)
+	}
+
+	addr, err := stdaddr.DecodeAddress(u.FundingAddress, netParams)
+	if err != nil {
+		return fmt.Errorf("invalid funding address %s: %v",
+			u.FundingAddress, err)
+	}
+	pkScript, err := input.PayToAddrScript(addr)
+	if err != nil {
+		return err
+	}
+
+	c.fundingOutput = wire.NewTxOut(u.FundingAmount, pkScript)
+	return nil
+}
+
+// processPendingUpdate records the channel point of the channel once the
+// funding flow with the remote peer has completed.
+func (c *batchChannel) processPendingUpdate(u *lnrpc.PendingUpdate) error {
+	hash, err := chainhash.NewHash(u.Txid)
+	if err != nil {
+		return err
+	}
+
+	c.chanPoint = wire.NewOutPoint(hash, u.OutputIndex, wire.TxTreeRegular)
+	c.isPending = true
+	return nil
+}
+
+// Batcher opens several channels to different peers with a single funding
+// transaction. Either all channels of the batch are opened or the funding
+// flows of all of them are rolled back.
+//
+// NOTE: A Batcher can only be used for a single batch.
+type Batcher struct {
+	cfg *BatchConfig
+
+	channels    []*batchChannel
+	lockedUTXOs []wire.OutPoint
+	didPublish  bool
+}
+
+// NewBatcher returns a new batch channel funding helper.
+func NewBatcher(cfg *BatchConfig) *Batcher {
+	return &Batcher{
+		cfg: cfg,
+	}
+}
+
+// BatchFund runs the funding flows of all channels in the request in
+// parallel, funds a single transaction that creates the funding outputs of
+// all channels and publishes it once every remote peer has signed the initial
+// commitment of its channel. If any of the funding flows fails, all other
+// flows are canceled, channels that were already negotiated are abandoned and
+// the locked wallet outputs are released.
+func (b *Batcher) BatchFund(ctx context.Context,
+	req *lnrpc.BatchOpenChannelRequest) ([]*lnrpc.PendingUpdate, error) {
+
+	if len(req.Channels) == 0 {
+		return nil, ErrEmptyBatch
+	}
+
+	label, err := labels.ValidateAPI(req.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	// The funding flows of the individual channels don't do any coin
+	// selection, so the fee and confirmation parameters only apply to the
+	// batch transaction.
+	feeRate, err := sweep.DetermineFeePerKB(
+		b.cfg.FeeEstimator, sweep.FeePreference{
+			ConfTarget: uint32(req.TargetConf),
+			FeeRate:    chainfee.AtomPerKByte(req.AtomsPerByte * 1000),
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	minConfs := req.MinConfs
+	switch {
+	case minConfs < 0:
+		return nil, fmt.Errorf("min confirmations must be >= 0")
+
+	case req.SpendUnconfirmed:
+		minConfs = 0
+
+	case minConfs == 0:
+		minConfs = 1
+	}
+
+	// Before starting any funding flow, we make sure every channel has a
+	// unique pending channel ID, so we can tell the flows apart.
+	pendingIDs := make(map[[32]byte]struct{}, len(req.Channels))
+	for idx, rpcChannel := range req.Channels {
+		c := &batchChannel{
+			fundingAmt: rpcChannel.LocalFundingAmount,
+		}
+
+		switch len(rpcChannel.PendingChanId) {
+		case 0:
+			if _, err := rand.Read(c.pendingChanID[:]); err != nil {
+				return nil, err
+			}
+
+		case 32:
+			copy(c.pendingChanID[:], rpcChannel.PendingChanId)
+
+		default:
+			return nil, fmt.Errorf("invalid pending channel ID "+
+				"length %d of channel %d, must be 32",
+				len(rpcChannel.PendingChanId), idx)
+		}
+
+		if _, ok := pendingIDs[c.pendingChanID]; ok {
+			return nil, fmt.Errorf("duplicate pending channel ID "+
+				"%x", c.pendingChanID[:])
+		}
+		pendingIDs[c.pendingChanID] = struct{}{}
+
+		b.channels = append(b.channels, c)
+	}
+
+	// From here on, every failure must roll back all funding flows that
+	// were started so far.
+	defer func() {
+		if !b.didPublish {
+			b.cleanup()
+		}
+	}()
+
+	// Start the funding flows of all channels. Each of them is driven by a
+	// PSBT shim that doesn't publish the funding transaction, as we'll do
+	// that ourselves once all channels are ready.
+	for idx, rpcChannel := range req.Channels {
+		c := b.channels[idx]
+
+		log.Debugf("Starting funding flow for channel %d of batch, "+
+			"pending_id=%x", idx, c.pendingChanID[:])
+
+		c.updateChan, c.errChan, err = b.cfg.ChannelOpener(
+			&lnrpc.OpenChannelRequest{
+				NodePubkey:         rpcChannel.NodePubkey,
+				LocalFundingAmount: rpcChannel.LocalFundingAmount,
+				PushAtoms:          rpcChannel.PushAtoms,
+				Private:            rpcChannel.Private,
+				MinHtlcMAtoms:      rpcChannel.MinHtlcMAtoms,
+				RemoteCsvDelay:     rpcChannel.RemoteCsvDelay,
+				CloseAddress:       rpcChannel.CloseAddress,
+				FundingShim: &lnrpc.FundingShim{
+					Shim: &lnrpc.FundingShim_PsbtShim{
+						PsbtShim: &lnrpc.PsbtShim{
+							PendingChanId: c.pendingChanID[:],
+							NoPublish:     true,
+						},
+					},
+				},
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error opening channel %d: %v",
+				idx, err)
+		}
+	}
+
+	// Wait until the funding output of every channel is known.
+	for idx, c := range b.channels {
+		if err := b.waitForUpdate(ctx, c, true); err != nil {
+			return nil, fmt.Errorf("error negotiating channel %d: "+
+				"%w", idx, err)
+		}
+	}
+
+	// We can now create the batch transaction that pays to all funding
+	// outputs and fund it with coins of our wallet. The inputs are locked
+	// while still holding the coin selection lock, so no other process
+	// can select them in the meantime.
+	txOuts := make([]*wire.TxOut, len(b.channels))
+	for idx, c := range b.channels {
+		txOuts[idx] = c.fundingOutput
+	}
+	packet, err := psbt.New(nil, txOuts, wire.TxVersion, 0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create batch PSBT: %v", err)
+	}
+
+	err = b.cfg.Wallet.WithCoinSelectLock(func() error {
+		_, err := b.cfg.Wallet.FundPsbt(packet, minConfs, feeRate)
+		if err != nil {
+			return fmt.Errorf("unable to fund batch PSBT: %w", err)
+		}
+
+		for _, txIn := range packet.UnsignedTx.TxIn {
+			op := txIn.PreviousOutPoint
+			_, err := b.cfg.Wallet.LeaseOutput(BatchLockID, op)
+			if err != nil {
+				return fmt.Errorf("unable to lock UTXO %v: %w",
+					op, err)
+			}
+			b.lockedUTXOs = append(b.lockedUTXOs, op)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Every funding intent needs to verify the funded transaction before
+	// we sign it.
+	for idx, c := range b.channels {
+		err := b.cfg.Wallet.PsbtFundingVerify(c.pendingChanID, packet)
+		if err != nil {
+			return nil, fmt.Errorf("unable to verify PSBT for "+
+				"channel %d: %v", idx, err)
+		}
+	}
+
+	if err := b.cfg.Wallet.FinalizePsbt(packet); err != nil {
+		return nil, fmt.Errorf("unable to sign batch PSBT: %v", err)
+	}
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract batch transaction: "+
+			"%v", err)
+	}
+
+	// With the signed transaction at hand, all funding flows can continue
+	// and exchange the commitment signatures with the remote peers.
+	for idx, c := range b.channels {
+		err := b.cfg.Wallet.PsbtFundingFinalize(
+			c.pendingChanID, nil, finalTx,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to finalize PSBT for "+
+				"channel %d: %v", idx, err)
+		}
+	}
+
+	// Only once every channel is pending is it safe to publish the batch
+	// transaction.
+	txHash := finalTx.TxHash()
+	for idx, c := range b.channels {
+		if err := b.waitForUpdate(ctx, c, false); err != nil {
+			return nil, fmt.Errorf("error completing channel %d: "+
+				"%w", idx, err)
+		}
+
+		if c.chanPoint.Hash != txHash {
+			return nil, fmt.Errorf("channel %d pending with "+
+				"unexpected channel point %v", idx, c.chanPoint)
+		}
+	}
+
+	log.Infof("Publishing batch funding transaction %v for %d channels",
+		txHash, len(b.channels))
+
+	if err := b.cfg.Wallet.PublishTransaction(finalTx, label); err != nil {
+		return nil, fmt.Errorf("unable to publish batch transaction: "+
+			"%v", err)
+	}
+	b.didPublish = true
+
+	pendingUpdates := make([]*lnrpc.PendingUpdate, len(b.channels))
+	for idx, c := range b.channels {
+		pendingUpdates[idx] = &lnrpc.PendingUpdate{
+			Txid:        c.chanPoint.Hash[:],
+			OutputIndex: c.chanPoint.Index,
+		}
+	}
+
+	return pendingUpdates, nil
+}
+
+// waitForUpdate waits for the next update of the funding flow of the given
+// channel. If initial is true, the update must signal that the funding output
+// is known, otherwise it must signal that the channel is pending.
+func (b *Batcher) waitForUpdate(ctx context.Context, c *batchChannel,
+	initial bool) error {
+
+	select {
+	case update := <-c.updateChan:
+		switch u := update.Update.(type) {
+		case *lnrpc.OpenStatusUpdate_PsbtFund:
+			if !initial {
+				return fmt.Errorf("unexpected PSBT funding " +
+					"update")
+			}
+
+			return c.processPsbtUpdate(u.PsbtFund, b.cfg.NetParams)
+
+		case *lnrpc.OpenStatusUpdate_ChanPending:
+			if initial {
+				return fmt.Errorf("unexpected channel " +
+					"pending update")
+			}
+
+			return c.processPendingUpdate(u.ChanPending)
+
+		default:
+			return fmt.Errorf("unexpected update type %T", u)
+		}
+
+	case err := <-c.errChan:
+		return err
+
+	case <-ctx.Done():
+		return ctx.Err()
+
+	case <-b.cfg.Quit:
+		return errShuttingDown
+	}
+}
+
+// cleanup rolls back the funding flows of all channels of a failed batch and
+// releases the outputs that were locked for the batch transaction.
+func (b *Batcher) cleanup() {
+	for _, c := range b.channels {
+		// There's nothing to roll back if the funding flow was never
+		// started.
+		if c.updateChan == nil {
+			continue
+		}
+
+		// Channels that were already negotiated with the remote peer
+		// have been stored in the database, so we need to abandon
+		// them as their funding transaction will never be published.
+		if c.isPending {
+			log.Debugf("Abandoning pending channel %v of failed "+
+				"batch", c.chanPoint)
+
+			if err := b.cfg.ChannelAbandoner(c.chanPoint); err != nil {
+				log.Errorf("Unable to abandon channel %v: %v",
+					c.chanPoint, err)
+			}
+			continue
+		}
+
+		// Canceling the funding intent fails the funding flow with the
+		// remote peer. This is expected to fail if the flow has
+		// already failed on its own.
+		err := b.cfg.Wallet.CancelFundingIntent(c.pendingChanID)
+		if err != nil {
+			log.Debugf("Unable to cancel funding intent for "+
+				"pending_id=%x: %v", c.pendingChanID[:], err)
+		}
+	}
+
+	for _, op := range b.lockedUTXOs {
+		if err := b.cfg.Wallet.ReleaseOutput(BatchLockID, op); err != nil {
+			log.Errorf("Unable to release lock on UTXO %v: %v", op,
+				err)
+		}
+	}
+}
@@ -0,0 +1,371 @@
+package funding
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errFundingFailed = errors.New("remote peer failed funding")
+
+	testFeeRate = chainfee.AtomPerKByte(10000)
+
+	testUtxo = wire.OutPoint{Index: 7}
+)
+
+// testChannel is the state of a single funding flow started by the test
+// harness.
+type testChannel struct {
+	pendingChanID [32]byte
+	fundingAddr   stdaddr.Address
+	updateChan    chan *lnrpc.OpenStatusUpdate
+	errChan       chan error
+}
+
+// testHarness implements the channel opener, the channel abandoner and the
+// wallet used by the batcher and simulates the remote peers of the funding
+// flows.
+type testHarness struct {
+	t         *testing.T
+	netParams *chaincfg.Params
+
+	// failOpenIdx, failPsbtIdx and failPendingIdx are the indexes of the
+	// channels whose funding flow fails when being started, before the
+	// funding output is known or before the channel is pending. A value
+	// of -1 means the flows don't fail.
+	failOpenIdx    int
+	failPsbtIdx    int
+	failPendingIdx int
+	failPublish    bool
+
+	channels        []*testChannel
+	canceledIntents map[[32]byte]struct{}
+	abandoned       map[wire.OutPoint]struct{}
+	leases          map[wire.OutPoint]struct{}
+	verified        map[[32]byte]struct{}
+	published       *wire.MsgTx
+}
+
+func newTestHarness(t *testing.T) *testHarness {
+	return &testHarness{
+		t:               t,
+		netParams:       chaincfg.RegNetParams(),
+		failOpenIdx:     -1,
+		failPsbtIdx:     -1,
+		failPendingIdx:  -1,
+		canceledIntents: make(map[[32]byte]struct{}),
+		abandoned:       make(map[wire.OutPoint]struct{}),
+		leases:          make(map[wire.OutPoint]struct{}),
+		verified:        make(map[[32]byte]struct{}),
+	}
+}
+
+func (h *testHarness) openChannel(req *lnrpc.OpenChannelRequest) (
+	chan *lnrpc.OpenStatusUpdate, chan error, error) {
+
+	idx := len(h.channels)
+	if idx == h.failOpenIdx {
+		return nil, nil, fmt.Errorf("invalid channel request")
+	}
+
+	psbtShim := req.FundingShim.GetPsbtShim()
+	require.NotNil(h.t, psbtShim)
+	require.True(h.t, psbtShim.NoPublish)
+
+	// Every channel gets a distinct funding address.
+	script := []byte{byte(idx), 0x51}
+	addr, err := stdaddr.NewAddressScriptHashV0(script, h.netParams)
+	require.NoError(h.t, err)
+
+	c := &testChannel{
+		fundingAddr: addr,
+		updateChan:  make(chan *lnrpc.OpenStatusUpdate, 2),
+		errChan:     make(chan error, 1),
+	}
+	copy(c.pendingChanID[:], psbtShim.PendingChanId)
+	h.channels = append(h.channels, c)
+
+	if idx == h.failPsbtIdx {
+		c.errChan <- errFundingFailed
+	} else {
+		c.updateChan <- &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_PsbtFund{
+				PsbtFund: &lnrpc.ReadyForPsbtFunding{
+					FundingAddress: addr.String(),
+					FundingAmount:  req.LocalFundingAmount,
+				},
+			},
+			PendingChanId: c.pendingChanID[:],
+		}
+	}
+
+	return c.updateChan, c.errChan, nil
+}
+
+func (h *testHarness) abandonChannel(chanPoint *wire.OutPoint) error {
+	h.abandoned[*chanPoint] = struct{}{}
+	return nil
+}
+
+func (h *testHarness) FundPsbt(packet *psbt.Packet, _ int32,
+	_ chainfee.AtomPerKByte) (int32, error) {
+
+	var outputSum int64
+	for _, txOut := range packet.UnsignedTx.TxOut {
+		outputSum += txOut.Value
+	}
+
+	packet.UnsignedTx.AddTxIn(wire.NewTxIn(&testUtxo, outputSum+1e6, nil))
+	packet.Inputs = append(packet.Inputs, psbt.PInput{
+		WitnessUtxo: wire.NewTxOut(outputSum+1e6, []byte{0x51}),
+	})
+
+	return -1, nil
+}
+
+func (h *testHarness) FinalizePsbt(packet *psbt.Packet) error {
+	for idx := range packet.Inputs {
+		packet.Inputs[idx].FinalScriptSig = []byte{0x01}
+	}
+	return nil
+}
+
+func (h *testHarness) LeaseOutput(id lnwallet.LockID,
+	op wire.OutPoint) (time.Time, error) {
+
+	require.Equal(h.t, BatchLockID, id)
+	h.leases[op] = struct{}{}
+	return time.Now().Add(lnwallet.DefaultLockDuration), nil
+}
+
+func (h *testHarness) ReleaseOutput(id lnwallet.LockID,
+	op wire.OutPoint) error {
+
+	require.Equal(h.t, BatchLockID, id)
+	delete(h.leases, op)
+	return nil
+}
+
+func (h *testHarness) WithCoinSelectLock(f func() error) error {
+	return f()
+}
+
+func (h *testHarness) PsbtFundingVerify(pid [32]byte,
+	packet *psbt.Packet) error {
+
+	h.verified[pid] = struct{}{}
+	return nil
+}
+
+// PsbtFundingFinalize simulates the remote peer signing the commitment of the
+// channel, which makes the channel pending.
+func (h *testHarness) PsbtFundingFinalize(pid [32]byte, _ *psbt.Packet,
+	rawTx *wire.MsgTx) error {
+
+	for idx, c := range h.channels {
+		if c.pendingChanID != pid {
+			continue
+		}
+		if _, ok := h.verified[pid]; !ok {
+			return fmt.Errorf("PSBT was not verified first")
+		}
+
+		if idx == h.failPendingIdx {
+			c.errChan <- errFundingFailed
+			return nil
+		}
+
+		pkScript, err := input.PayToAddrScript(c.fundingAddr)
+		require.NoError(h.t, err)
+		ok, outIdx := input.FindScriptOutputIndex(rawTx, pkScript)
+		require.True(h.t, ok)
+
+		txHash := rawTx.TxHash()
+		c.updateChan <- &lnrpc.OpenStatusUpdate{
+			Update: &lnrpc.OpenStatusUpdate_ChanPending{
+				ChanPending: &lnrpc.PendingUpdate{
+					Txid:        txHash[:],
+					OutputIndex: outIdx,
+				},
+			},
+			PendingChanId: pid[:],
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown funding intent %x", pid[:])
+}
+
+func (h *testHarness) CancelFundingIntent(pid [32]byte) error {
+	h.canceledIntents[pid] = struct{}{}
+	return nil
+}
+
+func (h *testHarness) PublishTransaction(tx *wire.MsgTx, _ string) error {
+	if h.failPublish {
+		return fmt.Errorf("transaction rejected")
+	}
+	h.published = tx
+	return nil
+}
+
+func (h *testHarness) newBatcher() *Batcher {
+	return NewBatcher(&BatchConfig{
+		ChannelOpener:    h.openChannel,
+		ChannelAbandoner: h.abandonChannel,
+		Wallet:           h,
+		FeeEstimator:     chainfee.NewStaticEstimator(testFeeRate, 0),
+		NetParams:        h.netParams,
+		Quit:             make(chan struct{}),
+	})
+}
+
+// newBatchRequest returns a request to open numChannels channels.
+func newBatchRequest(numChannels int) *lnrpc.BatchOpenChannelRequest {
+	req := &lnrpc.BatchOpenChannelRequest{
+		TargetConf: 6,
+	}
+	for i := 0; i < numChannels; i++ {
+		req.Channels = append(req.Channels, &lnrpc.BatchOpenChannel{
+			NodePubkey:         bytes.Repeat([]byte{byte(i)}, 33),
+			LocalFundingAmount: int64(i+1) * 1e6,
+		})
+	}
+
+	return req
+}
+
+// TestBatchFund tests that all channels of a batch are funded by a single
+// transaction that is only published once every channel is pending.
+func TestBatchFund(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	req := newBatchRequest(3)
+	pendingUpdates, err := h.newBatcher().BatchFund(
+		context.Background(), req,
+	)
+	require.NoError(t, err)
+
+	require.NotNil(t, h.published)
+	require.Len(t, h.published.TxOut, len(req.Channels))
+	require.Len(t, pendingUpdates, len(req.Channels))
+
+	txHash := h.published.TxHash()
+	for idx, update := range pendingUpdates {
+		require.Equal(t, txHash[:], update.Txid)
+
+		txOut := h.published.TxOut[update.OutputIndex]
+		require.Equal(
+			t, req.Channels[idx].LocalFundingAmount, txOut.Value,
+		)
+	}
+
+	// Nothing must be rolled back and the funding UTXO stays locked.
+	require.Empty(t, h.canceledIntents)
+	require.Empty(t, h.abandoned)
+	require.Contains(t, h.leases, testUtxo)
+}
+
+// TestBatchFundRollback tests that all funding flows of a batch are rolled
+// back if any of them fails.
+func TestBatchFundRollback(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+
+		setup func(h *testHarness)
+
+		// numCanceled and numAbandoned are the number of channels
+		// whose funding intent is expected to be canceled and the
+		// number of channels that are expected to be abandoned.
+		numCanceled  int
+		numAbandoned int
+	}{{
+		name: "invalid channel request",
+		setup: func(h *testHarness) {
+			h.failOpenIdx = 2
+		},
+		numCanceled: 2,
+	}, {
+		name: "funding output negotiation fails",
+		setup: func(h *testHarness) {
+			h.failPsbtIdx = 1
+		},
+		numCanceled: 3,
+	}, {
+		name: "commitment signing fails",
+		setup: func(h *testHarness) {
+			h.failPendingIdx = 2
+		},
+		numCanceled:  1,
+		numAbandoned: 2,
+	}, {
+		name: "publishing fails",
+		setup: func(h *testHarness) {
+			h.failPublish = true
+		},
+		numAbandoned: 3,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			h := newTestHarness(t)
+			tc.setup(h)
+
+			_, err := h.newBatcher().BatchFund(
+				context.Background(), newBatchRequest(3),
+			)
+			require.Error(t, err)
+
+			require.Nil(t, h.published)
+			require.Len(t, h.canceledIntents, tc.numCanceled)
+			require.Len(t, h.abandoned, tc.numAbandoned)
+			require.Empty(t, h.leases)
+		})
+	}
+}
+
+// TestBatchFundInvalidRequest tests that invalid batch requests are rejected
+// before any funding flow is started.
+func TestBatchFundInvalidRequest(t *testing.T) {
+	t.Parallel()
+
+	h := newTestHarness(t)
+	_, err := h.newBatcher().BatchFund(
+		context.Background(), newBatchRequest(0),
+	)
+	require.Equal(t, ErrEmptyBatch, err)
+
+	req := newBatchRequest(2)
+	req.Channels[0].PendingChanId = bytes.Repeat([]byte{1}, 32)
+	req.Channels[1].PendingChanId = bytes.Repeat([]byte{1}, 32)
+	_, err = h.newBatcher().BatchFund(context.Background(), req)
+	require.Error(t, err)
+
+	req = newBatchRequest(1)
+	req.Channels[0].PendingChanId = []byte{1, 2, 3}
+	_, err = h.newBatcher().BatchFund(context.Background(), req)
+	require.Error(t, err)
+
+	require.Empty(t, h.channels)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/aliasmgr"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/chanacceptor"
 	"github.com/decred/dcrlnd/channeldb"
@@ -113,6 +114,10 @@ type reservationWithCtx struct {
 	remoteMaxValue lnwire.MilliAtom
 	remoteMaxHtlcs uint16
 
+	// channelType is the explicit channel type that was negotiated for
+	// the channel, if any.
+	channelType *lnwire.ChannelType
+
 	updateMtx   sync.RWMutex
 	lastUpdated time.Time
 
@@ -377,6 +382,26 @@ type fundingConfig struct {
 	// RegisteredChains keeps track of all chains that have been registered
 	// with the daemon.
 	RegisteredChains *chainRegistry
+
+	// DeleteAliasEdge removes the graph edge of a zero-conf channel that
+	// is identified by the given alias. It is used to replace the edge
+	// with the one of the confirmed short channel id once the funding
+	// transaction of the channel confirms.
+	DeleteAliasEdge func(alias lnwire.ShortChannelID) error
+
+	// NewLocalAlias returns the alias short channel id we hand out for the
+	// private channel with the given channel id, allocating a new one if
+	// the channel doesn't have one yet.
+	NewLocalAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// PutPeerAlias stores the alias short channel id the remote peer
+	// handed out for the channel with the given channel id. We use it to
+	// refer to the channel in the route hints of our invoices.
+	PutPeerAlias func(lnwire.ChannelID, lnwire.ShortChannelID) error
+
+	// AddAliasForLink makes the switch forward HTLCs that refer to the
+	// given alias to the link of the channel with the given channel id.
+	AddAliasForLink func(lnwire.ChannelID, lnwire.ShortChannelID)
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -548,40 +573,17 @@ func (f *fundingManager) start() error {
 			// Rebroadcast the funding transaction for any pending
 			// channel that we initiated. No error will be returned
 			// if the transaction already has been broadcast.
-			chanType := channel.ChanType
-			if chanType.IsSingleFunder() && chanType.HasFundingTx() &&
-				channel.IsInitiator {
-
-				var fundingTxBuf bytes.Buffer
-				err := channel.FundingTxn.Serialize(&fundingTxBuf)
-				if err != nil {
-					fndgLog.Errorf("Unable to serialize "+
-						"funding transaction %v: %v",
-						channel.FundingTxn.TxHash(), err)
-
-					// Clear the buffer of any bytes that
-					// were written before the serialization
-					// error to prevent logging an
-					// incomplete transaction.
-					fundingTxBuf.Reset()
-				}
-
-				fndgLog.Debugf("Rebroadcasting funding tx for "+
-					"ChannelPoint(%v): %x",
-					channel.FundingOutpoint,
-					fundingTxBuf.Bytes())
-
-				err = f.cfg.PublishTransaction(
-					channel.FundingTxn, "",
-				)
-				if err != nil {
-					fndgLog.Errorf("Unable to rebroadcast "+
-						"funding tx %x for "+
-						"ChannelPoint(%v): %v",
-						fundingTxBuf.Bytes(),
-						channel.FundingOutpoint, err)
-				}
-			}
+			f.rebroadcastFundingTx(channel)
+		}
+
+		// Zero-conf channels are marked open before their funding
+		// transaction confirms, so we'll also rebroadcast the funding
+		// transaction of any zero-conf channel we initiated that isn't
+		// confirmed yet.
+		if !channel.IsPending && channel.ChanType.IsZeroConf() &&
+			!channel.ZeroConfConfirmed() {
+
+			f.rebroadcastFundingTx(channel)
 		}
 
 		// We will restart the funding state machine for all channels,
@@ -598,6 +600,39 @@ func (f *fundingManager) start() error {
 	return nil
 }
 
+// rebroadcastFundingTx republishes the funding transaction of the given channel
+// if we're the initiator of the channel and we have the funding transaction.
+func (f *fundingManager) rebroadcastFundingTx(channel *channeldb.OpenChannel) {
+	chanType := channel.ChanType
+	if !chanType.IsSingleFunder() || !chanType.HasFundingTx() ||
+		!channel.IsInitiator {
+
+		return
+	}
+
+	var fundingTxBuf bytes.Buffer
+	err := channel.FundingTxn.Serialize(&fundingTxBuf)
+	if err != nil {
+		fndgLog.Errorf("Unable to serialize funding transaction %v: %v",
+			channel.FundingTxn.TxHash(), err)
+
+		// Clear the buffer of any bytes that were written before the
+		// serialization error to prevent logging an incomplete
+		// transaction.
+		fundingTxBuf.Reset()
+	}
+
+	fndgLog.Debugf("Rebroadcasting funding tx for ChannelPoint(%v): %x",
+		channel.FundingOutpoint, fundingTxBuf.Bytes())
+
+	err = f.cfg.PublishTransaction(channel.FundingTxn, "")
+	if err != nil {
+		fndgLog.Errorf("Unable to rebroadcast funding tx %x for "+
+			"ChannelPoint(%v): %v", fundingTxBuf.Bytes(),
+			channel.FundingOutpoint, err)
+	}
+}
+
 // Stop signals all helper goroutines to execute a graceful shutdown. This
 // method will block until all goroutines have exited.
 func (f *fundingManager) Stop() error {
@@ -828,8 +863,18 @@ func (f *fundingManager) advanceFundingState(channel *channeldb.OpenChannel,
 	defer f.wg.Done()
 
 	// If the channel is still pending we must wait for the funding
-	// transaction to confirm.
-	if channel.IsPending {
+	// transaction to confirm, unless it's a zero-conf channel which we can
+	// mark as open right away.
+	switch {
+	case channel.IsPending && channel.ChanType.IsZeroConf():
+		if err := f.markZeroConfOpen(channel); err != nil {
+			fndgLog.Errorf("Unable to mark zero-conf "+
+				"ChannelPoint(%v) open: %v",
+				channel.FundingOutpoint, err)
+			return
+		}
+
+	case channel.IsPending:
 		err := f.advancePendingChannelState(channel, pendingChanID)
 		if err != nil {
 			fndgLog.Errorf("Unable to advance pending state of "+
@@ -988,6 +1033,22 @@ func (f *fundingManager) stateStep(channel *channeldb.OpenChannel,
 	// The channel was added to the Router's topology, but the channel
 	// announcement was not sent.
 	case addedToRouterGraph:
+		// A zero-conf channel is added to the graph using its alias.
+		// Before it can be announced we must wait for the funding
+		// transaction to confirm and replace the alias with the
+		// confirmed short channel id.
+		if channel.ChanType.IsZeroConf() {
+			realScid, err := f.handleZeroConfConfirmation(
+				channel, shortChanID,
+			)
+			if err != nil {
+				return fmt.Errorf("unable to handle "+
+					"confirmation of zero-conf channel: "+
+					"%v", err)
+			}
+			shortChanID = realScid
+		}
+
 		err := f.annAfterSixConfs(channel, shortChanID)
 		if err != nil {
 			return fmt.Errorf("error sending channel "+
@@ -1097,6 +1158,113 @@ func (f *fundingManager) advancePendingChannelState(
 	return nil
 }
 
+// markZeroConfOpen marks a pending zero-conf channel as open in the database
+// using its alias short channel id, without waiting for the funding
+// transaction to confirm. The channel then continues through the funding
+// state machine like any other channel.
+func (f *fundingManager) markZeroConfOpen(
+	channel *channeldb.OpenChannel) error {
+
+	fundingPoint := channel.FundingOutpoint
+	chanID := lnwire.NewChanIDFromOutPoint(&fundingPoint)
+	alias := aliasmgr.ZeroConfAlias(chanID)
+
+	fndgLog.Debugf("ChannelID(%v) is a zero-conf channel, marking it "+
+		"open with alias %v", chanID, alias)
+
+	// As with confirmed channels, we'll save the opening state before
+	// marking the channel open so we can recover from one of the writes
+	// failing.
+	err := f.saveChannelOpeningState(&fundingPoint, markedOpen, &alias)
+	if err != nil {
+		return fmt.Errorf("error setting channel state to markedOpen: %v",
+			err)
+	}
+
+	if err := channel.MarkAsOpen(alias); err != nil {
+		return fmt.Errorf("error setting channel pending flag to false: "+
+			"%v", err)
+	}
+
+	f.cfg.NotifyOpenChannelEvent(fundingPoint)
+
+	err = f.cfg.ReportShortChanID(fundingPoint)
+	if err != nil {
+		fndgLog.Errorf("unable to report short chan id: %v", err)
+	}
+
+	// Close the discoverySignal channel so the funding locked message of
+	// the peer can be processed.
+	f.localDiscoveryMtx.Lock()
+	if discoverySignal, ok := f.localDiscoverySignals[chanID]; ok {
+		close(discoverySignal)
+	}
+	f.localDiscoveryMtx.Unlock()
+
+	return nil
+}
+
+// handleZeroConfConfirmation waits for the funding transaction of a zero-conf
+// channel to confirm and replaces the alias of the channel with its confirmed
+// short channel id. The confirmed short channel id is reported to the switch
+// and the graph edge of the alias is replaced with the one of the confirmed
+// short channel id. All steps are idempotent, so the method can safely be
+// called again after a restart. The confirmed short channel id is returned.
+func (f *fundingManager) handleZeroConfConfirmation(
+	channel *channeldb.OpenChannel,
+	alias *lnwire.ShortChannelID) (*lnwire.ShortChannelID, error) {
+
+	fundingPoint := channel.FundingOutpoint
+
+	if !channel.ZeroConfConfirmed() {
+		confChannel, err := f.waitForFundingWithTimeout(channel)
+		if err != nil {
+			return nil, fmt.Errorf("error waiting for funding "+
+				"confirmation for ChannelPoint(%v): %v",
+				fundingPoint, err)
+		}
+
+		err = f.cfg.Wallet.ValidateChannel(
+			channel, confChannel.fundingTx,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to validate channel: "+
+				"%v", err)
+		}
+
+		err = channel.MarkRealScid(confChannel.shortChanID)
+		if err != nil {
+			return nil, fmt.Errorf("unable to store confirmed "+
+				"short chan id: %v", err)
+		}
+	}
+
+	realScid := channel.ZeroConfRealScid()
+	fndgLog.Infof("Zero-conf ChannelPoint(%v) confirmed, replacing alias "+
+		"%v with short_chan_id=%v", fundingPoint, alias, realScid)
+
+	// Let the switch know about the confirmed short chan id, so the link
+	// can also be found by it.
+	if err := f.cfg.ReportShortChanID(fundingPoint); err != nil {
+		fndgLog.Errorf("unable to report short chan id: %v", err)
+	}
+
+	// The edge of the alias must be removed before the edge of the
+	// confirmed short chan id is added, as both share the same funding
+	// outpoint.
+	err := f.cfg.DeleteAliasEdge(*alias)
+	if err != nil && err != channeldb.ErrEdgeNotFound {
+		return nil, fmt.Errorf("unable to delete alias edge: %v", err)
+	}
+
+	if err := f.addToRouterGraph(channel, &realScid); err != nil {
+		return nil, fmt.Errorf("failed adding to router graph: %v",
+			err)
+	}
+
+	return &realScid, nil
+}
+
 // handlePendingChannels responds to a request for details concerning all
 // currently pending channels waiting for the final phase of the funding
 // workflow (funding txn confirmation).
@@ -1171,6 +1339,34 @@ func commitmentType(localFeatures,
 	return lnwallet.CommitmentTypeLegacy
 }
 
+// zeroConfChannelType returns the explicit channel type of a zero-conf channel
+// that uses the given commitment type.
+func zeroConfChannelType(
+	commitType lnwallet.CommitmentType) *lnwire.ChannelType {
+
+	switch commitType {
+	case lnwallet.CommitmentTypeAnchors:
+		return lnwire.NewChannelType(
+			lnwire.ZeroConfRequired, lnwire.AnchorsRequired,
+			lnwire.StaticRemoteKeyRequired,
+		)
+
+	case lnwallet.CommitmentTypeTweakless:
+		return lnwire.NewChannelType(
+			lnwire.ZeroConfRequired, lnwire.StaticRemoteKeyRequired,
+		)
+
+	default:
+		return lnwire.NewChannelType(lnwire.ZeroConfRequired)
+	}
+}
+
+// isZeroConf returns true if the given channel type denotes a zero-conf
+// channel.
+func isZeroConf(channelType *lnwire.ChannelType) bool {
+	return channelType != nil && channelType.IsSet(lnwire.ZeroConfRequired)
+}
+
 // handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
 // then responds to the source peer with an accept channel message progressing
 // the funding workflow.
@@ -1279,6 +1475,36 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// Before we init the channel, we'll also check to see if we've
+	// negotiated the new tweakless commitment format. This is only the
+	// case if *both* us and the remote peer are signaling the proper
+	// feature bit.
+	commitType := commitmentType(
+		fmsg.peer.LocalFeatures(), fmsg.peer.RemoteFeatures(),
+	)
+
+	// If the remote party requested an explicit channel type, it must be
+	// a zero-conf channel that matches the negotiated commitment type, as
+	// that is the only channel type we support. Both of us must also
+	// signal support for zero-conf channels.
+	zeroConf := isZeroConf(msg.ChannelType)
+	if msg.ChannelType != nil {
+		zeroConfSupported := fmsg.peer.LocalFeatures().HasFeature(
+			lnwire.ZeroConfOptional,
+		) && fmsg.peer.RemoteFeatures().HasFeature(
+			lnwire.ZeroConfOptional,
+		)
+		if !zeroConfSupported ||
+			!msg.ChannelType.Equal(zeroConfChannelType(commitType)) {
+
+			f.failFundingFlow(
+				fmsg.peer, fmsg.msg.PendingChannelID,
+				fmt.Errorf("unsupported channel type"),
+			)
+			return
+		}
+	}
+
 	// Send the OpenChannel request to the ChannelAcceptor to determine whether
 	// this node will accept the channel.
 	chanReq := &chanacceptor.ChannelAcceptRequest{
@@ -1286,7 +1512,8 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		OpenChanMsg: fmsg.msg,
 	}
 
-	if !f.cfg.OpenChannelPredicate.Accept(chanReq) {
+	resp := f.cfg.OpenChannelPredicate.Accept(chanReq)
+	if !resp.Accept {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			fmt.Errorf("open channel request rejected"),
@@ -1294,6 +1521,17 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		return
 	}
 
+	// Zero-conf channels must explicitly be approved by the channel
+	// acceptor, as we're trusting the remote party not to double spend
+	// the funding transaction.
+	if zeroConf && !resp.ZeroConf {
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID,
+			fmt.Errorf("zero-conf channel rejected"),
+		)
+		return
+	}
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -1311,14 +1549,6 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// reservation attempt may be rejected. Note that since we're on the
 	// responding side of a single funder workflow, we don't commit any
 	// funds to the channel ourselves.
-	//
-	// Before we init the channel, we'll also check to see if we've
-	// negotiated the new tweakless commitment format. This is only the
-	// case if *both* us and the remote peer are signaling the proper
-	// feature bit.
-	commitType := commitmentType(
-		fmsg.peer.LocalFeatures(), fmsg.peer.RemoteFeatures(),
-	)
 	chainHash := msg.ChainHash
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &chainHash,
@@ -1346,8 +1576,12 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 	// that we require before both of us consider the channel open. We'll
 	// use our mapping to derive the proper number of confirmations based on
 	// the amount of the channel, and also if any funds are being pushed to
-	// us.
+	// us. Zero-conf channels don't require any confirmations.
 	numConfsReq := f.cfg.NumRequiredConfs(msg.FundingAmount, msg.PushAmount)
+	if zeroConf {
+		numConfsReq = 0
+		reservation.SetZeroConf()
+	}
 	reservation.SetNumConfsRequired(numConfsReq)
 
 	// We'll also validate and apply all the constraints the initiating
@@ -1416,6 +1650,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		remoteMinHtlc:  minHtlc,
 		remoteMaxValue: remoteMaxValue,
 		remoteMaxHtlcs: maxHtlcs,
+		channelType:    msg.ChannelType,
 		err:            make(chan error, 1),
 		peer:           fmsg.peer,
 	}
@@ -1488,6 +1723,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		HtlcPoint:             ourContribution.HtlcBasePoint.PubKey,
 		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
 		UpfrontShutdownScript: ourContribution.UpfrontShutdown,
+		ChannelType:           msg.ChannelType,
 	}
 
 	if err := fmsg.peer.SendMessage(true, &fundingAccept); err != nil {
@@ -1542,6 +1778,29 @@ func (f *fundingManager) handleFundingAccept(fmsg *fundingAcceptMsg) {
 		return
 	}
 
+	// The responder must echo the channel type we requested, if any.
+	if !msg.ChannelType.Equal(resCtx.channelType) {
+		err := fmt.Errorf("channel type mismatch")
+		fndgLog.Warnf("Unacceptable channel type: %v", err)
+		f.failFundingFlow(fmsg.peer, fmsg.msg.PendingChannelID, err)
+		return
+	}
+
+	// A zero-conf channel must not require any confirmations.
+	if isZeroConf(resCtx.channelType) {
+		if msg.MinAcceptDepth != 0 {
+			err := fmt.Errorf("non-zero min accept depth %v for "+
+				"zero-conf channel", msg.MinAcceptDepth)
+			fndgLog.Warnf("Unacceptable channel constraints: %v",
+				err)
+			f.failFundingFlow(
+				fmsg.peer, fmsg.msg.PendingChannelID, err,
+			)
+			return
+		}
+		resCtx.reservation.SetZeroConf()
+	}
+
 	// We'll also specify the responder's preference for the number of
 	// required confirmations, and also the set of channel constraints
 	// they've specified for commitment states we can create.
@@ -2206,6 +2465,13 @@ func (f *fundingManager) waitForFundingConfirmation(
 		return
 	}
 	numConfs := uint32(completeChan.NumConfsRequired)
+
+	// Zero-conf channels don't require any confirmations to be used, but
+	// we still need the funding transaction to confirm once to learn the
+	// confirmed short channel id.
+	if numConfs == 0 {
+		numConfs = 1
+	}
 	confNtfn, err := f.cfg.Notifier.RegisterConfirmationsNtfn(
 		&txid, fundingScript, numConfs,
 		completeChan.FundingBroadcastHeight,
@@ -2448,6 +2714,11 @@ func (f *fundingManager) sendFundingLocked(
 		fndgLog.Infof("Peer(%x) is online, sending FundingLocked "+
 			"for ChannelID(%v)", peerKey, chanID)
 
+		err := f.setAliasScid(completeChan, peer, fundingLockedMsg)
+		if err != nil {
+			return err
+		}
+
 		if err := peer.SendMessage(true, fundingLockedMsg); err == nil {
 			// Sending succeeded, we can break out and continue the
 			// funding flow.
@@ -2461,6 +2732,35 @@ func (f *fundingManager) sendFundingLocked(
 	return nil
 }
 
+// setAliasScid sets the alias we hand out for the channel in the given
+// FundingLocked message if the channel is private and the peer supports
+// aliases. The peer can then refer to the channel by its alias in the route
+// hints of its invoices without revealing the funding outpoint. The alias is
+// made known to the switch before it is sent, so HTLCs referring to it can be
+// forwarded.
+func (f *fundingManager) setAliasScid(completeChan *channeldb.OpenChannel,
+	peer lnpeer.Peer, msg *lnwire.FundingLocked) error {
+
+	isPublic := completeChan.ChannelFlags&lnwire.FFAnnounceChannel != 0
+	if isPublic ||
+		!peer.RemoteFeatures().HasFeature(lnwire.ScidAliasOptional) {
+
+		return nil
+	}
+
+	alias, err := f.cfg.NewLocalAlias(msg.ChanID)
+	if err != nil {
+		return fmt.Errorf("unable to allocate alias: %v", err)
+	}
+	f.cfg.AddAliasForLink(msg.ChanID, alias)
+
+	fndgLog.Debugf("Handing out alias %v for ChannelID(%v)", alias,
+		msg.ChanID)
+
+	msg.AliasScid = &alias
+	return nil
+}
+
 // addToRouterGraph sends a ChannelAnnouncement and a ChannelUpdate to the
 // gossiper so that the channel is added to the Router's internal graph.
 // These announcement messages are NOT broadcasted to the greater network,
@@ -2749,6 +3049,20 @@ func (f *fundingManager) handleFundingLocked(fmsg *fundingLockedMsg) {
 		return
 	}
 
+	// If the peer handed out an alias for the channel, we'll store it so
+	// we can refer to the channel by it in the route hints of our
+	// invoices. We do this before checking for duplicates, as the peer
+	// resends the alias along with the FundingLocked message on
+	// reconnection.
+	if fmsg.msg.AliasScid != nil {
+		err := f.cfg.PutPeerAlias(chanID, *fmsg.msg.AliasScid)
+		if err != nil {
+			fndgLog.Errorf("Unable to store alias of ChannelID(%v): "+
+				"%v", chanID, err)
+			return
+		}
+	}
+
 	// If the RemoteNextRevocation is non-nil, it means that we have
 	// already processed fundingLocked for this channel, so ignore.
 	if channel.RemoteNextRevocation != nil {
@@ -3181,6 +3495,21 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 	commitType := commitmentType(
 		msg.peer.LocalFeatures(), msg.peer.RemoteFeatures(),
 	)
+
+	// A zero-conf channel can only be opened if the remote peer signals
+	// support for it, in which case we'll request it through an explicit
+	// channel type.
+	var channelType *lnwire.ChannelType
+	if msg.openChanReq.zeroConf {
+		remoteFeatures := msg.peer.RemoteFeatures()
+		if !remoteFeatures.HasFeature(lnwire.ZeroConfOptional) {
+			msg.err <- fmt.Errorf("peer does not support " +
+				"zero-conf channels")
+			return
+		}
+		channelType = zeroConfChannelType(commitType)
+	}
+
 	req := &lnwallet.InitFundingReserveMsg{
 		ChainHash:        &msg.chainHash,
 		PendingChanID:    chanID,
@@ -3252,6 +3581,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		remoteMinHtlc:  minHtlcIn,
 		remoteMaxValue: maxValue,
 		remoteMaxHtlcs: maxHtlcs,
+		channelType:    channelType,
 		reservation:    reservation,
 		peer:           msg.peer,
 		updates:        msg.updates,
@@ -3295,6 +3625,7 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		FirstCommitmentPoint:  ourContribution.FirstCommitmentPoint,
 		ChannelFlags:          channelFlags,
 		UpfrontShutdownScript: shutdown,
+		ChannelType:           channelType,
 	}
 	if err := msg.peer.SendMessage(true, &fundingOpen); err != nil {
 		e := fmt.Errorf("unable to send funding request message: %v",
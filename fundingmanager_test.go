@@ -3367,3 +3367,53 @@ func TestWumboChannelConfig(t *testing.T) {
 	bob.fundingMgr.processFundingOpen(openChanMsg, alice)
 	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
 }
+
+// TestFundingManagerZeroConfUnsupported tests that zero-conf channels are
+// neither requested from nor accepted by peers that don't support them.
+func TestFundingManagerZeroConfUnsupported(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := setupFundingManagers(t)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	// Bob doesn't signal support for zero-conf channels, so Alice must
+	// refuse to request one.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMAtomsFromAtoms(0),
+		private:         true,
+		zeroConf:        true,
+		updates:         updateChan,
+		err:             errChan,
+	}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	select {
+	case err := <-errChan:
+		if err == nil {
+			t.Fatalf("expected zero-conf request to fail")
+		}
+	case msg := <-alice.msgChan:
+		t.Fatalf("alice unexpectedly sent %T", msg)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("zero-conf request was not rejected")
+	}
+
+	// Now Bob signals support for zero-conf channels, so Alice requests
+	// one. Bob's funding manager doesn't support them though, so he must
+	// reject the channel.
+	bob.remoteFeatures = []lnwire.FeatureBit{lnwire.ZeroConfOptional}
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+	openChanMsg := expectOpenChannelMsg(t, alice.msgChan)
+	if !isZeroConf(openChanMsg.ChannelType) {
+		t.Fatalf("expected zero-conf channel type, got %v",
+			openChanMsg.ChannelType)
+	}
+
+	bob.fundingMgr.processFundingOpen(openChanMsg, alice)
+	assertErrorSent(t, bob.msgChan)
+}
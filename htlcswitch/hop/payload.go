@@ -86,6 +86,10 @@ type Payload struct {
 	// a TLV onion payload.
 	MPP *record.MPP
 
+	// AMP holds the info provided in an option_amp record when parsed from
+	// a TLV onion payload.
+	AMP *record.AMP
+
 	// customRecords are user-defined records in the custom type range that
 	// were included in the payload.
 	customRecords record.CustomSet
@@ -115,6 +119,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		amt  uint64
 		cltv uint32
 		mpp  = &record.MPP{}
+		amp  = &record.AMP{}
 	)
 
 	tlvStream, err := tlv.NewStream(
@@ -122,6 +127,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		record.NewLockTimeRecord(&cltv),
 		record.NewNextHopIDRecord(&cid),
 		mpp.Record(),
+		amp.Record(),
 	)
 	if err != nil {
 		return nil, err
@@ -156,6 +162,12 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 		mpp = nil
 	}
 
+	// If no AMP field was parsed, set the AMP field on the resulting
+	// payload to nil.
+	if _, ok := parsedTypes[record.AMPOnionType]; !ok {
+		amp = nil
+	}
+
 	// Filter out the custom records.
 	customRecords := NewCustomRecords(parsedTypes)
 
@@ -167,6 +179,7 @@ func NewPayloadFromReader(r io.Reader) (*Payload, error) {
 			OutgoingCTLV:    cltv,
 		},
 		MPP:           mpp,
+		AMP:           amp,
 		customRecords: customRecords,
 	}, nil
 }
@@ -203,6 +216,7 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 	_, hasLockTime := parsedTypes[record.LockTimeOnionType]
 	_, hasNextHop := parsedTypes[record.NextHopOnionType]
 	_, hasMPP := parsedTypes[record.MPPOnionType]
+	_, hasAMP := parsedTypes[record.AMPOnionType]
 
 	switch {
 
@@ -239,6 +253,23 @@ func ValidateParsedPayloadTypes(parsedTypes tlv.TypeMap,
 			Violation: IncludedViolation,
 			FinalHop:  isFinalHop,
 		}
+
+	// Intermediate nodes should never receive AMP fields.
+	case !isFinalHop && hasAMP:
+		return ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: IncludedViolation,
+			FinalHop:  isFinalHop,
+		}
+
+	// An AMP record is only valid when paired with an MPP record, which
+	// carries the total amount and payment address of the set.
+	case isFinalHop && hasAMP && !hasMPP:
+		return ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: OmittedViolation,
+			FinalHop:  true,
+		}
 	}
 
 	return nil
@@ -250,6 +281,12 @@ func (h *Payload) MultiPath() *record.MPP {
 	return h.MPP
 }
 
+// AMPRecord returns the record corresponding to the option_amp parsed from the
+// onion payload.
+func (h *Payload) AMPRecord() *record.AMP {
+	return h.AMP
+}
+
 // CustomRecords returns the custom tlv type records that were parsed from the
 // payload.
 func (h *Payload) CustomRecords() record.CustomSet {
@@ -10,12 +10,17 @@ import (
 	"github.com/decred/dcrlnd/record"
 )
 
+// testUnknownRequiredType is an even, and therefore required, record type
+// that isn't known to the onion payload parser.
+const testUnknownRequiredType = 0x10
+
 type decodePayloadTest struct {
 	name             string
 	payload          []byte
 	expErr           error
 	expCustomRecords map[uint64][]byte
 	shouldHaveMPP    bool
+	shouldHaveAMP    bool
 }
 
 var decodePayloadTests = []decodePayloadTest{
@@ -81,10 +86,12 @@ var decodePayloadTests = []decodePayloadTest{
 		},
 	},
 	{
-		name:    "required type after omitted hop id",
-		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x0a, 0x00},
+		name: "required type after omitted hop id",
+		payload: []byte{
+			0x02, 0x00, 0x04, 0x00, testUnknownRequiredType, 0x00,
+		},
 		expErr: hop.ErrInvalidPayload{
-			Type:      10,
+			Type:      testUnknownRequiredType,
 			Violation: hop.RequiredViolation,
 			FinalHop:  true,
 		},
@@ -92,10 +99,11 @@ var decodePayloadTests = []decodePayloadTest{
 	{
 		name: "required type after included hop id",
 		payload: []byte{0x02, 0x00, 0x04, 0x00, 0x06, 0x08, 0x01, 0x00,
-			0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00,
+			0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			testUnknownRequiredType, 0x00,
 		},
 		expErr: hop.ErrInvalidPayload{
-			Type:      10,
+			Type:      testUnknownRequiredType,
 			Violation: hop.RequiredViolation,
 			FinalHop:  false,
 		},
@@ -194,6 +202,98 @@ var decodePayloadTests = []decodePayloadTest{
 		expErr:        nil,
 		shouldHaveMPP: true,
 	},
+	{
+		name: "intermediate hop with amp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// next hop id
+			0x06, 0x08,
+			0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+			// amp
+			0x0a, 0x41,
+			// amp.root_share
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			// amp.set_id
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			// amp.child_index
+			0x09,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.AMPOnionType,
+			Violation: hop.IncludedViolation,
+			FinalHop:  false,
+		},
+	},
+	{
+		name: "final hop with amp but no mpp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// amp
+			0x0a, 0x41,
+			// amp.root_share
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			// amp.set_id
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			// amp.child_index
+			0x09,
+		},
+		expErr: hop.ErrInvalidPayload{
+			Type:      record.MPPOnionType,
+			Violation: hop.OmittedViolation,
+			FinalHop:  true,
+		},
+	},
+	{
+		name: "final hop with mpp and amp",
+		payload: []byte{
+			// amount
+			0x02, 0x00,
+			// cltv
+			0x04, 0x00,
+			// mpp
+			0x08, 0x21,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+			0x08,
+			// amp
+			0x0a, 0x41,
+			// amp.root_share
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			// amp.set_id
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			// amp.child_index
+			0x09,
+		},
+		expErr:        nil,
+		shouldHaveMPP: true,
+		shouldHaveAMP: true,
+	},
 }
 
 // TestDecodeHopPayloadRecordValidation asserts that parsing the payloads in the
@@ -216,6 +316,19 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
 			0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
 		}
+		testRootShare = [32]byte{
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+			0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33, 0x33,
+		}
+		testSetID = [32]byte{
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+			0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44, 0x44,
+		}
+		testChildIndex = uint32(9)
 	)
 
 	p, err := hop.NewPayloadFromReader(bytes.NewReader(test.payload))
@@ -242,6 +355,24 @@ func testDecodeHopPayloadValidation(t *testing.T, test decodePayloadTest) {
 		t.Fatalf("unexpected MPP payload")
 	}
 
+	// Assert AMP fields if we expect them.
+	if test.shouldHaveAMP {
+		if p.AMP == nil {
+			t.Fatalf("payload should have AMP record")
+		}
+		if p.AMP.RootShare() != testRootShare {
+			t.Fatalf("invalid root share")
+		}
+		if p.AMP.SetID() != testSetID {
+			t.Fatalf("invalid set id")
+		}
+		if p.AMP.ChildIndex() != testChildIndex {
+			t.Fatalf("invalid child index")
+		}
+	} else if p.AMP != nil {
+		t.Fatalf("unexpected AMP payload")
+	}
+
 	// Convert expected nil map to empty map, because we always expect an
 	// initiated map from the payload.
 	expCustomRecords := make(record.CustomSet)
@@ -100,6 +100,13 @@ type ChannelLink interface {
 	// transaction changes location within the chain.
 	UpdateShortChanID() (lnwire.ShortChannelID, error)
 
+	// ConfirmedShortChanID returns the real short channel ID of a
+	// zero-conf channel whose funding transaction confirmed. The boolean
+	// is false if the link isn't a zero-conf channel or if its funding
+	// transaction hasn't confirmed yet. Zero-conf links keep using their
+	// alias as ShortChanID.
+	ConfirmedShortChanID() (lnwire.ShortChannelID, bool)
+
 	// UpdateForwardingPolicy updates the forwarding policy for the target
 	// ChannelLink. Once updated, the link will use the new forwarding
 	// policy to govern if it an incoming HTLC should be forwarded or not.
@@ -109,11 +116,12 @@ type ChannelLink interface {
 	// details satisfy the current forwarding policy fo the target link.
 	// Otherwise, a LinkError with a valid protocol failure message should
 	// be returned in order to signal to the source of the HTLC, the policy
-	// consistency issue.
+	// consistency issue. The inbound fee is the fee that the incoming link
+	// charges on top of the fee of the target link.
 	CheckHtlcForward(payHash [32]byte, incomingAmt lnwire.MilliAtom,
 		amtToForward lnwire.MilliAtom,
 		incomingTimeout, outgoingTimeout uint32,
-		heightNow uint32) *LinkError
+		inboundFee lnwire.InboundFee, heightNow uint32) *LinkError
 
 	// CheckHtlcTransit should return a nil error if the passed HTLC
 	// details satisfy the current channel policy.  Otherwise, a LinkError
@@ -181,10 +189,10 @@ type TowerClient interface {
 	// state. If the method returns nil, the backup is guaranteed to be
 	// successful unless the tower is unavailable and client is force quit,
 	// or the justice transaction would create dust outputs when trying to
-	// abide by the negotiated policy. If the channel we're trying to back
-	// up doesn't have a tweak for the remote party's output, then
-	// isTweakless should be true.
-	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution, bool) error
+	// abide by the negotiated policy. The channel type determines the
+	// scripts of the outputs being swept.
+	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution,
+		channeldb.ChannelType) error
 }
 
 // InterceptableHtlcForwarder is the interface to set the interceptor
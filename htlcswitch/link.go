@@ -98,6 +98,11 @@ type ForwardingPolicy struct {
 	//    per-hop payload of the incoming HTLC's onion packet.
 	TimeLockDelta uint32
 
+	// InboundFee is the fee that is charged for HTLCs that arrive over
+	// this link, on top of the fee of the outgoing link. It may be
+	// negative to grant a discount on the outgoing fee.
+	InboundFee lnwire.InboundFee
+
 	// TODO(roasbeef): add fee module inside of switch
 }
 
@@ -160,6 +165,18 @@ type ChannelLinkConfig struct {
 	// error messages.
 	FetchLastChannelUpdate func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error)
 
+	// FetchLocalAlias returns the alias short channel id we handed out for
+	// the channel with the given channel id, or channeldb.ErrNoAlias if
+	// the channel doesn't have one. The alias is resent along with the
+	// FundingLocked message on reconnection.
+	FetchLocalAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// SignAliasUpdate signs the given channel update in place. It is used
+	// to sign the updates attached to failure messages of channels we
+	// handed out an alias for, which carry the alias instead of the real
+	// short channel id.
+	SignAliasUpdate func(*lnwire.ChannelUpdate) error
+
 	// Peer is a lightning network node with which we have the channel link
 	// opened.
 	Peer lnpeer.Peer
@@ -257,7 +274,9 @@ type ChannelLinkConfig struct {
 
 	// TowerClient is an optional engine that manages the signing,
 	// encrypting, and uploading of justice transactions to the daemon's
-	// configured set of watchtowers.
+	// configured set of watchtowers. The client must use a policy that
+	// matches the type of the channel, i.e. anchor channels are backed up
+	// with a client that negotiates anchor sessions.
 	TowerClient TowerClient
 
 	// MaxOutgoingCltvExpiry is the maximum outgoing timelock that the link
@@ -436,12 +455,7 @@ func (l *channelLink) Start() error {
 
 	// If the config supplied watchtower client, ensure the channel is
 	// registered before trying to use it during operation.
-	// TODO(halseth): support anchor types for watchtower.
-	state := l.channel.State()
-	if l.cfg.TowerClient != nil && state.ChanType.HasAnchors() {
-		l.log.Warnf("Skipping tower registration for anchor " +
-			"channel type")
-	} else if l.cfg.TowerClient != nil && !state.ChanType.HasAnchors() {
+	if l.cfg.TowerClient != nil {
 		err := l.cfg.TowerClient.RegisterChannel(l.ChanID())
 		if err != nil {
 			return err
@@ -622,7 +636,17 @@ func shouldAdjustCommitFee(netFee, chanFee chainfee.AtomPerKByte) bool {
 func (l *channelLink) createFailureWithUpdate(
 	cb func(update *lnwire.ChannelUpdate) lnwire.FailureMessage) lnwire.FailureMessage {
 
-	update, err := l.cfg.FetchLastChannelUpdate(l.ShortChanID())
+	// The channel of a confirmed zero-conf link is known to the graph by
+	// its real short channel id.
+	shortChanID := l.ShortChanID()
+	if confirmedScid, ok := l.ConfirmedShortChanID(); ok {
+		shortChanID = confirmedScid
+	}
+
+	update, err := fetchFailureUpdate(
+		l.ChanID(), shortChanID, l.cfg.FetchLastChannelUpdate,
+		l.cfg.FetchLocalAlias, l.cfg.SignAliasUpdate,
+	)
 	if err != nil {
 		return &lnwire.FailTemporaryNodeFailure{}
 	}
@@ -630,6 +654,48 @@ func (l *channelLink) createFailureWithUpdate(
 	return cb(update)
 }
 
+// fetchFailureUpdate retrieves the latest channel update of the channel with
+// the given channel id and short channel id, to be attached to a failure
+// message. If we handed out an alias for the channel, the update is re-signed
+// with the alias in place of the real short channel id, so the failure doesn't
+// reveal the funding outpoint of the private channel.
+func fetchFailureUpdate(chanID lnwire.ChannelID, scid lnwire.ShortChannelID,
+	fetchUpdate func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error),
+	fetchAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error),
+	signUpdate func(*lnwire.ChannelUpdate) error) (*lnwire.ChannelUpdate,
+	error) {
+
+	update, err := fetchUpdate(scid)
+	if err != nil {
+		return nil, err
+	}
+
+	if fetchAlias == nil {
+		return update, nil
+	}
+
+	alias, err := fetchAlias(chanID)
+	switch {
+	case err == channeldb.ErrNoAlias:
+		return update, nil
+
+	case err != nil:
+		return nil, err
+
+	case signUpdate == nil:
+		return nil, fmt.Errorf("unable to sign update with alias %v",
+			alias)
+	}
+
+	aliasUpdate := *update
+	aliasUpdate.ShortChannelID = alias
+	if err := signUpdate(&aliasUpdate); err != nil {
+		return nil, err
+	}
+
+	return &aliasUpdate, nil
+}
+
 // syncChanState attempts to synchronize channel states with the remote party.
 // This method is to be called upon reconnection after the initial funding
 // flow. We'll compare out commitment chains with the remote party, and re-send
@@ -685,6 +751,21 @@ func (l *channelLink) syncChanStates() error {
 			fundingLockedMsg := lnwire.NewFundingLocked(
 				l.ChanID(), nextRevocation,
 			)
+
+			// If we handed out an alias for the channel, we'll
+			// resend it as well.
+			if l.cfg.FetchLocalAlias != nil {
+				alias, err := l.cfg.FetchLocalAlias(l.ChanID())
+				switch {
+				case err == nil:
+					fundingLockedMsg.AliasScid = &alias
+
+				case err != channeldb.ErrNoAlias:
+					return fmt.Errorf("unable to fetch "+
+						"alias: %v", err)
+				}
+			}
+
 			err = l.cfg.Peer.SendMessage(false, fundingLockedMsg)
 			if err != nil {
 				return fmt.Errorf("unable to re-send "+
@@ -1822,12 +1903,8 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 
 		// If we have a tower client, we'll proceed in backing up the
 		// state that was just revoked.
-		// TODO(halseth): support anchor types for watchtower.
-		state := l.channel.State()
-		if l.cfg.TowerClient != nil && state.ChanType.HasAnchors() {
-			l.log.Warnf("Skipping tower backup for anchor " +
-				"channel type")
-		} else if l.cfg.TowerClient != nil && !state.ChanType.HasAnchors() {
+		if l.cfg.TowerClient != nil {
+			state := l.channel.State()
 			breachInfo, err := lnwallet.NewBreachRetribution(
 				state, state.RemoteCommitment.CommitHeight-1, 0,
 			)
@@ -1840,7 +1917,7 @@ func (l *channelLink) handleUpstreamMsg(msg lnwire.Message) {
 			chanType := l.channel.State().ChanType
 			chanID := l.ChanID()
 			err = l.cfg.TowerClient.BackupState(
-				&chanID, breachInfo, chanType.IsTweakless(),
+				&chanID, breachInfo, chanType,
 			)
 			if err != nil {
 				l.fail(LinkFailureError{code: ErrInternalError},
@@ -2084,6 +2161,7 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	l.log.Infof("updating to short_chan_id=%s for chan_id=%v", sid, chanID)
 
 	l.Lock()
+	oldSid := l.shortChanID
 	l.shortChanID = sid
 	l.Unlock()
 
@@ -2098,13 +2176,30 @@ func (l *channelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	}()
 
 	// Now that the short channel ID has been properly updated, we can begin
-	// garbage collecting any forwarding packages we create.
-	l.wg.Add(1)
-	go l.fwdPkgGarbager()
+	// garbage collecting any forwarding packages we create. Links that
+	// already had a short channel ID, such as zero-conf links, are already
+	// doing so.
+	if oldSid == hop.Source {
+		l.wg.Add(1)
+		go l.fwdPkgGarbager()
+	}
 
 	return sid, nil
 }
 
+// ConfirmedShortChanID returns the real short channel ID of a zero-conf
+// channel whose funding transaction confirmed.
+//
+// NOTE: Part of the ChannelLink interface.
+func (l *channelLink) ConfirmedShortChanID() (lnwire.ShortChannelID, bool) {
+	state := l.channel.State()
+	if !state.ZeroConfConfirmed() {
+		return hop.Source, false
+	}
+
+	return state.ZeroConfRealScid(), true
+}
+
 // ChanID returns the channel ID for the channel link. The channel ID is a more
 // compact representation of a channel's full outpoint.
 //
@@ -2161,7 +2256,7 @@ func (l *channelLink) UpdateForwardingPolicy(newPolicy ForwardingPolicy) {
 func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	incomingHtlcAmt, amtToForward lnwire.MilliAtom,
 	incomingTimeout, outgoingTimeout uint32,
-	heightNow uint32) *LinkError {
+	inboundFee lnwire.InboundFee, heightNow uint32) *LinkError {
 
 	l.RLock()
 	policy := l.cfg.FwrdingPolicy
@@ -2178,18 +2273,25 @@ func (l *channelLink) CheckHtlcForward(payHash [32]byte,
 	// Next, using the amount of the incoming HTLC, we'll calculate the
 	// expected fee this incoming HTLC must carry in order to satisfy the
 	// constraints of the outgoing link.
-	expectedFee := ExpectedFee(policy, amtToForward)
+	outFee := ExpectedFee(policy, amtToForward)
+
+	// Then we'll add the inbound fee of the incoming link, which is based
+	// on the sum of the outgoing amount and the outgoing fee. A negative
+	// inbound fee lowers the expected fee, but the HTLC still can't carry
+	// less than the amount to forward.
+	inFee := inboundFee.CalcFee(amtToForward + outFee)
+	expectedFee := int64(outFee) + inFee
 
 	// If the actual fee is less than our expected fee, then we'll reject
 	// this HTLC as it didn't provide a sufficient amount of fees, or the
 	// values have been tampered with, or the send used incorrect/dated
 	// information to construct the forwarding information for this hop. In
 	// any case, we'll cancel this HTLC.
-	actualFee := incomingHtlcAmt - amtToForward
+	actualFee := int64(incomingHtlcAmt) - int64(amtToForward)
 	if incomingHtlcAmt < amtToForward || actualFee < expectedFee {
 		l.log.Errorf("outgoing htlc(%x) has insufficient fee: "+
 			"expected %v, got %v",
-			payHash[:], int64(expectedFee), int64(actualFee))
+			payHash[:], expectedFee, actualFee)
 
 		// As part of the returned error, we'll send our latest routing
 		// policy so the sending node obtains the most up to date data.
@@ -2575,6 +2677,12 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 		return
 	}
 
+	// Forwarded HTLCs are charged the inbound fee of this link, which is
+	// enforced by the outgoing link.
+	l.RLock()
+	inboundFee := l.cfg.FwrdingPolicy.InboundFee
+	l.RUnlock()
+
 	var switchPackets []*htlcPacket
 
 	for i, pd := range lockedInHtlcs {
@@ -2732,6 +2840,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 					customRecords:   pld.CustomRecords(),
 				}
 				switchPackets = append(
@@ -2796,6 +2905,7 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 					obfuscator:      obfuscator,
 					incomingTimeout: pd.Timeout,
 					outgoingTimeout: fwdInfo.OutgoingCTLV,
+					inboundFee:      inboundFee,
 					customRecords:   pld.CustomRecords(),
 				}
 
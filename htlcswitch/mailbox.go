@@ -75,13 +75,17 @@ type MailBox interface {
 }
 
 type mailBoxConfig struct {
+	// chanID is the channel id of the channel this mailbox belongs to.
+	chanID lnwire.ChannelID
+
 	// shortChanID is the short channel id of the channel this mailbox
 	// belongs to.
 	shortChanID lnwire.ShortChannelID
 
 	// fetchUpdate retreives the most recent channel update for the channel
 	// this mailbox belongs to.
-	fetchUpdate func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error)
+	fetchUpdate func(lnwire.ChannelID, lnwire.ShortChannelID) (
+		*lnwire.ChannelUpdate, error)
 
 	// forwardPackets send a varidic number of htlcPackets to the switch to
 	// be routed. A quit channel should be provided so that the call can
@@ -632,7 +636,7 @@ func (m *memoryMailBox) FailAdd(pkt *htlcPacket) {
 	// peer if this is a forward, or report to the user if the failed
 	// payment was locally initiated.
 	var failure lnwire.FailureMessage
-	update, err := m.cfg.fetchUpdate(m.cfg.shortChanID)
+	update, err := m.cfg.fetchUpdate(m.cfg.chanID, m.cfg.shortChanID)
 	if err != nil {
 		failure = &lnwire.FailTemporaryNodeFailure{}
 	} else {
@@ -740,7 +744,8 @@ type mailOrchConfig struct {
 
 	// fetchUpdate retreives the most recent channel update for the channel
 	// this mailbox belongs to.
-	fetchUpdate func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error)
+	fetchUpdate func(lnwire.ChannelID, lnwire.ShortChannelID) (
+		*lnwire.ChannelUpdate, error)
 
 	// clock is a time source for the generated mailboxes.
 	clock clock.Clock
@@ -802,6 +807,7 @@ func (mo *mailOrchestrator) exclusiveGetOrCreateMailBox(
 	mailbox, ok := mo.mailboxes[chanID]
 	if !ok {
 		mailbox = newMemoryMailBox(&mailBoxConfig{
+			chanID:         chanID,
 			shortChanID:    shortChanID,
 			fetchUpdate:    mo.cfg.fetchUpdate,
 			forwardPackets: mo.cfg.forwardPackets,
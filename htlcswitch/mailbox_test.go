@@ -202,8 +202,9 @@ func newMailboxContext(t *testing.T, startTime time.Time,
 		forwards: make(chan *htlcPacket, 1),
 	}
 	ctx.mailbox = newMemoryMailBox(&mailBoxConfig{
-		fetchUpdate: func(sid lnwire.ShortChannelID) (
-			*lnwire.ChannelUpdate, error) {
+		fetchUpdate: func(_ lnwire.ChannelID,
+			sid lnwire.ShortChannelID) (*lnwire.ChannelUpdate,
+			error) {
 			return &lnwire.ChannelUpdate{
 				ShortChannelID: sid,
 			}, nil
@@ -545,8 +546,9 @@ func TestMailOrchestrator(t *testing.T) {
 
 	// First, we'll create a new instance of our orchestrator.
 	mo := newMailOrchestrator(&mailOrchConfig{
-		fetchUpdate: func(sid lnwire.ShortChannelID) (
-			*lnwire.ChannelUpdate, error) {
+		fetchUpdate: func(_ lnwire.ChannelID,
+			sid lnwire.ShortChannelID) (*lnwire.ChannelUpdate,
+			error) {
 			return &lnwire.ChannelUpdate{
 				ShortChannelID: sid,
 			}, nil
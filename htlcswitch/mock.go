@@ -640,6 +640,10 @@ type mockChannelLink struct {
 
 	shortChanID lnwire.ShortChannelID
 
+	// confirmedShortChanID is the real short channel id of a zero-conf
+	// link, if its funding transaction confirmed.
+	confirmedShortChanID lnwire.ShortChannelID
+
 	chanID lnwire.ChannelID
 
 	peer lnpeer.Peer
@@ -719,7 +723,8 @@ func (f *mockChannelLink) HandleChannelUpdate(lnwire.Message) {
 func (f *mockChannelLink) UpdateForwardingPolicy(_ ForwardingPolicy) {
 }
 func (f *mockChannelLink) CheckHtlcForward([32]byte, lnwire.MilliAtom,
-	lnwire.MilliAtom, uint32, uint32, uint32) *LinkError {
+	lnwire.MilliAtom, uint32, uint32, lnwire.InboundFee,
+	uint32) *LinkError {
 
 	return f.checkHtlcForwardResult
 }
@@ -759,6 +764,10 @@ func (f *mockChannelLink) UpdateShortChanID() (lnwire.ShortChannelID, error) {
 	return f.shortChanID, nil
 }
 
+func (f *mockChannelLink) ConfirmedShortChanID() (lnwire.ShortChannelID, bool) {
+	return f.confirmedShortChanID, f.confirmedShortChanID != hop.Source
+}
+
 var _ ChannelLink = (*mockChannelLink)(nil)
 
 func newDB() (*channeldb.DB, func(), error) {
@@ -40,6 +40,10 @@ type htlcPacket struct {
 	// incoming link.
 	incomingAmount lnwire.MilliAtom
 
+	// inboundFee is the inbound fee of the incoming link that an HTLC
+	// forwarded to the outgoing link must pay on top of the outgoing fee.
+	inboundFee lnwire.InboundFee
+
 	// amount is the value of the HTLC that is being created or modified.
 	amount lnwire.MilliAtom
 
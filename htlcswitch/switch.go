@@ -11,6 +11,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/aliasmgr"
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/channeldb/kvdb"
@@ -109,6 +110,12 @@ type ChanClose struct {
 	// process for the cooperative closure transaction kicks off.
 	TargetFeePerKB chainfee.AtomPerKByte
 
+	// MaxFeePerKB is the highest fee rate the caller is willing to pay for
+	// the cooperative closure transaction. This value is only utilized if
+	// the closure type is CloseRegular. If zero, a default multiple of the
+	// target fee rate is used.
+	MaxFeePerKB chainfee.AtomPerKByte
+
 	// DeliveryScript is an optional delivery script to pay funds out to.
 	DeliveryScript lnwire.DeliveryAddress
 
@@ -155,6 +162,19 @@ type Config struct {
 	// error messages.
 	FetchLastChannelUpdate func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error)
 
+	// FetchLocalAlias returns the alias short channel id we handed out for
+	// the channel with the given channel id, or channeldb.ErrNoAlias if
+	// the channel doesn't have one. HTLCs that refer to the alias are
+	// forwarded to the link of the channel, while HTLCs that refer to its
+	// real short channel id are rejected.
+	FetchLocalAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// SignAliasUpdate signs the given channel update in place. It is used
+	// to sign the updates attached to failure messages of channels we
+	// handed out an alias for, which carry the alias instead of the real
+	// short channel id.
+	SignAliasUpdate func(*lnwire.ChannelUpdate) error
+
 	// Notifier is an instance of a chain notifier that we'll use to signal
 	// the switch when a new block has arrived.
 	Notifier chainntnfs.ChainNotifier
@@ -254,6 +274,11 @@ type Switch struct {
 	// ChannelLink
 	forwardingIndex map[lnwire.ShortChannelID]ChannelLink
 
+	// aliasIndex maps the channel id of a link to the alias short channel
+	// id that is indexed for the link in the forwarding index, so the
+	// alias can be removed along with the link.
+	aliasIndex map[lnwire.ChannelID]lnwire.ShortChannelID
+
 	// interfaceIndex maps the compressed public key of a peer to all the
 	// channels that the switch maintains with that peer.
 	interfaceIndex map[[33]byte]map[lnwire.ChannelID]ChannelLink
@@ -306,6 +331,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		circuits:          circuitMap,
 		linkIndex:         make(map[lnwire.ChannelID]ChannelLink),
 		forwardingIndex:   make(map[lnwire.ShortChannelID]ChannelLink),
+		aliasIndex:        make(map[lnwire.ChannelID]lnwire.ShortChannelID),
 		interfaceIndex:    make(map[[33]byte]map[lnwire.ChannelID]ChannelLink),
 		pendingLinkIndex:  make(map[lnwire.ChannelID]ChannelLink),
 		networkResults:    newNetworkResultStore(cfg.DB),
@@ -316,7 +342,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 	}
 
 	s.mailOrchestrator = newMailOrchestrator(&mailOrchConfig{
-		fetchUpdate:    s.cfg.FetchLastChannelUpdate,
+		fetchUpdate:    s.fetchFailureUpdate,
 		forwardPackets: s.ForwardPackets,
 		clock:          s.cfg.Clock,
 		expiry:         s.cfg.HTLCExpiry,
@@ -657,9 +683,18 @@ func (s *Switch) ForwardPackets(linkQuit chan struct{},
 	// failures.
 	if len(failedPackets) > 0 {
 		var failure lnwire.FailureMessage
-		update, err := s.cfg.FetchLastChannelUpdate(
-			failedPackets[0].incomingChanID,
-		)
+		incomingChanID := failedPackets[0].incomingChanID
+
+		// The channel id of the incoming link is needed to look up
+		// the alias we may have handed out for the channel.
+		var chanID lnwire.ChannelID
+		s.indexMtx.RLock()
+		if link, err := s.getLinkByShortID(incomingChanID); err == nil {
+			chanID = link.ChanID()
+		}
+		s.indexMtx.RUnlock()
+
+		update, err := s.fetchFailureUpdate(chanID, incomingChanID)
 		if err != nil {
 			failure = &lnwire.FailTemporaryNodeFailure{}
 		} else {
@@ -1000,6 +1035,27 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 
 			return s.failAddPacket(packet, linkError)
 		}
+
+		// If we handed out an alias for the channel, the HTLC must
+		// refer to the channel by an alias. Forwarding HTLCs that use
+		// the real short channel id would allow probing for the
+		// funding outpoint the alias is meant to hide, so we act as
+		// if we don't know the channel.
+		_, hasAlias := s.aliasIndex[targetLink.ChanID()]
+		if hasAlias && !aliasmgr.IsAlias(packet.outgoingChanID) {
+			s.indexMtx.RUnlock()
+
+			log.Debugf("rejecting forward to real short_chan_id "+
+				"%v of ChannelLink(%v) with alias",
+				packet.outgoingChanID, targetLink.ChanID())
+
+			linkError := NewLinkError(
+				&lnwire.FailUnknownNextPeer{},
+			)
+
+			return s.failAddPacket(packet, linkError)
+		}
+
 		targetPeerKey := targetLink.Peer().PubKey()
 		interfaceLinks, _ := s.getLinks(targetPeerKey)
 		s.indexMtx.RUnlock()
@@ -1031,7 +1087,8 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 				failure = link.CheckHtlcForward(
 					htlc.PaymentHash, packet.incomingAmount,
 					packet.amount, packet.incomingTimeout,
-					packet.outgoingTimeout, currentHeight,
+					packet.outgoingTimeout,
+					packet.inboundFee, currentHeight,
 				)
 			}
 
@@ -1052,7 +1109,7 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			// At this point, some or all of the links rejected the
 			// HTLC so we couldn't forward it. So we'll try to look
 			// up the error that came from the source.
-			linkErr, ok := linkErrs[packet.outgoingChanID]
+			linkErr, ok := linkErrs[targetLink.ShortChanID()]
 			if !ok {
 				// If we can't find the error of the source,
 				// then we'll return an unknown next peer,
@@ -1428,10 +1485,12 @@ func (s *Switch) teardownCircuit(pkt *htlcPacket) error {
 // CloseLink creates and sends the close channel command to the target link
 // directing the specified closure type. If the closure type is CloseRegular,
 // targetFeePerKB parameter should be the ideal fee-per-kb that will be used as
-// a starting point for close negotiation. The deliveryScript parameter is an
-// optional parameter which sets a user specified script to close out to.
+// a starting point for close negotiation and maxFeePerKB the highest fee-per-kb
+// the negotiated fee may reach. The deliveryScript parameter is an optional
+// parameter which sets a user specified script to close out to.
 func (s *Switch) CloseLink(chanPoint *wire.OutPoint,
-	closeType ChannelCloseType, targetFeePerKB chainfee.AtomPerKByte,
+	closeType ChannelCloseType, targetFeePerKB,
+	maxFeePerKB chainfee.AtomPerKByte,
 	deliveryScript lnwire.DeliveryAddress) (chan interface{}, chan error) {
 
 	// TODO(roasbeef) abstract out the close updates.
@@ -1443,6 +1502,7 @@ func (s *Switch) CloseLink(chanPoint *wire.OutPoint,
 		ChanPoint:      chanPoint,
 		Updates:        updateChan,
 		TargetFeePerKB: targetFeePerKB,
+		MaxFeePerKB:    maxFeePerKB,
 		DeliveryScript: deliveryScript,
 		Err:            errChan,
 	}
@@ -1987,6 +2047,26 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.linkIndex[link.ChanID()] = link
 	s.forwardingIndex[link.ShortChanID()] = link
 
+	// A confirmed zero-conf channel can also be reached by its real short
+	// channel id.
+	if confirmedScid, ok := link.ConfirmedShortChanID(); ok {
+		s.forwardingIndex[confirmedScid] = link
+	}
+
+	// If we handed out an alias for the channel, HTLCs referring to the
+	// alias must also be forwarded to the link.
+	if s.cfg.FetchLocalAlias != nil {
+		alias, err := s.cfg.FetchLocalAlias(link.ChanID())
+		switch {
+		case err == nil:
+			s.addAlias(link, alias)
+
+		case err != channeldb.ErrNoAlias:
+			log.Errorf("Unable to fetch alias of ChannelID(%v): %v",
+				link.ChanID(), err)
+		}
+	}
+
 	// Next we'll add the link to the interface index so we can
 	// quickly look up all the channels for a particular node.
 	peerPub := link.Peer().PubKey()
@@ -1996,6 +2076,43 @@ func (s *Switch) addLiveLink(link ChannelLink) {
 	s.interfaceIndex[peerPub][link.ChanID()] = link
 }
 
+// addAlias indexes the given alias of the link in the forwarding index.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) addAlias(link ChannelLink, alias lnwire.ShortChannelID) {
+	log.Debugf("Indexing alias %v for ChannelID(%v)", alias, link.ChanID())
+
+	s.forwardingIndex[alias] = link
+	s.aliasIndex[link.ChanID()] = alias
+}
+
+// AddAliasForLink indexes the alias we handed out for the channel with the
+// given channel id, so HTLCs that refer to the alias are forwarded to the link
+// of the channel. If the link isn't live yet, the alias is fetched using the
+// FetchLocalAlias config once the link is added.
+func (s *Switch) AddAliasForLink(chanID lnwire.ChannelID,
+	alias lnwire.ShortChannelID) {
+
+	s.indexMtx.Lock()
+	defer s.indexMtx.Unlock()
+
+	if link, ok := s.linkIndex[chanID]; ok {
+		s.addAlias(link, alias)
+	}
+}
+
+// fetchFailureUpdate retrieves the latest channel update of the channel with
+// the given channel id and short channel id, to be attached to a failure
+// message. The update carries the alias of the channel if we handed one out.
+func (s *Switch) fetchFailureUpdate(chanID lnwire.ChannelID,
+	scid lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
+
+	return fetchFailureUpdate(
+		chanID, scid, s.cfg.FetchLastChannelUpdate,
+		s.cfg.FetchLocalAlias, s.cfg.SignAliasUpdate,
+	)
+}
+
 // GetLink is used to initiate the handling of the get link command. The
 // request will be propagated/handled to/in the main goroutine.
 func (s *Switch) GetLink(chanID lnwire.ChannelID) (ChannelLink, error) {
@@ -2073,6 +2190,13 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 	delete(s.pendingLinkIndex, link.ChanID())
 	delete(s.linkIndex, link.ChanID())
 	delete(s.forwardingIndex, link.ShortChanID())
+	if confirmedScid, ok := link.ConfirmedShortChanID(); ok {
+		delete(s.forwardingIndex, confirmedScid)
+	}
+	if alias, ok := s.aliasIndex[link.ChanID()]; ok {
+		delete(s.forwardingIndex, alias)
+		delete(s.aliasIndex, link.ChanID())
+	}
 
 	// If the link has been added to the peer index, then we'll move to
 	// delete the entry within the index.
@@ -2091,12 +2215,20 @@ func (s *Switch) removeLink(chanID lnwire.ChannelID) ChannelLink {
 }
 
 // UpdateShortChanID updates the short chan ID for an existing channel. This is
-// required in the case of a re-org and re-confirmation or a channel, or in the
-// case that a link was added to the switch before its short chan ID was known.
+// required in the case of a re-org and re-confirmation or a channel, in the
+// case that a link was added to the switch before its short chan ID was known,
+// or in the case that the funding transaction of a zero-conf channel confirmed.
 func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	s.indexMtx.Lock()
 	defer s.indexMtx.Unlock()
 
+	// A live link can only be a zero-conf channel that keeps forwarding by
+	// its alias, but that is now also reachable by its real short channel
+	// id.
+	if link, ok := s.linkIndex[chanID]; ok {
+		return s.updateConfirmedShortChanID(link)
+	}
+
 	// Locate the target link in the pending link index. If no such link
 	// exists, then we will ignore the request.
 	link, ok := s.pendingLinkIndex[chanID]
@@ -2138,6 +2270,31 @@ func (s *Switch) UpdateShortChanID(chanID lnwire.ChannelID) error {
 	return nil
 }
 
+// updateConfirmedShortChanID adds the real short channel id of a live zero-conf
+// link whose funding transaction confirmed to the forwarding index.
+//
+// NOTE: This MUST be called with the indexMtx held.
+func (s *Switch) updateConfirmedShortChanID(link ChannelLink) error {
+	// Reload the channel state from disk so the link learns about the
+	// confirmation.
+	if _, err := link.UpdateShortChanID(); err != nil {
+		return err
+	}
+
+	confirmedScid, ok := link.ConfirmedShortChanID()
+	if !ok {
+		return fmt.Errorf("live link %v has no confirmed "+
+			"short_chan_id", link.ChanID())
+	}
+
+	log.Infof("Adding confirmed short_chan_id=%v for ChannelLink(%v) with "+
+		"alias=%v", confirmedScid, link.ChanID(), link.ShortChanID())
+
+	s.forwardingIndex[confirmedScid] = link
+
+	return nil
+}
+
 // GetLinksByInterface fetches all the links connected to a particular node
 // identified by the serialized compressed form of its public key.
 func (s *Switch) GetLinksByInterface(hop [33]byte) ([]ChannelLink, error) {
@@ -89,6 +89,330 @@ func TestSwitchAddDuplicateLink(t *testing.T) {
 	}
 }
 
+// TestSwitchZeroConfLink tests that a zero-conf link forwards by its alias and
+// can additionally be reached by its real short channel id once its funding
+// transaction confirmed.
+func TestSwitchZeroConfLink(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, _, realScid, _ := genIDs()
+	alias := lnwire.ShortChannelID{BlockHeight: 16_000_000, TxIndex: 1}
+
+	// The zero-conf link is added with its alias and is live right away.
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, alias, alicePeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if _, err := s.getLinkByShortID(alias); err != nil {
+		t.Fatalf("link not found by alias: %v", err)
+	}
+	if _, err := s.getLinkByShortID(realScid); err == nil {
+		t.Fatalf("link should not be found by real scid yet")
+	}
+
+	// Updating the short chan id before the channel confirmed must fail.
+	if err := s.UpdateShortChanID(chanID1); err == nil {
+		t.Fatalf("expected error updating unconfirmed zero-conf link")
+	}
+
+	// Once the channel confirmed, the link can be found by both its alias
+	// and its real short channel id.
+	aliceChannelLink.confirmedShortChanID = realScid
+	if err := s.UpdateShortChanID(chanID1); err != nil {
+		t.Fatalf("unable to update alice short_chan_id: %v", err)
+	}
+	for _, scid := range []lnwire.ShortChannelID{alias, realScid} {
+		link, err := s.getLinkByShortID(scid)
+		if err != nil {
+			t.Fatalf("link not found by %v: %v", scid, err)
+		}
+		if link.ShortChanID() != alias {
+			t.Fatalf("expected link to keep alias %v, got %v",
+				alias, link.ShortChanID())
+		}
+	}
+
+	// Removing the link clears both entries of the forwarding index.
+	s.RemoveLink(chanID1)
+	for _, scid := range []lnwire.ShortChannelID{alias, realScid} {
+		if _, err := s.getLinkByShortID(scid); err == nil {
+			t.Fatalf("link should have been removed for %v", scid)
+		}
+	}
+}
+
+// TestSwitchLinkAlias tests that HTLCs referring to the alias we handed out for
+// a channel can be routed to the link of the channel, whether the alias is
+// known when the link is added or only afterwards.
+func TestSwitchLinkAlias(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, scid1, scid2 := genIDs()
+	alias1 := lnwire.ShortChannelID{BlockHeight: 16_000_000}
+	alias2 := lnwire.ShortChannelID{BlockHeight: 16_000_000, TxPosition: 1}
+
+	// Only the first channel has an alias when its link is added.
+	s.cfg.FetchLocalAlias = func(
+		chanID lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+
+		if chanID == chanID1 {
+			return alias1, nil
+		}
+		return lnwire.ShortChannelID{}, channeldb.ErrNoAlias
+	}
+
+	link1 := newMockChannelLink(s, chanID1, scid1, alicePeer, true)
+	if err := s.AddLink(link1); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+	link2 := newMockChannelLink(s, chanID2, scid2, alicePeer, true)
+	if err := s.AddLink(link2); err != nil {
+		t.Fatalf("unable to add link: %v", err)
+	}
+
+	if _, err := s.getLinkByShortID(alias1); err != nil {
+		t.Fatalf("link not found by alias: %v", err)
+	}
+	if _, err := s.getLinkByShortID(alias2); err == nil {
+		t.Fatalf("link should not be found by alias yet")
+	}
+
+	// The alias of the second channel is handed out while its link is
+	// live.
+	s.AddAliasForLink(chanID2, alias2)
+
+	for _, scid := range []lnwire.ShortChannelID{alias2, scid2} {
+		link, err := s.getLinkByShortID(scid)
+		if err != nil {
+			t.Fatalf("link not found by %v: %v", scid, err)
+		}
+		if link.ChanID() != chanID2 {
+			t.Fatalf("expected link %v, got %v", chanID2,
+				link.ChanID())
+		}
+	}
+
+	// Removing the links clears their aliases from the forwarding index.
+	s.RemoveLink(chanID1)
+	s.RemoveLink(chanID2)
+	for _, scid := range []lnwire.ShortChannelID{alias1, alias2} {
+		if _, err := s.getLinkByShortID(scid); err == nil {
+			t.Fatalf("link should have been removed for %v", scid)
+		}
+	}
+}
+
+// TestSwitchRejectRealScidOfAlias tests that HTLCs referring to the real short
+// channel id of a channel we handed out an alias for are rejected, while HTLCs
+// referring to its alias are forwarded.
+func TestSwitchRejectRealScidOfAlias(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(
+		t, "alice", testStartingHeight, nil, testDefaultDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(
+		t, "bob", testStartingHeight, nil, testDefaultDelta,
+	)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+	bobAlias := lnwire.ShortChannelID{BlockHeight: 16_125_000}
+
+	// We handed out an alias for the channel with Bob.
+	s.cfg.FetchLocalAlias = func(
+		chanID lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+
+		if chanID == chanID2 {
+			return bobAlias, nil
+		}
+		return lnwire.ShortChannelID{}, channeldb.ErrNoAlias
+	}
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := sha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID: aliceChanID,
+		incomingHTLCID: 0,
+		outgoingChanID: bobChanID,
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+
+	// Forwarding over the real short channel id of Bob's channel must fail
+	// as if the channel didn't exist.
+	if err := s.ForwardPackets(nil, packet); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case p := <-aliceChannelLink.packets:
+		if p.linkFailure == nil {
+			t.Fatalf("expected link failure")
+		}
+		code := p.linkFailure.WireMessage().Code()
+		if code != lnwire.CodeUnknownNextPeer {
+			t.Fatalf("expected fail unknown next peer, got: %v",
+				code)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("no timely reply from switch")
+	}
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatal("expected not to receive message")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	if s.circuits.NumOpen() != 0 {
+		t.Fatal("wrong amount of circuits")
+	}
+
+	// Forwarding over the alias succeeds.
+	packet.incomingHTLCID++
+	packet.outgoingChanID = bobAlias
+	if err := s.ForwardPackets(nil, packet); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("request was not propagated to bob")
+	}
+}
+
+// TestFetchFailureUpdate tests that the channel update attached to failure
+// messages carries the alias of the channel if we handed one out.
+func TestFetchFailureUpdate(t *testing.T) {
+	t.Parallel()
+
+	chanID, _, scid, _ := genIDs()
+	alias := lnwire.ShortChannelID{BlockHeight: 16_125_000}
+
+	fetchUpdate := func(
+		sid lnwire.ShortChannelID) (*lnwire.ChannelUpdate, error) {
+
+		return &lnwire.ChannelUpdate{
+			ShortChannelID: sid,
+			BaseFee:        1000,
+		}, nil
+	}
+	noAlias := func(lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+		return lnwire.ShortChannelID{}, channeldb.ErrNoAlias
+	}
+	withAlias := func(lnwire.ChannelID) (lnwire.ShortChannelID, error) {
+		return alias, nil
+	}
+	signUpdate := func(update *lnwire.ChannelUpdate) error {
+		update.Signature[0] = 1
+		return nil
+	}
+
+	// Without an alias, the update is returned as is.
+	update, err := fetchFailureUpdate(
+		chanID, scid, fetchUpdate, noAlias, signUpdate,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch update: %v", err)
+	}
+	if update.ShortChannelID != scid || update.Signature[0] != 0 {
+		t.Fatalf("expected unmodified update, got %v", spew.Sdump(
+			update))
+	}
+
+	// With an alias, the update carries the alias and is re-signed.
+	update, err = fetchFailureUpdate(
+		chanID, scid, fetchUpdate, withAlias, signUpdate,
+	)
+	if err != nil {
+		t.Fatalf("unable to fetch update: %v", err)
+	}
+	if update.ShortChannelID != alias {
+		t.Fatalf("expected alias %v, got %v", alias,
+			update.ShortChannelID)
+	}
+	if update.Signature[0] != 1 {
+		t.Fatalf("expected update to be re-signed")
+	}
+	if update.BaseFee != 1000 {
+		t.Fatalf("expected policy to be kept, got %v", spew.Sdump(
+			update))
+	}
+
+	// Without a signer, the update with the real short channel id must
+	// not be returned.
+	_, err = fetchFailureUpdate(chanID, scid, fetchUpdate, withAlias, nil)
+	if err == nil {
+		t.Fatalf("expected error without signer")
+	}
+}
+
 // TestSwitchHasActiveLink tests the behavior of HasActiveLink, and asserts that
 // it only returns true if a link's short channel id has confirmed (meaning the
 // channel is no longer pending) and it's EligibleToForward method returns true,
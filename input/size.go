@@ -785,6 +785,17 @@ func (twe *TxSizeEstimator) AddP2SHOutput() *TxSizeEstimator {
 	return twe
 }
 
+// AddTxOutput updates the size estimate to account for an additional output
+// with the given pkScript.
+func (twe *TxSizeEstimator) AddTxOutput(txOut *wire.TxOut) *TxSizeEstimator {
+	pkScriptLen := int64(len(txOut.PkScript))
+	scriptLenSerSize := int64(wire.VarIntSerializeSize(uint64(pkScriptLen)))
+	twe.OutputSize += OutputSize + scriptLenSerSize + pkScriptLen
+	twe.outputCount++
+
+	return twe
+}
+
 // Size gets the estimated size of the transaction.
 func (twe *TxSizeEstimator) Size() int64 {
 	return baseTxSize +
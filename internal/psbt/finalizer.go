@@ -0,0 +1,125 @@
+package psbt
+
+// The Finalizer requires provision of a single PSBT input in which all
+// necessary signatures are encoded, and uses it to construct a fully valid
+// signature script. As Decred has no segregated witness, finalization always
+// produces a signature script.
+//
+// NOTE: Only inputs spending standard version 0 P2PKH outputs can currently be
+// finalized automatically. Inputs of other types must be finalized by the
+// caller by setting the FinalScriptSig field directly.
+
+import (
+	"github.com/decred/dcrd/txscript/v4"
+)
+
+// isFinalized considers this input finalized if it contains a final
+// signature script.
+func isFinalized(p *Packet, inIndex int) bool {
+	input := p.Inputs[inIndex]
+	return input.FinalScriptSig != nil
+}
+
+// isFinalizableP2PKH checks whether the input with the given index spends a
+// P2PKH output and has exactly one partial signature available.
+func isFinalizableP2PKH(p *Packet, inIndex int) bool {
+	pInput := p.Inputs[inIndex]
+	if len(pInput.PartialSigs) != 1 || pInput.RedeemScript != nil {
+		return false
+	}
+
+	utxo, err := p.InputUtxo(inIndex)
+	if err != nil {
+		return false
+	}
+
+	scriptClass := txscript.GetScriptClass(
+		utxo.Version, utxo.PkScript, false,
+	)
+	return scriptClass == txscript.PubKeyHashTy
+}
+
+// MaybeFinalize attempts to finalize the input at index inIndex in the PSBT p,
+// returning true with no error if it succeeds, OR if the input has already
+// been finalized.
+func MaybeFinalize(p *Packet, inIndex int) (bool, error) {
+	if inIndex < 0 || inIndex >= len(p.Inputs) {
+		return false, ErrInvalidPsbtFormat
+	}
+
+	if isFinalized(p, inIndex) {
+		return true, nil
+	}
+
+	if !isFinalizableP2PKH(p, inIndex) {
+		return false, ErrNotFinalizable
+	}
+
+	if err := Finalize(p, inIndex); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// MaybeFinalizeAll attempts to finalize all inputs of the psbt.Packet that are
+// not already finalized, and returns an error if it fails to do so.
+func MaybeFinalizeAll(p *Packet) error {
+	for i := range p.UnsignedTx.TxIn {
+		if _, err := MaybeFinalize(p, i); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Finalize assumes that the provided psbt.Packet struct has all partial
+// signatures and redeem scripts necessary for the input at index inIndex
+// and constructs its final signature script, removing all the now
+// unnecessary partial signing data from the input.
+func Finalize(p *Packet, inIndex int) error {
+	if inIndex < 0 || inIndex >= len(p.Inputs) {
+		return ErrInvalidPsbtFormat
+	}
+
+	if isFinalized(p, inIndex) {
+		return ErrInputAlreadyFinalized
+	}
+
+	if !isFinalizableP2PKH(p, inIndex) {
+		return ErrUnsupportedScriptType
+	}
+
+	pInput := &p.Inputs[inIndex]
+	partialSig := pInput.PartialSigs[0]
+
+	// The signature must use the sighash type requested for this input,
+	// if any was specified.
+	sigHashType := txscript.SigHashType(
+		partialSig.Signature[len(partialSig.Signature)-1],
+	)
+	if pInput.SighashType != 0 && pInput.SighashType != sigHashType {
+		return ErrInvalidSigHashFlags
+	}
+
+	// A P2PKH signature script is simply the signature followed by the
+	// public key.
+	sigScript, err := txscript.NewScriptBuilder().
+		AddData(partialSig.Signature).
+		AddData(partialSig.PubKey).
+		Script()
+	if err != nil {
+		return err
+	}
+
+	// Now that the input is finalized, all the other signing data can be
+	// removed.
+	pInput.FinalScriptSig = sigScript
+	pInput.PartialSigs = nil
+	pInput.SighashType = 0
+	pInput.RedeemScript = nil
+	pInput.Bip32Derivation = nil
+
+	return p.SanityCheck()
+}
@@ -0,0 +1,168 @@
+package psbt
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/sign"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// newP2PKHScript returns a version 0 P2PKH script paying to the given key.
+func newP2PKHScript(t *testing.T, privKey *secp256k1.PrivateKey) []byte {
+	t.Helper()
+
+	pkHash := dcrutil.Hash160(privKey.PubKey().SerializeCompressed())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		pkHash, chaincfg.RegNetParams(),
+	)
+	require.NoError(t, err)
+
+	_, script := addr.PaymentScript()
+	return script
+}
+
+// newTestPacket creates a packet that spends a single P2PKH output into a
+// single output.
+func newTestPacket(t *testing.T, pkScript []byte) *Packet {
+	t.Helper()
+
+	packet, err := New(
+		[]*wire.OutPoint{{Hash: chainhash.Hash{1, 2, 3}, Index: 1}},
+		[]*wire.TxOut{{Value: 90000, PkScript: []byte{0x51}}},
+		1, 0, []uint32{wire.MaxTxInSequenceNum},
+	)
+	require.NoError(t, err)
+
+	packet.Inputs[0].WitnessUtxo = &wire.TxOut{
+		Value:    100000,
+		PkScript: pkScript,
+	}
+
+	return packet
+}
+
+// TestPsbtSerializationRoundTrip asserts that a packet with all known fields
+// set survives a serialization round trip, in both raw and base64 form.
+func TestPsbtSerializationRoundTrip(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+
+	packet := newTestPacket(t, newP2PKHScript(t, privKey))
+	packet.Inputs[0].SighashType = txscript.SigHashAll
+	packet.Inputs[0].RedeemScript = []byte{0x51}
+	packet.Inputs[0].Bip32Derivation = []*Bip32Derivation{{
+		PubKey:               pubKey,
+		MasterKeyFingerprint: 0x01020304,
+		Bip32Path:            []uint32{0x80000000, 1, 2},
+	}}
+	packet.Inputs[0].Unknowns = []*Unknown{{
+		Key:   []byte{0xfc, 0x01},
+		Value: []byte{0x02},
+	}}
+	packet.Outputs[0].Bip32Derivation = []*Bip32Derivation{{
+		PubKey:               pubKey,
+		MasterKeyFingerprint: 0x05060708,
+		Bip32Path:            []uint32{3},
+	}}
+	packet.Unknowns = []*Unknown{{
+		Key:   []byte{0xfc, 0x02},
+		Value: []byte{0x03},
+	}}
+
+	var buf bytes.Buffer
+	require.NoError(t, packet.Serialize(&buf))
+	rawBytes := buf.Bytes()
+
+	decoded, err := NewFromRawBytes(bytes.NewReader(rawBytes), false)
+	require.NoError(t, err)
+	require.Equal(t, packet, decoded)
+
+	b64, err := packet.B64Encode()
+	require.NoError(t, err)
+	decoded, err = NewFromRawBytes(bytes.NewReader([]byte(b64)), true)
+	require.NoError(t, err)
+	require.Equal(t, packet, decoded)
+
+	// Changing the magic bytes must result in a failure to decode.
+	invalid := append([]byte{}, rawBytes...)
+	invalid[0] = 0x00
+	_, err = NewFromRawBytes(bytes.NewReader(invalid), false)
+	require.Equal(t, ErrInvalidMagicBytes, err)
+}
+
+// TestPsbtRejectsSignedTx asserts that a packet can't be created from a
+// transaction that already carries signature scripts.
+func TestPsbtRejectsSignedTx(t *testing.T) {
+	tx := wire.NewMsgTx()
+	tx.AddTxIn(&wire.TxIn{SignatureScript: []byte{0x01}})
+	tx.AddTxOut(&wire.TxOut{Value: 1})
+
+	_, err := NewFromUnsignedTx(tx)
+	require.Equal(t, ErrInvalidRawTxSigned, err)
+}
+
+// TestPsbtFinalizeP2PKH asserts that a signed P2PKH input can be finalized
+// and the final transaction extracted.
+func TestPsbtFinalizeP2PKH(t *testing.T) {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+	pubKey := privKey.PubKey().SerializeCompressed()
+	pkScript := newP2PKHScript(t, privKey)
+
+	packet := newTestPacket(t, pkScript)
+
+	// Without any signatures the packet can neither be finalized nor
+	// extracted.
+	_, err = MaybeFinalize(packet, 0)
+	require.Equal(t, ErrNotFinalizable, err)
+	require.Equal(t, ErrNotFinalizable, MaybeFinalizeAll(packet))
+	_, err = Extract(packet)
+	require.Equal(t, ErrIncompletePSBT, err)
+
+	sig, err := sign.RawTxInSignature(
+		packet.UnsignedTx, 0, pkScript, txscript.SigHashAll,
+		privKey.Serialize(), dcrec.STEcdsaSecp256k1,
+	)
+	require.NoError(t, err)
+	packet.Inputs[0].PartialSigs = []*PartialSig{{
+		PubKey:    pubKey,
+		Signature: sig,
+	}}
+
+	// A signature that doesn't match the requested sighash type must be
+	// rejected.
+	packet.Inputs[0].SighashType = txscript.SigHashSingle
+	_, err = MaybeFinalize(packet, 0)
+	require.Equal(t, ErrInvalidSigHashFlags, err)
+	packet.Inputs[0].SighashType = txscript.SigHashAll
+
+	require.NoError(t, MaybeFinalizeAll(packet))
+	require.True(t, packet.IsComplete())
+	require.Nil(t, packet.Inputs[0].PartialSigs)
+
+	finalTx, err := Extract(packet)
+	require.NoError(t, err)
+	require.Equal(t, int64(100000), finalTx.TxIn[0].ValueIn)
+
+	// The extracted transaction must be valid according to the script
+	// engine.
+	vm, err := txscript.NewEngine(
+		pkScript, finalTx, 0, 0, 0, nil,
+	)
+	require.NoError(t, err)
+	require.NoError(t, vm.Execute())
+
+	// The unsigned transaction of the packet itself must not have been
+	// modified.
+	require.Empty(t, packet.UnsignedTx.TxIn[0].SignatureScript)
+}
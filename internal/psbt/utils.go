@@ -0,0 +1,149 @@
+package psbt
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// readTxOut is a limited version of wire.readTxOut, as that function is not
+// exported. It reads the value, script version and pkScript of an output.
+func readTxOut(txout []byte) (*wire.TxOut, error) {
+	// The value (8 bytes) and script version (2 bytes) are followed by at
+	// least one byte for the length of the pkScript.
+	if len(txout) < 11 {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	valueSer := binary.LittleEndian.Uint64(txout[:8])
+	version := binary.LittleEndian.Uint16(txout[8:10])
+	scriptPubKey, err := wire.ReadVarBytes(
+		bytes.NewReader(txout[10:]), 0, MaxPsbtValueLength,
+		"pkScript",
+	)
+	if err != nil {
+		return nil, ErrInvalidPsbtFormat
+	}
+
+	return &wire.TxOut{
+		Value:    int64(valueSer),
+		Version:  version,
+		PkScript: scriptPubKey,
+	}, nil
+}
+
+// writeTxOut is the inverse of readTxOut and serializes the value, script
+// version and pkScript of an output.
+func writeTxOut(w io.Writer, txout *wire.TxOut) error {
+	var buf [10]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(txout.Value))
+	binary.LittleEndian.PutUint16(buf[8:], txout.Version)
+	if _, err := w.Write(buf[:]); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, txout.PkScript)
+}
+
+// serializeKVpair writes out a kv pair using a varbyte prefix for each.
+func serializeKVpair(w io.Writer, key []byte, value []byte) error {
+	if err := wire.WriteVarBytes(w, 0, key); err != nil {
+		return err
+	}
+
+	return wire.WriteVarBytes(w, 0, value)
+}
+
+// serializeKVPairWithType writes out to the passed writer a type coupled with
+// a key.
+func serializeKVPairWithType(w io.Writer, kt uint8, keydata []byte,
+	value []byte) error {
+
+	// If the key has no data, then we write a blank slice.
+	if keydata == nil {
+		keydata = []byte{}
+	}
+
+	// The final key to be written is: {type} || {keyData}
+	serializedKey := append([]byte{kt}, keydata...)
+	return serializeKVpair(w, serializedKey, value)
+}
+
+// getKey retrieves a single key - both the key type and the keydata (if
+// present) from the stream and returns the key type as an integer, or -1 if
+// the key was of zero length. This integer is is used to indicate the
+// presence of a separator byte which indicates the end of a given key-value
+// pair list, and the keydata as a byte slice or nil if none is present.
+func getKey(r io.Reader) (int, []byte, error) {
+	// For the key, we read the varint separately, instead of using the
+	// available ReadVarBytes, because we have a specific treatment of 0x00
+	// here:
+	count, err := wire.ReadVarInt(r, 0)
+	if err != nil {
+		return -1, nil, ErrInvalidPsbtFormat
+	}
+	if count == 0 {
+		// A separator indicates end of key-value pair list.
+		return -1, nil, nil
+	}
+
+	// Check that we don't attempt to decode a dangerously large key.
+	if count > MaxPsbtKeyLength {
+		return -1, nil, ErrInvalidKeydata
+	}
+
+	// Next, we ready out the designated number of bytes, which may include
+	// a type, key, and optional data.
+	keyTypeAndData := make([]byte, count)
+	if _, err := io.ReadFull(r, keyTypeAndData[:]); err != nil {
+		return -1, nil, err
+	}
+
+	keyType := int(keyTypeAndData[0])
+
+	// Note that the second return value will usually be empty, since most
+	// keys contain no more than the key type byte.
+	if len(keyTypeAndData) == 1 {
+		return keyType, nil, nil
+	}
+
+	// Otherwise, we return the key, along with any data that it may
+	// contain.
+	return keyType, keyTypeAndData[1:], nil
+}
+
+// readValue reads a key-value pair value, limited to MaxPsbtValueLength bytes,
+// from the stream.
+func readValue(r io.Reader) ([]byte, error) {
+	return wire.ReadVarBytes(r, 0, MaxPsbtValueLength, "PSBT value")
+}
+
+// serializeTx serializes the given transaction using the full Decred
+// serialization format.
+func serializeTx(tx *wire.MsgTx) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.Grow(tx.SerializeSize())
+	if err := tx.Serialize(&buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// sortPartialSigs sorts the given partial signatures by their public key,
+// to ensure a deterministic serialization.
+func sortPartialSigs(sigs []*PartialSig) {
+	sort.Slice(sigs, func(i, j int) bool {
+		return bytes.Compare(sigs[i].PubKey, sigs[j].PubKey) < 0
+	})
+}
+
+// sortBip32Derivations sorts the given derivations by their public key, to
+// ensure a deterministic serialization.
+func sortBip32Derivations(derivs []*Bip32Derivation) {
+	sort.Slice(derivs, func(i, j int) bool {
+		return bytes.Compare(derivs[i].PubKey, derivs[j].PubKey) < 0
+	})
+}
@@ -57,6 +57,10 @@ type RegistryConfig struct {
 	// send payments.
 	AcceptKeySend bool
 
+	// AcceptAMP indicates whether we want to accept spontaneous AMP
+	// payments.
+	AcceptAMP bool
+
 	// KeysendHoldTime indicates for how long we want to accept and hold
 	// spontaneous keysend payments.
 	KeysendHoldTime time.Duration
@@ -321,7 +325,7 @@ func (i *InvoiceRegistry) invoiceEventLoop() {
 func (i *InvoiceRegistry) dispatchToSingleClients(event *invoiceEvent) {
 	// Dispatch to single invoice subscribers.
 	for _, client := range i.singleNotificationClients {
-		if client.invoiceRef.PayHash() != event.hash {
+		if !client.matchesEvent(event) {
 			continue
 		}
 
@@ -342,7 +346,7 @@ func (i *InvoiceRegistry) dispatchToClients(event *invoiceEvent) {
 		// ensure we don't duplicate any events.
 
 		// TODO(joostjager): Refactor switches.
-		state := event.invoice.State
+		state := eventState(event.invoice)
 		switch {
 		// If we've already sent this settle event to
 		// the client, then we can skip this.
@@ -388,7 +392,7 @@ func (i *InvoiceRegistry) dispatchToClients(event *invoiceEvent) {
 		// the latest add/settle index it has. We'll use this to ensure
 		// we don't send a notification twice, which can happen if a new
 		// event is added while we're catching up a new client.
-		switch event.invoice.State {
+		switch state {
 		case channeldb.ContractSettled:
 			client.settleIndex = invoice.SettleIndex
 		case channeldb.ContractOpen:
@@ -400,6 +404,20 @@ func (i *InvoiceRegistry) dispatchToClients(event *invoiceEvent) {
 	}
 }
 
+// eventState returns the contract state that an invoice notification should be
+// dispatched under. AMP invoices remain open while their individual sets are
+// settled, so once a settle index has been assigned to them, their events are
+// treated as settle events.
+func eventState(invoice *channeldb.Invoice) channeldb.ContractState {
+	if invoice.IsAMP() && invoice.State == channeldb.ContractOpen &&
+		invoice.SettleIndex != 0 {
+
+		return channeldb.ContractSettled
+	}
+
+	return invoice.State
+}
+
 // deliverBacklogEvents will attempts to query the invoice database for any
 // notifications that the client has missed since it reconnected last.
 func (i *InvoiceRegistry) deliverBacklogEvents(client *InvoiceSubscription) error {
@@ -468,8 +486,20 @@ func (i *InvoiceRegistry) deliverSingleBacklogEvents(
 		return err
 	}
 
+	// References to AMP invoices may only carry the payment address, as
+	// every htlc paying to them has a distinct payment hash. The payment
+	// address then identifies the event instead.
+	var hash lntypes.Hash
+	switch {
+	case client.invoiceRef.PayHash() != nil:
+		hash = *client.invoiceRef.PayHash()
+
+	case client.invoiceRef.PayAddr() != nil:
+		hash = lntypes.Hash(*client.invoiceRef.PayAddr())
+	}
+
 	err = client.notify(&invoiceEvent{
-		hash:    client.invoiceRef.PayHash(),
+		hash:    hash,
 		invoice: &invoice,
 	})
 	if err != nil {
@@ -712,6 +742,63 @@ func (i *InvoiceRegistry) processKeySend(ctx invoiceUpdateCtx) error {
 	return nil
 }
 
+// processAMP just-in-time inserts an invoice if this htlc is an AMP htlc that
+// pays to a payment address we haven't seen before.
+func (i *InvoiceRegistry) processAMP(ctx invoiceUpdateCtx) error {
+	// AMP payments MUST also include an MPP record.
+	if ctx.mpp == nil {
+		return errors.New("no MPP record for AMP")
+	}
+
+	// Create an invoice for the total amount expected, provided in the MPP
+	// record.
+	amt := ctx.mpp.TotalMAtoms()
+
+	// Set the TLV and MPP optional features on the invoice. We'll also make
+	// the AMP features required so that it can't be paid by legacy or MPP
+	// htlcs.
+	rawFeatures := lnwire.NewRawFeatureVector(
+		lnwire.TLVOnionPayloadOptional,
+		lnwire.PaymentAddrOptional,
+		lnwire.AMPRequired,
+	)
+	features := lnwire.NewFeatureVector(rawFeatures, lnwire.Features)
+
+	// Use the minimum block delta that we require for settling htlcs.
+	finalCltvDelta := i.cfg.FinalCltvRejectDelta
+
+	// Pre-check expiry here to prevent inserting an invoice that will not
+	// be settled.
+	if ctx.expiry < uint32(ctx.currentHeight+finalCltvDelta) {
+		return errors.New("final expiry too soon")
+	}
+
+	// Create placeholder invoice. AMP invoices don't carry a preimage,
+	// since every htlc of an AMP payment is settled with a child preimage
+	// derived by the receiver.
+	invoice := &channeldb.Invoice{
+		CreationDate: i.cfg.Clock.Now(),
+		Terms: channeldb.ContractTerm{
+			FinalCltvDelta: finalCltvDelta,
+			Value:          amt,
+			PaymentAddr:    ctx.mpp.PaymentAddr(),
+			Features:       features,
+		},
+	}
+
+	// Insert invoice into database. The invoice is indexed by the hash of
+	// the first htlc that arrives for it. Ignore duplicates, because this
+	// may be a replay or a subsequent htlc of the same payment.
+	_, err := i.AddInvoice(invoice, ctx.hash)
+	if err != nil && err != channeldb.ErrDuplicateInvoice &&
+		err != channeldb.ErrDuplicatePayAddr {
+
+		return err
+	}
+
+	return nil
+}
+
 // NotifyExitHopHtlc attempts to mark an invoice as settled. The return value
 // describes how the htlc should be resolved.
 //
@@ -743,6 +830,7 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		finalCltvRejectDelta: i.cfg.FinalCltvRejectDelta,
 		customRecords:        payload.CustomRecords(),
 		mpp:                  payload.MultiPath(),
+		amp:                  payload.AMPRecord(),
 	}
 
 	// Process keysend if present. Do this outside of the lock, because
@@ -759,6 +847,20 @@ func (i *InvoiceRegistry) NotifyExitHopHtlc(rHash lntypes.Hash,
 		}
 	}
 
+	// Process AMP if present. Do this outside of the lock, because
+	// AddInvoice obtains its own lock. This is no problem, because the
+	// operation is idempotent.
+	if i.cfg.AcceptAMP && ctx.amp != nil {
+		err := i.processAMP(ctx)
+		if err != nil {
+			ctx.log(fmt.Sprintf("amp error: %v", err))
+
+			return NewFailResolution(
+				circuitKey, currentHeight, ResultAmpError,
+			), nil
+		}
+	}
+
 	// Execute locked notify exit hop logic.
 	i.Lock()
 	resolution, err := i.notifyExitHopHtlcLocked(&ctx, hodlChan)
@@ -872,18 +974,32 @@ func (i *InvoiceRegistry) notifyExitHopHtlcLocked(
 		// Also settle any previously accepted htlcs. If a htlc is
 		// marked as settled, we should follow now and settle the htlc
 		// with our peer.
+		setID := ctx.setID()
 		for key, htlc := range invoice.Htlcs {
 			if htlc.State != channeldb.HtlcStateSettled {
 				continue
 			}
 
+			// AMP htlcs are settled per set, each with its own
+			// child preimage. Only notify the htlcs of the set
+			// that was just completed.
+			preimage := res.Preimage
+			if setID != nil {
+				if !sameSet(setID, htlc) ||
+					htlc.AMP.Preimage == nil {
+
+					continue
+				}
+				preimage = *htlc.AMP.Preimage
+			}
+
 			// Notify subscribers that the htlcs should be settled
 			// with our peer. Note that the outcome of the
 			// resolution is set based on the outcome of the single
 			// htlc that we just settled, so may not be accurate
 			// for all htlcs.
 			htlcSettleResolution := NewSettleResolution(
-				res.Preimage, key,
+				preimage, key,
 				int32(htlc.AcceptHeight), res.Outcome,
 			)
 
@@ -1179,6 +1295,19 @@ func (i *invoiceSubscriptionKit) notify(event *invoiceEvent) error {
 	return nil
 }
 
+// matchesEvent returns true if the event applies to the invoice the client
+// subscribed to. Clients subscribed by payment address only, as done for AMP
+// invoices, are matched against the payment address of the invoice.
+func (s *SingleInvoiceSubscription) matchesEvent(event *invoiceEvent) bool {
+	if payHash := s.invoiceRef.PayHash(); payHash != nil {
+		return *payHash == event.hash
+	}
+
+	payAddr := s.invoiceRef.PayAddr()
+	return payAddr != nil && event.invoice != nil &&
+		*payAddr == event.invoice.Terms.PaymentAddr
+}
+
 // SubscribeNotifications returns an InvoiceSubscription which allows the
 // caller to receive async notifications when any invoices are settled or
 // added. The invoiceIndex parameter is a streaming "checkpoint". We'll start
@@ -1223,7 +1352,7 @@ func (i *InvoiceRegistry) SubscribeNotifications(
 				invoiceEvent := ntfn.(*invoiceEvent)
 
 				var targetChan chan *channeldb.Invoice
-				state := invoiceEvent.invoice.State
+				state := eventState(invoiceEvent.invoice)
 				switch state {
 				case channeldb.ContractOpen:
 					targetChan = client.NewInvoices
@@ -1279,6 +1408,14 @@ func (i *InvoiceRegistry) SubscribeNotifications(
 func (i *InvoiceRegistry) SubscribeSingleInvoice(
 	hash lntypes.Hash) (*SingleInvoiceSubscription, error) {
 
+	return i.subscribeSingleInvoice(channeldb.InvoiceRefByHash(hash))
+}
+
+// subscribeSingleInvoice returns an SingleInvoiceSubscription for the invoice
+// identified by the given reference.
+func (i *InvoiceRegistry) subscribeSingleInvoice(
+	ref channeldb.InvoiceRef) (*SingleInvoiceSubscription, error) {
+
 	client := &SingleInvoiceSubscription{
 		Updates: make(chan *channeldb.Invoice),
 		invoiceSubscriptionKit: invoiceSubscriptionKit{
@@ -1286,7 +1423,7 @@ func (i *InvoiceRegistry) SubscribeSingleInvoice(
 			ntfnQueue:  queue.NewConcurrentQueue(20),
 			cancelChan: make(chan struct{}),
 		},
-		invoiceRef: channeldb.InvoiceRefByHash(hash),
+		invoiceRef: ref,
 	}
 	client.ntfnQueue.Start()
 
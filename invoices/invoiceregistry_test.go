@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/decred/dcrlnd/amp"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/clock"
 	"github.com/decred/dcrlnd/lntypes"
@@ -29,7 +30,7 @@ func TestSettleInvoice(t *testing.T) {
 	}
 	defer subscription.Cancel()
 
-	if subscription.invoiceRef.PayHash() != testInvoicePaymentHash {
+	if *subscription.invoiceRef.PayHash() != testInvoicePaymentHash {
 		t.Fatalf("expected subscription for provided hash")
 	}
 
@@ -244,7 +245,7 @@ func TestCancelInvoice(t *testing.T) {
 	}
 	defer subscription.Cancel()
 
-	if subscription.invoiceRef.PayHash() != testInvoicePaymentHash {
+	if *subscription.invoiceRef.PayHash() != testInvoicePaymentHash {
 		t.Fatalf("expected subscription for provided hash")
 	}
 
@@ -373,7 +374,7 @@ func TestSettleHoldInvoice(t *testing.T) {
 	}
 	defer subscription.Cancel()
 
-	if subscription.invoiceRef.PayHash() != testInvoicePaymentHash {
+	if *subscription.invoiceRef.PayHash() != testInvoicePaymentHash {
 		t.Fatalf("expected subscription for provided hash")
 	}
 
@@ -985,6 +986,143 @@ func TestMppPayment(t *testing.T) {
 	}
 }
 
+// TestAMPPayment tests that a spontaneous AMP payment is settled once all of its
+// shards have arrived, and that every htlc is settled with its own child
+// preimage.
+func TestAMPPayment(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		testAMPPayment(t, true)
+	})
+	t.Run("disabled", func(t *testing.T) {
+		testAMPPayment(t, false)
+	})
+}
+
+// testAMPPayment is the inner test function that tests AMP for a particular
+// enabled state on the receiver end.
+func testAMPPayment(t *testing.T, ampEnabled bool) {
+	defer timeout()()
+
+	ctx := newTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.registry.cfg.AcceptAMP = ampEnabled
+
+	allSubscriptions, err := ctx.registry.SubscribeNotifications(0, 0)
+	require.NoError(t, err)
+	defer allSubscriptions.Cancel()
+
+	// Split a random root seed into two shares and derive the children
+	// that will be carried by the two htlcs of the payment.
+	sharer, err := amp.NewSeedSharer()
+	require.NoError(t, err)
+	left, right, err := sharer.Split()
+	require.NoError(t, err)
+	children := []*amp.Child{left.Child(0), right.Child(1)}
+
+	var (
+		payAddr  = [32]byte{0x44}
+		setID    = [32]byte{0x55}
+		shardAmt = lnwire.MilliAtom(1000)
+		totalAmt = 2 * shardAmt
+	)
+
+	ampPayload := func(child *amp.Child) *mockPayload {
+		return &mockPayload{
+			mpp: record.NewMPP(totalAmt, payAddr),
+			amp: record.NewAMP(child.Share, setID, child.Index),
+		}
+	}
+
+	// Send the first htlc. If AMP is disabled, we expect the htlc to be
+	// failed since there is no invoice to pay to.
+	hodlChan1 := make(chan interface{}, 1)
+	resolution, err := ctx.registry.NotifyExitHopHtlc(
+		children[0].Hash, shardAmt, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(10), hodlChan1, ampPayload(children[0]),
+	)
+	require.NoError(t, err)
+
+	if !ampEnabled {
+		failResolution, ok := resolution.(*HtlcFailResolution)
+		require.True(t, ok)
+		require.Equal(t, ResultInvoiceNotFound, failResolution.Outcome)
+		return
+	}
+
+	// Otherwise, the htlc is held until the rest of the set arrives.
+	require.Nil(t, resolution)
+
+	newInvoice := <-allSubscriptions.NewInvoices
+	require.Equal(t, channeldb.ContractOpen, newInvoice.State)
+	require.True(t, newInvoice.IsAMP())
+
+	// Subscribing to the invoice by its payment address only should
+	// deliver its current state.
+	subscription, err := ctx.registry.subscribeSingleInvoice(
+		channeldb.InvoiceRefByAddr(payAddr),
+	)
+	require.NoError(t, err)
+	defer subscription.Cancel()
+
+	update := <-subscription.Updates
+	require.Equal(t, channeldb.ContractOpen, update.State)
+	require.Equal(t, payAddr, update.Terms.PaymentAddr)
+
+	// Send the second htlc, which completes the set. It should be settled
+	// directly with its own child preimage.
+	hodlChan2 := make(chan interface{}, 1)
+	resolution, err = ctx.registry.NotifyExitHopHtlc(
+		children[1].Hash, shardAmt, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(11), hodlChan2, ampPayload(children[1]),
+	)
+	require.NoError(t, err)
+
+	settleResolution, ok := resolution.(*HtlcSettleResolution)
+	require.True(t, ok)
+	require.Equal(t, ResultSettled, settleResolution.Outcome)
+	require.Equal(t, children[1].Preimage, settleResolution.Preimage)
+
+	// The first htlc should be released with the preimage of its own
+	// child.
+	htlcResolution := (<-hodlChan1).(HtlcResolution)
+	settleResolution, ok = htlcResolution.(*HtlcSettleResolution)
+	require.True(t, ok)
+	require.Equal(t, children[0].Preimage, settleResolution.Preimage)
+
+	// Subscribers should be notified of the settled set, while the
+	// invoice itself remains open for further sets.
+	settledInvoice := <-allSubscriptions.SettledInvoices
+	require.Equal(t, channeldb.ContractOpen, settledInvoice.State)
+	require.Equal(t, totalAmt, settledInvoice.AmtPaid)
+
+	// The subscriber by payment address should be notified of the
+	// accepted and the settled set as well.
+	update = <-subscription.Updates
+	for update.AmtPaid != totalAmt {
+		update = <-subscription.Updates
+	}
+	require.Equal(t, payAddr, update.Terms.PaymentAddr)
+
+	ampState, ok := settledInvoice.AMPState[setID]
+	require.True(t, ok)
+	require.Equal(t, channeldb.HtlcStateSettled, ampState.State)
+	require.Equal(t, totalAmt, ampState.AmtPaid)
+
+	// Replaying an htlc of the settled set should yield the same child
+	// preimage.
+	resolution, err = ctx.registry.NotifyExitHopHtlc(
+		children[0].Hash, shardAmt, testHtlcExpiry, testCurrentHeight,
+		getCircuitKey(10), hodlChan1, ampPayload(children[0]),
+	)
+	require.NoError(t, err)
+
+	settleResolution, ok = resolution.(*HtlcSettleResolution)
+	require.True(t, ok)
+	require.Equal(t, ResultReplayToSettled, settleResolution.Outcome)
+	require.Equal(t, children[0].Preimage, settleResolution.Preimage)
+}
+
 // Tests that invoices are canceled after expiration.
 func TestInvoiceExpiryWithRegistry(t *testing.T) {
 	t.Parallel()
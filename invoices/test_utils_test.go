@@ -24,6 +24,7 @@ import (
 
 type mockPayload struct {
 	mpp           *record.MPP
+	amp           *record.AMP
 	customRecords record.CustomSet
 }
 
@@ -31,6 +32,10 @@ func (p *mockPayload) MultiPath() *record.MPP {
 	return p.mpp
 }
 
+func (p *mockPayload) AMPRecord() *record.AMP {
+	return p.amp
+}
+
 func (p *mockPayload) CustomRecords() record.CustomSet {
 	// This function should always return a map instance, but for mock
 	// configuration we do accept nil.
@@ -3,6 +3,7 @@ package invoices
 import (
 	"errors"
 
+	"github.com/decred/dcrlnd/amp"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lntypes"
 	"github.com/decred/dcrlnd/lnwire"
@@ -20,11 +21,18 @@ type invoiceUpdateCtx struct {
 	finalCltvRejectDelta int32
 	customRecords        record.CustomSet
 	mpp                  *record.MPP
+	amp                  *record.AMP
 }
 
 // invoiceRef returns an identifier that can be used to lookup or update the
 // invoice this HTLC is targeting.
 func (i *invoiceUpdateCtx) invoiceRef() channeldb.InvoiceRef {
+	// AMP invoices are looked up solely by their payment address, since
+	// every HTLC of an AMP payment carries a distinct payment hash.
+	if i.amp != nil && i.mpp != nil {
+		payAddr := i.mpp.PaymentAddr()
+		return channeldb.InvoiceRefByAddr(payAddr)
+	}
 	if i.mpp != nil {
 		payAddr := i.mpp.PaymentAddr()
 		return channeldb.InvoiceRefByHashAndAddr(i.hash, payAddr)
@@ -34,8 +42,20 @@ func (i *invoiceUpdateCtx) invoiceRef() channeldb.InvoiceRef {
 
 // log logs a message specific to this update context.
 func (i *invoiceUpdateCtx) log(s string) {
-	log.Debugf("Invoice%v: %v, amt=%v, expiry=%v, circuit=%v, mpp=%v",
-		i.invoiceRef, s, i.amtPaid, i.expiry, i.circuitKey, i.mpp)
+	log.Debugf("Invoice%v: %v, amt=%v, expiry=%v, circuit=%v, mpp=%v, "+
+		"amp=%v", i.invoiceRef(), s, i.amtPaid, i.expiry, i.circuitKey,
+		i.mpp, i.amp)
+}
+
+// setID returns the set id of the AMP htlc described by this context, or nil
+// if the htlc is not an AMP htlc.
+func (i *invoiceUpdateCtx) setID() *channeldb.SetID {
+	if i.amp == nil {
+		return nil
+	}
+
+	setID := channeldb.SetID(i.amp.SetID())
+	return &setID
 }
 
 // failRes is a helper function which creates a failure resolution with
@@ -80,9 +100,20 @@ func updateInvoice(ctx *invoiceUpdateCtx, inv *channeldb.Invoice) (
 			return nil, ctx.acceptRes(resultReplayToAccepted), nil
 
 		case channeldb.HtlcStateSettled:
+			// Settled AMP htlcs each carry their own preimage,
+			// which is distinct from the (absent) invoice
+			// preimage.
+			preimage := inv.Terms.PaymentPreimage
+			if htlc.AMP != nil {
+				preimage = htlc.AMP.Preimage
+			}
+			if preimage == nil {
+				return nil, nil, errors.New("settled htlc " +
+					"without preimage")
+			}
+
 			return nil, ctx.settleRes(
-				*inv.Terms.PaymentPreimage,
-				ResultReplayToSettled,
+				*preimage, ResultReplayToSettled,
 			), nil
 
 		default:
@@ -90,6 +121,11 @@ func updateInvoice(ctx *invoiceUpdateCtx, inv *channeldb.Invoice) (
 		}
 	}
 
+	// AMP invoices can only be paid by AMP htlcs and vice versa.
+	if inv.IsAMP() != (ctx.amp != nil) {
+		return nil, ctx.failRes(ResultAmpError), nil
+	}
+
 	if ctx.mpp == nil {
 		return updateLegacy(ctx, inv)
 	}
@@ -112,6 +148,24 @@ func updateMpp(ctx *invoiceUpdateCtx,
 		CustomRecords: ctx.customRecords,
 	}
 
+	// Store the AMP record and child hash of the htlc so that the set can
+	// be reconstructed once all of its shares have arrived.
+	setID := ctx.setID()
+	if ctx.amp != nil {
+		acceptDesc.AMP = &channeldb.InvoiceHtlcAMPData{
+			Record: *ctx.amp,
+			Hash:   ctx.hash,
+		}
+
+		// Don't accept further htlcs for a set that was already
+		// settled.
+		if state, ok := inv.AMPState[*setID]; ok &&
+			state.State == channeldb.HtlcStateSettled {
+
+			return nil, ctx.failRes(ResultAmpError), nil
+		}
+	}
+
 	// Only accept payments to open invoices. This behaviour differs from
 	// non-mpp payments that are accepted even after the invoice is settled.
 	// Because non-mpp payments don't have a payment address, this is needed
@@ -146,6 +200,12 @@ func updateMpp(ctx *invoiceUpdateCtx,
 			continue
 		}
 
+		// For AMP invoices, only the htlcs belonging to the same set
+		// as the incoming htlc are considered.
+		if !sameSet(setID, htlc) {
+			continue
+		}
+
 		if ctx.mpp.TotalMAtoms() != htlc.MppTotalAmt {
 			return nil, ctx.failRes(ResultHtlcSetTotalMismatch), nil
 		}
@@ -185,6 +245,12 @@ func updateMpp(ctx *invoiceUpdateCtx,
 		return &update, ctx.acceptRes(resultPartialAccepted), nil
 	}
 
+	// The set of an AMP payment is complete, derive the child preimages
+	// and settle the set.
+	if ctx.amp != nil {
+		return settleAMPSet(ctx, inv, &update)
+	}
+
 	// Check to see if we can settle or this is an hold invoice and
 	// we need to wait for the preimage.
 	if inv.HodlInvoice {
@@ -204,6 +270,77 @@ func updateMpp(ctx *invoiceUpdateCtx,
 	), nil
 }
 
+// sameSet returns true if the given htlc belongs to the AMP set identified by
+// setID. For non-AMP payments (nil setID) all htlcs are part of the same set.
+func sameSet(setID *channeldb.SetID, htlc *channeldb.InvoiceHTLC) bool {
+	if setID == nil {
+		return true
+	}
+
+	return htlc.AMP != nil && htlc.AMP.Record.SetID() == *setID
+}
+
+// settleAMPSet reconstructs the child preimages of a complete AMP set from the
+// shares carried by its htlcs and, if all of them match the payment hashes the
+// htlcs were received with, adds a set-level settle to the passed update.
+func settleAMPSet(ctx *invoiceUpdateCtx, inv *channeldb.Invoice,
+	update *channeldb.InvoiceUpdateDesc) (*channeldb.InvoiceUpdateDesc,
+	HtlcResolution, error) {
+
+	setID := ctx.setID()
+
+	// Collect the child descriptors of all accepted htlcs in the set,
+	// including the one that is currently being added.
+	var (
+		keys   []channeldb.CircuitKey
+		hashes []lntypes.Hash
+		descs  []amp.ChildDesc
+	)
+	for key, htlc := range inv.Htlcs {
+		if htlc.State != channeldb.HtlcStateAccepted ||
+			!sameSet(setID, htlc) {
+
+			continue
+		}
+
+		keys = append(keys, key)
+		hashes = append(hashes, htlc.AMP.Hash)
+		descs = append(descs, amp.ChildDesc{
+			Share: htlc.AMP.Record.RootShare(),
+			Index: htlc.AMP.Record.ChildIndex(),
+		})
+	}
+	keys = append(keys, ctx.circuitKey)
+	hashes = append(hashes, ctx.hash)
+	descs = append(descs, amp.ChildDesc{
+		Share: ctx.amp.RootShare(),
+		Index: ctx.amp.ChildIndex(),
+	})
+
+	// Derive the child preimages and verify that each of them matches the
+	// hash of the htlc it belongs to. A mismatch means that the sender
+	// didn't provide a consistent set of shares.
+	children := amp.ReconstructChildren(descs...)
+	preimages := make(map[channeldb.CircuitKey]lntypes.Preimage)
+	for idx, child := range children {
+		if child.Hash != hashes[idx] {
+			return nil, ctx.failRes(ResultAmpReconstruction), nil
+		}
+
+		preimages[keys[idx]] = child.Preimage
+	}
+
+	update.State = &channeldb.InvoiceStateUpdateDesc{
+		NewState:      channeldb.ContractSettled,
+		HTLCPreimages: preimages,
+		SetID:         setID,
+	}
+
+	return update, ctx.settleRes(
+		preimages[ctx.circuitKey], ResultSettled,
+	), nil
+}
+
 // updateLegacy is a callback for DB.UpdateInvoice that contains the invoice
 // settlement logic for legacy payments.
 func updateLegacy(ctx *invoiceUpdateCtx,
@@ -1,5 +1,7 @@
 package lncfg
 
+import "time"
+
 // AutoPilot holds the configuration options for the daemon's autopilot.
 type AutoPilot struct {
 	Active         bool               `long:"active" description:"If the autopilot agent should be active or not."`
@@ -11,4 +13,10 @@ type AutoPilot struct {
 	Private        bool               `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
 	MinConfs       int32              `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
 	ConfTarget     uint32             `long:"conftarget" description:"The confirmation target (in blocks) for channels opened by autopilot."`
+
+	EvaluationInterval time.Duration `long:"evaluationinterval" description:"The interval at which the existing channels we funded are evaluated by the heuristics that are able to judge them, such as the performance heuristic. Channels funded by the remote node are never closed. Channels scoring below closescore are closed, channels scoring below downsizescore are replaced by smaller ones. Set to 0 to never close channels."`
+	CloseScore         float64       `long:"closescore" description:"The channel score between 0 and 1 below which evaluated channels are closed."`
+	DownsizeScore      float64       `long:"downsizescore" description:"The channel score between 0 and 1 below which evaluated channels are closed and replaced by a channel to the same node whose size is reduced in proportion to the score. Must not be lower than closescore."`
+	RevenueWindow      time.Duration `long:"revenuewindow" description:"The time before the present over which the forwarding revenue of channels is considered by the performance heuristic."`
+	MinChanAge         time.Duration `long:"minchanage" description:"The minimum time a channel must have been monitored before it is judged by the performance heuristic."`
 }
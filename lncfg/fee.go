@@ -0,0 +1,30 @@
+package lncfg
+
+import "fmt"
+
+// Fee holds the configuration of the on-chain fee estimators.
+type Fee struct {
+	Estimator string `long:"estimator" description:"The name of the fee estimator to use on startup: static, dcrd, web1 to webN for each fee.url in the order they are given, or median for the median of the dcrd and web estimators. Defaults to median if there are several estimators, to the only dcrd or web estimator otherwise and to static if there is none. The estimator can be switched at runtime through walletrpc."`
+
+	URLs []string `long:"url" description:"The URL of an HTTP fee estimation service that returns its estimates as JSON. Can be specified multiple times."`
+
+	FeesPath string `long:"feespath" description:"The dot separated path of the fee estimates within the JSON response of the fee services, e.g. data.estimates. The estimates can either be an object mapping block targets to fee rates or an array of objects holding a block target and a fee rate each."`
+
+	TargetKey string `long:"targetkey" description:"The key of the block target within each estimate if the estimates are an array of objects. Defaults to target."`
+
+	FeeKey string `long:"feekey" description:"The key of the fee rate within each estimate if the estimates are an array of objects. Defaults to feerate."`
+
+	Multiplier float64 `long:"multiplier" description:"The factor that converts the fee rates of the fee services into atoms/kB, e.g. 1e8 for DCR/kB or 1000 for atoms/byte. Defaults to 1."`
+}
+
+// Validate checks the values configured for the fee estimators.
+func (f *Fee) Validate() error {
+	if f.Multiplier < 0 {
+		return fmt.Errorf("fee multiplier cannot be negative")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Fee implements the Validator interface.
+var _ Validator = (*Fee)(nil)
@@ -0,0 +1,109 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultFeeManagerInterval is the default interval at which the fee
+	// manager evaluates the fees of all channels.
+	DefaultFeeManagerInterval = time.Hour
+
+	// DefaultFeeManagerMaxBaseFee is the default maximum base fee in
+	// milli-atoms that the fee manager sets.
+	DefaultFeeManagerMaxBaseFee = 1000
+
+	// DefaultFeeManagerMinFeeRate is the default minimum fee rate in parts
+	// per million that the fee manager sets.
+	DefaultFeeManagerMinFeeRate = 1
+
+	// DefaultFeeManagerMaxFeeRate is the default maximum fee rate in parts
+	// per million that the fee manager sets.
+	DefaultFeeManagerMaxFeeRate = 1000
+
+	// DefaultFeeManagerFlowWindow is the default period of forwarding
+	// history that the fee manager takes into account.
+	DefaultFeeManagerFlowWindow = 72 * time.Hour
+
+	// DefaultFeeManagerUpdateInterval is the default minimum time between
+	// two fee updates of the same channel.
+	DefaultFeeManagerUpdateInterval = 6 * time.Hour
+
+	// DefaultFeeManagerChangeThreshold is the default minimum relative
+	// change of a channel's fees that warrants an update.
+	DefaultFeeManagerChangeThreshold = 0.1
+
+	// DefaultFeeManagerManualHold is the default time during which the fee
+	// manager leaves channels alone after their policy has been updated
+	// manually.
+	DefaultFeeManagerManualHold = 24 * time.Hour
+)
+
+// FeeManager holds the configuration of the automated fee manager.
+type FeeManager struct {
+	Active bool `long:"active" description:"If true, the fee manager adjusts the base fee and fee rate of each channel within the configured bounds, based on its local balance and its forwarding history. Channels that are drained are priced up, while channels with plenty of local balance are priced down."`
+
+	Interval time.Duration `long:"interval" description:"The interval at which the fees of all channels are evaluated."`
+
+	MinBaseFee uint64 `long:"minbasefee" description:"The minimum base fee in milli-atoms that is set for a channel."`
+
+	MaxBaseFee uint64 `long:"maxbasefee" description:"The maximum base fee in milli-atoms that is set for a channel."`
+
+	MinFeeRate uint32 `long:"minfeerate" description:"The minimum fee rate in parts per million that is set for a channel."`
+
+	MaxFeeRate uint32 `long:"maxfeerate" description:"The maximum fee rate in parts per million that is set for a channel."`
+
+	FlowWindow time.Duration `long:"flowwindow" description:"The period of forwarding history that is taken into account. The net flow out of a channel during this period is expected to continue, so channels are priced according to the local balance they are projected to have after another such period."`
+
+	UpdateInterval time.Duration `long:"updateinterval" description:"The minimum time between two fee updates of the same channel. This rate-limits the channel updates that are gossiped to the network."`
+
+	ChangeThreshold float64 `long:"changethreshold" description:"The minimum relative change of a channel's base fee or fee rate, between 0 and 1, that warrants an update. Smaller changes are not applied to avoid gossip spam."`
+
+	ManualHold time.Duration `long:"manualhold" description:"The time during which the fee manager leaves channels alone after their policy has been updated through UpdateChannelPolicy. Set to 0 to resume fee management at the next evaluation."`
+}
+
+// Validate checks the values configured for the fee manager.
+func (f *FeeManager) Validate() error {
+	if !f.Active {
+		return nil
+	}
+
+	if f.Interval <= 0 {
+		return fmt.Errorf("fee manager interval must be positive")
+	}
+
+	if f.MinBaseFee > f.MaxBaseFee {
+		return fmt.Errorf("fee manager min base fee cannot exceed the " +
+			"max base fee")
+	}
+
+	if f.MinFeeRate > f.MaxFeeRate {
+		return fmt.Errorf("fee manager min fee rate cannot exceed the " +
+			"max fee rate")
+	}
+
+	if f.FlowWindow < 0 {
+		return fmt.Errorf("fee manager flow window cannot be negative")
+	}
+
+	if f.UpdateInterval < 0 {
+		return fmt.Errorf("fee manager update interval cannot be " +
+			"negative")
+	}
+
+	if f.ChangeThreshold < 0 || f.ChangeThreshold > 1 {
+		return fmt.Errorf("fee manager change threshold must be " +
+			"between 0 and 1")
+	}
+
+	if f.ManualHold < 0 {
+		return fmt.Errorf("fee manager manual hold cannot be negative")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure FeeManager implements the Validator
+// interface.
+var _ Validator = (*FeeManager)(nil)
@@ -0,0 +1,73 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultFitnessFlushInterval is the default interval at which the
+	// uptime history of channels is persisted and channels are checked for
+	// their fitness.
+	DefaultFitnessFlushInterval = 10 * time.Minute
+
+	// DefaultFitnessMinLifespan is the default minimum time a channel must
+	// have been monitored before it may be closed automatically.
+	DefaultFitnessMinLifespan = 14 * 24 * time.Hour
+
+	// DefaultFitnessUptimeRetention is the default time for which the
+	// online periods of channels are retained.
+	DefaultFitnessUptimeRetention = 180 * 24 * time.Hour
+
+	// DefaultFitnessCompactionGap is the default maximum gap between online
+	// periods that are merged during compaction.
+	DefaultFitnessCompactionGap = time.Minute
+)
+
+// Fitness holds the configuration of the channel fitness monitor and the
+// automatic closing of unreliable channels.
+type Fitness struct {
+	FlushInterval time.Duration `long:"flushinterval" description:"The interval at which the uptime history of channels and the flap statistics of peers are persisted, and at which channels are checked for their fitness."`
+
+	AutoClose bool `long:"autoclose" description:"If true, open channels whose fitness score drops below fitness.minscore are closed automatically once they have been monitored for fitness.minlifespan. Channels are closed cooperatively once their peer is online, unless fitness.forceclose is set."`
+
+	MinScore float64 `long:"minscore" description:"The fitness score between 0 and 1 below which channels are closed automatically. The score is the fraction of the time the peer was online, reduced by 10% for every flap per day."`
+
+	MinLifespan time.Duration `long:"minlifespan" description:"The minimum time a channel must have been monitored before it may be closed automatically."`
+
+	ForceClose bool `long:"forceclose" description:"If true, unfit channels whose peer is offline are force closed instead of waiting for the peer to come back online for a cooperative close."`
+
+	UptimeRetention time.Duration `long:"uptimeretention" description:"The time for which the online periods of channels are retained on disk. Uptime can be queried for any window within the retention period. Set to 0 to retain the online periods for the lifetime of the channel."`
+
+	CompactionGap time.Duration `long:"compactiongap" description:"The maximum gap between the stored online periods of a channel that are merged into a single period during compaction. Larger gaps save space at the cost of precision, since merged gaps count as online time."`
+}
+
+// Validate checks the values configured for the fitness monitor.
+func (f *Fitness) Validate() error {
+	if f.FlushInterval <= 0 {
+		return fmt.Errorf("fitness flush interval must be positive")
+	}
+
+	if f.MinScore < 0 || f.MinScore > 1 {
+		return fmt.Errorf("fitness min score must be between 0 and 1")
+	}
+
+	if f.UptimeRetention < 0 {
+		return fmt.Errorf("fitness uptime retention cannot be negative")
+	}
+
+	if f.CompactionGap < 0 {
+		return fmt.Errorf("fitness compaction gap cannot be negative")
+	}
+
+	if f.AutoClose && f.MinScore == 0 {
+		return fmt.Errorf("fitness min score must be set to close " +
+			"channels automatically")
+	}
+
+	return nil
+}
+
+// Compile-time constraint to ensure Fitness implements the Validator
+// interface.
+var _ Validator = (*Fitness)(nil)
@@ -17,6 +17,11 @@ type ProtocolOptions struct {
 	// (channels larger than 0.16 BTC) channels, which is the opposite of
 	// mini.
 	WumboChans bool `long:"wumbo-channels" description:"if set, then lnd will create and accept requests for channels larger chan 0.16 BTC"`
+
+	// ZeroConfChans should be set if we want to enable support for
+	// zero-conf channels, which can be used before their funding
+	// transaction confirms.
+	ZeroConfChans bool `long:"zero-conf" description:"if set, then dcrlnd will signal support for zero-conf channels and accept them if the channel acceptor approves"`
 }
 
 // Wumbo returns true if lnd should permit the creation and acceptance of wumbo
@@ -24,3 +29,9 @@ type ProtocolOptions struct {
 func (l *ProtocolOptions) Wumbo() bool {
 	return l.WumboChans
 }
+
+// ZeroConf returns true if dcrlnd should signal support for zero-conf
+// channels and permit their creation and acceptance.
+func (l *ProtocolOptions) ZeroConf() bool {
+	return l.ZeroConfChans
+}
@@ -0,0 +1,44 @@
+package lncfg
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// defaultRPCMiddlewareTimeout is the time after which a request sent
+	// to a gRPC interception middleware times out. This value is chosen
+	// on the high side to allow the middleware to do expensive checks.
+	defaultRPCMiddlewareTimeout = 2 * time.Second
+)
+
+// RPCMiddleware holds the configuration for RPC interception middleware.
+type RPCMiddleware struct {
+	Enable bool `long:"enable" description:"Enable the RPC middleware interceptor functionality."`
+
+	InterceptTimeout time.Duration `long:"intercepttimeout" description:"Time after which a RPC middleware intercept request will time out and return an error if it hasn't yet received a response."`
+
+	Mandatory []string `long:"addmandatory" description:"Add the named middleware to the list of mandatory middlewares. All RPC requests are blocked/denied if any of the mandatory middlewares is not registered. Can be specified multiple times."`
+}
+
+// Validate checks the values configured for the RPC middleware.
+func (r *RPCMiddleware) Validate() error {
+	if r.InterceptTimeout < 0 {
+		return fmt.Errorf("RPC middleware intercept timeout cannot " +
+			"be negative")
+	}
+
+	if !r.Enable && len(r.Mandatory) > 0 {
+		return fmt.Errorf("cannot set mandatory RPC middleware " +
+			"without enabling interception")
+	}
+
+	return nil
+}
+
+// DefaultRPCMiddleware returns the default middleware configuration.
+func DefaultRPCMiddleware() *RPCMiddleware {
+	return &RPCMiddleware{
+		InterceptTimeout: defaultRPCMiddlewareTimeout,
+	}
+}
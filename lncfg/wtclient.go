@@ -15,6 +15,10 @@ type WtClient struct {
 	// SweepFeeRate specifies the fee rate in sat/byte to be used when
 	// constructing justice transactions sent to the tower.
 	SweepFeeRate uint64 `long:"sweep-fee-rate" description:"Specifies the fee rate in sat/byte to be used when constructing justice transactions sent to the watchtower."`
+
+	// SessionCloseRange is the range in blocks over which the deletion of
+	// sessions whose channels have all been closed is randomly delayed.
+	SessionCloseRange uint32 `long:"session-close-range" description:"The range over which to choose a random number of blocks to wait after the last channel of a session is closed before deleting the session from the watchtower. This makes it harder for the tower to correlate the session with the channel closures. If 0, the default of 288 blocks is used."`
 }
 
 // Validate ensures the user has provided a valid configuration.
@@ -43,6 +43,7 @@ import (
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
 	walletloader "github.com/decred/dcrlnd/lnwallet/dcrwallet/loader"
 	"github.com/decred/dcrlnd/macaroons"
+	"github.com/decred/dcrlnd/rpcperms"
 	"github.com/decred/dcrlnd/signal"
 	"github.com/decred/dcrlnd/tor"
 	"github.com/decred/dcrlnd/walletunlocker"
@@ -321,6 +322,7 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 
 	var (
 		walletInitParams WalletUnlockParams
+		shutdownUnlocker = func() {}
 		privateWalletPw  = lnwallet.DefaultPrivatePassphrase
 		publicWalletPw   = lnwallet.DefaultPublicPassphrase
 	)
@@ -382,7 +384,7 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 	// started with the --noseedbackup flag, we use the default password
 	// for wallet encryption.
 	if !cfg.NoSeedBackup || isRemoteWallet {
-		params, err := waitForWalletPassword(
+		params, shutdown, err := waitForWalletPassword(
 			cfg, cfg.RESTListeners, serverOpts, restDialOpts,
 			restProxyDest, tlsCfg, walletUnlockerListeners, remoteChanDB,
 		)
@@ -394,6 +396,7 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 		}
 
 		walletInitParams = *params
+		shutdownUnlocker = shutdown
 		privateWalletPw = walletInitParams.Password
 		publicWalletPw = walletInitParams.Password
 
@@ -404,11 +407,27 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 		}
 	}
 
+	// If RPC middleware is enabled, the middleware chain intercepts every
+	// call once it passed the macaroon checks. It also enforces the custom
+	// caveats of macaroons, which are rejected if it isn't enabled.
+	var (
+		middlewareChain *rpcperms.MiddlewareChain
+		caveatAcceptor  macaroons.CustomCaveatAcceptor
+	)
+	if cfg.RPCMiddleware.Enable {
+		middlewareChain = rpcperms.NewMiddlewareChain(
+			cfg.RPCMiddleware.Mandatory,
+		)
+		caveatAcceptor = middlewareChain
+	}
+
 	var macaroonService *macaroons.Service
 	if !cfg.NoMacaroons {
 		// Create the macaroon authentication/authorization service.
 		macaroonService, err = macaroons.NewService(
 			cfg.networkDir, "lnd", macaroons.IPLockChecker,
+			macaroons.CustomChecker(caveatAcceptor),
+			macaroons.AccountChecker,
 		)
 		if err != nil {
 			err := fmt.Errorf("unable to set up macaroon "+
@@ -426,8 +445,11 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 			return err
 		}
 
-		// Create macaroon files for dcrlncli to use if they don't exist.
-		if !fileExists(cfg.AdminMacPath) && !fileExists(cfg.ReadMacPath) &&
+		// Create macaroon files for dcrlncli to use if they don't exist,
+		// unless the user requested a stateless init.
+		if !walletInitParams.StatelessInit &&
+			!fileExists(cfg.AdminMacPath) &&
+			!fileExists(cfg.ReadMacPath) &&
 			!fileExists(cfg.InvoiceMacPath) {
 
 			err = genMacaroons(
@@ -441,8 +463,52 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 				return err
 			}
 		}
+
+		// As a security service to the user, we warn about macaroon
+		// files that are left on disk even though a stateless init was
+		// requested, as they are accessible by the host system.
+		if walletInitParams.StatelessInit {
+			macaroonFiles := []string{
+				cfg.AdminMacPath, cfg.ReadMacPath,
+				cfg.InvoiceMacPath,
+			}
+			for _, file := range macaroonFiles {
+				if !fileExists(file) {
+					continue
+				}
+
+				ltndLog.Warnf("Found macaroon file %s even "+
+					"though --stateless_init was "+
+					"requested. Change the password with "+
+					"the new_mac_root_key flag to "+
+					"invalidate it.", file)
+			}
+		}
+
+		// The admin macaroon is only returned to the user in the
+		// response of the RPC call that initialized the wallet or
+		// changed its password, which waits for it. A plain unlock
+		// doesn't return one.
+		if walletInitParams.StatelessInit &&
+			walletInitParams.MacResponseChan != nil {
+
+			adminMacBytes, err := bakeMacaroon(
+				ctx, macaroonService, adminPermissions(),
+			)
+			if err != nil {
+				err := fmt.Errorf("unable to create admin "+
+					"macaroon: %v", err)
+				ltndLog.Error(err)
+				return err
+			}
+			walletInitParams.MacResponseChan <- adminMacBytes
+		}
 	}
 
+	// Now we're done with the wallet unlocker, so we can shut it down
+	// before starting the main RPC server.
+	shutdownUnlocker()
+
 	// With the information parsed from the configuration, create valid
 	// instances of the pertinent interfaces required to operate the
 	// Lightning Network Daemon.
@@ -690,7 +756,7 @@ func Main(cfg *Config, lisCfg ListenerCfg, shutdownChan <-chan struct{}) error {
 	rpcServer, err := newRPCServer(
 		cfg, server, macaroonService, cfg.SubRPCServers, serverOpts,
 		restDialOpts, restProxyDest, atplManager, server.invoices,
-		tower, tlsCfg, rpcListeners, chainedAcceptor,
+		tower, tlsCfg, rpcListeners, chainedAcceptor, middlewareChain,
 	)
 	if err != nil {
 		err := fmt.Errorf("unable to create RPC server: %v", err)
@@ -860,13 +926,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	// access invoice related calls. This is useful for merchants and other
 	// services to allow an isolated instance that can only query and
 	// modify invoices.
-	invoiceMac, err := svc.NewMacaroon(
-		ctx, macaroons.DefaultRootKeyID, invoicePermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	invoiceMacBytes, err := invoiceMac.M().MarshalBinary()
+	invoiceMacBytes, err := bakeMacaroon(ctx, svc, invoicePermissions)
 	if err != nil {
 		return err
 	}
@@ -877,13 +937,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the read-only macaroon and write it to a file.
-	roMacaroon, err := svc.NewMacaroon(
-		ctx, macaroons.DefaultRootKeyID, readPermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	roBytes, err := roMacaroon.M().MarshalBinary()
+	roBytes, err := bakeMacaroon(ctx, svc, readPermissions)
 	if err != nil {
 		return err
 	}
@@ -893,14 +947,7 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	}
 
 	// Generate the admin macaroon and write it to a file.
-	adminPermissions := append(readPermissions, writePermissions...)
-	admMacaroon, err := svc.NewMacaroon(
-		ctx, macaroons.DefaultRootKeyID, adminPermissions...,
-	)
-	if err != nil {
-		return err
-	}
-	admBytes, err := admMacaroon.M().MarshalBinary()
+	admBytes, err := bakeMacaroon(ctx, svc, adminPermissions())
 	if err != nil {
 		return err
 	}
@@ -911,6 +958,30 @@ func genMacaroons(ctx context.Context, svc *macaroons.Service,
 	return nil
 }
 
+// bakeMacaroon creates a new macaroon with the default root key and the given
+// permissions and returns it in its binary serialized form.
+func bakeMacaroon(ctx context.Context, svc *macaroons.Service,
+	permissions []bakery.Op) ([]byte, error) {
+
+	mac, err := svc.NewMacaroon(
+		ctx, macaroons.DefaultRootKeyID, permissions...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return mac.M().MarshalBinary()
+}
+
+// adminPermissions returns a list of all permissions in a safe way that doesn't
+// modify any of the source lists.
+func adminPermissions() []bakery.Op {
+	admin := make([]bakery.Op, len(readPermissions)+len(writePermissions))
+	copy(admin[:len(readPermissions)], readPermissions)
+	copy(admin[len(readPermissions):], writePermissions)
+	return admin
+}
+
 // WalletUnlockParams holds the variables used to parameterize the unlocking of
 // lnd's wallet after it has already been created.
 type WalletUnlockParams struct {
@@ -943,28 +1014,50 @@ type WalletUnlockParams struct {
 	// ChansToRestore a set of static channel backups that should be
 	// restored before the main server instance starts up.
 	ChansToRestore walletunlocker.ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to not
+	// create any macaroon files.
+	StatelessInit bool
+
+	// MacResponseChan is the channel the admin macaroon must be sent over
+	// in case of a stateless init, so it can be returned to the user. It
+	// is nil if nobody waits for the admin macaroon, which is the case if
+	// the wallet was merely unlocked.
+	MacResponseChan chan []byte
 }
 
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
 // WalletUnlocker server, and block until a password is provided by
-// the user to this RPC server.
+// the user to this RPC server. The returned closure shuts the servers down
+// and must only be called once the admin macaroon was sent in case of a
+// stateless init, as the RPC call is only answered then.
 func waitForWalletPassword(cfg *Config, restEndpoints []net.Addr,
 	serverOpts []grpc.ServerOption, restDialOpts []grpc.DialOption,
 	restProxyDest string, tlsConf *tls.Config,
-	getListeners rpcListeners, chanDB *channeldb.DB) (*WalletUnlockParams, error) {
+	getListeners rpcListeners, chanDB *channeldb.DB) (*WalletUnlockParams,
+	func(), error) {
 
 	// Start a gRPC server listening for HTTP/2 connections, solely used
 	// for getting the encryption password from the client.
 	listeners, cleanup, err := getListeners()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	defer cleanup()
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
 	grpcServer := grpc.NewServer(serverOpts...)
-	defer func() {
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+
+	var restListeners []net.Listener
+	shutdownUnlocker := func() {
+		for _, lis := range restListeners {
+			lis.Close()
+		}
+		cancel()
+
 		// Unfortunately the grpc lib does not offer any external
 		// method to check if there are existing connections and while
 		// it claims GracefulStop() will wait for outstanding RPC calls
@@ -976,21 +1069,26 @@ func waitForWalletPassword(cfg *Config, restEndpoints []net.Addr,
 		// clients to finish processing.
 		time.Sleep(100 * time.Millisecond)
 		grpcServer.GracefulStop()
-	}()
 
-	// The macaroon files are passed to the wallet unlocker since they are
-	// also encrypted with the wallet's password. These files will be
-	// deleted within it and recreated when successfully changing the
-	// wallet's password.
+		cleanup()
+	}
+
+	// The macaroon files are passed to the wallet unlocker so they can be
+	// deleted when successfully changing the wallet's password. They are
+	// recreated at startup unless a stateless init was requested. The
+	// macaroon database itself is re-encrypted with the new password.
 	macaroonFiles := []string{
-		filepath.Join(cfg.networkDir, macaroons.DBFilename),
 		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
 	}
+	var macaroonDir string
+	if !cfg.NoMacaroons {
+		macaroonDir = cfg.networkDir
+	}
 	pwService := walletunlocker.New(
 		cfg.ChainDir, activeNetParams.Params, !cfg.SyncFreelist,
-		macaroonFiles, chanDB, cfg.Dcrwallet.GRPCHost, cfg.Dcrwallet.CertPath,
-		cfg.Dcrwallet.ClientKeyPath, cfg.Dcrwallet.ClientCertPath,
-		cfg.Dcrwallet.AccountNumber,
+		macaroonFiles, macaroonDir, chanDB, cfg.Dcrwallet.GRPCHost,
+		cfg.Dcrwallet.CertPath, cfg.Dcrwallet.ClientKeyPath,
+		cfg.Dcrwallet.ClientCertPath, cfg.Dcrwallet.AccountNumber,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
@@ -1013,17 +1111,14 @@ func waitForWalletPassword(cfg *Config, restEndpoints []net.Addr,
 	}
 
 	// Start a REST proxy for our gRPC server above.
-	ctx := context.Background()
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
 	mux := proxy.NewServeMux()
 
 	err = lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
 		ctx, mux, restProxyDest, restDialOpts,
 	)
 	if err != nil {
-		return nil, err
+		shutdownUnlocker()
+		return nil, nil, err
 	}
 
 	srv := &http.Server{Handler: allowCORS(mux, cfg.RestCORS)}
@@ -1035,9 +1130,10 @@ func waitForWalletPassword(cfg *Config, restEndpoints []net.Addr,
 				"password gRPC proxy unable to listen on %s",
 				restEndpoint,
 			)
-			return nil, err
+			shutdownUnlocker()
+			return nil, nil, err
 		}
-		defer lis.Close()
+		restListeners = append(restListeners, lis)
 
 		wg.Add(1)
 		go func() {
@@ -1077,8 +1173,9 @@ func waitForWalletPassword(cfg *Config, restEndpoints []net.Addr,
 		// version, then we'll return an error as we don't understand
 		// this.
 		if cipherSeed.InternalVersion != keychain.KeyDerivationVersion {
-			return nil, fmt.Errorf("invalid internal seed version "+
-				"%v, current version is %v",
+			shutdownUnlocker()
+			return nil, nil, fmt.Errorf("invalid internal seed "+
+				"version %v, current version is %v",
 				cipherSeed.InternalVersion,
 				keychain.KeyDerivationVersion)
 		}
@@ -1103,32 +1200,44 @@ func waitForWalletPassword(cfg *Config, restEndpoints []net.Addr,
 				ltndLog.Errorf("Could not unload new "+
 					"wallet: %v", err)
 			}
-			return nil, err
+			shutdownUnlocker()
+			return nil, nil, err
 		}
 
 		return &WalletUnlockParams{
-			Password:       password,
-			Birthday:       birthday,
-			RecoveryWindow: recoveryWindow,
-			Wallet:         newWallet,
-			Loader:         loader,
-			ChansToRestore: initMsg.ChanBackups,
-		}, nil
+			Password:        password,
+			Birthday:        birthday,
+			RecoveryWindow:  recoveryWindow,
+			Wallet:          newWallet,
+			Loader:          loader,
+			ChansToRestore:  initMsg.ChanBackups,
+			StatelessInit:   initMsg.StatelessInit,
+			MacResponseChan: pwService.MacResponseChan,
+		}, shutdownUnlocker, nil
 
 	// The wallet has already been created in the past, and is simply being
 	// unlocked. So we'll just return these passphrases.
 	case unlockMsg := <-pwService.UnlockMsgs:
-		return &WalletUnlockParams{
+		params := &WalletUnlockParams{
 			Password:       unlockMsg.Passphrase,
 			RecoveryWindow: unlockMsg.RecoveryWindow,
 			Wallet:         unlockMsg.Wallet,
 			Loader:         unlockMsg.Loader,
 			ChansToRestore: unlockMsg.ChanBackups,
 			Conn:           unlockMsg.Conn,
-		}, nil
+			StatelessInit:  unlockMsg.StatelessInit,
+		}
+
+		// Only ChangePassword waits for the admin macaroon.
+		if unlockMsg.PasswordChanged {
+			params.MacResponseChan = pwService.MacResponseChan
+		}
+
+		return params, shutdownUnlocker, nil
 
 	case <-signal.ShutdownChannel():
-		return nil, fmt.Errorf("shutting down")
+		shutdownUnlocker()
+		return nil, nil, fmt.Errorf("shutting down")
 	}
 }
 
@@ -51,6 +51,10 @@ type AddInvoiceConfig struct {
 	// GenInvoiceFeatures returns a feature containing feature bits that
 	// should be advertised on freshly generated invoices.
 	GenInvoiceFeatures func() *lnwire.FeatureVector
+
+	// GenAmpInvoiceFeatures returns a feature containing feature bits that
+	// should be advertised on freshly generated AMP invoices.
+	GenAmpInvoiceFeatures func() *lnwire.FeatureVector
 }
 
 // AddInvoiceData contains the required data to create a new invoice.
@@ -95,6 +99,12 @@ type AddInvoiceData struct {
 	// HodlInvoice signals that this invoice shouldn't be settled
 	// immediately upon receiving the payment.
 	HodlInvoice bool
+
+	// Amp signals whether or not to create an AMP invoice.
+	//
+	// NOTE: Preimage and Hash should be nil and HodlInvoice false when
+	// this is set.
+	Amp bool
 }
 
 // AddInvoice attempts to add a new invoice to the invoice database. Any
@@ -110,6 +120,25 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 
 	switch {
 
+	// AMP invoices are settled with preimages derived by the receiver
+	// from the shares carried by the htlcs, so neither a preimage nor a
+	// hash can be provided.
+	case invoice.Amp && (invoice.Preimage != nil || invoice.Hash != nil):
+		return nil, nil,
+			errors.New("preimage and hash must not be set for AMP " +
+				"invoices")
+
+	case invoice.Amp && invoice.HodlInvoice:
+		return nil, nil,
+			errors.New("AMP invoices cannot be hodl invoices")
+
+	// For AMP invoices, a random payment hash is used to index the
+	// invoice, while no preimage is stored.
+	case invoice.Amp:
+		if _, err := rand.Read(paymentHash[:]); err != nil {
+			return nil, nil, err
+		}
+
 	// Only either preimage or hash can be set.
 	case invoice.Preimage != nil && invoice.Hash != nil:
 		return nil, nil,
@@ -270,7 +299,12 @@ func AddInvoice(ctx context.Context, cfg *AddInvoiceConfig,
 	}
 
 	// Set our desired invoice features and add them to our list of options.
-	invoiceFeatures := cfg.GenInvoiceFeatures()
+	var invoiceFeatures *lnwire.FeatureVector
+	if invoice.Amp {
+		invoiceFeatures = cfg.GenAmpInvoiceFeatures()
+	} else {
+		invoiceFeatures = cfg.GenInvoiceFeatures()
+	}
 	options = append(options, zpay32.Features(invoiceFeatures))
 
 	// Generate and set a random payment address for this invoice. If the
@@ -376,7 +410,7 @@ func chanCanBeHopHint(channel *channeldb.OpenChannel,
 	}
 
 	// Fetch the policies for each end of the channel.
-	chanID := channel.ShortChanID().ToUint64()
+	chanID := graphChanID(channel).ToUint64()
 	info, p1, p2, err := graph.FetchChannelEdgesByID(chanID)
 	if err != nil {
 		log.Errorf("Unable to fetch the routing "+
@@ -397,14 +431,50 @@ func chanCanBeHopHint(channel *channeldb.OpenChannel,
 	return remotePolicy, true
 }
 
+// graphChanID returns the short channel id of the edge of the passed channel
+// in the channel graph. Zero-conf channels are identified by their alias until
+// their funding transaction confirms.
+func graphChanID(channel *channeldb.OpenChannel) lnwire.ShortChannelID {
+	if channel.ZeroConfConfirmed() {
+		return channel.ZeroConfRealScid()
+	}
+
+	return channel.ShortChanID()
+}
+
+// hopHintChanID returns the short channel id to use in a hop hint for the
+// passed channel. If our peer handed out an alias for the channel, the alias
+// is used so the hop hint doesn't reveal the funding outpoint of the channel.
+func hopHintChanID(channel *channeldb.OpenChannel,
+	aliases *channeldb.AliasStore) (lnwire.ShortChannelID, error) {
+
+	chanID := lnwire.NewChanIDFromOutPoint(&channel.FundingOutpoint)
+	alias, err := aliases.PeerAlias(chanID)
+	switch {
+	case err == nil:
+		return alias, nil
+
+	case err != channeldb.ErrNoAlias:
+		return lnwire.ShortChannelID{}, err
+	}
+
+	return graphChanID(channel), nil
+}
+
 // addHopHint creates a hop hint out of the passed channel and channel policy.
 // The new hop hint is appended to the passed slice.
 func addHopHint(hopHints *[]func(*zpay32.Invoice),
-	channel *channeldb.OpenChannel, chanPolicy *channeldb.ChannelEdgePolicy) {
+	channel *channeldb.OpenChannel, chanPolicy *channeldb.ChannelEdgePolicy,
+	aliases *channeldb.AliasStore) error {
+
+	chanID, err := hopHintChanID(channel, aliases)
+	if err != nil {
+		return err
+	}
 
 	hopHint := zpay32.HopHint{
 		NodeID:        channel.IdentityPub,
-		ChannelID:     channel.ShortChanID().ToUint64(),
+		ChannelID:     chanID.ToUint64(),
 		FeeBaseMAtoms: uint32(chanPolicy.FeeBaseMAtoms),
 		FeeProportionalMillionths: uint32(
 			chanPolicy.FeeProportionalMillionths,
@@ -414,6 +484,8 @@ func addHopHint(hopHints *[]func(*zpay32.Invoice),
 	*hopHints = append(
 		*hopHints, zpay32.RouteHint([]zpay32.HopHint{hopHint}),
 	)
+
+	return nil
 }
 
 // selectHopHints will select up to numMaxHophints from the set of passed open
@@ -426,6 +498,7 @@ func selectHopHints(amtMAtoms lnwire.MilliAtom, cfg *AddInvoiceConfig,
 	numMaxHophints int) []func(*zpay32.Invoice) {
 
 	graph := cfg.ChanDB.ChannelGraph()
+	aliases := channeldb.NewAliasStore(cfg.ChanDB)
 
 	// We'll add our hop hints in two passes, first we'll add all channels
 	// that are eligible to be hop hints, and also have a local balance
@@ -450,7 +523,12 @@ func selectHopHints(amtMAtoms lnwire.MilliAtom, cfg *AddInvoiceConfig,
 
 		// Now that we now this channel use usable, add it as a hop
 		// hint and the indexes we'll use later.
-		addHopHint(&hopHints, channel, edgePolicy)
+		err := addHopHint(&hopHints, channel, edgePolicy, aliases)
+		if err != nil {
+			log.Errorf("Unable to add hop hint for channel %v: %v",
+				channel.FundingOutpoint, err)
+			continue
+		}
 
 		hopHintChans[channel.FundingOutpoint] = struct{}{}
 		totalHintBandwidth += channel.LocalCommitment.RemoteBalance
@@ -496,7 +574,12 @@ func selectHopHints(amtMAtoms lnwire.MilliAtom, cfg *AddInvoiceConfig,
 
 		// Include the route hint in our set of options that will be
 		// used when creating the invoice.
-		addHopHint(&hopHints, channel, remotePolicy)
+		err := addHopHint(&hopHints, channel, remotePolicy, aliases)
+		if err != nil {
+			log.Errorf("Unable to add hop hint for channel %v: %v",
+				channel.FundingOutpoint, err)
+			continue
+		}
 
 		// As we've just added a new hop hint, we'll accumulate it's
 		// available balance now to update our tally.
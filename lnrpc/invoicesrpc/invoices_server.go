@@ -270,13 +270,14 @@ func (s *Server) AddHoldInvoice(ctx context.Context,
 	invoice *AddHoldInvoiceRequest) (*AddHoldInvoiceResp, error) {
 
 	addInvoiceCfg := &AddInvoiceConfig{
-		AddInvoice:         s.cfg.InvoiceRegistry.AddInvoice,
-		IsChannelActive:    s.cfg.IsChannelActive,
-		ChainParams:        s.cfg.ChainParams,
-		NodeSigner:         s.cfg.NodeSigner,
-		DefaultCLTVExpiry:  s.cfg.DefaultCLTVExpiry,
-		ChanDB:             s.cfg.ChanDB,
-		GenInvoiceFeatures: s.cfg.GenInvoiceFeatures,
+		AddInvoice:            s.cfg.InvoiceRegistry.AddInvoice,
+		IsChannelActive:       s.cfg.IsChannelActive,
+		ChainParams:           s.cfg.ChainParams,
+		NodeSigner:            s.cfg.NodeSigner,
+		DefaultCLTVExpiry:     s.cfg.DefaultCLTVExpiry,
+		ChanDB:                s.cfg.ChanDB,
+		GenInvoiceFeatures:    s.cfg.GenInvoiceFeatures,
+		GenAmpInvoiceFeatures: s.cfg.GenAmpInvoiceFeatures,
 	}
 
 	hash, err := lntypes.MakeHash(invoice.Hash)
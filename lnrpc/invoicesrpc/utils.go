@@ -15,12 +15,18 @@ import (
 // decodePayReq decodes the invoice payment request if present. This is needed,
 // because not all information is stored in dedicated invoice fields. If there
 // is no payment request present, a dummy request will be returned. This can
-// happen with just-in-time inserted keysend invoices.
+// happen with just-in-time inserted keysend and AMP invoices.
 func decodePayReq(invoice *channeldb.Invoice,
 	activeNetParams *chaincfg.Params) (*zpay32.Invoice, error) {
 
 	paymentRequest := string(invoice.PaymentRequest)
 	if paymentRequest == "" {
+		// Just-in-time inserted AMP invoices don't have a preimage
+		// from which the payment hash could be derived.
+		if invoice.IsAMP() {
+			return &zpay32.Invoice{}, nil
+		}
+
 		preimage := invoice.Terms.PaymentPreimage
 		if preimage == nil {
 			return nil, errors.New("cannot reconstruct pay req")
@@ -120,12 +126,72 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 			rpcHtlc.ResolveTime = htlc.ResolveTime.Unix()
 		}
 
+		// Populate any fields relevant to AMP payments.
+		if htlc.AMP != nil {
+			rootShare := htlc.AMP.Record.RootShare()
+			setID := htlc.AMP.Record.SetID()
+
+			var preimage []byte
+			if htlc.AMP.Preimage != nil {
+				preimage = htlc.AMP.Preimage[:]
+			}
+
+			rpcHtlc.Amp = &lnrpc.AMP{
+				RootShare:  rootShare[:],
+				SetId:      setID[:],
+				ChildIndex: htlc.AMP.Record.ChildIndex(),
+				Hash:       htlc.AMP.Hash[:],
+				Preimage:   preimage,
+			}
+		}
+
 		rpcHtlcs = append(rpcHtlcs, &rpcHtlc)
 	}
 
+	// Marshal the state of the settled sets of AMP invoices.
+	var ampInvoiceState map[string]*lnrpc.AMPInvoiceState
+	if invoice.IsAMP() {
+		ampInvoiceState = make(
+			map[string]*lnrpc.AMPInvoiceState,
+			len(invoice.AMPState),
+		)
+	}
+	for setID, ampState := range invoice.AMPState {
+		var state lnrpc.InvoiceHTLCState
+		switch ampState.State {
+		case channeldb.HtlcStateAccepted:
+			state = lnrpc.InvoiceHTLCState_ACCEPTED
+		case channeldb.HtlcStateSettled:
+			state = lnrpc.InvoiceHTLCState_SETTLED
+		case channeldb.HtlcStateCanceled:
+			state = lnrpc.InvoiceHTLCState_CANCELED
+		default:
+			return nil, fmt.Errorf("unknown state %v",
+				ampState.State)
+		}
+
+		var settleTime int64
+		if !ampState.SettleDate.IsZero() {
+			settleTime = ampState.SettleDate.Unix()
+		}
+
+		ampInvoiceState[hex.EncodeToString(setID[:])] =
+			&lnrpc.AMPInvoiceState{
+				State:         state,
+				SettleIndex:   ampState.SettleIndex,
+				SettleTime:    settleTime,
+				AmtPaidMAtoms: int64(ampState.AmtPaid),
+			}
+	}
+
+	var rHash []byte
+	if decoded.PaymentHash != nil {
+		rHash = decoded.PaymentHash[:]
+	}
+
 	rpcInvoice := &lnrpc.Invoice{
 		Memo:            string(invoice.Memo[:]),
-		RHash:           decoded.PaymentHash[:],
+		RHash:           rHash,
 		Value:           int64(atomsAmt),
 		ValueMAtoms:     int64(invoice.Terms.Value),
 		CreationDate:    invoice.CreationDate.Unix(),
@@ -146,7 +212,11 @@ func CreateRPCInvoice(invoice *channeldb.Invoice,
 		State:           state,
 		Htlcs:           rpcHtlcs,
 		Features:        CreateRPCFeatures(invoice.Terms.Features),
-		IsKeysend:       len(invoice.PaymentRequest) == 0,
+		IsKeysend: len(invoice.PaymentRequest) == 0 &&
+			!invoice.IsAMP(),
+		PaymentAddr:     invoice.Terms.PaymentAddr[:],
+		IsAmp:           invoice.IsAMP(),
+		AmpInvoiceState: ampInvoiceState,
 	}
 
 	if preimage != nil {
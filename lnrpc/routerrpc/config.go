@@ -1,6 +1,9 @@
 package routerrpc
 
 import (
+	"fmt"
+
+	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/routing"
 )
@@ -48,7 +51,13 @@ func DefaultConfig() *Config {
 		PenaltyHalfLife:       routing.DefaultPenaltyHalfLife,
 		AttemptCost: routing.DefaultPaymentAttemptPenalty.
 			ToAtoms(),
-		MaxMcHistory: routing.DefaultMaxMcHistory,
+		MaxMcHistory:             routing.DefaultMaxMcHistory,
+		ProbabilityEstimatorType: routing.AprioriEstimatorName,
+		BimodalScaleMAtoms: uint64(
+			routing.DefaultBimodalScaleMAtoms,
+		),
+		BimodalNodeWeight: routing.DefaultBimodalNodeWeight,
+		BimodalDecayTime:  routing.DefaultBimodalDecayTime,
 	}
 
 	return &Config{
@@ -65,5 +74,37 @@ func GetRoutingConfig(cfg *Config) *RoutingConfig {
 		AttemptCost:           cfg.AttemptCost,
 		PenaltyHalfLife:       cfg.PenaltyHalfLife,
 		MaxMcHistory:          cfg.MaxMcHistory,
+
+		ProbabilityEstimatorType: cfg.ProbabilityEstimatorType,
+		BimodalScaleMAtoms:       cfg.BimodalScaleMAtoms,
+		BimodalNodeWeight:        cfg.BimodalNodeWeight,
+		BimodalDecayTime:         cfg.BimodalDecayTime,
+	}
+}
+
+// GetEstimator creates the probability estimator that mission control uses
+// based on the routing config.
+func GetEstimator(cfg *RoutingConfig) (routing.Estimator, error) {
+	switch cfg.ProbabilityEstimatorType {
+	case routing.AprioriEstimatorName:
+		return routing.NewAprioriEstimator(routing.AprioriConfig{
+			AprioriHopProbability: cfg.AprioriHopProbability,
+			AprioriWeight:         cfg.AprioriWeight,
+			PenaltyHalfLife:       cfg.PenaltyHalfLife,
+		})
+
+	case routing.BimodalEstimatorName:
+		return routing.NewBimodalEstimator(routing.BimodalConfig{
+			AprioriHopProbability: cfg.AprioriHopProbability,
+			ScaleMAtoms: lnwire.MilliAtom(
+				cfg.BimodalScaleMAtoms,
+			),
+			NodeWeight: cfg.BimodalNodeWeight,
+			DecayTime:  cfg.BimodalDecayTime,
+		})
+
+	default:
+		return nil, fmt.Errorf("unknown probability estimator %v",
+			cfg.ProbabilityEstimatorType)
 	}
 }
@@ -2,6 +2,7 @@ package routerrpc
 
 import (
 	"context"
+	"crypto/rand"
 	"encoding/hex"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/accounts"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/lnrpc"
@@ -77,6 +79,13 @@ type RouterBackend struct {
 	// InterceptableForwarder exposes the ability to intercept forward events
 	// by letting the router register a ForwardInterceptor.
 	InterceptableForwarder htlcswitch.InterceptableHtlcForwarder
+
+	// ReserveAccountPayment reserves the maximum amount a payment can cost
+	// from the account the macaroon of the call is bound to. Payments
+	// that exceed the balance of the account are refused. A nil
+	// reservation is returned if the macaroon isn't bound to an account.
+	ReserveAccountPayment func(ctx context.Context, hash lntypes.Hash,
+		amt lnwire.MilliAtom) (*accounts.Reservation, error)
 }
 
 // MissionControl defines the mission control dependencies of routerrpc.
@@ -98,6 +107,19 @@ type MissionControl interface {
 	// pair.
 	GetPairHistorySnapshot(fromNode,
 		toNode route.Vertex) routing.TimedPairResult
+
+	// ImportHistory merges the pair results of a snapshot into the mission
+	// control state and returns the number of pairs that changed.
+	ImportHistory(snapshot *routing.MissionControlSnapshot,
+		force bool) (int, error)
+
+	// GetEstimator returns the probability estimator that mission control
+	// currently uses.
+	GetEstimator() routing.Estimator
+
+	// SetEstimator replaces the probability estimator that mission control
+	// uses.
+	SetEstimator(estimator routing.Estimator)
 }
 
 // QueryRoutes attempts to query the daemons' Channel Router for a possible
@@ -395,6 +417,19 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			}
 		}
 
+		// Extract the AMP fields if present on this hop.
+		var amp *lnrpc.AMPRecord
+		if hop.AMP != nil {
+			rootShare := hop.AMP.RootShare()
+			setID := hop.AMP.SetID()
+
+			amp = &lnrpc.AMPRecord{
+				RootShare:  rootShare[:],
+				SetId:      setID[:],
+				ChildIndex: hop.AMP.ChildIndex(),
+			}
+		}
+
 		resp.Hops[i] = &lnrpc.Hop{
 			ChanId:             hop.ChannelID,
 			ChanCapacity:       int64(chanCapacity),
@@ -409,6 +444,7 @@ func (r *RouterBackend) MarshallRoute(route *route.Route) (*lnrpc.Route, error)
 			CustomRecords: hop.CustomRecords,
 			TlvPayload:    !hop.LegacyPayload,
 			MppRecord:     mpp,
+			AmpRecord:     amp,
 		}
 		incomingAmt = hop.AmtToForward
 	}
@@ -431,6 +467,11 @@ func UnmarshallHopWithPubkey(rpcHop *lnrpc.Hop, pubkey route.Vertex) (*route.Hop
 		return nil, err
 	}
 
+	amp, err := UnmarshalAMP(rpcHop.AmpRecord)
+	if err != nil {
+		return nil, err
+	}
+
 	return &route.Hop{
 		OutgoingTimeLock: rpcHop.Expiry,
 		AmtToForward:     lnwire.MilliAtom(rpcHop.AmtToForwardMAtoms),
@@ -439,6 +480,7 @@ func UnmarshallHopWithPubkey(rpcHop *lnrpc.Hop, pubkey route.Vertex) (*route.Hop
 		CustomRecords:    customRecords,
 		LegacyPayload:    !rpcHop.TlvPayload,
 		MPP:              mpp,
+		AMP:              amp,
 	}, nil
 }
 
@@ -662,7 +704,11 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 			payIntent.Amount = *payReq.MilliAt
 		}
 
-		copy(payIntent.PaymentHash[:], payReq.PaymentHash[:])
+		// AMP payments don't use the payment hash of the invoice, each
+		// of their htlcs carries a child hash derived by the sender.
+		if !rpcPayReq.Amp {
+			copy(payIntent.PaymentHash[:], payReq.PaymentHash[:])
+		}
 		destKey := payReq.Destination.SerializeCompressed()
 		copy(payIntent.Target[:], destKey)
 
@@ -712,6 +758,13 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 		payIntent.DestFeatures = features
 	}
 
+	// Set up the AMP options if this is an AMP payment.
+	if rpcPayReq.Amp {
+		if err := setAMPOptions(payIntent, rpcPayReq); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check for disallowed payments to self.
 	if !rpcPayReq.AllowSelfPayment && payIntent.Target == r.SelfNode {
 		return nil, errors.New("self-payments not allowed")
@@ -720,6 +773,60 @@ func (r *RouterBackend) extractIntentFromSendRequest(
 	return payIntent, nil
 }
 
+// setAMPOptions generates the random set id and root share of an AMP payment
+// and attaches them to the payment intent. For spontaneous AMP payments, a
+// random payment address is generated as well.
+func setAMPOptions(payIntent *routing.LightningPayment,
+	rpcPayReq *SendPaymentRequest) error {
+
+	switch {
+	case len(rpcPayReq.PaymentHash) > 0:
+		return errors.New("payment_hash cannot be set for AMP " +
+			"payments")
+
+	case rpcPayReq.PaymentRequest != "" &&
+		(payIntent.DestFeatures == nil ||
+			!payIntent.DestFeatures.HasFeature(lnwire.AMPOptional)):
+
+		return errors.New("payment request does not support AMP")
+	}
+
+	// Spontaneous AMP payments pay to a payment address of our own
+	// choosing, which the receiver will use to insert an invoice
+	// just-in-time.
+	if payIntent.PaymentAddr == nil {
+		var payAddr [32]byte
+		if _, err := rand.Read(payAddr[:]); err != nil {
+			return err
+		}
+		payIntent.PaymentAddr = &payAddr
+	}
+
+	// If no destination features were specified, we'll assume the
+	// receiver understands AMP and the features it depends on, since
+	// they'd otherwise be looked up in the graph.
+	if payIntent.DestFeatures == nil {
+		payIntent.DestFeatures = lnwire.NewFeatureVector(
+			lnwire.NewRawFeatureVector(
+				lnwire.TLVOnionPayloadOptional,
+				lnwire.PaymentAddrOptional,
+				lnwire.MPPOptional,
+				lnwire.AMPOptional,
+			), lnwire.Features,
+		)
+	}
+
+	var ampOpts routing.AMPOptions
+	if _, err := rand.Read(ampOpts.SetID[:]); err != nil {
+		return err
+	}
+	if _, err := rand.Read(ampOpts.RootShare[:]); err != nil {
+		return err
+	}
+
+	return payIntent.SetAMP(&ampOpts)
+}
+
 // unmarshallRouteHints unmarshalls a list of route hints.
 func unmarshallRouteHints(rpcRouteHints []*lnrpc.RouteHint) (
 	[][]zpay32.HopHint, error) {
@@ -856,6 +963,36 @@ func UnmarshalMPP(reqMPP *lnrpc.MPPRecord) (*record.MPP, error) {
 	return record.NewMPP(total, addr), nil
 }
 
+// UnmarshalAMP accepts the root_share, set_id and child_index fields of an RPC
+// AMP record and converts them into a record.AMP object. An error is returned
+// if the root share or set id are not 32 bytes. A nil record is returned if no
+// AMP record was submitted.
+func UnmarshalAMP(reqAMP *lnrpc.AMPRecord) (*record.AMP, error) {
+	if reqAMP == nil {
+		return nil, nil
+	}
+
+	reqRootShare := reqAMP.RootShare
+	reqSetID := reqAMP.SetId
+
+	switch {
+	case len(reqRootShare) != 32:
+		return nil, errors.New("AMP root_share must be 32 bytes")
+
+	case len(reqSetID) != 32:
+		return nil, errors.New("AMP set_id must be 32 bytes")
+	}
+
+	var (
+		rootShare [32]byte
+		setID     [32]byte
+	)
+	copy(rootShare[:], reqRootShare)
+	copy(setID[:], reqSetID)
+
+	return record.NewAMP(rootShare, setID, reqAMP.ChildIndex), nil
+}
+
 // MarshalHTLCAttempt constructs an RPC HTLCAttempt from the db representation.
 func (r *RouterBackend) MarshalHTLCAttempt(
 	htlc channeldb.HTLCAttempt) (*lnrpc.HTLCAttempt, error) {
@@ -239,6 +239,18 @@ func (m *mockMissionControl) GetPairHistorySnapshot(fromNode,
 	return routing.TimedPairResult{}
 }
 
+func (m *mockMissionControl) ImportHistory(
+	snapshot *routing.MissionControlSnapshot, force bool) (int, error) {
+
+	return 0, nil
+}
+
+func (m *mockMissionControl) GetEstimator() routing.Estimator {
+	return nil
+}
+
+func (m *mockMissionControl) SetEstimator(estimator routing.Estimator) {}
+
 type mppOutcome byte
 
 const (
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"sync/atomic"
+	"time"
 
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrlnd/channeldb"
@@ -75,10 +77,22 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/ProbePayment": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/QueryMissionControl": {{
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/routerrpc.Router/XImportMissionControl": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/routerrpc.Router/XExportMissionControl": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/routerrpc.Router/QueryProbability": {{
 			Entity: "offchain",
 			Action: "read",
@@ -87,6 +101,14 @@ var (
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/routerrpc.Router/GetMissionControlConfig": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/routerrpc.Router/SetMissionControlConfig": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/routerrpc.Router/BuildRoute": {{
 			Entity: "offchain",
 			Action: "read",
@@ -273,14 +295,26 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 		return err
 	}
 
+	// If the call was made with a macaroon that is bound to an account,
+	// the maximum amount the payment can cost is reserved from it.
+	reservation, err := s.cfg.RouterBackend.ReserveAccountPayment(
+		stream.Context(), payment.Identifier(),
+		payment.Amount+payment.FeeLimit,
+	)
+	if err != nil {
+		return err
+	}
+
 	err = s.cfg.Router.SendPaymentAsync(payment)
 	if err != nil {
+		reservation.Release()
+
 		// Transform user errors to grpc code.
 		if err == channeldb.ErrPaymentInFlight ||
 			err == channeldb.ErrAlreadyPaid {
 
 			log.Debugf("SendPayment async result for hash %x: %v",
-				payment.PaymentHash, err)
+				payment.Identifier(), err)
 
 			return status.Error(
 				codes.AlreadyExists, err.Error(),
@@ -288,12 +322,18 @@ func (s *Server) SendPaymentV2(req *SendPaymentRequest,
 		}
 
 		log.Errorf("SendPayment async error for hash %x: %v",
-			payment.PaymentHash, err)
+			payment.Identifier(), err)
 
 		return err
 	}
 
-	return s.trackPayment(payment.PaymentHash, stream, req.NoInflightUpdates)
+	// The payment is dispatched, so the account is settled once it
+	// completes.
+	reservation.Track()
+
+	return s.trackPayment(
+		payment.Identifier(), stream, req.NoInflightUpdates,
+	)
 }
 
 // EstimateRouteFee allows callers to obtain a lower bound w.r.t how much it
@@ -339,6 +379,127 @@ func (s *Server) EstimateRouteFee(ctx context.Context,
 	}, nil
 }
 
+// defaultProbeMaxRoutes is the number of routes that ProbePayment probes at
+// most if the caller doesn't specify it.
+const defaultProbeMaxRoutes = 5
+
+// ProbePayment probes the liquidity towards a destination without ever
+// settling a payment, and returns the liquidity bounds learned about the
+// channels of the probed routes.
+func (s *Server) ProbePayment(ctx context.Context,
+	req *ProbePaymentRequest) (*ProbePaymentResponse, error) {
+
+	if len(req.Dest) != 33 {
+		return nil, errors.New("invalid length destination key")
+	}
+	var destNode route.Vertex
+	copy(destNode[:], req.Dest)
+
+	if req.AmtMAtoms <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+
+	feeLimit := lnwire.MilliAtom(math.MaxInt64)
+	if req.FeeLimitMAtoms != 0 {
+		if req.FeeLimitMAtoms < 0 {
+			return nil, errors.New("fee limit must not be negative")
+		}
+		feeLimit = lnwire.MilliAtom(req.FeeLimitMAtoms)
+	}
+
+	finalCltvDelta := s.cfg.RouterBackend.DefaultFinalCltvDelta
+	if req.FinalCltvDelta != 0 {
+		finalCltvDelta = uint16(req.FinalCltvDelta)
+	}
+
+	cltvLimit, err := ValidateCLTVLimit(
+		req.CltvLimit, s.cfg.RouterBackend.MaxTotalTimelock,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	maxRoutes := defaultProbeMaxRoutes
+	if req.MaxRoutes != 0 {
+		maxRoutes = int(req.MaxRoutes)
+	}
+
+	results, err := s.cfg.Router.ProbePayment(&routing.ProbeRequest{
+		Target:         destNode,
+		Amount:         lnwire.MilliAtom(req.AmtMAtoms),
+		FeeLimit:       feeLimit,
+		CltvLimit:      cltvLimit,
+		FinalCLTVDelta: finalCltvDelta,
+		MaxRoutes:      maxRoutes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &ProbePaymentResponse{}
+	for _, result := range results {
+		rpcRoute, err := s.marshallProbeResult(result)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Routes = append(resp.Routes, rpcRoute)
+		if result.Success {
+			resp.Success = true
+		}
+	}
+
+	return resp, nil
+}
+
+// marshallProbeResult marshalls the outcome of probing a route into its rpc
+// representation.
+func (s *Server) marshallProbeResult(
+	result *routing.ProbeRouteResult) (*ProbedRoute, error) {
+
+	rpcRoute, err := s.cfg.RouterBackend.MarshallRoute(result.Route)
+	if err != nil {
+		return nil, err
+	}
+
+	probed := &ProbedRoute{
+		Route:   rpcRoute,
+		Success: result.Success,
+	}
+
+	switch {
+	// A probe that was settled didn't fail at all.
+	case result.Success && result.Failure == nil:
+
+	case result.Failure == nil:
+		probed.Failure = &lnrpc.Failure{
+			Code: lnrpc.Failure_UNREADABLE_FAILURE,
+		}
+
+	default:
+		probed.Failure = &lnrpc.Failure{
+			FailureSourceIndex: uint32(result.FailureSourceIdx),
+		}
+		err := marshallWireError(result.Failure, probed.Failure)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, hop := range result.Hops {
+		probed.Channels = append(probed.Channels, &ProbedChannel{
+			ChanId:             hop.ChannelID,
+			NodeFrom:           hop.Pair.From[:],
+			NodeTo:             hop.Pair.To[:],
+			AmtMAtoms:          int64(hop.Amount),
+			MinLiquidityMAtoms: int64(hop.MinLiquidity),
+			MaxLiquidityMAtoms: int64(hop.MaxLiquidity),
+		})
+	}
+
+	return probed, nil
+}
+
 // SendToRouteV2 sends a payment through a predefined route. The response of this
 // call contains structured error information.
 func (s *Server) SendToRouteV2(ctx context.Context,
@@ -358,6 +519,13 @@ func (s *Server) SendToRouteV2(ctx context.Context,
 		return nil, err
 	}
 
+	reservation, err := s.cfg.RouterBackend.ReserveAccountPayment(
+		ctx, hash, route.TotalAmount,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	// Pass route to the router. This call returns the full htlc attempt
 	// information as it is stored in the database. It is possible that both
 	// the attempt return value and err are non-nil. This can happen when
@@ -365,6 +533,15 @@ func (s *Server) SendToRouteV2(ctx context.Context,
 	// case, we give precedence to the attempt information as stored in the
 	// db.
 	attempt, err := s.cfg.Router.SendToRoute(hash, route)
+
+	// The payment completed when the call returns, so the account can be
+	// settled right away.
+	if attempt != nil && attempt.Settle != nil {
+		reservation.Settle(route.TotalAmount)
+	} else {
+		reservation.Release()
+	}
+
 	if attempt != nil {
 		rpcAttempt, err := s.cfg.RouterBackend.MarshalHTLCAttempt(
 			*attempt,
@@ -419,6 +596,158 @@ func (s *Server) QueryMissionControl(ctx context.Context,
 	return &response, nil
 }
 
+// XImportMissionControl merges the pair histories exported by another node
+// into the mission control state.
+func (s *Server) XImportMissionControl(ctx context.Context,
+	req *XImportMissionControlRequest) (*XImportMissionControlResponse,
+	error) {
+
+	if len(req.Pairs) == 0 {
+		return nil, errors.New("at least one pair required for import")
+	}
+
+	snapshot := &routing.MissionControlSnapshot{
+		Pairs: make(
+			[]routing.MissionControlPairSnapshot, 0, len(req.Pairs),
+		),
+	}
+	for _, rpcPair := range req.Pairs {
+		pair, err := toPairSnapshot(rpcPair)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshot.Pairs = append(snapshot.Pairs, *pair)
+	}
+
+	imported, err := s.cfg.RouterBackend.MissionControl.ImportHistory(
+		snapshot, req.Force,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &XImportMissionControlResponse{
+		NumImported: uint32(imported),
+	}, nil
+}
+
+// XExportMissionControl exports the pair histories of mission control, in the
+// format accepted by XImportMissionControl.
+func (s *Server) XExportMissionControl(ctx context.Context,
+	req *XExportMissionControlRequest) (*XExportMissionControlResponse,
+	error) {
+
+	var since time.Time
+	if req.Since > 0 {
+		since = time.Unix(req.Since, 0)
+	}
+
+	snapshot := s.cfg.RouterBackend.MissionControl.GetHistorySnapshot()
+
+	rpcPairs := make([]*PairHistory, 0, len(snapshot.Pairs))
+	for _, p := range snapshot.Pairs {
+		// Prevent binding to loop variable.
+		pair := p
+
+		if !pair.FailTime.After(since) &&
+			!pair.SuccessTime.After(since) {
+
+			continue
+		}
+
+		rpcPair := PairHistory{
+			NodeFrom: pair.Pair.From[:],
+			NodeTo:   pair.Pair.To[:],
+			History:  toRPCPairData(&pair.TimedPairResult),
+		}
+
+		rpcPairs = append(rpcPairs, &rpcPair)
+	}
+
+	return &XExportMissionControlResponse{
+		Pairs: rpcPairs,
+	}, nil
+}
+
+// toPairSnapshot unmarshalls the rpc pair history of a node pair into a
+// mission control pair snapshot.
+func toPairSnapshot(pair *PairHistory) (*routing.MissionControlPairSnapshot,
+	error) {
+
+	from, err := route.NewVertexFromBytes(pair.NodeFrom)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := route.NewVertexFromBytes(pair.NodeTo)
+	if err != nil {
+		return nil, err
+	}
+
+	nodePair := routing.NewDirectedNodePair(from, to)
+	if pair.History == nil {
+		return nil, fmt.Errorf("no history for pair %v", nodePair)
+	}
+
+	failAmt, err := unmarshallPairAmt(
+		pair.History.FailAmtAtoms, pair.History.FailAmtMAtoms,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid fail amount for pair %v: %v",
+			nodePair, err)
+	}
+
+	successAmt, err := unmarshallPairAmt(
+		pair.History.SuccessAmtAtoms, pair.History.SuccessAmtMAtoms,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid success amount for pair %v: %v",
+			nodePair, err)
+	}
+
+	result := routing.TimedPairResult{
+		FailAmt:    failAmt,
+		SuccessAmt: successAmt,
+	}
+
+	if pair.History.FailTime > 0 {
+		result.FailTime = time.Unix(pair.History.FailTime, 0)
+	}
+
+	if pair.History.SuccessTime > 0 {
+		result.SuccessTime = time.Unix(pair.History.SuccessTime, 0)
+	}
+
+	return &routing.MissionControlPairSnapshot{
+		Pair:            nodePair,
+		TimedPairResult: result,
+	}, nil
+}
+
+// unmarshallPairAmt returns the amount of a pair result that may be specified
+// in atoms, milliatoms or both. If both are specified, they must match.
+func unmarshallPairAmt(amtAtoms, amtMAtoms int64) (lnwire.MilliAtom, error) {
+	if amtAtoms < 0 || amtMAtoms < 0 {
+		return 0, errors.New("amount must not be negative")
+	}
+
+	atoms := dcrutil.Amount(amtAtoms)
+	mAtoms := lnwire.MilliAtom(amtMAtoms)
+
+	switch {
+	case mAtoms == 0:
+		return lnwire.NewMAtomsFromAtoms(atoms), nil
+
+	case atoms != 0 && mAtoms.ToAtoms() != atoms:
+		return 0, errors.New("amounts in atoms and milliatoms don't " +
+			"match")
+
+	default:
+		return mAtoms, nil
+	}
+}
+
 // toRPCPairData marshalls mission control pair data to the rpc struct.
 func toRPCPairData(data *routing.TimedPairResult) *PairData {
 	rpcData := PairData{
@@ -466,6 +795,123 @@ func (s *Server) QueryProbability(ctx context.Context,
 	}, nil
 }
 
+// GetMissionControlConfig returns the probability estimator that mission
+// control currently uses, along with its parameters. The parameters of the
+// estimators that aren't in use are the ones configured at startup.
+func (s *Server) GetMissionControlConfig(ctx context.Context,
+	req *GetMissionControlConfigRequest) (*GetMissionControlConfigResponse,
+	error) {
+
+	config := &MissionControlConfig{
+		Apriori: &AprioriParameters{
+			HalfLifeSeconds: uint64(s.cfg.PenaltyHalfLife.Seconds()),
+			HopProbability:  s.cfg.AprioriHopProbability,
+			Weight:          s.cfg.AprioriWeight,
+		},
+		Bimodal: &BimodalParameters{
+			HopProbability: s.cfg.AprioriHopProbability,
+			ScaleMAtoms:    s.cfg.BimodalScaleMAtoms,
+			NodeWeight:     s.cfg.BimodalNodeWeight,
+			DecayTimeSeconds: uint64(
+				s.cfg.BimodalDecayTime.Seconds(),
+			),
+		},
+	}
+
+	mc := s.cfg.RouterBackend.MissionControl
+	switch estimator := mc.GetEstimator().(type) {
+	case *routing.AprioriEstimator:
+		cfg := estimator.Config()
+		config.Model = MissionControlConfig_APRIORI
+		config.Apriori = &AprioriParameters{
+			HalfLifeSeconds: uint64(cfg.PenaltyHalfLife.Seconds()),
+			HopProbability:  cfg.AprioriHopProbability,
+			Weight:          cfg.AprioriWeight,
+		}
+
+	case *routing.BimodalEstimator:
+		cfg := estimator.Config()
+		config.Model = MissionControlConfig_BIMODAL
+		config.Bimodal = &BimodalParameters{
+			HopProbability:   cfg.AprioriHopProbability,
+			ScaleMAtoms:      uint64(cfg.ScaleMAtoms),
+			NodeWeight:       cfg.NodeWeight,
+			DecayTimeSeconds: uint64(cfg.DecayTime.Seconds()),
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown probability estimator %v",
+			estimator)
+	}
+
+	return &GetMissionControlConfigResponse{
+		Config: config,
+	}, nil
+}
+
+// SetMissionControlConfig switches the probability estimator that mission
+// control uses, or updates its parameters.
+func (s *Server) SetMissionControlConfig(ctx context.Context,
+	req *SetMissionControlConfigRequest) (*SetMissionControlConfigResponse,
+	error) {
+
+	if req.Config == nil {
+		return nil, errors.New("mission control config required")
+	}
+
+	var (
+		estimator routing.Estimator
+		err       error
+	)
+	switch req.Config.Model {
+	case MissionControlConfig_APRIORI:
+		params := req.Config.Apriori
+		if params == nil {
+			return nil, errors.New("apriori parameters required")
+		}
+
+		estimator, err = routing.NewAprioriEstimator(
+			routing.AprioriConfig{
+				PenaltyHalfLife: time.Duration(
+					params.HalfLifeSeconds,
+				) * time.Second,
+				AprioriHopProbability: params.HopProbability,
+				AprioriWeight:         params.Weight,
+			},
+		)
+
+	case MissionControlConfig_BIMODAL:
+		params := req.Config.Bimodal
+		if params == nil {
+			return nil, errors.New("bimodal parameters required")
+		}
+
+		estimator, err = routing.NewBimodalEstimator(
+			routing.BimodalConfig{
+				AprioriHopProbability: params.HopProbability,
+				ScaleMAtoms: lnwire.MilliAtom(
+					params.ScaleMAtoms,
+				),
+				NodeWeight: params.NodeWeight,
+				DecayTime: time.Duration(
+					params.DecayTimeSeconds,
+				) * time.Second,
+			},
+		)
+
+	default:
+		return nil, fmt.Errorf("unknown probability model %v",
+			req.Config.Model)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.cfg.RouterBackend.MissionControl.SetEstimator(estimator)
+
+	return &SetMissionControlConfigResponse{}, nil
+}
+
 // TrackPaymentV2 returns a stream of payment state updates. The stream is
 // closed when the payment completes.
 func (s *Server) TrackPaymentV2(request *TrackPaymentRequest,
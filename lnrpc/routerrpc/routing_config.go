@@ -37,4 +37,23 @@ type RoutingConfig struct {
 	// MaxMcHistory defines the maximum number of payment results that
 	// are held on disk by mission control.
 	MaxMcHistory int `long:"maxmchistory" description:"the maximum number of payment results that are held on disk by mission control"`
+
+	// ProbabilityEstimatorType is the name of the probability estimator
+	// that mission control uses to estimate the success probability of
+	// payment attempts.
+	ProbabilityEstimatorType string `long:"estimator" choice:"apriori" choice:"bimodal" description:"The probability estimator used by mission control"`
+
+	// BimodalScaleMAtoms describes how far from the bounds of the
+	// possible range the liquidity of a channel is expected to be by the
+	// bimodal estimator.
+	BimodalScaleMAtoms uint64 `long:"bimodalscale" description:"The scale of the liquidity distribution of the bimodal estimator in milliatoms"`
+
+	// BimodalNodeWeight defines to what extent the bimodal estimator takes
+	// the results of the other channels of a node into account for its
+	// untried channels. Valid values are in [0, 1].
+	BimodalNodeWeight float64 `long:"bimodalnodeweight" description:"Weight of the results of the other channels of a node in the bimodal estimator. Valid values are in [0, 1]."`
+
+	// BimodalDecayTime is the time after which the liquidity bounds
+	// learned by the bimodal estimator have relaxed halfway.
+	BimodalDecayTime time.Duration `long:"bimodaldecaytime" description:"Defines the duration after which the liquidity bounds learned by the bimodal estimator have relaxed halfway"`
 }
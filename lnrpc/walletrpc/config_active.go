@@ -34,6 +34,11 @@ type Config struct {
 	// the WalletKit will use to respond to fee estimation requests.
 	FeeEstimator chainfee.Estimator
 
+	// FeeEstimators is the switch between all fee estimators available to
+	// the node. The FeeEstimator above forwards its requests to the active
+	// estimator of this switch.
+	FeeEstimators *chainfee.EstimatorSwitch
+
 	// Wallet is the primary wallet that the WalletKit will use to proxy
 	// any relevant requests to.
 	Wallet lnwallet.WalletController
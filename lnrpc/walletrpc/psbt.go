@@ -0,0 +1,77 @@
+// +build !no_walletrpc
+
+package walletrpc
+
+import (
+	"fmt"
+
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwallet"
+)
+
+var (
+	// PsbtLockID is the binary representation of the SHA256 of the string
+	// "dcrlnd-psbt-lock-id" and is used for UTXO lock leases to identify
+	// the outputs locked by the PSBT funding RPCs.
+	//
+	// NOTE: This ID is the same for all PSBT funding calls, so outputs
+	// locked by one call can be released by another one using the
+	// ReleaseOutput RPC with this ID.
+	PsbtLockID = lnwallet.LockID{
+		0xe6, 0xef, 0x6e, 0x1e, 0x20, 0xd9, 0x05, 0x3e,
+		0xd5, 0x3d, 0xed, 0x3e, 0x0c, 0x7b, 0xe1, 0x4b,
+		0x30, 0x14, 0x33, 0xd7, 0xb7, 0x7e, 0xa8, 0xe7,
+		0xee, 0x76, 0x12, 0x69, 0x6d, 0xb9, 0xe5, 0xb0,
+	}
+)
+
+// utxoLock is a type that contains an outpoint of an UTXO and its lock lease
+// information.
+type utxoLock struct {
+	lockID     lnwallet.LockID
+	outpoint   wire.OutPoint
+	expiration uint64
+}
+
+// lockInputs requests a lock lease for all inputs of the unsigned transaction
+// of a PSBT packet by using the internal, static PSBT lock ID of dcrlnd's
+// wallet.
+//
+// NOTE: This method requires the global coin selection lock to be held.
+func lockInputs(w lnwallet.WalletController,
+	tx *wire.MsgTx) ([]*utxoLock, error) {
+
+	locks := make([]*utxoLock, len(tx.TxIn))
+	for idx, rawInput := range tx.TxIn {
+		lock := &utxoLock{
+			lockID:   PsbtLockID,
+			outpoint: rawInput.PreviousOutPoint,
+		}
+
+		expiration, err := w.LeaseOutput(lock.lockID, lock.outpoint)
+		if err != nil {
+			// If we run into a problem with locking one output, we
+			// should try to unlock those that we successfully
+			// locked so far. If that fails as well, there's not
+			// much we can do.
+			for i := 0; i < idx; i++ {
+				op := locks[i].outpoint
+				if err := w.ReleaseOutput(
+					PsbtLockID, op,
+				); err != nil {
+
+					log.Errorf("could not release the "+
+						"lock on %v: %v", op, err)
+				}
+			}
+
+			return nil, fmt.Errorf("could not lease a lock on "+
+				"UTXO: %v", err)
+		}
+
+		lock.expiration = uint64(expiration.Unix())
+		locks[idx] = lock
+	}
+
+	return locks, nil
+}
@@ -10,12 +10,15 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/labels"
 	"github.com/decred/dcrlnd/lnrpc"
@@ -85,6 +88,14 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/walletrpc.WalletKit/ListFeeEstimators": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/walletrpc.WalletKit/SetFeeEstimator": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 		"/walletrpc.WalletKit/PendingSweeps": {{
 			Entity: "onchain",
 			Action: "read",
@@ -113,6 +124,18 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/walletrpc.WalletKit/FundPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/SignPsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/walletrpc.WalletKit/FinalizePsbt": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
 	}
 
 	// DefaultWalletKitMacFilename is the default name of the wallet kit
@@ -522,6 +545,39 @@ func (w *WalletKit) EstimateFee(ctx context.Context,
 	}, nil
 }
 
+// ListFeeEstimators returns the names of all fee estimators available to the
+// wallet, along with the name of the one that is currently used.
+func (w *WalletKit) ListFeeEstimators(ctx context.Context,
+	req *ListFeeEstimatorsRequest) (*ListFeeEstimatorsResponse, error) {
+
+	if w.cfg.FeeEstimators == nil {
+		return nil, fmt.Errorf("fee estimator switching is not " +
+			"supported")
+	}
+
+	return &ListFeeEstimatorsResponse{
+		Estimators: w.cfg.FeeEstimators.Names(),
+		Active:     w.cfg.FeeEstimators.Active(),
+	}, nil
+}
+
+// SetFeeEstimator switches the fee estimator used by the wallet and all other
+// subsystems to the one with the given name.
+func (w *WalletKit) SetFeeEstimator(ctx context.Context,
+	req *SetFeeEstimatorRequest) (*SetFeeEstimatorResponse, error) {
+
+	if w.cfg.FeeEstimators == nil {
+		return nil, fmt.Errorf("fee estimator switching is not " +
+			"supported")
+	}
+
+	if err := w.cfg.FeeEstimators.SetActive(req.Name); err != nil {
+		return nil, err
+	}
+
+	return &SetFeeEstimatorResponse{}, nil
+}
+
 // PendingSweeps returns lists of on-chain outputs that lnd is currently
 // attempting to sweep within its central batching engine. Outputs with similar
 // fee rates are batched together in order to sweep them within a single
@@ -589,6 +645,14 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 		requestedFee := pendingInput.Params.Fee
 		requestedFeeRate := uint32(requestedFee.FeeRate / 1000)
 
+		var sweepTxid, conflictTxid string
+		if pendingInput.SweepTx != nil {
+			sweepTxid = pendingInput.SweepTx.String()
+		}
+		if pendingInput.ConflictTx != nil {
+			conflictTxid = pendingInput.ConflictTx.String()
+		}
+
 		rpcPendingSweeps = append(rpcPendingSweeps, &PendingSweep{
 			Outpoint:              op,
 			WitnessType:           witnessType,
@@ -599,6 +663,13 @@ func (w *WalletKit) PendingSweeps(ctx context.Context,
 			RequestedAtomsPerByte: requestedFeeRate,
 			RequestedConfTarget:   requestedFee.ConfTarget,
 			Force:                 pendingInput.Params.Force,
+			DeadlineHeight: uint32(
+				pendingInput.Params.DeadlineHeight,
+			),
+			BudgetAtoms:  int64(pendingInput.Params.Budget),
+			SweepTxid:    sweepTxid,
+			InMempool:    pendingInput.InMempool,
+			ConflictTxid: conflictTxid,
 		})
 	}
 
@@ -824,3 +895,299 @@ func (w *WalletKit) LabelTransaction(ctx context.Context,
 	err = w.cfg.Wallet.LabelTransaction(*hash, req.Label, req.Overwrite)
 	return &LabelTransactionResponse{}, err
 }
+
+// FundPsbt creates a fully populated PSBT that contains enough inputs to fund
+// the outputs specified in the template. There are two ways of specifying a
+// template: Either by passing in a PSBT with at least one output declared or
+// by passing in a raw TxTemplate message. If there are no inputs specified in
+// the template, coin selection is performed automatically. If the template
+// does contain any inputs, it is assumed that full coin selection happened
+// externally and no additional inputs are added. If the specified inputs
+// aren't enough to fund the outputs with the given fee rate, an error is
+// returned. After either selecting or verifying the inputs, all input UTXOs
+// are locked with an internal app ID.
+//
+// NOTE: If this method returns without an error, it is the caller's
+// responsibility to either spend the locked UTXOs (by finalizing and then
+// publishing the transaction) or to unlock/release the locked UTXOs in case of
+// an error on the caller's side.
+func (w *WalletKit) FundPsbt(_ context.Context,
+	req *FundPsbtRequest) (*FundPsbtResponse, error) {
+
+	var (
+		err    error
+		packet *psbt.Packet
+	)
+
+	// There are two ways a user can specify what we call the template (a
+	// list of inputs and outputs to use in the PSBT): Either as a PSBT
+	// packet directly or as a special RPC message. Find out which one the
+	// user wants to use, they are mutually exclusive.
+	switch {
+	// The template is specified as a PSBT. All we have to do is parse it.
+	case req.GetPsbt() != nil:
+		r := bytes.NewReader(req.GetPsbt())
+		packet, err = psbt.NewFromRawBytes(r, false)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse PSBT: %v", err)
+		}
+
+	// The template is specified as a RPC message. We need to create a new
+	// PSBT and copy the RPC information over.
+	case req.GetRaw() != nil:
+		tpl := req.GetRaw()
+		if len(tpl.Outputs) == 0 {
+			return nil, fmt.Errorf("no outputs specified")
+		}
+
+		// Add the outputs in a deterministic order, so that the same
+		// template always results in the same transaction.
+		addrs := make([]string, 0, len(tpl.Outputs))
+		for addrStr := range tpl.Outputs {
+			addrs = append(addrs, addrStr)
+		}
+		sort.Strings(addrs)
+
+		txOut := make([]*wire.TxOut, 0, len(tpl.Outputs))
+		for _, addrStr := range addrs {
+			addr, err := stdaddr.DecodeAddress(
+				addrStr, w.cfg.ChainParams,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing address "+
+					"%s for network %s: %v", addrStr,
+					w.cfg.ChainParams.Name, err)
+			}
+			pkScript, err := input.PayToAddrScript(addr)
+			if err != nil {
+				return nil, fmt.Errorf("error getting pk "+
+					"script for address %s: %v", addrStr,
+					err)
+			}
+
+			txOut = append(txOut, &wire.TxOut{
+				Value:    int64(tpl.Outputs[addrStr]),
+				PkScript: pkScript,
+			})
+		}
+
+		txIn := make([]*wire.OutPoint, len(tpl.Inputs))
+		sequences := make([]uint32, len(tpl.Inputs))
+		for idx, in := range tpl.Inputs {
+			op, err := unmarshallOutPoint(in)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing "+
+					"outpoint: %v", err)
+			}
+			txIn[idx] = op
+			sequences[idx] = wire.MaxTxInSequenceNum
+		}
+
+		packet, err = psbt.New(
+			txIn, txOut, wire.TxVersion, 0, sequences,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not create PSBT: %v", err)
+		}
+
+	default:
+		return nil, fmt.Errorf("transaction template missing, need " +
+			"to specify either PSBT or raw TX template")
+	}
+
+	// Determine the desired transaction fee.
+	var feeAtomsPerKB chainfee.AtomPerKByte
+	switch {
+	// Estimate the fee by the target number of blocks to confirmation.
+	case req.GetTargetConf() != 0:
+		targetConf := req.GetTargetConf()
+		if targetConf < 2 {
+			return nil, fmt.Errorf("confirmation target must be " +
+				"greater than 1")
+		}
+
+		feeAtomsPerKB, err = w.cfg.FeeEstimator.EstimateFeePerKB(
+			targetConf,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("could not estimate fee: %v",
+				err)
+		}
+
+	// Convert the fee to atoms/kB from the specified value.
+	case req.GetAtomsPerKb() != 0:
+		feeAtomsPerKB = chainfee.AtomPerKByte(req.GetAtomsPerKb())
+
+	default:
+		return nil, fmt.Errorf("fee definition missing, need to " +
+			"specify either target_conf or atoms_per_kb")
+	}
+
+	// The fee rate must not be below the relay fee, otherwise the final
+	// transaction couldn't be published.
+	relayFee := w.cfg.FeeEstimator.RelayFeePerKB()
+	if feeAtomsPerKB < relayFee {
+		return nil, fmt.Errorf("fee rate of %v is below the relay "+
+			"fee of %v", feeAtomsPerKB, relayFee)
+	}
+
+	// Determine the minimum number of confirmations of the coins that
+	// may be selected.
+	minConfs := req.MinConfs
+	switch {
+	case minConfs < 0:
+		return nil, fmt.Errorf("min confirmations must be >= 0")
+
+	case req.SpendUnconfirmed:
+		minConfs = 0
+
+	case minConfs == 0:
+		minConfs = 1
+	}
+
+	// Now that we have the template and the fee, we can fund the PSBT and
+	// lock the inputs. All of that happens while holding the global coin
+	// selection lock, so that no other process can select the same coins.
+	var (
+		changeIndex int32
+		locks       []*utxoLock
+	)
+	err = w.cfg.CoinSelectionLocker.WithCoinSelectLock(func() error {
+		changeIndex, err = w.cfg.Wallet.FundPsbt(
+			packet, minConfs, feeAtomsPerKB,
+		)
+		if err != nil {
+			return fmt.Errorf("wallet couldn't fund PSBT: %v", err)
+		}
+
+		// Make sure we can properly serialize the packet. If this goes
+		// wrong then something isn't right with the inputs and we
+		// probably shouldn't try to lock any of them.
+		if err := packet.Serialize(ioutil.Discard); err != nil {
+			return fmt.Errorf("error serializing funded PSBT: %v",
+				err)
+		}
+
+		// Lock all inputs of the funded packet, so they can't be used
+		// in any other transaction.
+		locks, err = lockInputs(w.cfg.Wallet, packet.UnsignedTx)
+		if err != nil {
+			return fmt.Errorf("could not lock inputs: %v", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert the lock leases to the RPC format.
+	rpcLocks := make([]*UtxoLease, len(locks))
+	for idx, lock := range locks {
+		rpcLocks[idx] = &UtxoLease{
+			Id: lock.lockID[:],
+			Outpoint: &lnrpc.OutPoint{
+				TxidBytes:   lock.outpoint.Hash[:],
+				TxidStr:     lock.outpoint.Hash.String(),
+				OutputIndex: lock.outpoint.Index,
+			},
+			Expiration: lock.expiration,
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := packet.Serialize(&buf); err != nil {
+		return nil, fmt.Errorf("error serializing funded PSBT: %v", err)
+	}
+
+	return &FundPsbtResponse{
+		FundedPsbt:        buf.Bytes(),
+		ChangeOutputIndex: changeIndex,
+		LockedUtxos:       rpcLocks,
+	}, nil
+}
+
+// SignPsbt expects a partial transaction with all inputs and outputs fully
+// declared and tries to sign all inputs that belong to the wallet. Inputs that
+// don't belong to the wallet are left untouched.
+func (w *WalletKit) SignPsbt(_ context.Context, req *SignPsbtRequest) (
+	*SignPsbtResponse, error) {
+
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing PSBT: %v", err)
+	}
+
+	// Let the wallet do the heavy lifting. This will sign all inputs that
+	// we have the UTXO for.
+	signedInputs, err := w.cfg.Wallet.SignPsbt(packet)
+	if err != nil {
+		return nil, fmt.Errorf("error signing PSBT: %v", err)
+	}
+
+	// Serialize the signed PSBT in both the packet and wire format.
+	var signedPsbtBytes bytes.Buffer
+	err = packet.Serialize(&signedPsbtBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing PSBT: %v", err)
+	}
+
+	return &SignPsbtResponse{
+		SignedPsbt:   signedPsbtBytes.Bytes(),
+		SignedInputs: signedInputs,
+	}, nil
+}
+
+// FinalizePsbt expects a partial transaction with all inputs and outputs fully
+// declared and tries to sign all inputs that belong to the wallet. Dcrlnd must
+// be the last signer of the transaction. That means, if there are any unsigned
+// non-wallet inputs, this call will fail. If no error is returned, the PSBT is
+// ready to be extracted and the final TX within to be broadcast.
+//
+// NOTE: This method does NOT publish the transaction once finalized. It is the
+// caller's responsibility to either publish the transaction on success or
+// unlock/release any locked UTXOs in case of an error in this method.
+func (w *WalletKit) FinalizePsbt(_ context.Context,
+	req *FinalizePsbtRequest) (*FinalizePsbtResponse, error) {
+
+	// Parse the funded PSBT.
+	packet, err := psbt.NewFromRawBytes(
+		bytes.NewReader(req.FundedPsbt), false,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing PSBT: %v", err)
+	}
+
+	// Let the wallet do the heavy lifting. This will sign all inputs that
+	// we have the UTXO for. If some inputs can't be signed and don't have
+	// a signature of another party yet, this will fail.
+	if err := w.cfg.Wallet.FinalizePsbt(packet); err != nil {
+		return nil, fmt.Errorf("error finalizing PSBT: %v", err)
+	}
+
+	// Extract the final transaction, which also makes sure all inputs
+	// were finalized.
+	finalTx, err := psbt.Extract(packet)
+	if err != nil {
+		return nil, fmt.Errorf("unable to extract final TX: %v", err)
+	}
+
+	// Serialize the finalized PSBT in both the packet and wire format.
+	var finalPsbtBytes bytes.Buffer
+	err = packet.Serialize(&finalPsbtBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing PSBT: %v", err)
+	}
+	var finalTxBytes bytes.Buffer
+	err = finalTx.Serialize(&finalTxBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error serializing final TX: %v", err)
+	}
+
+	return &FinalizePsbtResponse{
+		SignedPsbt: finalPsbtBytes.Bytes(),
+		RawFinalTx: finalTxBytes.Bytes(),
+	}, nil
+}
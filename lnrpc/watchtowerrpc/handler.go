@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 
 	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/watchtower/wtdb"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -28,6 +29,14 @@ var (
 			Entity: "info",
 			Action: "read",
 		}},
+		"/watchtowerrpc.Watchtower/GetClientUsage": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/watchtowerrpc.Watchtower/ResetClientUsage": {{
+			Entity: "info",
+			Action: "write",
+		}},
 	}
 
 	// ErrTowerNotActive signals that RPC calls cannot be processed because
@@ -144,6 +153,88 @@ func (c *Handler) GetInfo(ctx context.Context,
 	}, nil
 }
 
+// GetClientUsage returns the number of sessions and state updates the client
+// with the given key or address has stored on the watchtower since its usage
+// was last reset, along with the quotas that apply to it.
+func (c *Handler) GetClientUsage(ctx context.Context,
+	req *GetClientUsageRequest) (*GetClientUsageResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	clientID, err := parseClientID(req.ClientKey, req.ClientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	usage, err := c.cfg.Tower.ClientUsage(clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	maxSessions, maxUpdates := c.cfg.Tower.ClientQuotas()
+	if req.ClientAddr != "" {
+		maxSessions, maxUpdates = c.cfg.Tower.AddressQuotas()
+	}
+
+	return &GetClientUsageResponse{
+		NumSessions: usage.NumSessions,
+		NumUpdates:  usage.NumUpdates,
+		MaxSessions: maxSessions,
+		MaxUpdates:  maxUpdates,
+	}, nil
+}
+
+// ResetClientUsage clears the usage of the client with the given key or
+// address, allowing it to create sessions and store state updates up to its
+// full quota again.
+func (c *Handler) ResetClientUsage(ctx context.Context,
+	req *ResetClientUsageRequest) (*ResetClientUsageResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	clientID, err := parseClientID(req.ClientKey, req.ClientAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.cfg.Tower.ResetClientUsage(clientID); err != nil {
+		return nil, err
+	}
+
+	return &ResetClientUsageResponse{}, nil
+}
+
+// parseClientID returns the ClientID identified by exactly one of the given
+// client key or client address.
+func parseClientID(key []byte, addr string) (wtdb.ClientID, error) {
+	switch {
+	case len(key) > 0 && addr != "":
+		return "", errors.New("only one of client key and client " +
+			"address may be specified")
+
+	case len(key) > 0:
+		var id wtdb.SessionID
+		if len(key) != len(id) {
+			return "", fmt.Errorf("client key must be %d bytes, "+
+				"got %d", len(id), len(key))
+		}
+		copy(id[:], key)
+
+		return wtdb.NewClientIDFromKey(&id), nil
+
+	case addr != "":
+		return wtdb.ParseClientID(addr), nil
+
+	default:
+		return "", errors.New("client key or client address must be " +
+			"specified")
+	}
+}
+
 // isActive returns nil if the tower backend is initialized, and the Handler can
 // proccess RPC requests.
 func (c *Handler) isActive() error {
@@ -4,6 +4,7 @@ import (
 	"net"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrlnd/watchtower/wtdb"
 )
 
 // WatchtowerBackend abstracts access to the watchtower information that is
@@ -20,4 +21,23 @@ type WatchtowerBackend interface {
 	// ExternalIPs returns the addresses where the watchtower can be reached
 	// by clients externally.
 	ExternalIPs() []net.Addr
+
+	// ClientQuotas returns the maximum number of sessions and state
+	// updates a single client key may store on the watchtower. A value of
+	// zero means that the respective resource is unlimited.
+	ClientQuotas() (uint32, uint64)
+
+	// AddressQuotas returns the maximum number of sessions and state
+	// updates the clients connecting from a single address may store on
+	// the watchtower. A value of zero means that the respective resource
+	// is unlimited.
+	AddressQuotas() (uint32, uint64)
+
+	// ClientUsage returns the resources consumed by the client with the
+	// given id since its usage was last reset.
+	ClientUsage(wtdb.ClientID) (*wtdb.ClientUsage, error)
+
+	// ResetClientUsage clears the resources accounted to the client with
+	// the given id, allowing it to consume its full quota again.
+	ResetClientUsage(wtdb.ClientID) error
 }
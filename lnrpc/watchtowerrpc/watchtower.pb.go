@@ -133,6 +133,241 @@ func (x *GetInfoResponse) GetUris() []string {
 	return nil
 }
 
+type GetClientUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The public key the client authenticates with. Exactly one of client_key
+	// and client_addr must be set.
+	ClientKey []byte `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	// The IP address the clients connect from. Clients connecting over IPv6
+	// are identified by the /64 prefix of their address.
+	ClientAddr string `protobuf:"bytes,2,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
+}
+
+func (x *GetClientUsageRequest) Reset() {
+	*x = GetClientUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_watchtowerrpc_watchtower_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUsageRequest) ProtoMessage() {}
+
+func (x *GetClientUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_watchtowerrpc_watchtower_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetClientUsageRequest) Descriptor() ([]byte, []int) {
+	return file_watchtowerrpc_watchtower_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetClientUsageRequest) GetClientKey() []byte {
+	if x != nil {
+		return x.ClientKey
+	}
+	return nil
+}
+
+func (x *GetClientUsageRequest) GetClientAddr() string {
+	if x != nil {
+		return x.ClientAddr
+	}
+	return ""
+}
+
+type GetClientUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of sessions the client has created since its usage was last
+	// reset.
+	NumSessions uint32 `protobuf:"varint,1,opt,name=num_sessions,json=numSessions,proto3" json:"num_sessions,omitempty"`
+	// The number of state updates the client has stored since its usage was
+	// last reset.
+	NumUpdates uint64 `protobuf:"varint,2,opt,name=num_updates,json=numUpdates,proto3" json:"num_updates,omitempty"`
+	// The maximum number of sessions a single client key or address may
+	// create, 0 means unlimited.
+	MaxSessions uint32 `protobuf:"varint,3,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`
+	// The maximum number of state updates a single client key or address may
+	// store, 0 means unlimited.
+	MaxUpdates uint64 `protobuf:"varint,4,opt,name=max_updates,json=maxUpdates,proto3" json:"max_updates,omitempty"`
+}
+
+func (x *GetClientUsageResponse) Reset() {
+	*x = GetClientUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_watchtowerrpc_watchtower_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetClientUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientUsageResponse) ProtoMessage() {}
+
+func (x *GetClientUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watchtowerrpc_watchtower_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetClientUsageResponse) Descriptor() ([]byte, []int) {
+	return file_watchtowerrpc_watchtower_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetClientUsageResponse) GetNumSessions() uint32 {
+	if x != nil {
+		return x.NumSessions
+	}
+	return 0
+}
+
+func (x *GetClientUsageResponse) GetNumUpdates() uint64 {
+	if x != nil {
+		return x.NumUpdates
+	}
+	return 0
+}
+
+func (x *GetClientUsageResponse) GetMaxSessions() uint32 {
+	if x != nil {
+		return x.MaxSessions
+	}
+	return 0
+}
+
+func (x *GetClientUsageResponse) GetMaxUpdates() uint64 {
+	if x != nil {
+		return x.MaxUpdates
+	}
+	return 0
+}
+
+type ResetClientUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The public key the client authenticates with. Exactly one of client_key
+	// and client_addr must be set.
+	ClientKey []byte `protobuf:"bytes,1,opt,name=client_key,json=clientKey,proto3" json:"client_key,omitempty"`
+	// The IP address the clients connect from. Clients connecting over IPv6
+	// are identified by the /64 prefix of their address.
+	ClientAddr string `protobuf:"bytes,2,opt,name=client_addr,json=clientAddr,proto3" json:"client_addr,omitempty"`
+}
+
+func (x *ResetClientUsageRequest) Reset() {
+	*x = ResetClientUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_watchtowerrpc_watchtower_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetClientUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetClientUsageRequest) ProtoMessage() {}
+
+func (x *ResetClientUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_watchtowerrpc_watchtower_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetClientUsageRequest.ProtoReflect.Descriptor instead.
+func (*ResetClientUsageRequest) Descriptor() ([]byte, []int) {
+	return file_watchtowerrpc_watchtower_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResetClientUsageRequest) GetClientKey() []byte {
+	if x != nil {
+		return x.ClientKey
+	}
+	return nil
+}
+
+func (x *ResetClientUsageRequest) GetClientAddr() string {
+	if x != nil {
+		return x.ClientAddr
+	}
+	return ""
+}
+
+type ResetClientUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResetClientUsageResponse) Reset() {
+	*x = ResetClientUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_watchtowerrpc_watchtower_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResetClientUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetClientUsageResponse) ProtoMessage() {}
+
+func (x *ResetClientUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_watchtowerrpc_watchtower_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetClientUsageResponse.ProtoReflect.Descriptor instead.
+func (*ResetClientUsageResponse) Descriptor() ([]byte, []int) {
+	return file_watchtowerrpc_watchtower_proto_rawDescGZIP(), []int{5}
+}
+
 var File_watchtowerrpc_watchtower_proto protoreflect.FileDescriptor
 
 var file_watchtowerrpc_watchtower_proto_rawDesc = []byte{
@@ -145,16 +380,52 @@ var file_watchtowerrpc_watchtower_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09,
 	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
 	0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x72,
-	0x69, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x32, 0x56,
-	0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x07,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x74,
-	0x6f, 0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f,
-	0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2e, 0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x63, 0x72, 0x65, 0x64, 0x2f, 0x64, 0x63, 0x72, 0x6c,
-	0x6e, 0x64, 0x2f, 0x6c, 0x6e, 0x72, 0x70, 0x63, 0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f,
-	0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x75, 0x72, 0x69, 0x73, 0x22, 0x57,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0xa0, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x75, 0x6d, 0x5f, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x75, 0x6d, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6e, 0x75, 0x6d, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6d, 0x61, 0x78, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x17, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0x9a, 0x02, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72,
+	0x12, 0x48, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1d, 0x2e, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x77, 0x61, 0x74,
+	0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5d, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x72,
+	0x70, 0x63, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x10, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e,
+	0x77, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77,
+	0x65, 0x72, 0x72, 0x70, 0x63, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x43, 0x6c, 0x69, 0x65, 0x6e,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2e,
+	0x5a, 0x2c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x63,
+	0x72, 0x65, 0x64, 0x2f, 0x64, 0x63, 0x72, 0x6c, 0x6e, 0x64, 0x2f, 0x6c, 0x6e, 0x72, 0x70, 0x63,
+	0x2f, 0x77, 0x61, 0x74, 0x63, 0x68, 0x74, 0x6f, 0x77, 0x65, 0x72, 0x72, 0x70, 0x63, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -169,16 +440,24 @@ func file_watchtowerrpc_watchtower_proto_rawDescGZIP() []byte {
 	return file_watchtowerrpc_watchtower_proto_rawDescData
 }
 
-var file_watchtowerrpc_watchtower_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_watchtowerrpc_watchtower_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
 var file_watchtowerrpc_watchtower_proto_goTypes = []interface{}{
-	(*GetInfoRequest)(nil),  // 0: watchtowerrpc.GetInfoRequest
-	(*GetInfoResponse)(nil), // 1: watchtowerrpc.GetInfoResponse
+	(*GetInfoRequest)(nil),           // 0: watchtowerrpc.GetInfoRequest
+	(*GetInfoResponse)(nil),          // 1: watchtowerrpc.GetInfoResponse
+	(*GetClientUsageRequest)(nil),    // 2: watchtowerrpc.GetClientUsageRequest
+	(*GetClientUsageResponse)(nil),   // 3: watchtowerrpc.GetClientUsageResponse
+	(*ResetClientUsageRequest)(nil),  // 4: watchtowerrpc.ResetClientUsageRequest
+	(*ResetClientUsageResponse)(nil), // 5: watchtowerrpc.ResetClientUsageResponse
 }
 var file_watchtowerrpc_watchtower_proto_depIdxs = []int32{
 	0, // 0: watchtowerrpc.Watchtower.GetInfo:input_type -> watchtowerrpc.GetInfoRequest
-	1, // 1: watchtowerrpc.Watchtower.GetInfo:output_type -> watchtowerrpc.GetInfoResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
+	2, // 1: watchtowerrpc.Watchtower.GetClientUsage:input_type -> watchtowerrpc.GetClientUsageRequest
+	4, // 2: watchtowerrpc.Watchtower.ResetClientUsage:input_type -> watchtowerrpc.ResetClientUsageRequest
+	1, // 3: watchtowerrpc.Watchtower.GetInfo:output_type -> watchtowerrpc.GetInfoResponse
+	3, // 4: watchtowerrpc.Watchtower.GetClientUsage:output_type -> watchtowerrpc.GetClientUsageResponse
+	5, // 5: watchtowerrpc.Watchtower.ResetClientUsage:output_type -> watchtowerrpc.ResetClientUsageResponse
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -214,6 +493,54 @@ func file_watchtowerrpc_watchtower_proto_init() {
 				return nil
 			}
 		}
+		file_watchtowerrpc_watchtower_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClientUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_watchtowerrpc_watchtower_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetClientUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_watchtowerrpc_watchtower_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetClientUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_watchtowerrpc_watchtower_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResetClientUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -221,7 +548,7 @@ func file_watchtowerrpc_watchtower_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_watchtowerrpc_watchtower_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   6,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -252,6 +579,18 @@ type WatchtowerClient interface {
 	//including its public key and URIs where the server is currently
 	//listening for clients.
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// lncli: tower usage
+	//GetClientUsage returns the number of sessions and state updates the client
+	//with the given key, or the clients connecting from the given address, have
+	//stored on the watchtower since the usage was last reset, along with the
+	//quotas that apply to it.
+	GetClientUsage(ctx context.Context, in *GetClientUsageRequest, opts ...grpc.CallOption) (*GetClientUsageResponse, error)
+	// lncli: tower resetusage
+	//ResetClientUsage clears the usage of the client with the given key, or of
+	//the given client address, allowing it to create sessions and store state
+	//updates up to its full quota again. The sessions and state updates already stored for the client
+	//are kept.
+	ResetClientUsage(ctx context.Context, in *ResetClientUsageRequest, opts ...grpc.CallOption) (*ResetClientUsageResponse, error)
 }
 
 type watchtowerClient struct {
@@ -271,6 +610,24 @@ func (c *watchtowerClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts
 	return out, nil
 }
 
+func (c *watchtowerClient) GetClientUsage(ctx context.Context, in *GetClientUsageRequest, opts ...grpc.CallOption) (*GetClientUsageResponse, error) {
+	out := new(GetClientUsageResponse)
+	err := c.cc.Invoke(ctx, "/watchtowerrpc.Watchtower/GetClientUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *watchtowerClient) ResetClientUsage(ctx context.Context, in *ResetClientUsageRequest, opts ...grpc.CallOption) (*ResetClientUsageResponse, error) {
+	out := new(ResetClientUsageResponse)
+	err := c.cc.Invoke(ctx, "/watchtowerrpc.Watchtower/ResetClientUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WatchtowerServer is the server API for Watchtower service.
 type WatchtowerServer interface {
 	// lncli: tower info
@@ -278,6 +635,18 @@ type WatchtowerServer interface {
 	//including its public key and URIs where the server is currently
 	//listening for clients.
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// lncli: tower usage
+	//GetClientUsage returns the number of sessions and state updates the client
+	//with the given key, or the clients connecting from the given address, have
+	//stored on the watchtower since the usage was last reset, along with the
+	//quotas that apply to it.
+	GetClientUsage(context.Context, *GetClientUsageRequest) (*GetClientUsageResponse, error)
+	// lncli: tower resetusage
+	//ResetClientUsage clears the usage of the client with the given key, or of
+	//the given client address, allowing it to create sessions and store state
+	//updates up to its full quota again. The sessions and state updates already stored for the client
+	//are kept.
+	ResetClientUsage(context.Context, *ResetClientUsageRequest) (*ResetClientUsageResponse, error)
 }
 
 // UnimplementedWatchtowerServer can be embedded to have forward compatible implementations.
@@ -287,6 +656,12 @@ type UnimplementedWatchtowerServer struct {
 func (*UnimplementedWatchtowerServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (*UnimplementedWatchtowerServer) GetClientUsage(context.Context, *GetClientUsageRequest) (*GetClientUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientUsage not implemented")
+}
+func (*UnimplementedWatchtowerServer) ResetClientUsage(context.Context, *ResetClientUsageRequest) (*ResetClientUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetClientUsage not implemented")
+}
 
 func RegisterWatchtowerServer(s *grpc.Server, srv WatchtowerServer) {
 	s.RegisterService(&_Watchtower_serviceDesc, srv)
@@ -310,6 +685,42 @@ func _Watchtower_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Watchtower_GetClientUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerServer).GetClientUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchtowerrpc.Watchtower/GetClientUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerServer).GetClientUsage(ctx, req.(*GetClientUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Watchtower_ResetClientUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetClientUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WatchtowerServer).ResetClientUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/watchtowerrpc.Watchtower/ResetClientUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WatchtowerServer).ResetClientUsage(ctx, req.(*ResetClientUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Watchtower_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "watchtowerrpc.Watchtower",
 	HandlerType: (*WatchtowerServer)(nil),
@@ -318,6 +729,14 @@ var _Watchtower_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _Watchtower_GetInfo_Handler,
 		},
+		{
+			MethodName: "GetClientUsage",
+			Handler:    _Watchtower_GetClientUsage_Handler,
+		},
+		{
+			MethodName: "ResetClientUsage",
+			Handler:    _Watchtower_ResetClientUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "watchtowerrpc/watchtower.proto",
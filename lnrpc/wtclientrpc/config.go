@@ -1,6 +1,7 @@
 package wtclientrpc
 
 import (
+	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lncfg"
 	"github.com/decred/dcrlnd/watchtower/wtclient"
 	"github.com/decred/slog"
@@ -19,6 +20,15 @@ type Config struct {
 	// through the watchtower RPC subserver.
 	Client wtclient.Client
 
+	// AnchorClient is the backing watchtower client for anchor channels that
+	// we'll interact through the watchtower RPC subserver.
+	AnchorClient wtclient.Client
+
+	// KeyRing is used to derive the key that encrypts exported session
+	// backups, so that only a node restored from the same seed can import
+	// them.
+	KeyRing keychain.KeyRing
+
 	// Resolver is a custom resolver that will be used to resolve watchtower
 	// addresses to ensure we don't leak any information when running over
 	// non-clear networks, e.g. Tor, etc.
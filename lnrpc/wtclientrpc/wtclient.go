@@ -1,6 +1,7 @@
 package wtclientrpc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -13,6 +14,8 @@ import (
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/watchtower"
 	"github.com/decred/dcrlnd/watchtower/wtclient"
+	"github.com/decred/dcrlnd/watchtower/wtdb"
+	"github.com/decred/dcrlnd/watchtower/wtpolicy"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
 	"gopkg.in/macaroon-bakery.v2/bakery"
@@ -55,6 +58,14 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/wtclientrpc.WatchtowerClient/ExportSessions": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/wtclientrpc.WatchtowerClient/ImportSessions": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 	}
 
 	// ErrWtclientNotActive signals that RPC calls cannot be processed
@@ -179,6 +190,9 @@ func (c *WatchtowerClient) AddTower(ctx context.Context,
 	if err := c.cfg.Client.AddTower(towerAddr); err != nil {
 		return nil, err
 	}
+	if err := c.cfg.AnchorClient.AddTower(towerAddr); err != nil {
+		return nil, err
+	}
 
 	return &AddTowerResponse{}, nil
 }
@@ -211,9 +225,14 @@ func (c *WatchtowerClient) RemoveTower(ctx context.Context,
 		}
 	}
 
+	// We'll remove the tower from both the legacy and anchor clients, as
+	// both make use of the same set of towers.
 	if err := c.cfg.Client.RemoveTower(pubKey, addr); err != nil {
 		return nil, err
 	}
+	if err := c.cfg.AnchorClient.RemoveTower(pubKey, addr); err != nil {
+		return nil, err
+	}
 
 	return &RemoveTowerResponse{}, nil
 }
@@ -231,8 +250,25 @@ func (c *WatchtowerClient) ListTowers(ctx context.Context,
 		return nil, err
 	}
 
+	// Both clients share the same database, so the towers and their
+	// sessions are the same for both. A tower is however an active
+	// session candidate if either of the clients considers it one.
+	anchorTowers, err := c.cfg.AnchorClient.RegisteredTowers()
+	if err != nil {
+		return nil, err
+	}
+
+	anchorCandidates := make(map[wtdb.TowerID]bool, len(anchorTowers))
+	for _, tower := range anchorTowers {
+		anchorCandidates[tower.ID] = tower.ActiveSessionCandidate
+	}
+
 	rpcTowers := make([]*Tower, 0, len(towers))
 	for _, tower := range towers {
+		if anchorCandidates[tower.ID] {
+			tower.ActiveSessionCandidate = true
+		}
+
 		rpcTower := marshallTower(tower, req.IncludeSessions)
 		rpcTowers = append(rpcTowers, rpcTower)
 	}
@@ -258,6 +294,14 @@ func (c *WatchtowerClient) GetTowerInfo(ctx context.Context,
 		return nil, err
 	}
 
+	anchorTower, err := c.cfg.AnchorClient.LookupTower(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	if anchorTower.ActiveSessionCandidate {
+		tower.ActiveSessionCandidate = true
+	}
+
 	return marshallTower(tower, req.IncludeSessions), nil
 }
 
@@ -269,13 +313,29 @@ func (c *WatchtowerClient) Stats(ctx context.Context,
 		return nil, err
 	}
 
+	// The statistics are reported for both the legacy and anchor clients
+	// combined.
 	stats := c.cfg.Client.Stats()
+	anchorStats := c.cfg.AnchorClient.Stats()
 	return &StatsResponse{
-		NumBackups:           uint32(stats.NumTasksAccepted),
-		NumFailedBackups:     uint32(stats.NumTasksIneligible),
-		NumPendingBackups:    uint32(stats.NumTasksReceived),
-		NumSessionsAcquired:  uint32(stats.NumSessionsAcquired),
-		NumSessionsExhausted: uint32(stats.NumSessionsExhausted),
+		NumBackups: uint32(
+			stats.NumTasksAccepted + anchorStats.NumTasksAccepted,
+		),
+		NumFailedBackups: uint32(
+			stats.NumTasksIneligible +
+				anchorStats.NumTasksIneligible,
+		),
+		NumPendingBackups: uint32(
+			stats.NumTasksReceived + anchorStats.NumTasksReceived,
+		),
+		NumSessionsAcquired: uint32(
+			stats.NumSessionsAcquired +
+				anchorStats.NumSessionsAcquired,
+		),
+		NumSessionsExhausted: uint32(
+			stats.NumSessionsExhausted +
+				anchorStats.NumSessionsExhausted,
+		),
 	}, nil
 }
 
@@ -287,13 +347,85 @@ func (c *WatchtowerClient) Policy(ctx context.Context,
 		return nil, err
 	}
 
-	policy := c.cfg.Client.Policy()
+	var policy wtpolicy.Policy
+	switch req.PolicyType {
+	case PolicyType_LEGACY:
+		policy = c.cfg.Client.Policy()
+
+	case PolicyType_ANCHOR:
+		policy = c.cfg.AnchorClient.Policy()
+
+	default:
+		return nil, fmt.Errorf("unknown policy type: %v",
+			req.PolicyType)
+	}
+
 	return &PolicyResponse{
 		MaxUpdates:        uint32(policy.MaxUpdates),
 		SweepAtomsPerByte: uint32(policy.SweepFeeRate / 1000),
 	}, nil
 }
 
+// ExportSessions returns an encrypted backup of the towers and sessions of the
+// watchtower client.
+func (c *WatchtowerClient) ExportSessions(ctx context.Context,
+	req *ExportSessionsRequest) (*ExportSessionsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	// Both clients share the same database, so exporting the sessions of
+	// the legacy client covers the anchor client as well.
+	backup, err := c.cfg.Client.ExportSessions()
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := backup.PackToWriter(&b, c.cfg.KeyRing); err != nil {
+		return nil, fmt.Errorf("unable to pack session backup: %v",
+			err)
+	}
+
+	return &ExportSessionsResponse{Backup: b.Bytes()}, nil
+}
+
+// ImportSessions adds the towers and sessions of an encrypted backup created
+// by ExportSessions to the watchtower client.
+func (c *WatchtowerClient) ImportSessions(ctx context.Context,
+	req *ImportSessionsRequest) (*ImportSessionsResponse, error) {
+
+	if err := c.isActive(); err != nil {
+		return nil, err
+	}
+
+	var backup wtdb.ClientBackup
+	err := backup.UnpackFromReader(
+		bytes.NewReader(req.Backup), c.cfg.KeyRing,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to unpack session backup: %v",
+			err)
+	}
+
+	// The sessions are written to the shared database by whichever client
+	// imports them first, but both clients need to pick up the sessions
+	// that use their policy.
+	numImported, err := c.cfg.Client.ImportSessions(&backup)
+	if err != nil {
+		return nil, err
+	}
+	numAnchorImported, err := c.cfg.AnchorClient.ImportSessions(&backup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ImportSessionsResponse{
+		NumImported: uint32(numImported + numAnchorImported),
+	}, nil
+}
+
 // marshallTower converts a client registered watchtower into its corresponding
 // RPC type.
 func marshallTower(tower *wtclient.RegisteredTower, includeSessions bool) *Tower {
@@ -234,6 +234,24 @@ func (b *DcrdEstimator) EstimateFeePerKB(numBlocks uint32) (AtomPerKByte, error)
 	return feeEstimate, nil
 }
 
+// liveFeePerKB queries the connected chain client for a fee estimation for the
+// given block range. Unlike EstimateFeePerKB, it fails instead of returning
+// the fall back fee rate.
+//
+// NOTE: This method is part of the liveEstimator interface.
+func (b *DcrdEstimator) liveFeePerKB(numBlocks uint32) (AtomPerKByte, error) {
+	feeEstimate, err := b.fetchEstimate(numBlocks)
+	if err != nil {
+		return 0, err
+	}
+	if feeEstimate == 0 {
+		return 0, fmt.Errorf("dcrd has no fee estimate for conf "+
+			"target of %v", numBlocks)
+	}
+
+	return feeEstimate, nil
+}
+
 // fetchEstimate returns a fee estimate for a transaction to be confirmed in
 // confTarget blocks. The estimate is returned in atom/kB.
 func (b *DcrdEstimator) fetchEstimate(confTarget uint32) (AtomPerKByte, error) {
@@ -270,6 +288,10 @@ func (b *DcrdEstimator) fetchEstimate(confTarget uint32) (AtomPerKByte, error) {
 // FeeEstimator interface.
 var _ Estimator = (*DcrdEstimator)(nil)
 
+// A compile-time assertion to ensure that DcrdEstimator implements the
+// liveEstimator interface.
+var _ liveEstimator = (*DcrdEstimator)(nil)
+
 // WebAPIFeeSource is an interface allows the WebAPIEstimator to query an
 // arbitrary HTTP-based fee estimator. Each new set/network will gain an
 // implementation of this interface in order to allow the WebAPIEstimator to
@@ -348,6 +370,10 @@ type WebAPIEstimator struct {
 	feesMtx          sync.Mutex
 	feeByBlockTarget map[uint32]uint32
 
+	// updateErr is the error of the last attempt to query the API, or nil
+	// if it succeeded. It is guarded by feesMtx.
+	updateErr error
+
 	// defaultFeePerKB is a fallback value that we'll use if we're unable
 	// to query the API for any reason.
 	defaultFeePerKB AtomPerKByte
@@ -426,6 +452,22 @@ func (w *WebAPIEstimator) EstimateFeePerKB(numBlocks uint32) (AtomPerKByte, erro
 	return atomsPerKB, nil
 }
 
+// liveFeePerKB returns the same estimate as EstimateFeePerKB, but fails if the
+// last attempt to query the API failed, instead of returning stale fees.
+//
+// NOTE: This method is part of the liveEstimator interface.
+func (w *WebAPIEstimator) liveFeePerKB(numBlocks uint32) (AtomPerKByte, error) {
+	w.feesMtx.Lock()
+	updateErr := w.updateErr
+	w.feesMtx.Unlock()
+
+	if updateErr != nil {
+		return 0, fmt.Errorf("web API unavailable: %v", updateErr)
+	}
+
+	return w.EstimateFeePerKB(numBlocks)
+}
+
 // Start signals the Estimator to start any processes or goroutines it needs
 // to perform its duty.
 //
@@ -514,6 +556,7 @@ func (w *WebAPIEstimator) updateFeeEstimates() {
 	if err != nil {
 		log.Errorf("unable to query web api for fee response: %v",
 			err)
+		w.setUpdateErr(err)
 		return
 	}
 	defer resp.Body.Close()
@@ -524,11 +567,21 @@ func (w *WebAPIEstimator) updateFeeEstimates() {
 	if err != nil {
 		log.Errorf("unable to query web api for fee response: %v",
 			err)
+		w.setUpdateErr(err)
 		return
 	}
 
 	w.feesMtx.Lock()
 	w.feeByBlockTarget = feesByBlockTarget
+	w.updateErr = nil
+	w.feesMtx.Unlock()
+}
+
+// setUpdateErr records the failure of the last attempt to query the API. The
+// cached fees are kept, so that they can still be used on their own.
+func (w *WebAPIEstimator) setUpdateErr(err error) {
+	w.feesMtx.Lock()
+	w.updateErr = err
 	w.feesMtx.Unlock()
 }
 
@@ -549,3 +602,7 @@ func (w *WebAPIEstimator) feeUpdateManager() {
 // A compile-time assertion to ensure that WebAPIEstimator implements the
 // Estimator interface.
 var _ Estimator = (*WebAPIEstimator)(nil)
+
+// A compile-time assertion to ensure that WebAPIEstimator implements the
+// liveEstimator interface.
+var _ liveEstimator = (*WebAPIEstimator)(nil)
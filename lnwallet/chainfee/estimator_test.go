@@ -3,6 +3,7 @@ package chainfee
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"io"
 	"net/http"
 	"reflect"
@@ -192,3 +193,339 @@ func TestWebAPIFeeEstimator(t *testing.T) {
 		})
 	}
 }
+
+// TestWebAPIFeeEstimatorFailedUpdate checks that the live estimate of the
+// WebAPIEstimator fails while its API is unavailable, while its regular
+// estimate keeps returning the cached fees.
+func TestWebAPIFeeEstimatorFailedUpdate(t *testing.T) {
+	t.Parallel()
+
+	feeSource := mockSparseConfFeeSource{
+		url:  "https://www.github.com",
+		fees: map[uint32]uint32{2: 54321},
+	}
+
+	estimator := NewWebAPIEstimator(feeSource, 10)
+	estimator.netGetter = emptyGetter
+	estimator.updateFeeEstimates()
+
+	fee, err := estimator.liveFeePerKB(2)
+	if err != nil {
+		t.Fatalf("unable to get live estimate: %v", err)
+	}
+	if fee != 54321 {
+		t.Fatalf("expected live estimate of 54321, got %v", fee)
+	}
+
+	// Make the API unavailable.
+	estimator.netGetter = func(string) (*http.Response, error) {
+		return nil, errors.New("unreachable")
+	}
+	estimator.updateFeeEstimates()
+
+	if _, err := estimator.liveFeePerKB(2); err == nil {
+		t.Fatalf("expected live estimate to fail")
+	}
+
+	fee, err = estimator.EstimateFeePerKB(2)
+	if err != nil {
+		t.Fatalf("unable to estimate fee: %v", err)
+	}
+	if fee != 54321 {
+		t.Fatalf("expected cached estimate of 54321, got %v", fee)
+	}
+
+	// Once the API is reachable again, the live estimate succeeds.
+	estimator.netGetter = emptyGetter
+	estimator.updateFeeEstimates()
+
+	if _, err := estimator.liveFeePerKB(2); err != nil {
+		t.Fatalf("unable to get live estimate: %v", err)
+	}
+}
+
+// TestJSONFeeSource checks that JSONFeeSource parses the different layouts of
+// API responses as expected.
+func TestJSONFeeSource(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		feeSource JSONFeeSource
+		resp      string
+		fees      map[uint32]uint32
+	}{
+		{
+			name:      "top level object",
+			feeSource: JSONFeeSource{},
+			resp:      `{"2": 12345, "6": 10000}`,
+			fees:      map[uint32]uint32{2: 12345, 6: 10000},
+		},
+		{
+			name:      "nested object",
+			feeSource: JSONFeeSource{FeesPath: "data.estimates"},
+			resp: `{"data": {"estimates": {"2": 12345, ` +
+				`"6": 10000}}}`,
+			fees: map[uint32]uint32{2: 12345, 6: 10000},
+		},
+		{
+			name:      "array with default keys",
+			feeSource: JSONFeeSource{FeesPath: "estimates"},
+			resp: `{"estimates": [{"target": 2, ` +
+				`"feerate": 12345}, {"target": 6, ` +
+				`"feerate": 10000}]}`,
+			fees: map[uint32]uint32{2: 12345, 6: 10000},
+		},
+		{
+			name: "array with custom keys",
+			feeSource: JSONFeeSource{
+				TargetKey: "blocks",
+				FeeKey:    "fee",
+			},
+			resp: `[{"blocks": 2, "fee": 12345}, ` +
+				`{"blocks": 6, "fee": 10000}]`,
+			fees: map[uint32]uint32{2: 12345, 6: 10000},
+		},
+		{
+			name:      "array index in path",
+			feeSource: JSONFeeSource{FeesPath: "results.1"},
+			resp:      `{"results": [{"2": 1}, {"2": 12345}]}`,
+			fees:      map[uint32]uint32{2: 12345},
+		},
+		{
+			name: "multiplier",
+			feeSource: JSONFeeSource{
+				Multiplier: 1e8,
+			},
+			resp: `{"2": 0.00012345, "6": 0.0001}`,
+			fees: map[uint32]uint32{2: 12345, 6: 10000},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			fees, err := tc.feeSource.ParseResponse(
+				strings.NewReader(tc.resp),
+			)
+			if err != nil {
+				t.Fatalf("unable to parse API response: %v",
+					err)
+			}
+			if !reflect.DeepEqual(fees, tc.fees) {
+				t.Fatalf("expected %v, got %v", tc.fees, fees)
+			}
+		})
+	}
+
+	badCases := []struct {
+		name      string
+		feeSource JSONFeeSource
+		resp      string
+	}{
+		{"invalid target", JSONFeeSource{}, `{"two": 12345}`},
+		{"invalid fee", JSONFeeSource{}, `{"2": "12345"}`},
+		{"negative fee", JSONFeeSource{}, `{"2": -12345}`},
+		{"missing path", JSONFeeSource{FeesPath: "data"}, `{"2": 1}`},
+		{"missing key", JSONFeeSource{}, `[{"target": 2, "fee": 1}]`},
+		{"not estimates", JSONFeeSource{}, `12345`},
+	}
+	for _, tc := range badCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.feeSource.ParseResponse(
+				strings.NewReader(tc.resp),
+			)
+			if err == nil {
+				t.Fatalf("expected ParseResponse to fail")
+			}
+		})
+	}
+}
+
+// mockEstimator is an Estimator that returns a fixed fee rate or error.
+type mockEstimator struct {
+	fee      AtomPerKByte
+	relayFee AtomPerKByte
+	err      error
+	started  bool
+}
+
+func (m *mockEstimator) EstimateFeePerKB(uint32) (AtomPerKByte, error) {
+	return m.fee, m.err
+}
+
+func (m *mockEstimator) RelayFeePerKB() AtomPerKByte {
+	return m.relayFee
+}
+
+func (m *mockEstimator) Start() error {
+	m.started = true
+	return nil
+}
+
+func (m *mockEstimator) Stop() error {
+	m.started = false
+	return nil
+}
+
+// mockFallbackEstimator is an Estimator that returns a fall back fee rate,
+// while its live estimate fails.
+type mockFallbackEstimator struct {
+	mockEstimator
+}
+
+func (m *mockFallbackEstimator) liveFeePerKB(uint32) (AtomPerKByte, error) {
+	return 0, errors.New("source unavailable")
+}
+
+// TestMedianEstimator checks that the MedianEstimator returns the median of
+// the estimates of its estimators, ignoring the failing ones.
+func TestMedianEstimator(t *testing.T) {
+	t.Parallel()
+
+	failing := &mockEstimator{err: errors.New("unavailable")}
+	newEstimator := func(fee AtomPerKByte) *mockEstimator {
+		return &mockEstimator{fee: fee, relayFee: fee / 10}
+	}
+
+	testCases := []struct {
+		name       string
+		estimators []Estimator
+		fee        AtomPerKByte
+		relayFee   AtomPerKByte
+		err        error
+	}{
+		{
+			name: "odd number of estimates",
+			estimators: []Estimator{
+				newEstimator(30000), newEstimator(10000),
+				newEstimator(1000000),
+			},
+			fee:      30000,
+			relayFee: 100000,
+		},
+		{
+			name: "even number of estimates",
+			estimators: []Estimator{
+				newEstimator(30000), newEstimator(10000),
+				failing,
+			},
+			fee:      20000,
+			relayFee: 3000,
+		},
+		{
+			name: "fall back estimate",
+			estimators: []Estimator{
+				newEstimator(30000), newEstimator(10000),
+				&mockFallbackEstimator{*newEstimator(1000000)},
+			},
+			fee:      20000,
+			relayFee: 100000,
+		},
+		{
+			name:       "no estimates",
+			estimators: []Estimator{failing, failing},
+			err:        ErrNoFeeEstimates,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			estimator := NewMedianEstimator(tc.estimators...)
+
+			fee, err := estimator.EstimateFeePerKB(2)
+			if err != tc.err {
+				t.Fatalf("expected error %v, got %v", tc.err,
+					err)
+			}
+			if fee != tc.fee {
+				t.Fatalf("expected fee rate %v, got %v",
+					tc.fee, fee)
+			}
+
+			relayFee := estimator.RelayFeePerKB()
+			if relayFee != tc.relayFee {
+				t.Fatalf("expected relay fee rate %v, got %v",
+					tc.relayFee, relayFee)
+			}
+		})
+	}
+}
+
+// TestEstimatorSwitch checks that the EstimatorSwitch forwards requests to
+// the active estimator and manages the lifecycle of all estimators.
+func TestEstimatorSwitch(t *testing.T) {
+	t.Parallel()
+
+	first := &mockEstimator{fee: 10000, relayFee: 1000}
+	second := &mockEstimator{fee: 20000, relayFee: 2000}
+
+	feeSwitch := NewEstimatorSwitch()
+	if err := feeSwitch.AddEstimator("first", first); err != nil {
+		t.Fatalf("unable to add estimator: %v", err)
+	}
+	if err := feeSwitch.AddEstimator("second", second); err != nil {
+		t.Fatalf("unable to add estimator: %v", err)
+	}
+	if err := feeSwitch.AddEstimator("first", second); err == nil {
+		t.Fatalf("expected duplicate estimator to be rejected")
+	}
+
+	names := feeSwitch.Names()
+	if !reflect.DeepEqual(names, []string{"first", "second"}) {
+		t.Fatalf("unexpected estimator names: %v", names)
+	}
+
+	if err := feeSwitch.Start(); err != nil {
+		t.Fatalf("unable to start estimators: %v", err)
+	}
+	if !first.started || !second.started {
+		t.Fatalf("expected all estimators to be started")
+	}
+
+	assertFee := func(active string, fee, relayFee AtomPerKByte) {
+		t.Helper()
+
+		if feeSwitch.Active() != active {
+			t.Fatalf("expected active estimator %v, got %v",
+				active, feeSwitch.Active())
+		}
+
+		estimate, err := feeSwitch.EstimateFeePerKB(2)
+		if err != nil {
+			t.Fatalf("unable to estimate fee: %v", err)
+		}
+		if estimate != fee {
+			t.Fatalf("expected fee rate %v, got %v", fee,
+				estimate)
+		}
+		if feeSwitch.RelayFeePerKB() != relayFee {
+			t.Fatalf("expected relay fee rate %v, got %v",
+				relayFee, feeSwitch.RelayFeePerKB())
+		}
+	}
+
+	// The first estimator that was added is active by default.
+	assertFee("first", 10000, 1000)
+
+	if err := feeSwitch.SetActive("second"); err != nil {
+		t.Fatalf("unable to switch estimator: %v", err)
+	}
+	assertFee("second", 20000, 2000)
+
+	// Switching to an unknown estimator fails without changing the
+	// active one.
+	if err := feeSwitch.SetActive("third"); err == nil {
+		t.Fatalf("expected switch to unknown estimator to fail")
+	}
+	assertFee("second", 20000, 2000)
+
+	if err := feeSwitch.Stop(); err != nil {
+		t.Fatalf("unable to stop estimators: %v", err)
+	}
+	if first.started || second.started {
+		t.Fatalf("expected all estimators to be stopped")
+	}
+}
@@ -0,0 +1,213 @@
+package chainfee
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultJSONTargetKey is the key of the block target within each
+	// estimate of a JSONFeeSource response that lists its estimates as an
+	// array of objects.
+	DefaultJSONTargetKey = "target"
+
+	// DefaultJSONFeeKey is the key of the fee rate within each estimate of
+	// a JSONFeeSource response that lists its estimates as an array of
+	// objects.
+	DefaultJSONFeeKey = "feerate"
+)
+
+// JSONFeeSource is a generic implementation of the WebAPIFeeSource that is
+// able to parse the JSON responses of arbitrary fee estimation services. The
+// estimates are located within the response through FeesPath, and can either
+// be an object mapping block targets to fee rates:
+//
+//	{"data": {"estimates": {"2": 10000, "6": 10000}}}
+//
+// or an array of objects holding a block target and a fee rate each:
+//
+//	{"data": {"estimates": [{"target": 2, "feerate": 10000}]}}
+//
+// Fee rates are converted to atoms/kB using the Multiplier.
+type JSONFeeSource struct {
+	// URL is the fee estimation API specified by the user.
+	URL string
+
+	// FeesPath is the dot separated path of the fee estimates within the
+	// response, e.g. "data.estimates". Elements of arrays along the path
+	// are selected by their index. If empty, the estimates are expected
+	// at the top level of the response.
+	FeesPath string
+
+	// TargetKey is the key of the block target within each estimate if
+	// the estimates are an array of objects. If empty,
+	// DefaultJSONTargetKey is used.
+	TargetKey string
+
+	// FeeKey is the key of the fee rate within each estimate if the
+	// estimates are an array of objects. If empty, DefaultJSONFeeKey is
+	// used.
+	FeeKey string
+
+	// Multiplier converts the fee rates of the response to atoms/kB, e.g.
+	// 1e8 for fee rates in DCR/kB or 1000 for fee rates in atoms/byte. If
+	// zero, the fee rates are expected in atoms/kB.
+	Multiplier float64
+}
+
+// GenQueryURL generates the full query URL. The value returned by this
+// method should be able to be used directly as a path for an HTTP GET
+// request.
+//
+// NOTE: Part of the WebAPIFeeSource interface.
+func (s JSONFeeSource) GenQueryURL() string {
+	return s.URL
+}
+
+// ParseResponse attempts to parse the body of the response generated by the
+// above query URL, mapping block targets to fee rates in atoms/kB.
+//
+// NOTE: Part of the WebAPIFeeSource interface.
+func (s JSONFeeSource) ParseResponse(r io.Reader) (map[uint32]uint32, error) {
+	jsonReader := json.NewDecoder(r)
+	jsonReader.UseNumber()
+
+	var resp interface{}
+	if err := jsonReader.Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	estimates, err := s.locateEstimates(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	feeByBlockTarget := make(map[uint32]uint32)
+	switch e := estimates.(type) {
+	case map[string]interface{}:
+		for targetStr, feeValue := range e {
+			target, err := strconv.ParseUint(targetStr, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid block target "+
+					"%q: %v", targetStr, err)
+			}
+
+			fee, err := s.parseFee(feeValue)
+			if err != nil {
+				return nil, err
+			}
+			feeByBlockTarget[uint32(target)] = fee
+		}
+
+	case []interface{}:
+		targetKey := s.TargetKey
+		if targetKey == "" {
+			targetKey = DefaultJSONTargetKey
+		}
+		feeKey := s.FeeKey
+		if feeKey == "" {
+			feeKey = DefaultJSONFeeKey
+		}
+
+		for _, elem := range e {
+			estimate, ok := elem.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected fee estimate "+
+					"object, got %T", elem)
+			}
+
+			targetNum, ok := estimate[targetKey].(json.Number)
+			if !ok {
+				return nil, fmt.Errorf("fee estimate lacks "+
+					"numeric %q", targetKey)
+			}
+			target, err := strconv.ParseUint(
+				targetNum.String(), 10, 32,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("invalid block target "+
+					"%v: %v", targetNum, err)
+			}
+
+			fee, err := s.parseFee(estimate[feeKey])
+			if err != nil {
+				return nil, err
+			}
+			feeByBlockTarget[uint32(target)] = fee
+		}
+
+	default:
+		return nil, fmt.Errorf("expected fee estimates to be an "+
+			"object or array, got %T", estimates)
+	}
+
+	return feeByBlockTarget, nil
+}
+
+// locateEstimates follows the FeesPath within the decoded response and
+// returns the value it points to.
+func (s JSONFeeSource) locateEstimates(resp interface{}) (interface{}, error) {
+	if s.FeesPath == "" {
+		return resp, nil
+	}
+
+	value := resp
+	for _, key := range strings.Split(s.FeesPath, ".") {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			next, ok := v[key]
+			if !ok {
+				return nil, fmt.Errorf("response lacks %q of "+
+					"fees path %q", key, s.FeesPath)
+			}
+			value = next
+
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return nil, fmt.Errorf("invalid index %q of "+
+					"fees path %q", key, s.FeesPath)
+			}
+			value = v[index]
+
+		default:
+			return nil, fmt.Errorf("unable to follow fees path "+
+				"%q into %T", s.FeesPath, value)
+		}
+	}
+
+	return value, nil
+}
+
+// parseFee converts a fee rate of the response to atoms/kB.
+func (s JSONFeeSource) parseFee(value interface{}) (uint32, error) {
+	feeNum, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("expected numeric fee rate, got %T",
+			value)
+	}
+
+	fee, err := feeNum.Float64()
+	if err != nil {
+		return 0, fmt.Errorf("invalid fee rate %v: %v", feeNum, err)
+	}
+
+	if s.Multiplier != 0 {
+		fee *= s.Multiplier
+	}
+
+	fee = math.Round(fee)
+	if fee < 0 || fee > math.MaxUint32 {
+		return 0, fmt.Errorf("fee rate %v out of range", feeNum)
+	}
+
+	return uint32(fee), nil
+}
+
+// A compile-time assertion to ensure that JSONFeeSource implements the
+// WebAPIFeeSource interface.
+var _ WebAPIFeeSource = (*JSONFeeSource)(nil)
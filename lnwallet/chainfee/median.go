@@ -0,0 +1,121 @@
+package chainfee
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNoFeeEstimates is returned by the MedianEstimator if none of the
+// estimators it combines is able to provide a fee estimate.
+var ErrNoFeeEstimates = errors.New("no fee estimates available")
+
+// liveEstimator is implemented by estimators that fall back to a default or
+// stale fee rate when their source is unavailable. Its method returns an error
+// instead, so that such fall backs aren't mistaken for real estimates.
+type liveEstimator interface {
+	// liveFeePerKB returns a fresh estimate of the fee rate for the given
+	// confirmation target, or an error if the source is unavailable.
+	liveFeePerKB(numBlocks uint32) (AtomPerKByte, error)
+}
+
+// MedianEstimator is an implementation of the Estimator interface that
+// combines several estimators by returning the median of their estimates.
+// Estimators that fail to provide a live estimate are ignored, which makes the
+// combined estimate robust against a single faulty or manipulated source.
+//
+// The combined estimators aren't started or stopped by the MedianEstimator,
+// which allows them to be used on their own as well. Their lifecycle must be
+// managed by the caller.
+type MedianEstimator struct {
+	estimators []Estimator
+}
+
+// NewMedianEstimator creates a new MedianEstimator that combines the given
+// estimators.
+func NewMedianEstimator(estimators ...Estimator) *MedianEstimator {
+	return &MedianEstimator{
+		estimators: estimators,
+	}
+}
+
+// EstimateFeePerKB returns the median of the estimates of all estimators that
+// are able to provide a live one for the given confirmation target. For an even
+// number of estimates, the mean of the two middle estimates is returned.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MedianEstimator) EstimateFeePerKB(numBlocks uint32) (AtomPerKByte,
+	error) {
+
+	fees := make([]AtomPerKByte, 0, len(m.estimators))
+	for _, estimator := range m.estimators {
+		var (
+			fee AtomPerKByte
+			err error
+		)
+		if live, ok := estimator.(liveEstimator); ok {
+			fee, err = live.liveFeePerKB(numBlocks)
+		} else {
+			fee, err = estimator.EstimateFeePerKB(numBlocks)
+		}
+		if err != nil {
+			log.Debugf("Ignoring failed fee estimate for conf "+
+				"target of %d: %v", numBlocks, err)
+			continue
+		}
+		fees = append(fees, fee)
+	}
+
+	if len(fees) == 0 {
+		return 0, ErrNoFeeEstimates
+	}
+
+	sort.Slice(fees, func(i, j int) bool {
+		return fees[i] < fees[j]
+	})
+
+	mid := len(fees) / 2
+	median := fees[mid]
+	if len(fees)%2 == 0 {
+		median = (fees[mid-1] + fees[mid]) / 2
+	}
+
+	log.Debugf("Returning median of %d estimates of %v for conf target "+
+		"of %d", len(fees), median, numBlocks)
+
+	return median, nil
+}
+
+// RelayFeePerKB returns the highest minimum relay fee rate of all combined
+// estimators, so that transactions are relayed by all of their backends.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MedianEstimator) RelayFeePerKB() AtomPerKByte {
+	var relayFee AtomPerKByte
+	for _, estimator := range m.estimators {
+		if fee := estimator.RelayFeePerKB(); fee > relayFee {
+			relayFee = fee
+		}
+	}
+
+	return relayFee
+}
+
+// Start is a no-op, since the combined estimators must be started by the
+// caller.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MedianEstimator) Start() error {
+	return nil
+}
+
+// Stop is a no-op, since the combined estimators must be stopped by the
+// caller.
+//
+// NOTE: This method is part of the Estimator interface.
+func (m *MedianEstimator) Stop() error {
+	return nil
+}
+
+// A compile-time assertion to ensure that MedianEstimator implements the
+// Estimator interface.
+var _ Estimator = (*MedianEstimator)(nil)
@@ -0,0 +1,163 @@
+package chainfee
+
+import (
+	"fmt"
+	"sync"
+)
+
+// EstimatorSwitch is an implementation of the Estimator interface that
+// forwards all fee estimation requests to one of several named estimators.
+// The active estimator can be switched at runtime, which allows switching
+// between fee sources without restarting the daemon.
+type EstimatorSwitch struct {
+	mu         sync.RWMutex
+	estimators map[string]Estimator
+	names      []string
+	active     string
+
+	// numStarted is the number of estimators, in the order they were
+	// added, that have been started.
+	numStarted int
+}
+
+// NewEstimatorSwitch creates a new EstimatorSwitch without any estimators.
+func NewEstimatorSwitch() *EstimatorSwitch {
+	return &EstimatorSwitch{
+		estimators: make(map[string]Estimator),
+	}
+}
+
+// AddEstimator registers an estimator under the given name. The first
+// estimator that is added becomes the active one.
+func (s *EstimatorSwitch) AddEstimator(name string,
+	estimator Estimator) error {
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.estimators[name]; ok {
+		return fmt.Errorf("fee estimator %v already exists", name)
+	}
+
+	s.estimators[name] = estimator
+	s.names = append(s.names, name)
+	if s.active == "" {
+		s.active = name
+	}
+
+	return nil
+}
+
+// SetActive switches to the estimator with the given name.
+func (s *EstimatorSwitch) SetActive(name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.estimators[name]; !ok {
+		return fmt.Errorf("unknown fee estimator %v", name)
+	}
+
+	if s.active != name {
+		log.Infof("Switching fee estimator from %v to %v", s.active,
+			name)
+	}
+	s.active = name
+
+	return nil
+}
+
+// Active returns the name of the active estimator.
+func (s *EstimatorSwitch) Active() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.active
+}
+
+// Names returns the names of all estimators in the order they were added.
+func (s *EstimatorSwitch) Names() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	names := make([]string, len(s.names))
+	copy(names, s.names)
+
+	return names
+}
+
+// activeEstimator returns the active estimator.
+func (s *EstimatorSwitch) activeEstimator() Estimator {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.estimators[s.active]
+}
+
+// EstimateFeePerKB forwards the request to the active estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (s *EstimatorSwitch) EstimateFeePerKB(numBlocks uint32) (AtomPerKByte,
+	error) {
+
+	estimator := s.activeEstimator()
+	if estimator == nil {
+		return 0, fmt.Errorf("no fee estimator available")
+	}
+
+	return estimator.EstimateFeePerKB(numBlocks)
+}
+
+// RelayFeePerKB returns the minimum relay fee rate of the active estimator.
+//
+// NOTE: This method is part of the Estimator interface.
+func (s *EstimatorSwitch) RelayFeePerKB() AtomPerKByte {
+	estimator := s.activeEstimator()
+	if estimator == nil {
+		return FeePerKBFloor
+	}
+
+	return estimator.RelayFeePerKB()
+}
+
+// Start starts all registered estimators, so that switching between them
+// takes effect right away.
+//
+// NOTE: This method is part of the Estimator interface.
+func (s *EstimatorSwitch) Start() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, name := range s.names[s.numStarted:] {
+		if err := s.estimators[name].Start(); err != nil {
+			return fmt.Errorf("unable to start fee estimator %v: "+
+				"%v", name, err)
+		}
+		s.numStarted++
+	}
+
+	return nil
+}
+
+// Stop stops all estimators that have been started.
+//
+// NOTE: This method is part of the Estimator interface.
+func (s *EstimatorSwitch) Stop() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var firstErr error
+	for _, name := range s.names[:s.numStarted] {
+		err := s.estimators[name].Stop()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	s.numStarted = 0
+
+	return firstErr
+}
+
+// A compile-time assertion to ensure that EstimatorSwitch implements the
+// Estimator interface.
+var _ Estimator = (*EstimatorSwitch)(nil)
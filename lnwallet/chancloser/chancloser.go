@@ -32,6 +32,12 @@ var (
 	// shutdown script previously set for that party.
 	ErrUpfrontShutdownScriptMismatch = fmt.Errorf("shutdown script does not " +
 		"match upfront shutdown script")
+
+	// ErrNoFeeRangeOverlap is returned when the fee range signaled by the
+	// remote party doesn't overlap with the range of fees we're willing to
+	// accept for the close transaction.
+	ErrNoFeeRangeOverlap = fmt.Errorf("remote fee range doesn't overlap " +
+		"with local fee range")
 )
 
 // closeState represents all the possible states the channel closer state
@@ -91,6 +97,16 @@ type ChanCloseCfg struct {
 	// Disconnect will disconnect from the remote peer in this close.
 	Disconnect func() error
 
+	// MaxFeePerKB is the highest fee rate we're willing to pay for the
+	// close transaction if we're the initiator of the channel and thus
+	// pay the closing fee. If zero, DefaultMaxFeeMultiplier times the
+	// ideal fee is used.
+	MaxFeePerKB chainfee.AtomPerKByte
+
+	// FeePolicy decides which fees are proposed and accepted during the
+	// fee negotiation. If nil, the DefaultFeePolicy is used.
+	FeePolicy FeePolicy
+
 	// Quit is a channel that should be sent upon in the occasion the state
 	// machine should cease all progress and shutdown.
 	Quit chan struct{}
@@ -124,6 +140,14 @@ type ChanCloser struct {
 	// offer when starting negotiation. This will be used as a baseline.
 	idealFeeSat dcrutil.Amount
 
+	// maxFee is the highest fee we're willing to accept for the closing
+	// transaction.
+	maxFee dcrutil.Amount
+
+	// feeRange is the range of fees we signal to the remote party in each
+	// of our proposals.
+	feeRange *lnwire.FeeRange
+
 	// lastFeeProposal is the last fee that we proposed to the remote
 	// party.  We'll use this as a pivot point to ratchet our next offer
 	// up, down, or simply accept the remote party's prior offer.
@@ -183,8 +207,38 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 		idealFeeSat = channelCommitFee
 	}
 
-	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v sat",
-		cfg.Channel.ChannelPoint(), int64(idealFeeSat))
+	// The closing fee is paid by the initiator of the channel, so the max
+	// fee only limits our own proposals if we're the initiator. Otherwise
+	// we accept any fee up to the commitment fee, which is the highest fee
+	// a closing transaction may pay.
+	maxFee := channelCommitFee
+	if cfg.Channel.IsInitiator() {
+		maxFee = idealFeeSat * DefaultMaxFeeMultiplier
+		if cfg.MaxFeePerKB != 0 {
+			maxFee = cfg.Channel.CalcFee(cfg.MaxFeePerKB)
+		}
+		if maxFee > channelCommitFee {
+			maxFee = channelCommitFee
+		}
+	}
+
+	// Our ideal fee may never exceed the max fee.
+	if idealFeeSat > maxFee {
+		chancloserLog.Infof("Ideal starting fee of %v is greater than max "+
+			"fee of %v, clamping", int64(idealFeeSat), int64(maxFee))
+
+		idealFeeSat = maxFee
+	}
+
+	if cfg.FeePolicy == nil {
+		cfg.FeePolicy = &DefaultFeePolicy{}
+	}
+	feeRange := cfg.FeePolicy.FeeRange(idealFeeSat, maxFee)
+
+	chancloserLog.Infof("Ideal fee for closure of ChannelPoint(%v) is: %v "+
+		"atoms, accepted fee range is [%v, %v] atoms",
+		cfg.Channel.ChannelPoint(), int64(idealFeeSat),
+		int64(feeRange.MinFeeAtoms), int64(feeRange.MaxFeeAtoms))
 
 	cid := lnwire.NewChanIDFromOutPoint(cfg.Channel.ChannelPoint())
 	return &ChanCloser{
@@ -195,6 +249,8 @@ func NewChanCloser(cfg ChanCloseCfg, deliveryScript []byte,
 		cfg:                 cfg,
 		negotiationHeight:   negotiationHeight,
 		idealFeeSat:         idealFeeSat,
+		maxFee:              maxFee,
+		feeRange:            feeRange,
 		localDeliveryScript: deliveryScript,
 		priorFeeOffers:      make(map[dcrutil.Amount]*lnwire.ClosingSigned),
 		locallyInitiated:    locallyInitiated,
@@ -486,12 +542,14 @@ func (c *ChanCloser) ProcessCloseMsg(msg lnwire.Message) ([]lnwire.Message,
 		// to
 		remoteProposedFee := closeSignedMsg.FeeAtoms
 		if _, ok := c.priorFeeOffers[remoteProposedFee]; !ok {
-			// We'll now attempt to ratchet towards a fee deemed acceptable by
-			// both parties, factoring in our ideal fee rate, and the last
-			// proposed fee by both sides.
-			feeProposal := calcCompromiseFee(c.chanPoint, c.idealFeeSat,
-				c.lastFeeProposal, remoteProposedFee,
-			)
+			// We'll now attempt to move towards a fee deemed
+			// acceptable by both parties, factoring in our ideal
+			// fee rate, the last proposed fee by both sides and
+			// the fee range of the remote party, if any.
+			feeProposal, err := c.calcFeeProposal(closeSignedMsg)
+			if err != nil {
+				return nil, false, err
+			}
 
 			// With our new fee proposal calculated, we'll craft a new close
 			// signed signature to send to the other party so we can continue
@@ -608,6 +666,7 @@ func (c *ChanCloser) proposeCloseSigned(fee dcrutil.Amount) (*lnwire.ClosingSign
 	// it to the caller so we can kick off the final stage of the channel
 	// closure process.
 	closeSignedMsg := lnwire.NewClosingSigned(c.cid, fee, parsedSig)
+	closeSignedMsg.FeeRange = c.feeRange
 
 	// We'll also save this close signed, in the case that the remote party
 	// accepts our offer. This way, we don't have to re-sign.
@@ -616,6 +675,55 @@ func (c *ChanCloser) proposeCloseSigned(fee dcrutil.Amount) (*lnwire.ClosingSign
 	return closeSignedMsg, nil
 }
 
+// calcFeeProposal computes the next fee to propose in response to the given
+// proposal of the remote party. If the remote party signaled a fee range, the
+// fee is chosen from the overlap of both ranges. Otherwise the legacy fee
+// ratcheting is used, capped at our max fee.
+func (c *ChanCloser) calcFeeProposal(
+	remoteMsg *lnwire.ClosingSigned) (dcrutil.Amount, error) {
+
+	remoteFee := remoteMsg.FeeAtoms
+	remoteRange := remoteMsg.FeeRange
+
+	if remoteRange == nil {
+		fee := c.cfg.FeePolicy.CompromiseFee(
+			c.chanPoint, c.idealFeeSat, c.lastFeeProposal, remoteFee,
+		)
+		if fee > c.maxFee {
+			chancloserLog.Infof("ChannelPoint(%v): compromise fee of "+
+				"%v is greater than max fee of %v, clamping",
+				c.chanPoint, int64(fee), int64(c.maxFee))
+
+			fee = c.maxFee
+		}
+
+		return fee, nil
+	}
+
+	if !remoteRange.Contains(remoteFee) {
+		return 0, fmt.Errorf("remote fee of %v atoms is outside of its "+
+			"fee range [%v, %v]", int64(remoteFee),
+			int64(remoteRange.MinFeeAtoms), int64(remoteRange.MaxFeeAtoms))
+	}
+
+	overlap, ok := c.feeRange.Overlap(remoteRange)
+	if !ok {
+		chancloserLog.Warnf("ChannelPoint(%v): remote fee range [%v, %v] "+
+			"doesn't overlap with local fee range [%v, %v]",
+			c.chanPoint, int64(remoteRange.MinFeeAtoms),
+			int64(remoteRange.MaxFeeAtoms),
+			int64(c.feeRange.MinFeeAtoms),
+			int64(c.feeRange.MaxFeeAtoms))
+
+		return 0, ErrNoFeeRangeOverlap
+	}
+
+	// A custom policy may not leave the range both parties accept, so we
+	// clamp its choice to the overlap.
+	fee := c.cfg.FeePolicy.RangeFee(c.idealFeeSat, remoteFee, overlap)
+	return clampFee(fee, overlap), nil
+}
+
 // feeInAcceptableRange returns true if the passed remote fee is deemed to be
 // in an "acceptable" range to our local fee. This is an attempt at a
 // compromise and to ensure that the fee negotiation has a stopping point. We
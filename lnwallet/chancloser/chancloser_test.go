@@ -4,6 +4,8 @@ import (
 	"crypto/rand"
 	"testing"
 
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwire"
 )
 
@@ -74,3 +76,147 @@ func TestMaybeMatchScript(t *testing.T) {
 		})
 	}
 }
+
+// fixedFeePolicy is a FeePolicy that always proposes the same fee when
+// negotiating with a peer that signals a fee range.
+type fixedFeePolicy struct {
+	DefaultFeePolicy
+
+	fee dcrutil.Amount
+}
+
+func (p *fixedFeePolicy) RangeFee(_, _ dcrutil.Amount,
+	_ *lnwire.FeeRange) dcrutil.Amount {
+
+	return p.fee
+}
+
+// TestCalcFeeProposal tests that the fee proposed in response to a remote
+// proposal respects the fee ranges of both parties and the max fee.
+func TestCalcFeeProposal(t *testing.T) {
+	const (
+		idealFee = dcrutil.Amount(1000)
+		maxFee   = dcrutil.Amount(3000)
+	)
+
+	tests := []struct {
+		name        string
+		policy      FeePolicy
+		lastSent    dcrutil.Amount
+		remoteFee   dcrutil.Amount
+		remoteRange *lnwire.FeeRange
+		expectedFee dcrutil.Amount
+		expectedErr error
+	}{
+		{
+			name:      "remote fee within both ranges",
+			remoteFee: 2000,
+			remoteRange: &lnwire.FeeRange{
+				MinFeeAtoms: 1500, MaxFeeAtoms: 4000,
+			},
+			expectedFee: 2000,
+		},
+		{
+			name:      "remote fee above local range",
+			remoteFee: 5000,
+			remoteRange: &lnwire.FeeRange{
+				MinFeeAtoms: 1500, MaxFeeAtoms: 6000,
+			},
+			expectedFee: 1500,
+		},
+		{
+			name:      "remote fee below local range",
+			remoteFee: 100,
+			remoteRange: &lnwire.FeeRange{
+				MinFeeAtoms: 100, MaxFeeAtoms: 800,
+			},
+			expectedFee: 800,
+		},
+		{
+			name:      "no overlap",
+			remoteFee: 4000,
+			remoteRange: &lnwire.FeeRange{
+				MinFeeAtoms: 3500, MaxFeeAtoms: 6000,
+			},
+			expectedErr: ErrNoFeeRangeOverlap,
+		},
+		{
+			name:      "custom policy clamped to overlap",
+			policy:    &fixedFeePolicy{fee: 10000},
+			remoteFee: 2000,
+			remoteRange: &lnwire.FeeRange{
+				MinFeeAtoms: 1500, MaxFeeAtoms: 2500,
+			},
+			expectedFee: 2500,
+		},
+		{
+			name:        "legacy first proposal",
+			remoteFee:   2000,
+			expectedFee: idealFee,
+		},
+		{
+			name:        "legacy compromise capped at max fee",
+			lastSent:    2900,
+			remoteFee:   3500,
+			expectedFee: maxFee,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			policy := test.policy
+			if policy == nil {
+				policy = &DefaultFeePolicy{}
+			}
+
+			c := &ChanCloser{
+				cfg:             ChanCloseCfg{FeePolicy: policy},
+				idealFeeSat:     idealFee,
+				maxFee:          maxFee,
+				feeRange:        policy.FeeRange(idealFee, maxFee),
+				lastFeeProposal: test.lastSent,
+			}
+
+			remoteMsg := lnwire.NewClosingSigned(
+				lnwire.ChannelID{}, test.remoteFee, lnwire.Sig{},
+			)
+			remoteMsg.FeeRange = test.remoteRange
+
+			fee, err := c.calcFeeProposal(remoteMsg)
+			if err != test.expectedErr {
+				t.Fatalf("Error: %v, expected error: %v", err,
+					test.expectedErr)
+			}
+			if fee != test.expectedFee {
+				t.Fatalf("expected fee %v, got %v",
+					test.expectedFee, fee)
+			}
+		})
+	}
+}
+
+// TestCalcFeeProposalInvalidRemoteRange tests that a remote proposal whose
+// fee lies outside of its own fee range is rejected.
+func TestCalcFeeProposalInvalidRemoteRange(t *testing.T) {
+	policy := &DefaultFeePolicy{}
+	c := &ChanCloser{
+		chanPoint:   wire.OutPoint{Index: 1},
+		cfg:         ChanCloseCfg{FeePolicy: policy},
+		idealFeeSat: 1000,
+		maxFee:      3000,
+		feeRange:    policy.FeeRange(1000, 3000),
+	}
+
+	remoteMsg := lnwire.NewClosingSigned(
+		lnwire.ChannelID{}, 2000, lnwire.Sig{},
+	)
+	remoteMsg.FeeRange = &lnwire.FeeRange{
+		MinFeeAtoms: 500, MaxFeeAtoms: 1500,
+	}
+
+	if _, err := c.calcFeeProposal(remoteMsg); err == nil {
+		t.Fatalf("expected remote fee outside of its range to fail")
+	}
+}
@@ -0,0 +1,104 @@
+package chancloser
+
+import (
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+const (
+	// DefaultMaxFeeMultiplier is the multiple of the ideal fee that is
+	// used as the maximum fee we're willing to pay for a cooperative close
+	// if the caller doesn't specify a maximum fee.
+	DefaultMaxFeeMultiplier = 3
+)
+
+// FeePolicy decides which fees the ChanCloser proposes and accepts during the
+// fee negotiation of a cooperative close. Callers can supply their own policy
+// to control how closing fees are negotiated with peers that use different fee
+// estimators.
+type FeePolicy interface {
+	// FeeRange returns the range of fees we're willing to accept for the
+	// close transaction given our ideal fee and the maximum fee we're
+	// willing to pay. The returned range must contain the ideal fee and
+	// must not exceed the maximum fee.
+	FeeRange(idealFee, maxFee dcrutil.Amount) *lnwire.FeeRange
+
+	// RangeFee returns the fee to propose to a peer that signaled a fee
+	// range. The overlap is the range of fees that both parties accept
+	// and the returned fee must lie within it. If the returned fee is
+	// equal to the fee proposed by the remote party, the negotiation ends.
+	RangeFee(idealFee, remoteFee dcrutil.Amount,
+		overlap *lnwire.FeeRange) dcrutil.Amount
+
+	// CompromiseFee returns the fee to propose to a peer that didn't
+	// signal a fee range, taking into account our ideal fee, the fee we
+	// last proposed (zero if we haven't proposed any fee yet) and the fee
+	// proposed by the remote party. If the returned fee is equal to the
+	// fee proposed by the remote party, the negotiation ends.
+	CompromiseFee(chanPoint wire.OutPoint, idealFee, lastSentFee,
+		remoteFee dcrutil.Amount) dcrutil.Amount
+}
+
+// DefaultFeePolicy is the FeePolicy used if the caller doesn't supply one. It
+// accepts any fee between half of the ideal fee and the maximum fee, settles
+// on the remote party's fee whenever it is acceptable to both sides and falls
+// back to ratcheting towards the remote party's proposals if the peer doesn't
+// signal a fee range.
+type DefaultFeePolicy struct{}
+
+// A compile time check to ensure DefaultFeePolicy implements the FeePolicy
+// interface.
+var _ FeePolicy = (*DefaultFeePolicy)(nil)
+
+// FeeRange returns the range of fees we're willing to accept for the close
+// transaction.
+//
+// NOTE: This is part of the FeePolicy interface.
+func (p *DefaultFeePolicy) FeeRange(idealFee,
+	maxFee dcrutil.Amount) *lnwire.FeeRange {
+
+	return &lnwire.FeeRange{
+		MinFeeAtoms: idealFee / 2,
+		MaxFeeAtoms: maxFee,
+	}
+}
+
+// RangeFee returns the fee to propose to a peer that signaled a fee range. The
+// remote party's fee is accepted if it's within the overlap of both ranges,
+// otherwise our ideal fee clamped to the overlap is proposed.
+//
+// NOTE: This is part of the FeePolicy interface.
+func (p *DefaultFeePolicy) RangeFee(idealFee, remoteFee dcrutil.Amount,
+	overlap *lnwire.FeeRange) dcrutil.Amount {
+
+	if overlap.Contains(remoteFee) {
+		return remoteFee
+	}
+
+	return clampFee(idealFee, overlap)
+}
+
+// CompromiseFee returns the fee to propose to a peer that didn't signal a fee
+// range, using the legacy fee ratcheting algorithm.
+//
+// NOTE: This is part of the FeePolicy interface.
+func (p *DefaultFeePolicy) CompromiseFee(chanPoint wire.OutPoint, idealFee,
+	lastSentFee, remoteFee dcrutil.Amount) dcrutil.Amount {
+
+	return calcCompromiseFee(chanPoint, idealFee, lastSentFee, remoteFee)
+}
+
+// clampFee returns the fee clamped to the given fee range.
+func clampFee(fee dcrutil.Amount, feeRange *lnwire.FeeRange) dcrutil.Amount {
+	switch {
+	case fee < feeRange.MinFeeAtoms:
+		return feeRange.MinFeeAtoms
+
+	case fee > feeRange.MaxFeeAtoms:
+		return feeRange.MaxFeeAtoms
+
+	default:
+		return fee
+	}
+}
@@ -2,7 +2,6 @@ package chanfunding
 
 import (
 	"bytes"
-	"crypto/sha256"
 	"errors"
 	"fmt"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
@@ -179,11 +178,11 @@ func (i *PsbtIntent) FundingParams() (stdaddr.Address, int64, *psbt.Packet,
 		return nil, 0, nil, fmt.Errorf("unable to create funding "+
 			"output: %v", err)
 	}
-	witnessScriptHash := sha256.Sum256(witnessScript)
 
-	// Encode the address in the human readable bech32 format.
+	// Encode the P2SH address of the multisig script in the human readable
+	// format.
 	addr, err := stdaddr.NewAddressScriptHashV0(
-		witnessScriptHash[:], i.netParams,
+		witnessScript, i.netParams,
 	)
 	if err != nil {
 		return nil, 0, nil, fmt.Errorf("unable to encode address: %v",
@@ -142,7 +142,6 @@ func TestPsbtIntent(t *testing.T) {
 		PkScript: []byte{99, 99, 99},
 	}
 	pendingPsbt.Inputs[0].FinalScriptSig = []byte{88, 88, 88}
-	pendingPsbt.Inputs[0].FinalScriptWitness = []byte{2, 0, 0}
 
 	// If we call Finalize, the intent will signal to the funding manager
 	// that it can continue with the funding flow. We want to make sure
@@ -589,7 +588,7 @@ func TestPsbtFinalize(t *testing.T) {
 					Value:    int64(chanCapacity) + 1,
 					PkScript: []byte{1, 2, 3},
 				},
-				FinalScriptWitness: []byte{0x01, 0x00},
+				FinalScriptSig: []byte{0x01, 0x00},
 			}}
 			err = psbtIntent.Verify(pendingPsbt)
 			if err != nil {
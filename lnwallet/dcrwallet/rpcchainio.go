@@ -8,6 +8,7 @@ import (
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
+	chainjson "github.com/decred/dcrd/rpc/jsonrpc/types/v3"
 	"github.com/decred/dcrd/rpcclient/v7"
 	"github.com/decred/dcrd/wire"
 
@@ -33,9 +34,14 @@ var (
 type RPCChainIO struct {
 	net *chaincfg.Params
 
-	// mu is a mutex that protects the chain field.
+	// mu is a mutex that protects the chain and mempoolTxs fields.
 	mu    sync.Mutex
 	chain *rpcclient.Client
+
+	// mempoolTxs caches the outpoints spent by the transactions found in
+	// the mempool, so that only new mempool transactions need to be
+	// fetched when the mempool is queried again.
+	mempoolTxs map[chainhash.Hash][]wire.OutPoint
 }
 
 // Compile time check to ensure RPCChainIO fulfills lnwallet.BlockChainIO.
@@ -67,8 +73,9 @@ func NewRPCChainIO(rpcConfig rpcclient.ConnConfig, net *chaincfg.Params) (*RPCCh
 	}
 
 	return &RPCChainIO{
-		net:   net,
-		chain: chain,
+		net:        net,
+		chain:      chain,
+		mempoolTxs: make(map[chainhash.Hash][]wire.OutPoint),
 	}, nil
 }
 
@@ -147,3 +154,85 @@ func (s *RPCChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash, error) {
 	}
 	return s.chain.GetBlockHash(context.TODO(), blockHeight)
 }
+
+// MempoolSpends returns the hashes of the mempool transactions that spend any
+// of the given outpoints, keyed by the outpoint they spend.
+//
+// This method is a part of the sweep.Mempool interface.
+func (s *RPCChainIO) MempoolSpends(outpoints []wire.OutPoint) (
+	map[wire.OutPoint]chainhash.Hash, error) {
+
+	// Snapshot the connection and the transactions we already know about,
+	// so that the lock isn't held while querying the node.
+	s.mu.Lock()
+	chain := s.chain
+	known := make(map[chainhash.Hash]struct{}, len(s.mempoolTxs))
+	for txHash := range s.mempoolTxs {
+		known[txHash] = struct{}{}
+	}
+	s.mu.Unlock()
+
+	if chain == nil {
+		return nil, ErrUnconnected
+	}
+
+	ctx := context.TODO()
+	txHashes, err := chain.GetRawMempool(ctx, chainjson.GRMRegular)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch the transactions that entered the mempool since the last
+	// query.
+	inMempool := make(map[chainhash.Hash]struct{}, len(txHashes))
+	fetched := make(map[chainhash.Hash][]wire.OutPoint)
+	for _, txHash := range txHashes {
+		inMempool[*txHash] = struct{}{}
+		if _, ok := known[*txHash]; ok {
+			continue
+		}
+
+		tx, err := chain.GetRawTransaction(ctx, txHash)
+		if err != nil {
+			// The transaction may have left the mempool in the
+			// meantime.
+			continue
+		}
+
+		spent := make([]wire.OutPoint, 0, len(tx.MsgTx().TxIn))
+		for _, txIn := range tx.MsgTx().TxIn {
+			spent = append(spent, txIn.PreviousOutPoint)
+		}
+		fetched[*txHash] = spent
+	}
+
+	// Merge the fetched transactions into the cache and forget about the
+	// ones that left the mempool.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for txHash, spent := range fetched {
+		s.mempoolTxs[txHash] = spent
+	}
+	for txHash := range s.mempoolTxs {
+		if _, ok := inMempool[txHash]; !ok {
+			delete(s.mempoolTxs, txHash)
+		}
+	}
+
+	wanted := make(map[wire.OutPoint]struct{}, len(outpoints))
+	for _, op := range outpoints {
+		wanted[op] = struct{}{}
+	}
+
+	spends := make(map[wire.OutPoint]chainhash.Hash)
+	for txHash, spent := range s.mempoolTxs {
+		for _, op := range spent {
+			if _, ok := wanted[op]; ok {
+				spends[op] = txHash
+			}
+		}
+	}
+
+	return spends, nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
@@ -17,6 +18,7 @@ import (
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
 
+	"github.com/decred/dcrlnd/internal/psbt"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwallet/chainfee"
 
@@ -78,6 +80,10 @@ type DcrWallet struct {
 
 	syncer WalletSyncer
 
+	// leases tracks the outputs leased through LeaseOutput. Leased
+	// outputs are also locked in the underlying wallet.
+	leases *lnwallet.OutputLeases
+
 	ctx       context.Context
 	cancelCtx func()
 
@@ -138,6 +144,7 @@ func New(cfg Config) (*DcrWallet, error) {
 		syncedChan:         make(chan struct{}),
 		atomicWalletSynced: syncStatusUnsynced,
 		netParams:          cfg.NetParams,
+		leases:             lnwallet.NewOutputLeases(),
 		ctx:                ctx,
 		cancelCtx:          cancelCtx,
 	}, nil
@@ -366,6 +373,10 @@ func (b *DcrWallet) UnlockOutpoint(o wire.OutPoint) {
 // This is a part of the WalletController interface.
 func (b *DcrWallet) ListUnspentWitness(minConfs, maxConfs int32) (
 	[]*lnwallet.Utxo, error) {
+
+	// Outputs with expired leases are eligible for coin selection again.
+	b.pruneExpiredLeases()
+
 	// First, grab all the unfiltered currently unspent outputs.
 	unspentOutputs, err := b.wallet.ListUnspent(context.TODO(), minConfs,
 		maxConfs, nil, "")
@@ -944,18 +955,82 @@ func (b *DcrWallet) LabelTransaction(hash chainhash.Hash, label string, overwrit
 	return fmt.Errorf("unimplemented")
 }
 
-// LeaseOutput markes the output as used for some time.
+// pruneExpiredLeases unlocks all outputs whose leases have expired.
+func (b *DcrWallet) pruneExpiredLeases() {
+	for _, op := range b.leases.PruneExpired() {
+		b.UnlockOutpoint(op)
+	}
+}
+
+// LeaseOutput locks an output to the given ID, preventing it from being
+// available for any future coin selection attempts. The absolute time of the
+// lock's expiration is returned. The expiration of the lock can be extended by
+// successive invocations of this call.
+//
+// Leases are only kept in memory, therefore all leases are released once the
+// wallet is restarted.
 //
 // This is a part of the WalletController interface.
-func (b *DcrWallet) LeaseOutput(lnwallet.LockID, wire.OutPoint) (time.Time, error) {
-	return time.Time{}, fmt.Errorf("unimplemented")
+func (b *DcrWallet) LeaseOutput(id lnwallet.LockID,
+	op wire.OutPoint) (time.Time, error) {
+
+	b.pruneExpiredLeases()
+
+	_, err := b.FetchInputInfo(&op)
+	if errors.Is(err, lnwallet.ErrNotMine) {
+		return time.Time{}, lnwallet.ErrUnknownOutput
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	expiration, err := b.leases.Lease(id, op, lnwallet.DefaultLockDuration)
+	if err != nil {
+		return time.Time{}, err
+	}
+	b.LockOutpoint(op)
+
+	return expiration, nil
 }
 
-// ReleaseOutput marks the output as unused.
+// ReleaseOutput unlocks an output, allowing it to be available for coin
+// selection if it remains unspent. The ID should match the one used to
+// originally lock the output.
 //
 // This is a part of the WalletController interface.
-func (b *DcrWallet) ReleaseOutput(lnwallet.LockID, wire.OutPoint) error {
-	return fmt.Errorf("unimplemented")
+func (b *DcrWallet) ReleaseOutput(id lnwallet.LockID, op wire.OutPoint) error {
+	b.pruneExpiredLeases()
+
+	if err := b.leases.Release(id, op); err != nil {
+		return err
+	}
+	b.UnlockOutpoint(op)
+
+	return nil
+}
+
+// FundPsbt funds the passed PSBT packet with coins of the wallet.
+//
+// This is a part of the WalletController interface.
+func (b *DcrWallet) FundPsbt(packet *psbt.Packet, minConfs int32,
+	feeRate chainfee.AtomPerKByte) (int32, error) {
+
+	return lnwallet.FundPsbtPacket(b, packet, minConfs, feeRate)
+}
+
+// SignPsbt adds partial signatures for all inputs of the packet controlled by
+// the wallet.
+//
+// This is a part of the WalletController interface.
+func (b *DcrWallet) SignPsbt(packet *psbt.Packet) ([]uint32, error) {
+	return lnwallet.SignPsbtPacket(b, packet)
+}
+
+// FinalizePsbt signs and finalizes all inputs of the packet.
+//
+// This is a part of the WalletController interface.
+func (b *DcrWallet) FinalizePsbt(packet *psbt.Packet) error {
+	return lnwallet.FinalizePsbtPacket(b, packet)
 }
 
 // GetRecoveryInfo returns the current status of the recovery of the wallet.
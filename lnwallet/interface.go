@@ -13,6 +13,7 @@ import (
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
 	"github.com/decred/dcrlnd/lnwallet/chainfee"
 )
 
@@ -252,9 +253,9 @@ type WalletController interface {
 	// be extended by successive invocations of this call. Outputs can be
 	// unlocked before their expiration through `ReleaseOutput`.
 	//
-	// If the output is not known, wtxmgr.ErrUnknownOutput is returned. If
-	// the output has already been locked to a different ID, then
-	// wtxmgr.ErrOutputAlreadyLocked is returned.
+	// If the output is not known, ErrUnknownOutput is returned. If the
+	// output has already been locked to a different ID, then
+	// ErrOutputAlreadyLocked is returned.
 	//
 	// NOTE: This method requires the global coin selection lock to be held.
 	LeaseOutput(id LockID, op wire.OutPoint) (time.Time, error)
@@ -266,6 +267,31 @@ type WalletController interface {
 	// NOTE: This method requires the global coin selection lock to be held.
 	ReleaseOutput(id LockID, op wire.OutPoint) error
 
+	// FundPsbt funds the passed PSBT packet. If the packet doesn't contain
+	// any inputs, coins with at least minConfs confirmations are selected
+	// from the wallet to pay for all outputs and the fee at the given fee
+	// rate. Otherwise the existing inputs must belong to the wallet and be
+	// sufficient to pay for the outputs and fee. A change output is added
+	// if necessary and its index returned, or -1 if no change output was
+	// added.
+	//
+	// NOTE: The inputs of the packet are not locked by this method.
+	//
+	// NOTE: This method requires the global coin selection lock to be held.
+	FundPsbt(packet *psbt.Packet, minConfs int32,
+		feeRate chainfee.AtomPerKByte) (int32, error)
+
+	// SignPsbt adds partial signatures to all inputs of the packet that
+	// are controlled by the wallet and returns the indexes of the inputs
+	// that were signed. Inputs not controlled by the wallet are skipped.
+	SignPsbt(packet *psbt.Packet) ([]uint32, error)
+
+	// FinalizePsbt signs all inputs of the packet that are controlled by
+	// the wallet and then finalizes all inputs, so that the final
+	// transaction can be extracted. An error is returned if any input
+	// can't be finalized.
+	FinalizePsbt(packet *psbt.Packet) error
+
 	// PublishTransaction performs cursory validation (dust checks, etc),
 	// then finally broadcasts the passed transaction to the Decred network.
 	// If the transaction is rejected because it is conflicting with an
@@ -0,0 +1,116 @@
+package lnwallet
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/decred/dcrd/wire"
+)
+
+// DefaultLockDuration is the default duration used to lease outputs.
+const DefaultLockDuration = 10 * time.Minute
+
+var (
+	// ErrUnknownOutput is returned when attempting to lease an output
+	// that is not known to the wallet.
+	ErrUnknownOutput = errors.New("unknown output")
+
+	// ErrOutputAlreadyLocked is returned when attempting to lease an
+	// output that has already been leased to a different ID.
+	ErrOutputAlreadyLocked = errors.New("output already locked")
+
+	// ErrOutputUnlockNotAllowed is returned when attempting to release an
+	// output with an ID that doesn't match the one used to lease it.
+	ErrOutputUnlockNotAllowed = errors.New("output unlock not allowed")
+)
+
+// outputLease is a single lease of an output.
+type outputLease struct {
+	id         LockID
+	expiration time.Time
+}
+
+// OutputLeases keeps track of the outputs of a wallet that were leased to
+// some ID until a given expiration time. It's meant to be used by
+// WalletController implementations whose backing wallet doesn't natively
+// support output leases.
+//
+// Leases are expired lazily: expired leases are only removed by calls to
+// PruneExpired.
+type OutputLeases struct {
+	mu     sync.Mutex
+	leases map[wire.OutPoint]outputLease
+
+	// now returns the current time. It can be overridden in tests.
+	now func() time.Time
+}
+
+// NewOutputLeases returns a new, empty set of output leases.
+func NewOutputLeases() *OutputLeases {
+	return &OutputLeases{
+		leases: make(map[wire.OutPoint]outputLease),
+		now:    time.Now,
+	}
+}
+
+// PruneExpired removes all expired leases and returns their outpoints, so
+// that callers can release any lock they hold on them in the backing wallet.
+func (l *OutputLeases) PruneExpired() []wire.OutPoint {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var expired []wire.OutPoint
+	now := l.now()
+	for op, lease := range l.leases {
+		if !now.Before(lease.expiration) {
+			expired = append(expired, op)
+			delete(l.leases, op)
+		}
+	}
+
+	return expired
+}
+
+// Lease leases the output to the given ID for the given duration, returning
+// the expiration time of the lease. The expiration of an existing lease with
+// the same ID is extended.
+func (l *OutputLeases) Lease(id LockID, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	lease, ok := l.leases[op]
+	if ok && lease.id != id && now.Before(lease.expiration) {
+		return time.Time{}, ErrOutputAlreadyLocked
+	}
+
+	expiration := now.Add(duration)
+	l.leases[op] = outputLease{
+		id:         id,
+		expiration: expiration,
+	}
+
+	return expiration, nil
+}
+
+// Release removes the lease of the output with the given ID. It returns
+// ErrOutputUnlockNotAllowed if the output is leased to a different ID.
+// Releasing an output that isn't leased is not an error.
+func (l *OutputLeases) Release(id LockID, op wire.OutPoint) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	lease, ok := l.leases[op]
+	if !ok {
+		return nil
+	}
+	if lease.id != id {
+		return ErrOutputUnlockNotAllowed
+	}
+
+	delete(l.leases, op)
+	return nil
+}
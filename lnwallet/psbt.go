@@ -0,0 +1,308 @@
+package lnwallet
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
+	"github.com/decred/dcrlnd/lnwallet/chainfee"
+)
+
+var (
+	// ErrInsufficientPsbtInputs is returned when the inputs specified in a
+	// PSBT template do not cover the outputs and the required fee.
+	ErrInsufficientPsbtInputs = errors.New("inputs of PSBT do not cover " +
+		"outputs and fee")
+
+	// ErrInsufficientPsbtFunds is returned when the wallet doesn't have
+	// enough eligible coins to fund a PSBT.
+	ErrInsufficientPsbtFunds = errors.New("not enough outputs to fund " +
+		"PSBT")
+
+	// ErrPsbtNoOutputs is returned when attempting to fund a PSBT that
+	// doesn't have any outputs.
+	ErrPsbtNoOutputs = errors.New("PSBT does not contain any outputs")
+)
+
+// PsbtWallet is the subset of the WalletController and input.Signer
+// functionality needed by the generic PSBT funding, signing and finalizing
+// helpers. It allows every WalletController implementation to share the same
+// logic for handling PSBTs.
+type PsbtWallet interface {
+	// FetchInputInfo queries for the wallet's knowledge of the passed
+	// outpoint. ErrNotMine is returned if the wallet does not control
+	// the output.
+	FetchInputInfo(prevOut *wire.OutPoint) (*Utxo, error)
+
+	// NewAddress returns the next external or internal address of the
+	// wallet.
+	NewAddress(addrType AddressType, change bool) (stdaddr.Address, error)
+
+	// ListUnspentWitness returns the unspent outputs of the wallet that
+	// are eligible for coin selection.
+	ListUnspentWitness(minconfirms, maxconfirms int32) ([]*Utxo, error)
+
+	// ComputeInputScript generates the signature script elements needed
+	// to spend the output described by the sign descriptor.
+	ComputeInputScript(tx *wire.MsgTx,
+		signDesc *input.SignDescriptor) (*input.Script, error)
+}
+
+// estimatePsbtSize returns the estimated size of the transaction described by
+// the given packet, assuming all inputs spend P2PKH outputs and optionally
+// accounting for an additional P2PKH change output.
+func estimatePsbtSize(tx *wire.MsgTx, withChange bool) int64 {
+	var sizeEstimate input.TxSizeEstimator
+	for range tx.TxIn {
+		sizeEstimate.AddP2PKHInput()
+	}
+	for _, txOut := range tx.TxOut {
+		sizeEstimate.AddTxOutput(txOut)
+	}
+	if withChange {
+		sizeEstimate.AddP2PKHOutput()
+	}
+
+	return sizeEstimate.Size()
+}
+
+// FundPsbtPacket funds the passed PSBT packet with inputs of the wallet and
+// adds a change output if necessary. If the packet doesn't contain any inputs,
+// coins with at least minConfs confirmations are selected from the wallet to
+// cover all outputs and the fee. Otherwise the existing inputs must belong to
+// the wallet and cover the outputs and fee, and only a change output is
+// added. The index of the change output is returned, or -1 if no change
+// output was added.
+//
+// Only P2PKH inputs are currently supported.
+//
+// NOTE: This method requires the global coin selection lock to be held.
+func FundPsbtPacket(w PsbtWallet, packet *psbt.Packet, minConfs int32,
+	feeRate chainfee.AtomPerKByte) (int32, error) {
+
+	tx := packet.UnsignedTx
+	if len(tx.TxOut) == 0 {
+		return -1, ErrPsbtNoOutputs
+	}
+	if err := packet.SanityCheck(); err != nil {
+		return -1, err
+	}
+
+	var outputSum dcrutil.Amount
+	for _, txOut := range tx.TxOut {
+		outputSum += dcrutil.Amount(txOut.Value)
+	}
+
+	var inputSum dcrutil.Amount
+	if len(tx.TxIn) > 0 {
+		// The caller chose the inputs, so we only need to make sure
+		// they're ours and populate the UTXO information.
+		for idx, txIn := range tx.TxIn {
+			utxo, err := w.FetchInputInfo(&txIn.PreviousOutPoint)
+			if err != nil {
+				return -1, fmt.Errorf("unable to fetch input %v: "+
+					"%v", txIn.PreviousOutPoint, err)
+			}
+
+			if packet.Inputs[idx].WitnessUtxo == nil &&
+				packet.Inputs[idx].NonWitnessUtxo == nil {
+
+				packet.Inputs[idx].WitnessUtxo = &wire.TxOut{
+					Value:    int64(utxo.Value),
+					PkScript: utxo.PkScript,
+				}
+			}
+			inputSum += utxo.Value
+		}
+
+		requiredFee := feeRate.FeeForSize(estimatePsbtSize(tx, false))
+		if inputSum < outputSum+requiredFee {
+			return -1, ErrInsufficientPsbtInputs
+		}
+	} else {
+		utxos, err := w.ListUnspentWitness(minConfs, math.MaxInt32)
+		if err != nil {
+			return -1, err
+		}
+
+		// Only P2PKH outputs can be signed by the wallet, so those are
+		// the only ones we'll select. We'll use the largest outputs
+		// first, to keep the number of inputs low.
+		coins := make([]*Utxo, 0, len(utxos))
+		for _, utxo := range utxos {
+			if utxo.AddressType != PubKeyHash {
+				continue
+			}
+			coins = append(coins, utxo)
+		}
+		sort.Slice(coins, func(i, j int) bool {
+			return coins[i].Value > coins[j].Value
+		})
+
+		var funded bool
+		for _, coin := range coins {
+			tx.AddTxIn(wire.NewTxIn(
+				&coin.OutPoint, int64(coin.Value), nil,
+			))
+			packet.Inputs = append(packet.Inputs, psbt.PInput{
+				WitnessUtxo: &wire.TxOut{
+					Value:    int64(coin.Value),
+					PkScript: coin.PkScript,
+				},
+			})
+			inputSum += coin.Value
+
+			requiredFee := feeRate.FeeForSize(
+				estimatePsbtSize(tx, false),
+			)
+			if inputSum >= outputSum+requiredFee {
+				funded = true
+				break
+			}
+		}
+		if !funded {
+			return -1, fmt.Errorf("%w: need %v, only %v available",
+				ErrInsufficientPsbtFunds, outputSum, inputSum)
+		}
+	}
+
+	// Now that we know the inputs cover the outputs, we'll determine
+	// whether the remainder is large enough to warrant a change output.
+	feeWithChange := feeRate.FeeForSize(estimatePsbtSize(tx, true))
+	changeAmt := inputSum - outputSum - feeWithChange
+	if changeAmt <= DefaultDustLimit() {
+		return -1, nil
+	}
+
+	changeAddr, err := w.NewAddress(PubKeyHash, true)
+	if err != nil {
+		return -1, err
+	}
+	changeScript, err := input.PayToAddrScript(changeAddr)
+	if err != nil {
+		return -1, err
+	}
+
+	tx.AddTxOut(wire.NewTxOut(int64(changeAmt), changeScript))
+	packet.Outputs = append(packet.Outputs, psbt.POutput{})
+
+	return int32(len(tx.TxOut) - 1), nil
+}
+
+// SignPsbtPacket adds partial signatures for all inputs of the packet that
+// belong to the wallet and that aren't yet finalized or signed by it. The
+// indexes of the inputs that were signed are returned.
+//
+// Only inputs spending P2PKH outputs can currently be signed.
+func SignPsbtPacket(w PsbtWallet, packet *psbt.Packet) ([]uint32, error) {
+	if err := packet.SanityCheck(); err != nil {
+		return nil, err
+	}
+
+	tx := packet.UnsignedTx
+	var signedInputs []uint32
+	for idx, txIn := range tx.TxIn {
+		pInput := &packet.Inputs[idx]
+
+		// Inputs that are already finalized can't receive any
+		// additional signatures.
+		if pInput.FinalScriptSig != nil {
+			continue
+		}
+
+		utxo, err := w.FetchInputInfo(&txIn.PreviousOutPoint)
+		switch {
+		// Inputs not controlled by the wallet are skipped, as they'll
+		// be signed by another party.
+		case errors.Is(err, ErrNotMine):
+			continue
+
+		case err != nil:
+			return nil, fmt.Errorf("unable to fetch input %v: %v",
+				txIn.PreviousOutPoint, err)
+		}
+
+		if utxo.AddressType != PubKeyHash {
+			walletLog.Debugf("Skipping signing of PSBT input %d of "+
+				"unsupported type %v", idx, utxo.AddressType)
+			continue
+		}
+
+		signOutput := &wire.TxOut{
+			Value:    int64(utxo.Value),
+			PkScript: utxo.PkScript,
+		}
+		if pInput.WitnessUtxo == nil && pInput.NonWitnessUtxo == nil {
+			pInput.WitnessUtxo = signOutput
+		}
+
+		sigHashType := txscript.SigHashAll
+		if pInput.SighashType != 0 {
+			sigHashType = pInput.SighashType
+		}
+
+		script, err := w.ComputeInputScript(tx, &input.SignDescriptor{
+			Output:     signOutput,
+			HashType:   sigHashType,
+			InputIndex: idx,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to sign input %d: %v",
+				idx, err)
+		}
+		if len(script.Witness) != 2 {
+			return nil, fmt.Errorf("unexpected number of "+
+				"signature script elements for input %d: %d",
+				idx, len(script.Witness))
+		}
+
+		partialSig := &psbt.PartialSig{
+			Signature: script.Witness[0],
+			PubKey:    script.Witness[1],
+		}
+
+		// Don't add a second signature for the same key, which might
+		// happen if the packet is signed more than once.
+		var alreadySigned bool
+		for _, sig := range pInput.PartialSigs {
+			if string(sig.PubKey) == string(partialSig.PubKey) {
+				alreadySigned = true
+				break
+			}
+		}
+		if alreadySigned {
+			continue
+		}
+
+		pInput.PartialSigs = append(pInput.PartialSigs, partialSig)
+		signedInputs = append(signedInputs, uint32(idx))
+	}
+
+	return signedInputs, nil
+}
+
+// FinalizePsbtPacket signs all inputs of the packet that belong to the wallet
+// and then attempts to finalize every input. An error is returned if any of
+// the inputs can't be finalized, for example because a signature from another
+// party is still missing.
+func FinalizePsbtPacket(w PsbtWallet, packet *psbt.Packet) error {
+	if _, err := SignPsbtPacket(w, packet); err != nil {
+		return err
+	}
+
+	for idx := range packet.UnsignedTx.TxIn {
+		if _, err := psbt.MaybeFinalize(packet, idx); err != nil {
+			return fmt.Errorf("unable to finalize input %d: %v",
+				idx, err)
+		}
+	}
+
+	return nil
+}
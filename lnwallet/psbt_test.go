@@ -0,0 +1,273 @@
+package lnwallet
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrd/chaincfg/chainhash"
+	"github.com/decred/dcrd/chaincfg/v3"
+	"github.com/decred/dcrd/dcrec"
+	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrd/txscript/v4"
+	"github.com/decred/dcrd/txscript/v4/sign"
+	"github.com/decred/dcrd/txscript/v4/stdaddr"
+	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
+	"github.com/decred/dcrlnd/lnwallet/chainfee"
+	"github.com/stretchr/testify/require"
+)
+
+// mockPsbtWallet is a PsbtWallet that controls a single key and a fixed set
+// of P2PKH outputs paying to it.
+type mockPsbtWallet struct {
+	t       *testing.T
+	privKey *secp256k1.PrivateKey
+	addr    stdaddr.Address
+	utxos   []*Utxo
+}
+
+func newMockPsbtWallet(t *testing.T, values ...dcrutil.Amount) *mockPsbtWallet {
+	privKey, err := secp256k1.GeneratePrivateKey()
+	require.NoError(t, err)
+
+	pkHash := dcrutil.Hash160(privKey.PubKey().SerializeCompressed())
+	addr, err := stdaddr.NewAddressPubKeyHashEcdsaSecp256k1V0(
+		pkHash, chaincfg.RegNetParams(),
+	)
+	require.NoError(t, err)
+	pkScript, err := input.PayToAddrScript(addr)
+	require.NoError(t, err)
+
+	w := &mockPsbtWallet{
+		t:       t,
+		privKey: privKey,
+		addr:    addr,
+	}
+	for i, value := range values {
+		w.utxos = append(w.utxos, &Utxo{
+			AddressType:   PubKeyHash,
+			Value:         value,
+			Confirmations: 6,
+			PkScript:      pkScript,
+			OutPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{byte(i + 1)},
+				Index: uint32(i),
+			},
+		})
+	}
+
+	return w
+}
+
+func (w *mockPsbtWallet) FetchInputInfo(prevOut *wire.OutPoint) (*Utxo,
+	error) {
+
+	for _, utxo := range w.utxos {
+		if utxo.OutPoint == *prevOut {
+			return utxo, nil
+		}
+	}
+
+	return nil, ErrNotMine
+}
+
+func (w *mockPsbtWallet) NewAddress(AddressType, bool) (stdaddr.Address,
+	error) {
+
+	return w.addr, nil
+}
+
+func (w *mockPsbtWallet) ListUnspentWitness(int32, int32) ([]*Utxo, error) {
+	return w.utxos, nil
+}
+
+func (w *mockPsbtWallet) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *input.SignDescriptor) (*input.Script, error) {
+
+	sig, err := sign.RawTxInSignature(
+		tx, signDesc.InputIndex, signDesc.Output.PkScript,
+		signDesc.HashType, w.privKey.Serialize(),
+		dcrec.STEcdsaSecp256k1,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &input.Script{
+		Witness: [][]byte{
+			sig, w.privKey.PubKey().SerializeCompressed(),
+		},
+	}, nil
+}
+
+// newTestPsbt returns a packet without inputs paying the given amount to an
+// arbitrary P2PKH script.
+func newTestPsbt(t *testing.T, amt dcrutil.Amount) *psbt.Packet {
+	packet, err := psbt.New(
+		nil, []*wire.TxOut{{
+			Value:    int64(amt),
+			PkScript: make([]byte, input.P2PKHPkScriptSize),
+		}}, 1, 0, nil,
+	)
+	require.NoError(t, err)
+
+	return packet
+}
+
+// TestFundSignFinalizePsbt tests funding, signing and finalizing a PSBT with
+// the generic wallet helpers.
+func TestFundSignFinalizePsbt(t *testing.T) {
+	const feeRate = 10000
+
+	w := newMockPsbtWallet(t, 1e8, 2e8, 5e7)
+
+	// A packet without outputs can't be funded.
+	packet, err := psbt.New(nil, nil, 1, 0, nil)
+	require.NoError(t, err)
+	_, err = FundPsbtPacket(w, packet, 1, feeRate)
+	require.Equal(t, ErrPsbtNoOutputs, err)
+
+	// Paying more than the wallet controls must fail.
+	packet = newTestPsbt(t, 4e8)
+	_, err = FundPsbtPacket(w, packet, 1, feeRate)
+	require.True(t, errors.Is(err, ErrInsufficientPsbtFunds))
+
+	// Paying 2.5 DCR requires the two largest outputs to be selected
+	// and a change output to be added.
+	packet = newTestPsbt(t, 25e7)
+	changeIndex, err := FundPsbtPacket(w, packet, 1, feeRate)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), changeIndex)
+	require.Len(t, packet.UnsignedTx.TxIn, 2)
+	require.Len(t, packet.Inputs, 2)
+	require.Len(t, packet.Outputs, 2)
+	require.Equal(t, w.utxos[1].OutPoint,
+		packet.UnsignedTx.TxIn[0].PreviousOutPoint)
+	require.Equal(t, w.utxos[0].OutPoint,
+		packet.UnsignedTx.TxIn[1].PreviousOutPoint)
+
+	// The fee must match the estimated size of the final transaction.
+	var inputSum, outputSum int64
+	for _, in := range packet.Inputs {
+		inputSum += in.WitnessUtxo.Value
+	}
+	for _, out := range packet.UnsignedTx.TxOut {
+		outputSum += out.Value
+	}
+	expectedFee := chainfee.AtomPerKByte(feeRate).FeeForSize(
+		estimatePsbtSize(packet.UnsignedTx, false),
+	)
+	require.Equal(t, int64(expectedFee), inputSum-outputSum)
+
+	// Keep a copy of the funded packet for finalizing it later on.
+	b64Packet, err := packet.B64Encode()
+	require.NoError(t, err)
+	fundedPacket, err := psbt.NewFromRawBytes(
+		strings.NewReader(b64Packet), true,
+	)
+	require.NoError(t, err)
+
+	// Add an input not controlled by the wallet. It must not be signed,
+	// so the packet can't be finalized.
+	foreignOutPoint := wire.OutPoint{Hash: chainhash.Hash{0xff}}
+	packet.UnsignedTx.AddTxIn(wire.NewTxIn(&foreignOutPoint, 0, nil))
+	packet.Inputs = append(packet.Inputs, psbt.PInput{})
+	signed, err := SignPsbtPacket(w, packet)
+	require.NoError(t, err)
+	require.Equal(t, []uint32{0, 1}, signed)
+
+	// Signing a second time doesn't add any signatures.
+	signed, err = SignPsbtPacket(w, packet)
+	require.NoError(t, err)
+	require.Empty(t, signed)
+
+	require.Error(t, FinalizePsbtPacket(w, packet))
+
+	// Without the foreign input, the packet can be finalized and the
+	// extracted transaction must be valid.
+	require.NoError(t, FinalizePsbtPacket(w, fundedPacket))
+
+	finalTx, err := psbt.Extract(fundedPacket)
+	require.NoError(t, err)
+	for idx, in := range fundedPacket.Inputs {
+		vm, err := txscript.NewEngine(
+			in.WitnessUtxo.PkScript, finalTx, idx, 0, 0, nil,
+		)
+		require.NoError(t, err)
+		require.NoError(t, vm.Execute())
+	}
+}
+
+// TestFundPsbtWithInputs tests funding a PSBT template that already specifies
+// its inputs.
+func TestFundPsbtWithInputs(t *testing.T) {
+	const feeRate = 10000
+
+	w := newMockPsbtWallet(t, 1e8)
+
+	// The input doesn't cover the output.
+	packet, err := psbt.New(
+		[]*wire.OutPoint{&w.utxos[0].OutPoint}, []*wire.TxOut{{
+			Value:    1e8,
+			PkScript: make([]byte, input.P2PKHPkScriptSize),
+		}}, 1, 0, []uint32{wire.MaxTxInSequenceNum},
+	)
+	require.NoError(t, err)
+	_, err = FundPsbtPacket(w, packet, 1, feeRate)
+	require.Equal(t, ErrInsufficientPsbtInputs, err)
+
+	// The input covers the output, so only change is added and the UTXO
+	// information is populated.
+	packet.UnsignedTx.TxOut[0].Value = 5e7
+	changeIndex, err := FundPsbtPacket(w, packet, 1, feeRate)
+	require.NoError(t, err)
+	require.Equal(t, int32(1), changeIndex)
+	require.Len(t, packet.UnsignedTx.TxIn, 1)
+	require.Equal(t, int64(1e8), packet.Inputs[0].WitnessUtxo.Value)
+
+	// Unknown inputs are rejected.
+	packet.UnsignedTx.TxIn[0].PreviousOutPoint.Index = 10
+	_, err = FundPsbtPacket(w, packet, 1, feeRate)
+	require.Error(t, err)
+}
+
+// TestOutputLeases tests leasing and releasing outputs.
+func TestOutputLeases(t *testing.T) {
+	now := time.Unix(1000, 0)
+	leases := NewOutputLeases()
+	leases.now = func() time.Time { return now }
+
+	op := wire.OutPoint{Index: 1}
+	id1, id2 := LockID{1}, LockID{2}
+
+	expiration, err := leases.Lease(id1, op, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Minute), expiration)
+
+	// The output can't be leased or released by a different ID.
+	_, err = leases.Lease(id2, op, time.Minute)
+	require.Equal(t, ErrOutputAlreadyLocked, err)
+	require.Equal(t, ErrOutputUnlockNotAllowed, leases.Release(id2, op))
+
+	// The lease can be extended by the same ID.
+	now = now.Add(30 * time.Second)
+	expiration, err = leases.Lease(id1, op, time.Minute)
+	require.NoError(t, err)
+	require.Equal(t, now.Add(time.Minute), expiration)
+	require.Empty(t, leases.PruneExpired())
+
+	// Once expired, the output is pruned and can be leased by a
+	// different ID.
+	now = expiration
+	require.Equal(t, []wire.OutPoint{op}, leases.PruneExpired())
+	_, err = leases.Lease(id2, op, time.Minute)
+	require.NoError(t, err)
+
+	require.NoError(t, leases.Release(id2, op))
+	require.NoError(t, leases.Release(id1, op))
+	require.Empty(t, leases.leases)
+}
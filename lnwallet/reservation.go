@@ -377,6 +377,16 @@ func (r *ChannelReservation) SetNumConfsRequired(numConfs uint16) {
 	r.partialState.NumConfsRequired = numConfs
 }
 
+// SetZeroConf marks the channel as a zero-conf channel, meaning that it can be
+// used before its funding transaction confirms. Both parties must have agreed
+// on a zero-conf channel during the funding workflow.
+func (r *ChannelReservation) SetZeroConf() {
+	r.Lock()
+	defer r.Unlock()
+
+	r.partialState.ChanType |= channeldb.ZeroConfBit
+}
+
 // CommitConstraints takes the constraints that the remote party specifies for
 // the type of commitments that we can generate for them. These constraints
 // include several parameters that serve as flow control restricting the amount
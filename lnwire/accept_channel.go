@@ -92,6 +92,12 @@ type AcceptChannel struct {
 	// and has a length prefix, so a zero will be written if it is not set
 	// and its length followed by the script will be written if it is set.
 	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the explicit channel type the responder agrees to,
+	// encoded as a TLV record after the upfront shutdown script. It must
+	// be set if and only if the OpenChannel message carried a channel
+	// type, in which case both must be equal.
+	ChannelType *ChannelType
 }
 
 // A compile time check to ensure AcceptChannel implements the lnwire.Message
@@ -104,7 +110,7 @@ var _ Message = (*AcceptChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	if err := WriteElements(w,
 		a.PendingChannelID[:],
 		a.DustLimit,
 		a.MaxValueInFlight,
@@ -120,7 +126,11 @@ func (a *AcceptChannel) Encode(w io.Writer, pver uint32) error {
 		a.HtlcPoint,
 		a.FirstCommitmentPoint,
 		a.UpfrontShutdownScript,
-	)
+	); err != nil {
+		return err
+	}
+
+	return encodeChannelTypeTLV(w, a.ChannelType)
 }
 
 // Decode deserializes the serialized AcceptChannel stored in the passed
@@ -153,10 +163,18 @@ func (a *AcceptChannel) Decode(r io.Reader, pver uint32) error {
 	// Check for the optional upfront shutdown script field. If it is not there,
 	// silence the EOF error.
 	err = ReadElement(r, &a.UpfrontShutdownScript)
-	if err != nil && err != io.EOF {
+	switch {
+	case err == io.EOF:
+		return nil
+
+	case err != nil:
 		return err
 	}
-	return nil
+
+	// Any remaining bytes are the TLV stream carrying the optional channel
+	// type.
+	a.ChannelType, err = decodeChannelTypeTLV(r)
+	return err
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -178,5 +196,8 @@ func (a *AcceptChannel) MaxPayloadLength(uint32) uint32 {
 	// Upfront shutdown script max length.
 	length += 2 + deliveryAddressMaxSize
 
+	// Channel type TLV record max length.
+	length += channelTypeMaxRecordSize
+
 	return length
 }
@@ -0,0 +1,139 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+
+	"github.com/decred/dcrlnd/tlv"
+)
+
+const (
+	// ChannelTypeRecordType is the TLV type of the optional channel type
+	// carried by the OpenChannel and AcceptChannel messages.
+	ChannelTypeRecordType tlv.Type = 1
+
+	// channelTypeMaxLength is the maximum length of an encoded channel
+	// type we accept. It leaves room for all feature bits that are
+	// currently defined for channel types.
+	channelTypeMaxLength = 8
+
+	// channelTypeMaxRecordSize is the maximum size of the TLV record
+	// carrying the channel type: 1 byte type, 1 byte length and the
+	// encoded channel type.
+	channelTypeMaxRecordSize = 2 + channelTypeMaxLength
+)
+
+// ChannelType is the explicit type of a channel that is negotiated during the
+// funding flow. It is expressed as a set of feature bits, each of which
+// denotes a property of the channel such as the commitment format or whether
+// the channel can be used before its funding transaction confirms.
+type ChannelType RawFeatureVector
+
+// NewChannelType returns a channel type with all of the given feature bits
+// set.
+func NewChannelType(bits ...FeatureBit) *ChannelType {
+	return (*ChannelType)(NewRawFeatureVector(bits...))
+}
+
+// IsSet returns true if the given feature bit is set in the channel type.
+func (c *ChannelType) IsSet(bit FeatureBit) bool {
+	return (*RawFeatureVector)(c).IsSet(bit)
+}
+
+// Equal returns true if both channel types have the same set of feature bits.
+func (c *ChannelType) Equal(other *ChannelType) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+
+	if len(c.features) != len(other.features) {
+		return false
+	}
+	for bit := range c.features {
+		if !other.IsSet(bit) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// encodeChannelType is the tlv.Encoder of a ChannelType record.
+func encodeChannelType(w io.Writer, val interface{}, _ *[8]byte) error {
+	if v, ok := val.(*ChannelType); ok {
+		return (*RawFeatureVector)(v).EncodeBase256(w)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.ChannelType")
+}
+
+// decodeChannelType is the tlv.Decoder of a ChannelType record.
+func decodeChannelType(r io.Reader, val interface{}, _ *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*ChannelType); ok && l <= channelTypeMaxLength {
+		return (*RawFeatureVector)(v).DecodeBase256(r, int(l))
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.ChannelType", l,
+		channelTypeMaxLength)
+}
+
+// channelTypeRecord returns the TLV record of the given channel type.
+func channelTypeRecord(channelType *ChannelType) tlv.Record {
+	sizeFunc := func() uint64 {
+		return uint64((*RawFeatureVector)(channelType).SerializeSize())
+	}
+
+	return tlv.MakeDynamicRecord(
+		ChannelTypeRecordType, channelType, sizeFunc,
+		encodeChannelType, decodeChannelType,
+	)
+}
+
+// encodeChannelTypeTLV writes the TLV stream carrying the given channel type.
+// Nothing is written if the channel type is nil.
+func encodeChannelTypeTLV(w io.Writer, channelType *ChannelType) error {
+	if channelType == nil {
+		return nil
+	}
+
+	tlvStream, err := tlv.NewStream(channelTypeRecord(channelType))
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
+}
+
+// decodeChannelTypeTLV reads the remaining bytes of r as a TLV stream and
+// returns the channel type it carries, or nil if it doesn't carry one.
+func decodeChannelTypeTLV(r io.Reader) (*ChannelType, error) {
+	tlvData, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(tlvData) == 0 {
+		return nil, nil
+	}
+
+	channelType := NewChannelType()
+	tlvStream, err := tlv.NewStream(channelTypeRecord(channelType))
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(tlvData),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := parsedTypes[ChannelTypeRecordType]; !ok {
+		return nil, nil
+	}
+
+	return channelType, nil
+}
@@ -1,11 +1,99 @@
 package lnwire
 
 import (
+	"bytes"
 	"io"
+	"io/ioutil"
 
 	"github.com/decred/dcrd/dcrutil/v4"
+	"github.com/decred/dcrlnd/tlv"
 )
 
+const (
+	// FeeRangeRecordType is the TLV type of the optional fee range carried
+	// by a ClosingSigned message.
+	FeeRangeRecordType tlv.Type = 1
+
+	// feeRangeLength is the length of an encoded FeeRange: the minimum and
+	// maximum fee as two 8-byte integers.
+	feeRangeLength = 16
+)
+
+// FeeRange is the range of fees a party to a channel is willing to pay for the
+// cooperative close transaction. Signaling a fee range allows both parties to
+// settle on a fee within the overlap of their ranges in a single round instead
+// of bisecting towards each other's proposals.
+type FeeRange struct {
+	// MinFeeAtoms is the minimum fee in atoms the sender accepts for the
+	// close transaction.
+	MinFeeAtoms dcrutil.Amount
+
+	// MaxFeeAtoms is the maximum fee in atoms the sender accepts for the
+	// close transaction.
+	MaxFeeAtoms dcrutil.Amount
+}
+
+// Contains returns true if the given fee is within the fee range.
+func (f *FeeRange) Contains(fee dcrutil.Amount) bool {
+	return fee >= f.MinFeeAtoms && fee <= f.MaxFeeAtoms
+}
+
+// Overlap returns the range of fees that is contained in both fee ranges. The
+// second return value is false if the ranges don't overlap.
+func (f *FeeRange) Overlap(other *FeeRange) (*FeeRange, bool) {
+	overlap := &FeeRange{
+		MinFeeAtoms: f.MinFeeAtoms,
+		MaxFeeAtoms: f.MaxFeeAtoms,
+	}
+	if other.MinFeeAtoms > overlap.MinFeeAtoms {
+		overlap.MinFeeAtoms = other.MinFeeAtoms
+	}
+	if other.MaxFeeAtoms < overlap.MaxFeeAtoms {
+		overlap.MaxFeeAtoms = other.MaxFeeAtoms
+	}
+
+	if overlap.MinFeeAtoms > overlap.MaxFeeAtoms {
+		return nil, false
+	}
+
+	return overlap, true
+}
+
+// encodeFeeRange is the tlv.Encoder of a FeeRange record.
+func encodeFeeRange(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*FeeRange); ok {
+		err := tlv.EUint64T(w, uint64(v.MinFeeAtoms), buf)
+		if err != nil {
+			return err
+		}
+		return tlv.EUint64T(w, uint64(v.MaxFeeAtoms), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.FeeRange")
+}
+
+// decodeFeeRange is the tlv.Decoder of a FeeRange record.
+func decodeFeeRange(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*FeeRange); ok && l == feeRangeLength {
+		var minFee, maxFee uint64
+		if err := tlv.DUint64(r, &minFee, buf, 8); err != nil {
+			return err
+		}
+		if err := tlv.DUint64(r, &maxFee, buf, 8); err != nil {
+			return err
+		}
+
+		v.MinFeeAtoms = dcrutil.Amount(minFee)
+		v.MaxFeeAtoms = dcrutil.Amount(maxFee)
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.FeeRange", l,
+		feeRangeLength)
+}
+
 // ClosingSigned is sent by both parties to a channel once the channel is clear
 // of HTLCs, and is primarily concerned with negotiating fees for the close
 // transaction. Each party provides a signature for a transaction with a fee
@@ -27,6 +115,12 @@ type ClosingSigned struct {
 
 	// Signature is for the proposed channel close transaction.
 	Signature Sig
+
+	// FeeRange is the optional range of fees the sender accepts for the
+	// close transaction. It is encoded as a TLV record after the
+	// signature and is nil if the sender doesn't support fee range
+	// negotiation.
+	FeeRange *FeeRange
 }
 
 // NewClosingSigned creates a new empty ClosingSigned message.
@@ -49,7 +143,44 @@ var _ Message = (*ClosingSigned)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *ClosingSigned) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r, &c.ChannelID, &c.FeeAtoms, &c.Signature)
+	err := ReadElements(r, &c.ChannelID, &c.FeeAtoms, &c.Signature)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes are the TLV stream carrying the optional fields
+	// of the message.
+	tlvData, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(tlvData) == 0 {
+		return nil
+	}
+
+	var feeRange FeeRange
+	tlvStream, err := tlv.NewStream(
+		tlv.MakeStaticRecord(
+			FeeRangeRecordType, &feeRange, feeRangeLength,
+			encodeFeeRange, decodeFeeRange,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(tlvData),
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := parsedTypes[FeeRangeRecordType]; ok {
+		c.FeeRange = &feeRange
+	}
+
+	return nil
 }
 
 // Encode serializes the target ClosingSigned into the passed io.Writer
@@ -57,7 +188,26 @@ func (c *ClosingSigned) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *ClosingSigned) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w, c.ChannelID, c.FeeAtoms, c.Signature)
+	err := WriteElements(w, c.ChannelID, c.FeeAtoms, c.Signature)
+	if err != nil {
+		return err
+	}
+
+	if c.FeeRange == nil {
+		return nil
+	}
+
+	tlvStream, err := tlv.NewStream(
+		tlv.MakeStaticRecord(
+			FeeRangeRecordType, c.FeeRange, feeRangeLength,
+			encodeFeeRange, decodeFeeRange,
+		),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
 }
 
 // MsgType returns the integer uniquely identifying this message type on the
@@ -84,5 +234,8 @@ func (c *ClosingSigned) MaxPayloadLength(uint32) uint32 {
 	// Signature - 64 bytes
 	length += 64
 
+	// FeeRange TLV record - 1 byte type, 1 byte length, 16 bytes value
+	length += 2 + feeRangeLength
+
 	return length
 }
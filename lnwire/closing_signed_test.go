@@ -0,0 +1,102 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/decred/dcrd/dcrutil/v4"
+)
+
+// TestFeeRangeOverlap tests that the overlap of two fee ranges is computed
+// correctly.
+func TestFeeRangeOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		a, b    FeeRange
+		overlap *FeeRange
+	}{{
+		name:    "identical",
+		a:       FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 20},
+		b:       FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 20},
+		overlap: &FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 20},
+	}, {
+		name:    "partial",
+		a:       FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 20},
+		b:       FeeRange{MinFeeAtoms: 15, MaxFeeAtoms: 30},
+		overlap: &FeeRange{MinFeeAtoms: 15, MaxFeeAtoms: 20},
+	}, {
+		name:    "contained",
+		a:       FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 40},
+		b:       FeeRange{MinFeeAtoms: 15, MaxFeeAtoms: 30},
+		overlap: &FeeRange{MinFeeAtoms: 15, MaxFeeAtoms: 30},
+	}, {
+		name:    "single fee",
+		a:       FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 20},
+		b:       FeeRange{MinFeeAtoms: 20, MaxFeeAtoms: 30},
+		overlap: &FeeRange{MinFeeAtoms: 20, MaxFeeAtoms: 20},
+	}, {
+		name: "disjoint",
+		a:    FeeRange{MinFeeAtoms: 10, MaxFeeAtoms: 20},
+		b:    FeeRange{MinFeeAtoms: 21, MaxFeeAtoms: 30},
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			// The overlap must not depend on the order of the
+			// ranges.
+			for _, pair := range [][2]*FeeRange{
+				{&tc.a, &tc.b}, {&tc.b, &tc.a},
+			} {
+				overlap, ok := pair[0].Overlap(pair[1])
+				if ok != (tc.overlap != nil) {
+					t.Fatalf("expected overlap %v, got %v",
+						tc.overlap != nil, ok)
+				}
+				if !reflect.DeepEqual(tc.overlap, overlap) {
+					t.Fatalf("expected overlap %v, got %v",
+						tc.overlap, overlap)
+				}
+			}
+		})
+	}
+}
+
+// TestClosingSignedLegacyEncoding tests that a ClosingSigned message without
+// a fee range is encoded without any trailing TLV data, so peers that don't
+// know about fee ranges can still parse it.
+func TestClosingSignedLegacyEncoding(t *testing.T) {
+	t.Parallel()
+
+	msg := NewClosingSigned(ChannelID{1}, dcrutil.Amount(1000), Sig{})
+
+	var b bytes.Buffer
+	if err := msg.Encode(&b, 0); err != nil {
+		t.Fatalf("unable to encode message: %v", err)
+	}
+	if b.Len() != 32+8+64 {
+		t.Fatalf("unexpected legacy message length %d", b.Len())
+	}
+
+	msg.FeeRange = &FeeRange{MinFeeAtoms: 500, MaxFeeAtoms: 2000}
+	b.Reset()
+	if err := msg.Encode(&b, 0); err != nil {
+		t.Fatalf("unable to encode message: %v", err)
+	}
+	if b.Len() != int(msg.MaxPayloadLength(0)) {
+		t.Fatalf("unexpected message length %d, expected %d",
+			b.Len(), msg.MaxPayloadLength(0))
+	}
+
+	var decoded ClosingSigned
+	if err := decoded.Decode(&b, 0); err != nil {
+		t.Fatalf("unable to decode message: %v", err)
+	}
+	if !reflect.DeepEqual(msg, &decoded) {
+		t.Fatalf("expected %v, got %v", msg, decoded)
+	}
+}
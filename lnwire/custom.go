@@ -0,0 +1,76 @@
+package lnwire
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// CustomTypeStart is the start of the custom message type range. Message
+// types at or above this value are not defined by the BOLTs and can be used
+// by applications to build their own protocols on top of the peer
+// connections.
+const CustomTypeStart MessageType = 32768
+
+// Custom is a message of a type within the custom message type range. Its
+// payload is opaque to the daemon and is handed to the application as is.
+type Custom struct {
+	// Type is the message type of the custom message.
+	Type MessageType
+
+	// Data is the opaque payload of the message.
+	Data []byte
+}
+
+// A compile time check to ensure Custom implements the lnwire.Message
+// interface.
+var _ Message = (*Custom)(nil)
+
+// NewCustom returns a new custom message of the given type. An error is
+// returned if the type is not within the custom message type range.
+func NewCustom(msgType MessageType, data []byte) (*Custom, error) {
+	if msgType < CustomTypeStart {
+		return nil, fmt.Errorf("msg type %d is not within the custom "+
+			"range (>= %d)", msgType, CustomTypeStart)
+	}
+
+	return &Custom{
+		Type: msgType,
+		Data: data,
+	}, nil
+}
+
+// Decode deserializes a serialized Custom message stored in the passed
+// io.Reader observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Decode(r io.Reader, pver uint32) error {
+	var err error
+	c.Data, err = ioutil.ReadAll(r)
+	return err
+}
+
+// Encode serializes the target Custom message into the passed io.Writer
+// observing the protocol version specified.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) Encode(w io.Writer, pver uint32) error {
+	_, err := w.Write(c.Data)
+	return err
+}
+
+// MsgType returns the integer uniquely identifying this message type on the
+// wire.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MsgType() MessageType {
+	return c.Type
+}
+
+// MaxPayloadLength returns the maximum allowed payload size for a Custom
+// complete message observing the specified protocol version.
+//
+// This is part of the lnwire.Message interface.
+func (c *Custom) MaxPayloadLength(uint32) uint32 {
+	return MaxMessagePayload
+}
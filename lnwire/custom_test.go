@@ -0,0 +1,45 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestCustomMessage tests that messages within the custom type range are
+// encoded and decoded as custom messages and that messages outside of it are
+// rejected.
+func TestCustomMessage(t *testing.T) {
+	t.Parallel()
+
+	if _, err := NewCustom(CustomTypeStart-1, nil); err == nil {
+		t.Fatalf("expected error for type below custom range")
+	}
+
+	msg, err := NewCustom(CustomTypeStart+1, []byte{1, 2, 3})
+	if err != nil {
+		t.Fatalf("unable to create custom message: %v", err)
+	}
+
+	var b bytes.Buffer
+	if _, err := WriteMessage(&b, msg, 0); err != nil {
+		t.Fatalf("unable to write custom message: %v", err)
+	}
+
+	decoded, err := ReadMessage(&b, 0)
+	if err != nil {
+		t.Fatalf("unable to read custom message: %v", err)
+	}
+	if !reflect.DeepEqual(msg, decoded) {
+		t.Fatalf("expected %v, got %v", msg, decoded)
+	}
+
+	// Types below the custom range that we don't know of must still be
+	// reported as unknown.
+	b.Reset()
+	b.Write([]byte{0x7f, 0xff})
+	_, err = ReadMessage(&b, 0)
+	if _, ok := err.(*UnknownMessage); !ok {
+		t.Fatalf("expected unknown message error, got %v", err)
+	}
+}
@@ -119,6 +119,37 @@ const (
 	// outputs.
 	AnchorsOptional FeatureBit = 21
 
+	// AMPRequired is a required feature bit that signals that the receiver
+	// of a payment requires settlement of an invoice with more than one
+	// HTLC using atomic multi-path payments.
+	AMPRequired FeatureBit = 30
+
+	// AMPOptional is an optional feature bit that signals that the
+	// receiver of a payment supports settlement of an invoice with more
+	// than one HTLC using atomic multi-path payments.
+	AMPOptional FeatureBit = 31
+
+	// ScidAliasRequired is a required feature bit that signals that the
+	// node requires private channels to be referred to by an alias short
+	// channel id that is negotiated in the FundingLocked message.
+	ScidAliasRequired FeatureBit = 46
+
+	// ScidAliasOptional is an optional feature bit that signals that the
+	// node supports referring to private channels by an alias short
+	// channel id that is negotiated in the FundingLocked message.
+	ScidAliasOptional FeatureBit = 47
+
+	// ZeroConfRequired is a required feature bit that signals that the
+	// node requires the use of zero-conf channels, which can be used
+	// before their funding transaction confirms. It is also set in the
+	// channel type of such channels.
+	ZeroConfRequired FeatureBit = 50
+
+	// ZeroConfOptional is an optional feature bit that signals that the
+	// node supports zero-conf channels, which can be used before their
+	// funding transaction confirms.
+	ZeroConfOptional FeatureBit = 51
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -160,6 +191,12 @@ var Features = map[FeatureBit]string{
 	AnchorsOptional:               "anchor-commitments",
 	WumboChannelsRequired:         "wumbo-channels",
 	WumboChannelsOptional:         "wumbo-channels",
+	AMPRequired:                   "amp",
+	AMPOptional:                   "amp",
+	ScidAliasRequired:             "scid-alias",
+	ScidAliasOptional:             "scid-alias",
+	ZeroConfRequired:              "zero-conf",
+	ZeroConfOptional:              "zero-conf",
 }
 
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
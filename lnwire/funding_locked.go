@@ -1,9 +1,22 @@
 package lnwire
 
 import (
+	"bytes"
 	"io"
+	"io/ioutil"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrlnd/tlv"
+)
+
+const (
+	// AliasScidRecordType is the TLV type of the optional alias short
+	// channel id carried by the FundingLocked message.
+	AliasScidRecordType tlv.Type = 1
+
+	// aliasScidMaxRecordSize is the size of the TLV record carrying the
+	// alias: 1 byte type, 1 byte length and the 8 byte short channel id.
+	aliasScidMaxRecordSize = 2 + 8
 )
 
 // FundingLocked is the message that both parties to a new channel creation
@@ -19,6 +32,13 @@ type FundingLocked struct {
 	// NextPerCommitmentPoint is the secret that can be used to revoke the
 	// next commitment transaction for the channel.
 	NextPerCommitmentPoint *secp256k1.PublicKey
+
+	// AliasScid is an optional alias short channel id the sender wishes
+	// the receiver to use instead of the confirmed short channel id when
+	// referring to the channel, for instance in the route hints of
+	// invoices. It is encoded as a TLV record and is nil if the sender
+	// doesn't provide an alias.
+	AliasScid *ShortChannelID
 }
 
 // NewFundingLocked creates a new FundingLocked message, populating it with the
@@ -40,9 +60,43 @@ var _ Message = (*FundingLocked)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
-	return ReadElements(r,
+	err := ReadElements(r,
 		&c.ChanID,
 		&c.NextPerCommitmentPoint)
+	if err != nil {
+		return err
+	}
+
+	// Any remaining bytes are the TLV stream carrying the optional alias.
+	tlvData, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if len(tlvData) == 0 {
+		return nil
+	}
+
+	var alias uint64
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(AliasScidRecordType, &alias),
+	)
+	if err != nil {
+		return err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(tlvData),
+	)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := parsedTypes[AliasScidRecordType]; ok {
+		aliasScid := NewShortChanIDFromInt(alias)
+		c.AliasScid = &aliasScid
+	}
+
+	return nil
 }
 
 // Encode serializes the target FundingLocked message into the passed io.Writer
@@ -51,9 +105,26 @@ func (c *FundingLocked) Decode(r io.Reader, pver uint32) error {
 //
 // This is part of the lnwire.Message interface.
 func (c *FundingLocked) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	err := WriteElements(w,
 		c.ChanID,
 		c.NextPerCommitmentPoint)
+	if err != nil {
+		return err
+	}
+
+	if c.AliasScid == nil {
+		return nil
+	}
+
+	alias := c.AliasScid.ToUint64()
+	tlvStream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(AliasScidRecordType, &alias),
+	)
+	if err != nil {
+		return err
+	}
+
+	return tlvStream.Encode(w)
 }
 
 // MsgType returns the uint32 code which uniquely identifies this message as a
@@ -78,6 +149,9 @@ func (c *FundingLocked) MaxPayloadLength(uint32) uint32 {
 	// NextPerCommitmentPoint - 33 bytes
 	length += 33
 
-	// 65 bytes
+	// AliasScid TLV record - 10 bytes
+	length += aliasScidMaxRecordSize
+
+	// 75 bytes
 	return length
 }
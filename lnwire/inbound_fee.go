@@ -0,0 +1,160 @@
+package lnwire
+
+import (
+	"bytes"
+	"io"
+
+	"github.com/decred/dcrlnd/tlv"
+)
+
+const (
+	// InboundFeeRecordType is the TLV type of the optional inbound fee
+	// carried in the extra opaque data of a ChannelUpdate. The type is odd,
+	// so that nodes that don't know about inbound fees ignore it.
+	InboundFeeRecordType tlv.Type = 55555
+
+	// inboundFeeLength is the length of an encoded InboundFee: the base
+	// fee and the fee rate as two 4-byte integers.
+	inboundFeeLength = 8
+)
+
+// InboundFee is the fee that a node charges for HTLCs that arrive over a
+// channel, on top of the fee of the channel they are forwarded over. It is
+// announced in the ChannelUpdate of the node's own direction of the incoming
+// channel. The inbound fee may be negative, which grants a discount on the
+// outgoing fee to HTLCs that arrive from a particular peer. The total fee of
+// a forward never drops below zero though.
+type InboundFee struct {
+	// BaseFee is the base fee in milli-atoms that is charged for each
+	// incoming HTLC.
+	BaseFee int32
+
+	// FeeRate is the fee rate that is charged per millionth of an atom of
+	// the forwarded amount, including the outgoing fee.
+	FeeRate int32
+}
+
+// CalcFee returns the inbound fee for an HTLC that forwards the given amount,
+// including the outgoing fee. Positive fees are rounded down, while negative
+// fees are rounded up, so the fee never exceeds the exact value.
+func (f InboundFee) CalcFee(amt MilliAtom) int64 {
+	return int64(f.BaseFee) + int64(f.FeeRate)*int64(amt)/1000000
+}
+
+// encodeInboundFee is the tlv.Encoder of an InboundFee record.
+func encodeInboundFee(w io.Writer, val interface{}, buf *[8]byte) error {
+	if v, ok := val.(*InboundFee); ok {
+		err := tlv.EUint32T(w, uint32(v.BaseFee), buf)
+		if err != nil {
+			return err
+		}
+		return tlv.EUint32T(w, uint32(v.FeeRate), buf)
+	}
+
+	return tlv.NewTypeForEncodingErr(val, "lnwire.InboundFee")
+}
+
+// decodeInboundFee is the tlv.Decoder of an InboundFee record.
+func decodeInboundFee(r io.Reader, val interface{}, buf *[8]byte,
+	l uint64) error {
+
+	if v, ok := val.(*InboundFee); ok && l == inboundFeeLength {
+		var baseFee, feeRate uint32
+		if err := tlv.DUint32(r, &baseFee, buf, 4); err != nil {
+			return err
+		}
+		if err := tlv.DUint32(r, &feeRate, buf, 4); err != nil {
+			return err
+		}
+
+		v.BaseFee = int32(baseFee)
+		v.FeeRate = int32(feeRate)
+		return nil
+	}
+
+	return tlv.NewTypeForDecodingErr(val, "lnwire.InboundFee", l,
+		inboundFeeLength)
+}
+
+// inboundFeeRecord returns the TLV record of the given inbound fee.
+func inboundFeeRecord(fee *InboundFee) tlv.Record {
+	return tlv.MakeStaticRecord(
+		InboundFeeRecordType, fee, inboundFeeLength,
+		encodeInboundFee, decodeInboundFee,
+	)
+}
+
+// decodeInboundFeeTLV decodes the extra opaque data of a ChannelUpdate as a
+// TLV stream. It returns the inbound fee it carries, or nil if it doesn't
+// carry one, along with the raw records of all other types.
+func decodeInboundFeeTLV(extraData []byte) (*InboundFee, tlv.TypeMap,
+	error) {
+
+	var fee InboundFee
+	tlvStream, err := tlv.NewStream(inboundFeeRecord(&fee))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	parsedTypes, err := tlvStream.DecodeWithParsedTypes(
+		bytes.NewReader(extraData),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := parsedTypes[InboundFeeRecordType]; !ok {
+		return nil, parsedTypes, nil
+	}
+	delete(parsedTypes, InboundFeeRecordType)
+
+	return &fee, parsedTypes, nil
+}
+
+// ExtractInboundFee returns the inbound fee that is carried in the given
+// extra opaque data of a ChannelUpdate, or nil if it doesn't carry one.
+func ExtractInboundFee(extraData []byte) (*InboundFee, error) {
+	if len(extraData) == 0 {
+		return nil, nil
+	}
+
+	fee, _, err := decodeInboundFeeTLV(extraData)
+	return fee, err
+}
+
+// SetInboundFee returns the given extra opaque data of a ChannelUpdate with
+// its inbound fee replaced by the given one. If the fee is nil, the inbound
+// fee is removed. All other records of the extra opaque data are preserved.
+func SetInboundFee(extraData []byte, fee *InboundFee) ([]byte, error) {
+	_, otherTypes, err := decodeInboundFeeTLV(extraData)
+	if err != nil {
+		return nil, err
+	}
+
+	rawRecords := make(map[uint64][]byte, len(otherTypes))
+	for typ, value := range otherTypes {
+		rawRecords[uint64(typ)] = value
+	}
+	records := tlv.MapToRecords(rawRecords)
+
+	if fee != nil {
+		records = append(records, inboundFeeRecord(fee))
+		tlv.SortRecords(records)
+	}
+
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	tlvStream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var b bytes.Buffer
+	if err := tlvStream.Encode(&b); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
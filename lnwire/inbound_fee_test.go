@@ -0,0 +1,88 @@
+package lnwire
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// TestInboundFeeCalcFee tests that inbound fees are calculated correctly,
+// including negative fees.
+func TestInboundFeeCalcFee(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name string
+		fee  InboundFee
+		amt  MilliAtom
+		exp  int64
+	}{{
+		name: "zero",
+		amt:  1000000,
+	}, {
+		name: "positive",
+		fee:  InboundFee{BaseFee: 100, FeeRate: 1500},
+		amt:  1000001,
+		exp:  1600,
+	}, {
+		name: "negative",
+		fee:  InboundFee{BaseFee: -100, FeeRate: -1500},
+		amt:  1000001,
+		exp:  -1600,
+	}}
+
+	for _, tc := range testCases {
+		fee := tc.fee.CalcFee(tc.amt)
+		if fee != tc.exp {
+			t.Fatalf("%v: expected fee %v, got %v", tc.name, tc.exp,
+				fee)
+		}
+	}
+}
+
+// TestInboundFeeExtraData tests that an inbound fee can be set in and
+// extracted from the extra opaque data of a channel update, while other
+// records are preserved.
+func TestInboundFeeExtraData(t *testing.T) {
+	t.Parallel()
+
+	// Without extra data, there is no inbound fee.
+	fee, err := ExtractInboundFee(nil)
+	if err != nil {
+		t.Fatalf("unable to extract fee: %v", err)
+	}
+	if fee != nil {
+		t.Fatalf("expected no inbound fee, got %v", fee)
+	}
+
+	// Start out from extra data that carries an unknown odd record.
+	unknownRecord := []byte{0x01, 0x02, 0xaa, 0xbb}
+
+	expected := &InboundFee{BaseFee: -1000, FeeRate: -200}
+	extraData, err := SetInboundFee(unknownRecord, expected)
+	if err != nil {
+		t.Fatalf("unable to set fee: %v", err)
+	}
+	if !bytes.HasPrefix(extraData, unknownRecord) {
+		t.Fatalf("expected unknown record to be preserved, got %x",
+			extraData)
+	}
+
+	fee, err = ExtractInboundFee(extraData)
+	if err != nil {
+		t.Fatalf("unable to extract fee: %v", err)
+	}
+	if !reflect.DeepEqual(fee, expected) {
+		t.Fatalf("expected inbound fee %v, got %v", expected, fee)
+	}
+
+	// Removing the inbound fee leaves the unknown record.
+	extraData, err = SetInboundFee(extraData, nil)
+	if err != nil {
+		t.Fatalf("unable to remove fee: %v", err)
+	}
+	if !bytes.Equal(extraData, unknownRecord) {
+		t.Fatalf("expected extra data %x, got %x", unknownRecord,
+			extraData)
+	}
+}
@@ -244,11 +244,31 @@ func TestMaxOutPointIndex(t *testing.T) {
 func TestEmptyMessageUnknownType(t *testing.T) {
 	t.Parallel()
 
-	fakeType := MessageType(math.MaxUint16)
+	fakeType := CustomTypeStart - 1
 	if _, err := makeEmptyMessage(fakeType); err == nil {
 		t.Fatalf("should not be able to make an empty message of an " +
 			"unknown type")
 	}
+
+	// Types within the custom range aren't unknown, but decode to custom
+	// messages.
+	for _, customType := range []MessageType{
+		CustomTypeStart, math.MaxUint16,
+	} {
+		msg, err := makeEmptyMessage(customType)
+		if err != nil {
+			t.Fatalf("unable to make empty message of custom "+
+				"type %v: %v", customType, err)
+		}
+		custom, ok := msg.(*Custom)
+		if !ok {
+			t.Fatalf("expected custom message, got %T", msg)
+		}
+		if custom.Type != customType {
+			t.Fatalf("expected custom type %v, got %v",
+				customType, custom.Type)
+		}
+	}
 }
 
 // TestLightningWireProtocol uses the testing/quick package to create a series
@@ -380,6 +400,14 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.UpfrontShutdownScript = []byte{}
 			}
 
+			// 1/2 chance of an explicit channel type.
+			if r.Intn(2) == 0 {
+				req.ChannelType = NewChannelType(
+					StaticRemoteKeyRequired,
+					ZeroConfRequired,
+				)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgAcceptChannel: func(v []reflect.Value, r *rand.Rand) {
@@ -441,6 +469,14 @@ func TestLightningWireProtocol(t *testing.T) {
 				req.UpfrontShutdownScript = []byte{}
 			}
 
+			// 1/2 chance of an explicit channel type.
+			if r.Intn(2) == 0 {
+				req.ChannelType = NewChannelType(
+					StaticRemoteKeyRequired,
+					ZeroConfRequired,
+				)
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgFundingCreated: func(v []reflect.Value, r *rand.Rand) {
@@ -501,6 +537,12 @@ func TestLightningWireProtocol(t *testing.T) {
 
 			req := NewFundingLocked(ChannelID(c), pubKey)
 
+			// 1/2 chance of an alias short channel id.
+			if r.Intn(2) == 0 {
+				alias := NewShortChanIDFromInt(uint64(r.Int63()))
+				req.AliasScid = &alias
+			}
+
 			v[0] = reflect.ValueOf(*req)
 		},
 		MsgClosingSigned: func(v []reflect.Value, r *rand.Rand) {
@@ -519,6 +561,14 @@ func TestLightningWireProtocol(t *testing.T) {
 				return
 			}
 
+			// Only include the optional fee range half the time.
+			if r.Intn(2) == 0 {
+				req.FeeRange = &FeeRange{
+					MinFeeAtoms: dcrutil.Amount(r.Int63()),
+					MaxFeeAtoms: dcrutil.Amount(r.Int63()),
+				}
+			}
+
 			v[0] = reflect.ValueOf(req)
 		},
 		MsgCommitSig: func(v []reflect.Value, r *rand.Rand) {
@@ -116,6 +116,9 @@ func (t MessageType) String() string {
 	case MsgGossipTimestampRange:
 		return "GossipTimestampRange"
 	default:
+		if t >= CustomTypeStart {
+			return fmt.Sprintf("Custom(%d)", uint16(t))
+		}
 		return "<unknown>"
 	}
 }
@@ -217,6 +220,13 @@ func makeEmptyMessage(msgType MessageType) (Message, error) {
 	case MsgGossipTimestampRange:
 		msg = &GossipTimestampRange{}
 	default:
+		// Messages within the custom range are opaque to us and are
+		// handed to the application as is.
+		if msgType >= CustomTypeStart {
+			msg = &Custom{Type: msgType}
+			break
+		}
+
 		return nil, &UnknownMessage{msgType}
 	}
 
@@ -128,6 +128,12 @@ type OpenChannel struct {
 	// and has a length prefix, so a zero will be written if it is not set
 	// and its length followed by the script will be written if it is set.
 	UpfrontShutdownScript DeliveryAddress
+
+	// ChannelType is the explicit channel type the initiator wishes to
+	// open, encoded as a TLV record after the upfront shutdown script. It
+	// is nil if the initiator relies on the implicit channel type derived
+	// from the feature bits of both parties.
+	ChannelType *ChannelType
 }
 
 // A compile time check to ensure OpenChannel implements the lnwire.Message
@@ -140,7 +146,7 @@ var _ Message = (*OpenChannel)(nil)
 //
 // This is part of the lnwire.Message interface.
 func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
-	return WriteElements(w,
+	if err := WriteElements(w,
 		o.ChainHash[:],
 		o.PendingChannelID[:],
 		o.FundingAmount,
@@ -160,7 +166,11 @@ func (o *OpenChannel) Encode(w io.Writer, pver uint32) error {
 		o.FirstCommitmentPoint,
 		o.ChannelFlags,
 		o.UpfrontShutdownScript,
-	)
+	); err != nil {
+		return err
+	}
+
+	return encodeChannelTypeTLV(w, o.ChannelType)
 }
 
 // Decode deserializes the serialized OpenChannel stored in the passed
@@ -195,11 +205,18 @@ func (o *OpenChannel) Decode(r io.Reader, pver uint32) error {
 	// Check for the optional upfront shutdown script field. If it is not there,
 	// silence the EOF error.
 	err := ReadElement(r, &o.UpfrontShutdownScript)
-	if err != nil && err != io.EOF {
+	switch {
+	case err == io.EOF:
+		return nil
+
+	case err != nil:
 		return err
 	}
 
-	return nil
+	// Any remaining bytes are the TLV stream carrying the optional channel
+	// type.
+	o.ChannelType, err = decodeChannelTypeTLV(r)
+	return err
 }
 
 // MsgType returns the MessageType code which uniquely identifies this message
@@ -221,5 +238,8 @@ func (o *OpenChannel) MaxPayloadLength(uint32) uint32 {
 	// Upfront shutdown script max length.
 	length += 2 + deliveryAddressMaxSize
 
+	// Channel type TLV record max length.
+	length += channelTypeMaxRecordSize
+
 	return length
 }
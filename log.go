@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/decred/dcrd/connmgr"
+	"github.com/decred/dcrlnd/accounts"
 	"github.com/decred/dcrlnd/autopilot"
 	"github.com/decred/dcrlnd/build"
 	"github.com/decred/dcrlnd/chainntnfs"
@@ -15,6 +16,8 @@ import (
 	"github.com/decred/dcrlnd/channelnotifier"
 	"github.com/decred/dcrlnd/contractcourt"
 	"github.com/decred/dcrlnd/discovery"
+	"github.com/decred/dcrlnd/feemanager"
+	"github.com/decred/dcrlnd/funding"
 	"github.com/decred/dcrlnd/healthcheck"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/invoices"
@@ -37,6 +40,7 @@ import (
 	"github.com/decred/dcrlnd/peernotifier"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/localchans"
+	"github.com/decred/dcrlnd/rpcperms"
 	"github.com/decred/dcrlnd/signal"
 	"github.com/decred/dcrlnd/sweep"
 	"github.com/decred/dcrlnd/walletunlocker"
@@ -103,6 +107,7 @@ func SetupLoggers(root *build.RotatingLogWriter) {
 	// in sub packages.
 	signal.UseLogger(ltndLog)
 	autopilot.UseLogger(atplLog)
+	funding.UseLogger(fndgLog)
 
 	AddSubLogger(root, "LNWL", lnwallet.UseLogger)
 	AddSubLogger(root, "DISC", discovery.UseLogger)
@@ -136,6 +141,9 @@ func SetupLoggers(root *build.RotatingLogWriter) {
 	AddSubLogger(root, chanfitness.Subsystem, chanfitness.UseLogger)
 	AddSubLogger(root, verrpc.Subsystem, verrpc.UseLogger)
 	AddSubLogger(root, healthcheck.Subsystem, healthcheck.UseLogger)
+	AddSubLogger(root, rpcperms.Subsystem, rpcperms.UseLogger)
+	AddSubLogger(root, accounts.Subsystem, accounts.UseLogger)
+	AddSubLogger(root, feemanager.Subsystem, feemanager.UseLogger)
 
 	// Decred-specific logs.
 	AddSubLogger(root, "DCRW", dcrwallet.UseLogger)
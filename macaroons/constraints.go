@@ -2,8 +2,10 @@ package macaroons
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
 	"net"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -12,6 +14,23 @@ import (
 	macaroon "gopkg.in/macaroon.v2"
 )
 
+const (
+	// CondCustom is the first party caveat condition name that is used for
+	// custom caveats. Custom caveats are not checked by the macaroon
+	// service itself but are enforced by the RPC middleware that
+	// registered for the caveat name.
+	CondCustom = "lnd-custom"
+
+	// CondAccount is the first party caveat condition name that binds a
+	// macaroon to an account. Payments made with such a macaroon are
+	// debited from the balance of the account and invoices settled with it
+	// are credited to it.
+	CondAccount = "account"
+
+	// AccountIDLen is the length of an account ID in bytes.
+	AccountIDLen = 8
+)
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -87,3 +106,167 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// CustomConstraint returns a function that adds a custom caveat condition to a
+// macaroon. The caveat is enforced by the RPC middleware that registered for
+// the given name.
+func CustomConstraint(name, condition string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if name == "" || strings.Contains(name, " ") {
+			return fmt.Errorf("invalid custom caveat name %q", name)
+		}
+
+		caveat := checkers.Condition(
+			CondCustom, fmt.Sprintf("%s %s", name, condition),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// CustomCaveatAcceptor is an entity that enforces custom caveats, such as the
+// RPC middleware chain.
+type CustomCaveatAcceptor interface {
+	// CustomCaveatSupported returns nil if a middleware that enforces
+	// custom caveats with the given name is registered.
+	CustomCaveatSupported(customCaveatName string) error
+}
+
+// CustomChecker returns a checker for custom caveats. The caveats themselves
+// are enforced by the RPC middleware that registered for them and not by the
+// macaroon service, so the checker only makes sure that such a middleware is
+// registered with the given acceptor. If the acceptor is nil, all custom
+// caveats are rejected.
+func CustomChecker(acceptor CustomCaveatAcceptor) Checker {
+	return func() (string, checkers.Func) {
+		return CondCustom, func(ctx context.Context, cond,
+			arg string) error {
+
+			name, _, err := ParseCustomCaveat(
+				fmt.Sprintf("%s %s", cond, arg),
+			)
+			if err != nil {
+				return err
+			}
+
+			if acceptor == nil {
+				return fmt.Errorf("cannot accept macaroon with "+
+					"custom caveat '%s', no middleware "+
+					"registered to handle it", name)
+			}
+
+			return acceptor.CustomCaveatSupported(name)
+		}
+	}
+}
+
+// ParseCustomCaveat parses a custom caveat into the name the caveat was
+// registered for and its condition.
+func ParseCustomCaveat(caveat string) (string, string, error) {
+	if !strings.HasPrefix(caveat, CondCustom+" ") {
+		return "", "", fmt.Errorf("caveat %q is not a custom caveat",
+			caveat)
+	}
+
+	nameAndCondition := strings.TrimPrefix(caveat, CondCustom+" ")
+	parts := strings.SplitN(nameAndCondition, " ", 2)
+	if parts[0] == "" {
+		return "", "", fmt.Errorf("custom caveat %q is missing a name",
+			caveat)
+	}
+
+	var condition string
+	if len(parts) == 2 {
+		condition = parts[1]
+	}
+
+	return parts[0], condition, nil
+}
+
+// GetCustomCaveatCondition returns the condition of the custom caveat with
+// the given name. If the macaroon doesn't contain such a caveat, false is
+// returned.
+func GetCustomCaveatCondition(mac *macaroon.Macaroon,
+	name string) (string, bool) {
+
+	if mac == nil {
+		return "", false
+	}
+
+	for _, caveat := range mac.Caveats() {
+		caveatName, condition, err := ParseCustomCaveat(
+			string(caveat.Id),
+		)
+		if err != nil {
+			continue
+		}
+
+		if caveatName == name {
+			return condition, true
+		}
+	}
+
+	return "", false
+}
+
+// AccountConstraint returns a function that binds a macaroon to the account
+// with the given ID.
+func AccountConstraint(id [AccountIDLen]byte) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		caveat := checkers.Condition(
+			CondAccount, hex.EncodeToString(id[:]),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// AccountChecker accepts all well formed account caveats. The balance of the
+// account is enforced when a payment is made, not by the macaroon service. It
+// is of the `Checker` type.
+func AccountChecker() (string, checkers.Func) {
+	return CondAccount, func(ctx context.Context, cond, arg string) error {
+		_, err := parseAccountID(arg)
+		return err
+	}
+}
+
+// AccountIDFromMacaroon returns the ID of the account the macaroon is bound
+// to. If the macaroon isn't bound to an account, false is returned.
+func AccountIDFromMacaroon(mac *macaroon.Macaroon) ([AccountIDLen]byte, bool,
+	error) {
+
+	var id [AccountIDLen]byte
+	if mac == nil {
+		return id, false, nil
+	}
+
+	prefix := CondAccount + " "
+	for _, caveat := range mac.Caveats() {
+		if !strings.HasPrefix(string(caveat.Id), prefix) {
+			continue
+		}
+
+		id, err := parseAccountID(
+			strings.TrimPrefix(string(caveat.Id), prefix),
+		)
+		if err != nil {
+			return id, false, err
+		}
+
+		return id, true, nil
+	}
+
+	return id, false, nil
+}
+
+// parseAccountID parses the hex encoded account ID of an account caveat.
+func parseAccountID(idStr string) ([AccountIDLen]byte, error) {
+	var id [AccountIDLen]byte
+
+	idBytes, err := hex.DecodeString(idStr)
+	if err != nil || len(idBytes) != AccountIDLen {
+		return id, fmt.Errorf("invalid account ID %q", idStr)
+	}
+	copy(id[:], idBytes)
+
+	return id, nil
+}
@@ -1,6 +1,7 @@
 package macaroons_test
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -108,3 +109,107 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestCustomConstraint tests that a custom caveat is added to a macaroon and
+// that its condition can be retrieved by name.
+func TestCustomConstraint(t *testing.T) {
+	constraintFunc := macaroons.CustomConstraint("limits", "max-spend=1000")
+	testMacaroon := createDummyMacaroon(t)
+	err := constraintFunc(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error applying custom constraint: %v", err)
+	}
+
+	expected := "lnd-custom limits max-spend=1000"
+	if string(testMacaroon.Caveats()[0].Id) != expected {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+
+	condition, ok := macaroons.GetCustomCaveatCondition(
+		testMacaroon, "limits",
+	)
+	if !ok || condition != "max-spend=1000" {
+		t.Fatalf("Unexpected custom caveat condition '%s'", condition)
+	}
+
+	_, ok = macaroons.GetCustomCaveatCondition(testMacaroon, "other")
+	if ok {
+		t.Fatalf("Found custom caveat condition for unknown name")
+	}
+
+	// Caveat names must not contain spaces, as the name couldn't be told
+	// apart from the condition otherwise.
+	constraintFunc = macaroons.CustomConstraint("bad name", "")
+	if err := constraintFunc(createDummyMacaroon(t)); err == nil {
+		t.Fatalf("CustomConstraint with bad name should fail.")
+	}
+}
+
+// mockCaveatAcceptor accepts the custom caveats of a single name.
+type mockCaveatAcceptor struct {
+	name string
+}
+
+func (a *mockCaveatAcceptor) CustomCaveatSupported(name string) error {
+	if name != a.name {
+		return fmt.Errorf("unsupported custom caveat '%s'", name)
+	}
+	return nil
+}
+
+// TestCustomChecker tests that custom caveats are only accepted if a
+// middleware is registered to enforce them.
+func TestCustomChecker(t *testing.T) {
+	// Without an acceptor, custom caveats are rejected outright.
+	name, checker := macaroons.CustomChecker(nil)()
+	if name != macaroons.CondCustom {
+		t.Fatalf("Unexpected checker name '%s'", name)
+	}
+	err := checker(context.Background(), name, "limits max-spend=1000")
+	if err == nil {
+		t.Fatalf("Custom caveat accepted without acceptor.")
+	}
+
+	_, checker = macaroons.CustomChecker(
+		&mockCaveatAcceptor{name: "limits"},
+	)()
+	err = checker(context.Background(), name, "limits max-spend=1000")
+	if err != nil {
+		t.Fatalf("Supported custom caveat rejected: %v", err)
+	}
+	err = checker(context.Background(), name, "other max-spend=1000")
+	if err == nil {
+		t.Fatalf("Unsupported custom caveat accepted.")
+	}
+}
+
+// TestAccountConstraint tests that a macaroon can be bound to an account and
+// that the account ID can be retrieved from it.
+func TestAccountConstraint(t *testing.T) {
+	testMacaroon := createDummyMacaroon(t)
+	_, ok, err := macaroons.AccountIDFromMacaroon(testMacaroon)
+	if err != nil || ok {
+		t.Fatalf("Found account ID in macaroon without account caveat")
+	}
+
+	id := [macaroons.AccountIDLen]byte{1, 2, 3, 4, 5, 6, 7, 8}
+	constraintFunc := macaroons.AccountConstraint(id)
+	if err := constraintFunc(testMacaroon); err != nil {
+		t.Fatalf("Error applying account constraint: %v", err)
+	}
+
+	expected := "account 0102030405060708"
+	if string(testMacaroon.Caveats()[0].Id) != expected {
+		t.Fatalf("Added caveat '%s' does not meet the expectations!",
+			testMacaroon.Caveats()[0].Id)
+	}
+
+	accountID, ok, err := macaroons.AccountIDFromMacaroon(testMacaroon)
+	if err != nil {
+		t.Fatalf("Error retrieving account ID: %v", err)
+	}
+	if !ok || accountID != id {
+		t.Fatalf("Unexpected account ID %x", accountID)
+	}
+}
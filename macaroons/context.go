@@ -2,7 +2,11 @@ package macaroons
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+
+	"google.golang.org/grpc/metadata"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 var (
@@ -42,3 +46,28 @@ func RootKeyIDFromContext(ctx context.Context) ([]byte, error) {
 
 	return id, nil
 }
+
+// MacaroonFromContext extracts the macaroon an RPC call was made with from
+// the gRPC metadata of the context, along with its serialized form. A nil
+// macaroon is returned if the call doesn't carry a macaroon, which is the case
+// if macaroons are disabled.
+func MacaroonFromContext(ctx context.Context) (*macaroon.Macaroon, []byte,
+	error) {
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["macaroon"]) != 1 {
+		return nil, nil, nil
+	}
+
+	rawMac, err := hex.DecodeString(md["macaroon"][0])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mac := &macaroon.Macaroon{}
+	if err := mac.UnmarshalBinary(rawMac); err != nil {
+		return nil, nil, err
+	}
+
+	return mac, rawMac, nil
+}
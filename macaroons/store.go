@@ -197,24 +197,143 @@ func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 			return nil
 		}
 
-		// Otherwise, create a RootKeyLen-byte root key, encrypt it,
-		// and store it in the bucket.
-		rootKey = make([]byte, RootKeyLen)
-		if _, err := io.ReadFull(rand.Reader, rootKey); err != nil {
+		// Otherwise, create a new root key and store it in the bucket.
+		var err error
+		rootKey, err = generateAndStoreNewRootKey(ns, id, r.encKey)
+		return err
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rootKey, id, nil
+}
+
+// ChangePassword re-encrypts all root keys with an encryption key derived
+// from the new password. The store must already be unlocked and the old
+// password must match the one it was unlocked with.
+func (r *RootKeyStorage) ChangePassword(oldPw, newPw []byte) error {
+	r.encKeyMtx.Lock()
+	defer r.encKeyMtx.Unlock()
+
+	// We need the store to be unlocked already, which makes sure there is
+	// an encryption key in the DB.
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	if oldPw == nil || newPw == nil {
+		return ErrPasswordRequired
+	}
+
+	var encKeyNew *snacl.SecretKey
+	err := kvdb.Update(r, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(rootKeyBucketName)
+		dbKey := bucket.Get(encryptedKeyID)
+		if len(dbKey) == 0 {
+			return ErrStoreLocked
+		}
+
+		// Make sure the old password is correct before touching any of
+		// the root keys.
+		encKeyOld := &snacl.SecretKey{}
+		if err := encKeyOld.Unmarshal(dbKey); err != nil {
+			return err
+		}
+		if err := encKeyOld.DeriveKey(&oldPw); err != nil {
 			return err
 		}
+		defer encKeyOld.Zero()
+
+		var err error
+		encKeyNew, err = snacl.NewSecretKey(
+			&newPw, scryptN, scryptR, scryptP,
+		)
+		if err != nil {
+			return err
+		}
+
+		// Decrypt all root keys first, as the bucket can't be modified
+		// while we're iterating over it.
+		rootKeys := make(map[string][]byte)
+		err = bucket.ForEach(func(k, v []byte) error {
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			rootKey, err := encKeyOld.Decrypt(v)
+			if err != nil {
+				return err
+			}
 
-		encKey, err := r.encKey.Encrypt(rootKey)
+			rootKeys[string(k)] = rootKey
+			return nil
+		})
 		if err != nil {
 			return err
 		}
-		return ns.Put(id, encKey)
+
+		for id, rootKey := range rootKeys {
+			encRootKey, err := encKeyNew.Encrypt(rootKey)
+			if err != nil {
+				return err
+			}
+
+			err = bucket.Put([]byte(id), encRootKey)
+			if err != nil {
+				return err
+			}
+		}
+
+		return bucket.Put(encryptedKeyID, encKeyNew.Marshal())
 	})
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 
-	return rootKey, id, nil
+	// From now on, the root keys can only be decrypted with the key that
+	// was derived from the new password.
+	r.encKey.Zero()
+	r.encKey = encKeyNew
+	return nil
+}
+
+// GenerateNewRootKey deletes all root keys and creates a new default root key,
+// which invalidates every macaroon that was baked before. The store must
+// already be unlocked.
+func (r *RootKeyStorage) GenerateNewRootKey() error {
+	r.encKeyMtx.RLock()
+	defer r.encKeyMtx.RUnlock()
+
+	if r.encKey == nil {
+		return ErrStoreLocked
+	}
+
+	return kvdb.Update(r, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(rootKeyBucketName)
+
+		var ids [][]byte
+		err := bucket.ForEach(func(k, _ []byte) error {
+			if !bytes.Equal(k, encryptedKeyID) {
+				ids = append(ids, append([]byte(nil), k...))
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			if err := bucket.Delete(id); err != nil {
+				return err
+			}
+		}
+
+		_, err = generateAndStoreNewRootKey(
+			bucket, DefaultRootKeyID, r.encKey,
+		)
+		return err
+	})
 }
 
 // Close closes the underlying database and zeroes the encryption key stored
@@ -313,3 +432,26 @@ func (r *RootKeyStorage) DeleteMacaroonID(
 
 	return rootKeyIDDeleted, nil
 }
+
+// generateAndStoreNewRootKey creates a new random RootKeyLen-byte root key,
+// encrypts it with the given encryption key and stores it in the bucket under
+// the given ID.
+func generateAndStoreNewRootKey(bucket kvdb.RwBucket, id []byte,
+	encKey *snacl.SecretKey) ([]byte, error) {
+
+	rootKey := make([]byte, RootKeyLen)
+	if _, err := io.ReadFull(rand.Reader, rootKey); err != nil {
+		return nil, err
+	}
+
+	encRootKey, err := encKey.Encrypt(rootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := bucket.Put(id, encRootKey); err != nil {
+		return nil, err
+	}
+
+	return rootKey, nil
+}
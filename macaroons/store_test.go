@@ -169,3 +169,155 @@ func TestStore(t *testing.T) {
 			rootID, id)
 	}
 }
+
+// openTestStore opens the root key store in the given directory.
+func openTestStore(t *testing.T, tempDir string) *macaroons.RootKeyStorage {
+	db, err := kvdb.Create(
+		kvdb.BoltBackendName, path.Join(tempDir, "weks.db"), true,
+	)
+	if err != nil {
+		t.Fatalf("Error opening store DB: %v", err)
+	}
+
+	store, err := macaroons.NewRootKeyStorage(db)
+	if err != nil {
+		db.Close()
+		t.Fatalf("Error creating root key store: %v", err)
+	}
+
+	return store
+}
+
+// TestStoreChangePassword tests that the root keys are still available after
+// changing the password of the store, but only with the new password.
+func TestStoreChangePassword(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	store := openTestStore(t, tempDir)
+
+	pw := []byte("weks")
+	newPw := []byte("newweks")
+	err = store.ChangePassword(pw, newPw)
+	if err != macaroons.ErrStoreLocked {
+		t.Fatalf("Received %v instead of ErrStoreLocked", err)
+	}
+
+	if err := store.CreateUnlock(&pw); err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	ctx := macaroons.ContextWithRootKeyID(
+		context.TODO(), macaroons.DefaultRootKeyID,
+	)
+	rootKey, _, err := store.RootKey(ctx)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	err = store.ChangePassword(newPw, newPw)
+	if err != snacl.ErrInvalidPassword {
+		t.Fatalf("Received %v instead of ErrInvalidPassword", err)
+	}
+
+	err = store.ChangePassword(pw, nil)
+	if err != macaroons.ErrPasswordRequired {
+		t.Fatalf("Received %v instead of ErrPasswordRequired", err)
+	}
+
+	if err := store.ChangePassword(pw, newPw); err != nil {
+		t.Fatalf("Error changing password: %v", err)
+	}
+
+	// The store must still be usable after the password change.
+	key, err := store.Get(ctx, macaroons.DefaultRootKeyID)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(key, rootKey) {
+		t.Fatalf("Root key doesn't match: expected %v, got %v",
+			rootKey, key)
+	}
+	store.Close()
+
+	// After re-opening the store, only the new password must unlock it.
+	store = openTestStore(t, tempDir)
+	defer store.Close()
+
+	err = store.CreateUnlock(&pw)
+	if err != snacl.ErrInvalidPassword {
+		t.Fatalf("Received %v instead of ErrInvalidPassword", err)
+	}
+
+	if err := store.CreateUnlock(&newPw); err != nil {
+		t.Fatalf("Error unlocking root key store: %v", err)
+	}
+
+	key, err = store.Get(ctx, macaroons.DefaultRootKeyID)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(key, rootKey) {
+		t.Fatalf("Root key doesn't match: expected %v, got %v",
+			rootKey, key)
+	}
+}
+
+// TestStoreGenerateNewRootKey tests that generating a new root key replaces
+// the default root key and removes all other root keys.
+func TestStoreGenerateNewRootKey(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "macaroonstore-")
+	if err != nil {
+		t.Fatalf("Error creating temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	store := openTestStore(t, tempDir)
+	defer store.Close()
+
+	err = store.GenerateNewRootKey()
+	if err != macaroons.ErrStoreLocked {
+		t.Fatalf("Received %v instead of ErrStoreLocked", err)
+	}
+
+	pw := []byte("weks")
+	if err := store.CreateUnlock(&pw); err != nil {
+		t.Fatalf("Error creating store encryption key: %v", err)
+	}
+
+	ctx := macaroons.ContextWithRootKeyID(
+		context.TODO(), macaroons.DefaultRootKeyID,
+	)
+	rootKey, _, err := store.RootKey(ctx)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	otherCtx := macaroons.ContextWithRootKeyID(context.TODO(), []byte("1"))
+	if _, _, err := store.RootKey(otherCtx); err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+
+	if err := store.GenerateNewRootKey(); err != nil {
+		t.Fatalf("Error generating new root key: %v", err)
+	}
+
+	key, err := store.Get(ctx, macaroons.DefaultRootKeyID)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if bytes.Equal(key, rootKey) {
+		t.Fatalf("Root key wasn't replaced")
+	}
+
+	ids, err := store.ListMacaroonIDs(context.TODO())
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	if len(ids) != 1 || !bytes.Equal(ids[0], macaroons.DefaultRootKeyID) {
+		t.Fatalf("Expected only the default root key ID, got %v", ids)
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/decred/dcrlnd/chainntnfs"
 	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwallet/chainfee"
@@ -342,6 +343,17 @@ func (*mockWalletController) LeaseOutput(lnwallet.LockID, wire.OutPoint) (time.T
 func (*mockWalletController) ReleaseOutput(lnwallet.LockID, wire.OutPoint) error {
 	return nil
 }
+func (*mockWalletController) FundPsbt(*psbt.Packet, int32,
+	chainfee.AtomPerKByte) (int32, error) {
+
+	return 0, nil
+}
+func (*mockWalletController) SignPsbt(*psbt.Packet) ([]uint32, error) {
+	return nil, nil
+}
+func (*mockWalletController) FinalizePsbt(*psbt.Packet) error {
+	return nil
+}
 
 func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx, _ string) error {
 	m.publishedTransactions <- tx
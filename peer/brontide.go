@@ -486,6 +486,18 @@ func (p *Brontide) loadActiveChannels(chans []*channeldb.OpenChannel) (
 				FeeRate:       selfPolicy.FeeProportionalMillionths,
 				TimeLockDelta: uint32(selfPolicy.TimeLockDelta),
 			}
+
+			// An inbound fee that can't be parsed is ignored, as
+			// the rest of the network wouldn't be able to parse
+			// it either.
+			inboundFee, err := selfPolicy.InboundFee()
+			if err != nil {
+				peerLog.Warnf("Unable to parse inbound fee of "+
+					"channel %v: %v", chanPoint, err)
+			}
+			if inboundFee != nil {
+				forwardingPolicy.InboundFee = *inboundFee
+			}
 		} else {
 			peerLog.Warnf("Unable to find our forwarding policy "+
 				"for channel %v, using default values",
@@ -542,6 +554,13 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 	chainEvents *contractcourt.ChainEventSubscription,
 	syncStates bool) error {
 
+	// Anchor channels are backed up with a tower client whose sessions
+	// expect the anchor commitment format.
+	towerClient := p.cfg.TowerClient
+	if lnChan.State().ChanType.HasAnchors() {
+		towerClient = p.cfg.AnchorTowerClient
+	}
+
 	// onChannelFailure will be called by the link in case the channel
 	// fails for some reason.
 	onChannelFailure := func(chanID lnwire.ChannelID,
@@ -571,6 +590,8 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		DecodeHopIterators:      p.cfg.Sphinx.DecodeHopIterators,
 		ExtractErrorEncrypter:   p.cfg.Sphinx.ExtractErrorEncrypter,
 		FetchLastChannelUpdate:  p.cfg.FetchLastChanUpdate,
+		FetchLocalAlias:         p.cfg.FetchLocalAlias,
+		SignAliasUpdate:         p.cfg.SignAliasUpdate,
 		HodlMask:                p.cfg.Hodl.Mask(),
 		Registry:                p.cfg.Invoices,
 		Switch:                  p.cfg.Switch,
@@ -591,7 +612,7 @@ func (p *Brontide) addLink(chanPoint *wire.OutPoint,
 		MinFeeUpdateTimeout:     htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout:     htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
 		OutgoingCltvRejectDelta: p.cfg.OutgoingCltvRejectDelta,
-		TowerClient:             p.cfg.TowerClient,
+		TowerClient:             towerClient,
 		MaxOutgoingCltvExpiry:   p.cfg.MaxOutgoingCltvExpiry,
 		MaxFeeAllocation:        p.cfg.MaxChannelFeeAllocation,
 		NotifyActiveLink:        p.cfg.ChannelNotifier.NotifyActiveLinkEvent,
@@ -1188,6 +1209,13 @@ out:
 
 			discStream.AddMsg(msg)
 
+		case *lnwire.Custom:
+			err := p.cfg.HandleCustomMessage(p.PubKey(), msg)
+			if err != nil {
+				p.storeError(err)
+				peerLog.Errorf("peer: %v, %v", p, err)
+			}
+
 		default:
 			// If the message we received is unknown to us, store
 			// the type to track the failure.
@@ -2124,7 +2152,8 @@ func (p *Brontide) fetchActiveChanCloser(chanID lnwire.ChannelID) (
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				FeePolicy: p.cfg.CoopCloseFeePolicy,
+				Quit:      p.quit,
 			},
 			deliveryScript,
 			feePerKB,
@@ -2240,7 +2269,9 @@ func (p *Brontide) handleLocalCloseReq(req *htlcswitch.ChanClose) {
 				Disconnect: func() error {
 					return p.cfg.DisconnectPeer(p.IdentityKey())
 				},
-				Quit: p.quit,
+				MaxFeePerKB: req.MaxFeePerKB,
+				FeePolicy:   p.cfg.CoopCloseFeePolicy,
+				Quit:        p.quit,
 			},
 			deliveryScript,
 			req.TargetFeePerKB,
@@ -21,6 +21,7 @@ import (
 	"github.com/decred/dcrlnd/lnpeer"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwallet/chainfee"
+	"github.com/decred/dcrlnd/lnwallet/chancloser"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/netann"
 	"github.com/decred/dcrlnd/pool"
@@ -117,6 +118,11 @@ type Config struct {
 	// initializing the coop close process.
 	FeeEstimator chainfee.Estimator
 
+	// CoopCloseFeePolicy decides which fees are proposed and accepted when
+	// negotiating the fee of a cooperative close transaction. If nil, the
+	// default policy of the chancloser package is used.
+	CoopCloseFeePolicy chancloser.FeePolicy
+
 	// Signer is used when creating *lnwallet.LightningChannel instances.
 	Signer input.Signer
 
@@ -155,9 +161,14 @@ type Config struct {
 	// HtlcNotifier is used when creating a ChannelLink.
 	HtlcNotifier *htlcswitch.HtlcNotifier
 
-	// TowerClient is used when creating a ChannelLink.
+	// TowerClient is used when creating a ChannelLink for a channel that
+	// doesn't use anchor outputs.
 	TowerClient wtclient.Client
 
+	// AnchorTowerClient is used when creating a ChannelLink for a channel
+	// that uses anchor outputs.
+	AnchorTowerClient wtclient.Client
+
 	// DisconnectPeer is used to disconnect this peer if the cooperative close
 	// process fails.
 	DisconnectPeer func(*secp256k1.PublicKey) error
@@ -176,6 +187,15 @@ type Config struct {
 	FetchLastChanUpdate func(lnwire.ShortChannelID) (*lnwire.ChannelUpdate,
 		error)
 
+	// FetchLocalAlias returns the alias short channel id we handed out for
+	// the channel with the given channel id, or channeldb.ErrNoAlias if
+	// the channel doesn't have one.
+	FetchLocalAlias func(lnwire.ChannelID) (lnwire.ShortChannelID, error)
+
+	// SignAliasUpdate signs the given channel update, which carries the
+	// alias we handed out for a channel, in place.
+	SignAliasUpdate func(*lnwire.ChannelUpdate) error
+
 	// ProcessFundingOpen is used to hand off an OpenChannel message to the
 	// funding manager.
 	ProcessFundingOpen func(*lnwire.OpenChannel, lnpeer.Peer)
@@ -200,6 +220,10 @@ type Config struct {
 	// manager.
 	ProcessFundingError func(*lnwire.Error, *secp256k1.PublicKey)
 
+	// HandleCustomMessage is used to hand off a message within the custom
+	// message type range to the application.
+	HandleCustomMessage func(peer [33]byte, msg *lnwire.Custom) error
+
 	// IsPendingChannel is used to determine whether to send an Error message
 	// to the funding manager or not.
 	IsPendingChannel func([32]byte, *secp256k1.PublicKey) bool
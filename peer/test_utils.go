@@ -26,6 +26,7 @@ import (
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/input"
+	"github.com/decred/dcrlnd/internal/psbt"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwallet/chainfee"
@@ -319,6 +320,20 @@ func (*mockWalletController) ReleaseOutput(lnwallet.LockID, wire.OutPoint) error
 	return nil
 }
 
+func (*mockWalletController) FundPsbt(*psbt.Packet, int32,
+	chainfee.AtomPerKByte) (int32, error) {
+
+	return 0, nil
+}
+
+func (*mockWalletController) SignPsbt(*psbt.Packet) ([]uint32, error) {
+	return nil, nil
+}
+
+func (*mockWalletController) FinalizePsbt(*psbt.Packet) error {
+	return nil
+}
+
 func (*mockWalletController) GetRecoveryInfo() (bool, float64, error) {
 	return false, 0, nil
 }
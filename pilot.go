@@ -4,13 +4,17 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"time"
 
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/autopilot"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lncfg"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing"
+	"github.com/decred/dcrlnd/ticker"
 	"github.com/decred/dcrlnd/tor"
 )
 
@@ -120,8 +124,11 @@ func (c *chanController) OpenChannel(target *secp256k1.PublicKey,
 	}
 }
 
+// CloseChannel cooperatively closes the target channel. The close is carried
+// out in the background, so a failure to close, e.g. because the peer is
+// offline, is only logged.
 func (c *chanController) CloseChannel(chanPoint *wire.OutPoint) error {
-	return nil
+	return c.server.autoCloseChannel(*chanPoint, false)
 }
 func (c *chanController) SpliceIn(chanPoint *wire.OutPoint,
 	amt dcrutil.Amount) (*autopilot.Channel, error) {
@@ -162,6 +169,17 @@ func initAutoPilot(svr *server, cfg *lncfg.AutoPilot,
 		return nil, err
 	}
 
+	// The performance heuristic needs to be handed the signals it scores
+	// nodes by.
+	for _, h := range heuristics {
+		p, ok := h.AttachmentHeuristic.(*autopilot.PerformanceAttachment)
+		if !ok {
+			continue
+		}
+
+		p.SetConfig(performanceConfig(svr, cfg))
+	}
+
 	weightedAttachment, err := autopilot.NewWeightedCombAttachment(
 		heuristics...,
 	)
@@ -169,6 +187,11 @@ func initAutoPilot(svr *server, cfg *lncfg.AutoPilot,
 		return nil, err
 	}
 
+	var evaluationTicker ticker.Ticker
+	if cfg.EvaluationInterval > 0 {
+		evaluationTicker = ticker.New(cfg.EvaluationInterval)
+	}
+
 	// With the heuristic itself created, we can now populate the remainder
 	// of the items that the autopilot agent needs to perform its duties.
 	self := svr.identityECDH.PubKey()
@@ -244,7 +267,18 @@ func initAutoPilot(svr *server, cfg *lncfg.AutoPilot,
 
 			return false, nil
 		},
-		DisconnectPeer: svr.DisconnectPeer,
+		DisconnectPeer:   svr.DisconnectPeer,
+		EvaluationTicker: evaluationTicker,
+		CloseScore:       cfg.CloseScore,
+		DownsizeScore:    cfg.DownsizeScore,
+		IsLocalFunded: func(chanPoint wire.OutPoint) (bool, error) {
+			channel, err := svr.remoteChanDB.FetchChannel(chanPoint)
+			if err != nil {
+				return false, err
+			}
+
+			return channel.IsInitiator, nil
+		},
 	}
 
 	// Create and return the autopilot.ManagerCfg that administrates this
@@ -268,6 +302,7 @@ func initAutoPilot(svr *server, cfg *lncfg.AutoPilot,
 					Capacity: channel.Capacity,
 					Node: autopilot.NewNodeID(
 						channel.IdentityPub),
+					ChanPoint: channel.FundingOutpoint,
 				}
 			}
 
@@ -277,3 +312,163 @@ func initAutoPilot(svr *server, cfg *lncfg.AutoPilot,
 		SubscribeTopology:     svr.chanRouter.SubscribeTopology,
 	}, nil
 }
+
+// performanceConfig returns the config of the performance heuristic, whose
+// signals are taken from the forwarding log, the channel event store and
+// mission control.
+func performanceConfig(svr *server,
+	cfg *lncfg.AutoPilot) *autopilot.PerformanceConfig {
+
+	return &autopilot.PerformanceConfig{
+		Revenue: func(since time.Time) (
+			map[autopilot.NodeID]lnwire.MilliAtom, error) {
+
+			return forwardingRevenue(svr, since)
+		},
+		Fitness: func() (map[autopilot.NodeID]*autopilot.NodeFitness,
+			error) {
+
+			return peerFitness(svr)
+		},
+		SuccessRates: func() (map[autopilot.NodeID]float64, error) {
+			snapshot := svr.missionControl.GetHistorySnapshot()
+			return nodeSuccessRates(snapshot), nil
+		},
+		RevenueWindow: cfg.RevenueWindow,
+		MinChanAge:    cfg.MinChanAge,
+	}
+}
+
+// forwardingRevenue returns the routing fees earned since the given time
+// through the open and closed channels of the node, grouped by the channels'
+// remote nodes. The fee of a forward is attributed to both its incoming and
+// its outgoing channel, since it takes both to earn it.
+func forwardingRevenue(svr *server, since time.Time) (
+	map[autopilot.NodeID]lnwire.MilliAtom, error) {
+
+	chanNodes := make(map[lnwire.ShortChannelID]autopilot.NodeID)
+	openChannels, err := svr.remoteChanDB.FetchAllOpenChannels()
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range openChannels {
+		chanNodes[c.ShortChanID()] = autopilot.NewNodeID(c.IdentityPub)
+	}
+
+	closedChannels, err := svr.remoteChanDB.FetchClosedChannels(false)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range closedChannels {
+		chanNodes[c.ShortChanID] = autopilot.NewNodeID(c.RemotePub)
+	}
+
+	// Every node we've had a channel with is given a revenue, so that
+	// channels that never earned anything are known as such.
+	revenue := make(map[autopilot.NodeID]lnwire.MilliAtom)
+	for _, nID := range chanNodes {
+		revenue[nID] = 0
+	}
+
+	if err := svr.htlcSwitch.FlushForwardingEvents(); err != nil {
+		return nil, fmt.Errorf("unable to flush forwarding events: %v",
+			err)
+	}
+
+	query := channeldb.ForwardingEventQuery{
+		StartTime:    since,
+		EndTime:      time.Now(),
+		NumMaxEvents: 1000,
+	}
+	fwdEventLog := svr.remoteChanDB.ForwardingLog()
+	for {
+		timeSlice, err := fwdEventLog.Query(query)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(timeSlice.ForwardingEvents) == 0 {
+			break
+		}
+
+		for _, event := range timeSlice.ForwardingEvents {
+			fee := event.AmtIn - event.AmtOut
+
+			chanIDs := []lnwire.ShortChannelID{
+				event.IncomingChanID, event.OutgoingChanID,
+			}
+			for _, chanID := range chanIDs {
+				nID, ok := chanNodes[chanID]
+				if !ok {
+					continue
+				}
+				revenue[nID] += fee
+			}
+		}
+
+		query.IndexOffset = timeSlice.LastIndexOffset
+	}
+
+	return revenue, nil
+}
+
+// peerFitness returns the fitness of the nodes we have open channels with, as
+// judged by the channel event store.
+func peerFitness(svr *server) (map[autopilot.NodeID]*autopilot.NodeFitness,
+	error) {
+
+	peers, err := svr.chanEventStore.GetAllPeerFitness()
+	if err != nil {
+		return nil, err
+	}
+
+	fitness := make(map[autopilot.NodeID]*autopilot.NodeFitness, len(peers))
+	for _, peer := range peers {
+		nodeFitness := &autopilot.NodeFitness{
+			Score: peer.Score,
+		}
+		for _, c := range peer.Channels {
+			if c.Lifespan > nodeFitness.Lifespan {
+				nodeFitness.Lifespan = c.Lifespan
+			}
+		}
+
+		fitness[autopilot.NodeID(peer.Peer)] = nodeFitness
+	}
+
+	return fitness, nil
+}
+
+// nodeSuccessRates derives the success rate of the payment attempts routed
+// through each node from a mission control snapshot. Mission control only
+// keeps the last result of each node pair, so the rate is the fraction of the
+// pairs that include the node whose last result was a success.
+func nodeSuccessRates(
+	snapshot *routing.MissionControlSnapshot) map[autopilot.NodeID]float64 {
+
+	var (
+		successes = make(map[autopilot.NodeID]int)
+		results   = make(map[autopilot.NodeID]int)
+	)
+	for _, pair := range snapshot.Pairs {
+		success := pair.SuccessTime.After(pair.FailTime)
+
+		nodes := []autopilot.NodeID{
+			autopilot.NodeID(pair.Pair.From),
+			autopilot.NodeID(pair.Pair.To),
+		}
+		for _, nID := range nodes {
+			results[nID]++
+			if success {
+				successes[nID]++
+			}
+		}
+	}
+
+	rates := make(map[autopilot.NodeID]float64, len(results))
+	for nID, n := range results {
+		rates[nID] = float64(successes[nID]) / float64(n)
+	}
+
+	return rates
+}
@@ -52,6 +52,10 @@ type ControlTower interface {
 	// FetchInFlightPayments returns all payments with status InFlight.
 	FetchInFlightPayments() ([]*channeldb.InFlightPayment, error)
 
+	// DeletePayment removes the payment with the given hash. Payments that
+	// are still in flight can't be deleted.
+	DeletePayment(paymentHash lntypes.Hash) error
+
 	// SubscribePayment subscribes to updates for the payment with the given
 	// hash. A first update with the current state of the payment is always
 	// sent out immediately.
@@ -217,6 +221,15 @@ func (p *controlTower) FetchInFlightPayments() ([]*channeldb.InFlightPayment, er
 	return p.db.FetchInFlightPayments()
 }
 
+// DeletePayment removes the payment with the given hash from the DB, unless it
+// is still in flight.
+func (p *controlTower) DeletePayment(paymentHash lntypes.Hash) error {
+	p.paymentsMtx.Lock(paymentHash)
+	defer p.paymentsMtx.Unlock(paymentHash)
+
+	return p.db.DeletePayment(paymentHash)
+}
+
 // SubscribePayment subscribes to updates for the payment with the given hash. A
 // first update with the current state of the payment is always sent out
 // immediately.
@@ -3,7 +3,6 @@ package routing
 import (
 	"container/heap"
 
-	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/lnwire"
 	"github.com/decred/dcrlnd/routing/route"
 )
@@ -38,8 +37,13 @@ type nodeWithDist struct {
 	// time locks.
 	weight int64
 
+	// outboundFee is the fee that this node charges for forwarding over
+	// its outgoing channel of the route. It bounds the discount that a
+	// negative inbound fee of this node can grant.
+	outboundFee lnwire.MilliAtom
+
 	// nextHop is the edge this route comes from.
-	nextHop *channeldb.ChannelEdgePolicy
+	nextHop *unifiedPolicyEdge
 
 	// routingInfoSize is the total size requirement for the payloads field
 	// in the onion packet from this hop towards the final destination.
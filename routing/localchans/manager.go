@@ -98,6 +98,7 @@ func (r *Manager) UpdatePolicy(newSchema routing.ChannelPolicy,
 			TimeLockDelta: uint32(edge.TimeLockDelta),
 			MinHTLCOut:    edge.MinHTLC,
 			MaxHTLC:       edge.MaxHTLC,
+			InboundFee:    newSchema.InboundFee,
 		}
 
 		return nil
@@ -134,6 +135,20 @@ func (r *Manager) updateEdge(chanPoint wire.OutPoint,
 	)
 	edge.TimeLockDelta = uint16(newSchema.TimeLockDelta)
 
+	// Update the inbound fee, which is carried in the extra opaque data of
+	// the channel update. A zero inbound fee is removed.
+	var inboundFee *lnwire.InboundFee
+	if newSchema.InboundFee != (lnwire.InboundFee{}) {
+		inboundFee = &newSchema.InboundFee
+	}
+	extraData, err := lnwire.SetInboundFee(
+		edge.ExtraOpaqueData, inboundFee,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to set inbound fee: %v", err)
+	}
+	edge.ExtraOpaqueData = extraData
+
 	// Retrieve negotiated channel htlc amt limits.
 	amtMin, amtMax, err := r.getHtlcAmtLimits(chanPoint)
 	if err != nil {
@@ -29,6 +29,10 @@ func TestManager(t *testing.T) {
 		FeeSchema: routing.FeeSchema{
 			BaseFee: 100,
 			FeeRate: 200,
+			InboundFee: lnwire.InboundFee{
+				BaseFee: -50,
+				FeeRate: -100,
+			},
 		},
 		TimeLockDelta: 80,
 		MaxHTLC:       5000,
@@ -59,6 +63,9 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		if policy.InboundFee != newPolicy.InboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 	}
 
 	propagateChanPolicyUpdate := func(
@@ -84,6 +91,13 @@ func TestManager(t *testing.T) {
 		if policy.MaxHTLC != newPolicy.MaxHTLC {
 			t.Fatal("unexpected max htlc")
 		}
+		inboundFee, err := policy.InboundFee()
+		if err != nil {
+			t.Fatalf("unable to extract inbound fee: %v", err)
+		}
+		if inboundFee == nil || *inboundFee != newPolicy.InboundFee {
+			t.Fatal("unexpected inbound fee")
+		}
 
 		return nil
 	}
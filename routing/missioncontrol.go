@@ -1,6 +1,8 @@
 package routing
 
 import (
+	"errors"
+	"fmt"
 	"sync"
 	"time"
 
@@ -84,7 +86,7 @@ type MissionControl struct {
 
 	// estimator is the probability estimator that is used with the payment
 	// results that mission control collects.
-	estimator *probabilityEstimator
+	estimator Estimator
 
 	sync.Mutex
 
@@ -125,6 +127,11 @@ type MissionControlConfig struct {
 
 	// SelfNode is our own pubkey.
 	SelfNode route.Vertex
+
+	// Estimator is the probability estimator that mission control starts
+	// out with. If it is nil, an AprioriEstimator is created from
+	// PenaltyHalfLife, AprioriHopProbability and AprioriWeight.
+	Estimator Estimator
 }
 
 // TimedPairResult describes a timestamped pair result.
@@ -190,11 +197,16 @@ func NewMissionControl(db kvdb.Backend, cfg *MissionControlConfig) (
 		return nil, err
 	}
 
-	estimator := &probabilityEstimator{
-		aprioriHopProbability:  cfg.AprioriHopProbability,
-		aprioriWeight:          cfg.AprioriWeight,
-		penaltyHalfLife:        cfg.PenaltyHalfLife,
-		prevSuccessProbability: prevSuccessProbability,
+	estimator := cfg.Estimator
+	if estimator == nil {
+		estimator, err = NewAprioriEstimator(AprioriConfig{
+			AprioriHopProbability: cfg.AprioriHopProbability,
+			AprioriWeight:         cfg.AprioriWeight,
+			PenaltyHalfLife:       cfg.PenaltyHalfLife,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	mc := &MissionControl{
@@ -227,8 +239,20 @@ func (m *MissionControl) init() error {
 		m.applyPaymentResult(result)
 	}
 
+	// Merge the imported pair results into the rederived state, keeping
+	// the most recent result of each pair.
+	importedPairs, err := m.store.fetchImportedPairs()
+	if err != nil {
+		return err
+	}
+
+	m.state.importSnapshot(
+		&MissionControlSnapshot{Pairs: importedPairs}, false,
+	)
+
 	log.Debugf("Mission control state reconstruction finished: "+
-		"n=%v, time=%v", len(results), time.Since(start))
+		"n=%v, imported=%v, time=%v", len(results),
+		len(importedPairs), time.Since(start))
 
 	return nil
 }
@@ -263,10 +287,32 @@ func (m *MissionControl) GetProbability(fromNode, toNode route.Vertex,
 
 	// Use a distinct probability estimation function for local channels.
 	if fromNode == m.cfg.SelfNode {
-		return m.estimator.getLocalPairProbability(now, results, toNode)
+		return m.estimator.LocalPairProbability(now, results, toNode)
 	}
 
-	return m.estimator.getPairProbability(now, results, toNode, amt)
+	return m.estimator.PairProbability(now, results, toNode, amt)
+}
+
+// GetEstimator returns the probability estimator that mission control
+// currently uses.
+func (m *MissionControl) GetEstimator() Estimator {
+	m.Lock()
+	defer m.Unlock()
+
+	return m.estimator
+}
+
+// SetEstimator replaces the probability estimator that mission control uses.
+// The collected payment results are kept, so the new estimator takes effect
+// immediately for all subsequent probability estimates.
+func (m *MissionControl) SetEstimator(estimator Estimator) {
+	m.Lock()
+	defer m.Unlock()
+
+	log.Infof("Mission control switching from %v to %v estimator",
+		m.estimator, estimator)
+
+	m.estimator = estimator
 }
 
 // GetHistorySnapshot takes a snapshot from the current mission control state
@@ -280,6 +326,46 @@ func (m *MissionControl) GetHistorySnapshot() *MissionControlSnapshot {
 	return m.state.getSnapshot()
 }
 
+// ImportHistory merges the pair results of a snapshot, which is typically
+// taken by the mission control of another node, into the current state. A
+// pair's imported failure or success only replaces the known one if it is more
+// recent, unless force is set. The merged results are persisted and merged
+// into the state rederived from the stored payment results on restart, where
+// the most recent result of a pair prevails. The number of pairs that changed
+// is returned.
+func (m *MissionControl) ImportHistory(snapshot *MissionControlSnapshot,
+	force bool) (int, error) {
+
+	if snapshot == nil {
+		return 0, errors.New("cannot import nil history")
+	}
+
+	for _, pair := range snapshot.Pairs {
+		if pair.Pair.From == pair.Pair.To {
+			return 0, fmt.Errorf("invalid pair %v: source and "+
+				"destination are equal", pair.Pair)
+		}
+
+		if pair.FailTime.IsZero() && pair.SuccessTime.IsZero() {
+			return 0, fmt.Errorf("invalid pair %v: no result",
+				pair.Pair)
+		}
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	imported := m.state.importSnapshot(snapshot, force)
+	if err := m.store.addImportedPairs(imported); err != nil {
+		return 0, err
+	}
+
+	log.Infof("Imported %v of %v pairs into mission control",
+		len(imported), len(snapshot.Pairs))
+
+	return len(imported), nil
+}
+
 // GetPairHistorySnapshot returns the stored history for a given node pair.
 func (m *MissionControl) GetPairHistorySnapshot(
 	fromNode, toNode route.Vertex) TimedPairResult {
@@ -135,6 +135,91 @@ func (m *missionControlState) setLastPairResult(fromNode, toNode route.Vertex,
 	nodePairs[toNode] = current
 }
 
+// importSnapshot merges the pair results of a snapshot into the state. For
+// each pair, the imported failure and success replace the current ones only if
+// they are more recent, unless force is set. The ranges are then made
+// consistent again, giving precedence to the most recent result. The merged
+// results of the pairs that changed are returned.
+func (m *missionControlState) importSnapshot(snapshot *MissionControlSnapshot,
+	force bool) []MissionControlPairSnapshot {
+
+	var imported []MissionControlPairSnapshot
+	for _, pair := range snapshot.Pairs {
+		fromNode := pair.Pair.From
+		toNode := pair.Pair.To
+
+		nodePairs, ok := m.lastPairResult[fromNode]
+		if !ok {
+			nodePairs = make(NodeResults)
+			m.lastPairResult[fromNode] = nodePairs
+		}
+
+		current := nodePairs[toNode]
+		merged := mergePairResult(current, pair.TimedPairResult, force)
+		if merged == current {
+			continue
+		}
+
+		log.Debugf("Importing %v->%v range [%v-%v]", fromNode, toNode,
+			merged.SuccessAmt, merged.FailAmt)
+
+		nodePairs[toNode] = merged
+		imported = append(imported, MissionControlPairSnapshot{
+			Pair:            pair.Pair,
+			TimedPairResult: merged,
+		})
+	}
+
+	return imported
+}
+
+// mergePairResult merges an imported pair result into the current one. The
+// failure and success are taken over from the imported result if they are
+// more recent, or if force is set. If the failure and success ranges overlap
+// afterwards, the range of the older result is moved out of the way.
+func mergePairResult(current, imported TimedPairResult,
+	force bool) TimedPairResult {
+
+	merged := current
+
+	if !imported.FailTime.IsZero() &&
+		(force || imported.FailTime.After(current.FailTime)) {
+
+		merged.FailTime = imported.FailTime
+		merged.FailAmt = imported.FailAmt
+	}
+
+	if !imported.SuccessTime.IsZero() &&
+		(force || imported.SuccessTime.After(current.SuccessTime)) {
+
+		merged.SuccessTime = imported.SuccessTime
+		merged.SuccessAmt = imported.SuccessAmt
+	}
+
+	if merged.FailTime.IsZero() || merged.SuccessTime.IsZero() {
+		return merged
+	}
+
+	switch {
+	// A more recent success moves the failure range up, like a reported
+	// success would.
+	case merged.SuccessTime.After(merged.FailTime):
+		if merged.SuccessAmt >= merged.FailAmt {
+			merged.FailAmt = merged.SuccessAmt + 1
+		}
+
+	// A more recent amount-independent failure resets the success amount.
+	case merged.FailAmt == 0:
+		merged.SuccessAmt = 0
+
+	// A more recent failure moves the success range down.
+	case merged.FailAmt <= merged.SuccessAmt:
+		merged.SuccessAmt = merged.FailAmt - 1
+	}
+
+	return merged
+}
+
 // setAllFail stores a fail result for all known connections to and from the
 // given node.
 func (m *missionControlState) setAllFail(node route.Vertex,
@@ -45,3 +45,105 @@ func TestMissionControlStateFailureResult(t *testing.T) {
 		t.Fatalf("unexpected fail amount %v", result[to].FailAmt)
 	}
 }
+
+// TestMissionControlStateImport tests merging imported pair results into the
+// mission control state.
+func TestMissionControlStateImport(t *testing.T) {
+	state := newMissionControlState(time.Minute)
+
+	var (
+		from = route.Vertex{1}
+		to   = route.Vertex{2}
+		pair = NewDirectedNodePair(from, to)
+	)
+
+	state.setLastPairResult(from, to, testTime, &pairResult{amt: 1000})
+
+	importPair := func(result TimedPairResult, force bool) int {
+		return len(state.importSnapshot(&MissionControlSnapshot{
+			Pairs: []MissionControlPairSnapshot{{
+				Pair:            pair,
+				TimedPairResult: result,
+			}},
+		}, force))
+	}
+
+	assertResult := func(expected TimedPairResult) {
+		t.Helper()
+
+		results, _ := state.getLastPairResult(from)
+		if results[to] != expected {
+			t.Fatalf("expected result %v, got %v", expected,
+				results[to])
+		}
+	}
+
+	// An older failure is not imported.
+	imported := importPair(TimedPairResult{
+		FailTime: testTime.Add(-time.Hour),
+		FailAmt:  500,
+	}, false)
+	if imported != 0 {
+		t.Fatalf("expected older failure not to be imported")
+	}
+	assertResult(TimedPairResult{FailTime: testTime, FailAmt: 1000})
+
+	// Unless the import is forced.
+	imported = importPair(TimedPairResult{
+		FailTime: testTime.Add(-time.Hour),
+		FailAmt:  500,
+	}, true)
+	if imported != 1 {
+		t.Fatalf("expected forced failure to be imported")
+	}
+	assertResult(TimedPairResult{
+		FailTime: testTime.Add(-time.Hour),
+		FailAmt:  500,
+	})
+
+	// A more recent success for a larger amount moves the failure range
+	// up.
+	importPair(TimedPairResult{
+		SuccessTime: testTime,
+		SuccessAmt:  800,
+	}, false)
+	assertResult(TimedPairResult{
+		FailTime:    testTime.Add(-time.Hour),
+		FailAmt:     801,
+		SuccessTime: testTime,
+		SuccessAmt:  800,
+	})
+
+	// A more recent failure for a smaller amount moves the success range
+	// down.
+	importPair(TimedPairResult{
+		FailTime: testTime.Add(time.Hour),
+		FailAmt:  600,
+	}, false)
+	assertResult(TimedPairResult{
+		FailTime:    testTime.Add(time.Hour),
+		FailAmt:     600,
+		SuccessTime: testTime,
+		SuccessAmt:  599,
+	})
+
+	// Pairs that weren't known are imported as they are.
+	newPair := NewDirectedNodePair(to, from)
+	imported = len(state.importSnapshot(&MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{{
+			Pair: newPair,
+			TimedPairResult: TimedPairResult{
+				SuccessTime: testTime,
+				SuccessAmt:  100,
+			},
+		}},
+	}, false))
+	if imported != 1 {
+		t.Fatalf("expected new pair to be imported")
+	}
+	results, _ := state.getLastPairResult(to)
+	if results[from].SuccessAmt != 100 {
+		t.Fatalf("unexpected success amount %v",
+			results[from].SuccessAmt)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/channeldb/kvdb"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
 )
 
 var (
@@ -17,6 +18,10 @@ var (
 	// stored.
 	resultsKey = []byte("missioncontrol-results")
 
+	// importedPairsKey is the fixed key under which the pair results
+	// imported from an external source are stored.
+	importedPairsKey = []byte("missioncontrol-imported-pairs")
+
 	// Big endian is the preferred byte order, due to cursor scans over
 	// integer keys iterating in order.
 	byteOrder = binary.BigEndian
@@ -63,6 +68,12 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int) (*missionControlSto
 			store.numRecords++
 		}
 
+		_, err = tx.CreateTopLevelBucket(importedPairsKey)
+		if err != nil {
+			return fmt.Errorf("cannot create imported pairs "+
+				"bucket: %v", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -72,15 +83,20 @@ func newMissionControlStore(db kvdb.Backend, maxRecords int) (*missionControlSto
 	return store, nil
 }
 
-// clear removes all results from the db.
+// clear removes all results and imported pair results from the db.
 func (b *missionControlStore) clear() error {
 	return kvdb.Update(b.db, func(tx kvdb.RwTx) error {
-		if err := tx.DeleteTopLevelBucket(resultsKey); err != nil {
-			return err
+		for _, key := range [][]byte{resultsKey, importedPairsKey} {
+			if err := tx.DeleteTopLevelBucket(key); err != nil {
+				return err
+			}
+
+			if _, err := tx.CreateTopLevelBucket(key); err != nil {
+				return err
+			}
 		}
 
-		_, err := tx.CreateTopLevelBucket(resultsKey)
-		return err
+		return nil
 	})
 }
 
@@ -267,3 +283,127 @@ func getResultKey(rp *paymentResult) []byte {
 
 	return keyBytes[:]
 }
+
+// addImportedPairs stores the given imported pair results, replacing any
+// result previously stored for the same pair.
+func (b *missionControlStore) addImportedPairs(
+	pairs []MissionControlPairSnapshot) error {
+
+	return kvdb.Update(b.db, func(tx kvdb.RwTx) error {
+		bucket := tx.ReadWriteBucket(importedPairsKey)
+
+		for _, pair := range pairs {
+			k, v, err := serializeImportedPair(&pair)
+			if err != nil {
+				return err
+			}
+
+			if err := bucket.Put(k, v); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// fetchImportedPairs returns all imported pair results currently stored in the
+// database.
+func (b *missionControlStore) fetchImportedPairs() (
+	[]MissionControlPairSnapshot, error) {
+
+	var pairs []MissionControlPairSnapshot
+
+	err := kvdb.View(b.db, func(tx kvdb.RTx) error {
+		bucket := tx.ReadBucket(importedPairsKey)
+		pairs = make([]MissionControlPairSnapshot, 0)
+
+		return bucket.ForEach(func(k, v []byte) error {
+			pair, err := deserializeImportedPair(k, v)
+			if err != nil {
+				return err
+			}
+
+			pairs = append(pairs, *pair)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pairs, nil
+}
+
+// serializeImportedPair serializes an imported pair result and returns a key
+// and value byte slice to insert into the bucket. The key is the concatenation
+// of the from and to node of the pair.
+func serializeImportedPair(pair *MissionControlPairSnapshot) ([]byte, []byte,
+	error) {
+
+	var key [2 * route.VertexSize]byte
+	copy(key[:], pair.Pair.From[:])
+	copy(key[route.VertexSize:], pair.Pair.To[:])
+
+	var b bytes.Buffer
+	err := channeldb.WriteElements(
+		&b,
+		serializeTime(pair.FailTime), uint64(pair.FailAmt),
+		serializeTime(pair.SuccessTime), uint64(pair.SuccessAmt),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key[:], b.Bytes(), nil
+}
+
+// deserializeImportedPair deserializes an imported pair result.
+func deserializeImportedPair(k, v []byte) (*MissionControlPairSnapshot,
+	error) {
+
+	var pair MissionControlPairSnapshot
+	if len(k) != 2*route.VertexSize {
+		return nil, fmt.Errorf("invalid imported pair key length %v",
+			len(k))
+	}
+	copy(pair.Pair.From[:], k)
+	copy(pair.Pair.To[:], k[route.VertexSize:])
+
+	var failTime, failAmt, successTime, successAmt uint64
+	err := channeldb.ReadElements(
+		bytes.NewReader(v), &failTime, &failAmt, &successTime,
+		&successAmt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pair.FailTime = deserializeTime(failTime)
+	pair.FailAmt = lnwire.MilliAtom(failAmt)
+	pair.SuccessTime = deserializeTime(successTime)
+	pair.SuccessAmt = lnwire.MilliAtom(successAmt)
+
+	return &pair, nil
+}
+
+// serializeTime encodes a time as unix nano timestamp, using zero for the zero
+// time.
+func serializeTime(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return uint64(t.UnixNano())
+}
+
+// deserializeTime decodes a time encoded by serializeTime, converting it to
+// the local time zone for consistent logging.
+func deserializeTime(t uint64) time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(0, int64(t)).Local()
+}
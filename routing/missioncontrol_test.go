@@ -194,6 +194,113 @@ func TestMissionControl(t *testing.T) {
 	ctx.reportSuccess()
 }
 
+// TestMissionControlSetEstimator tests that the probability estimator of
+// mission control can be switched while keeping the collected results.
+func TestMissionControlSetEstimator(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	ctx.reportFailure(1000, lnwire.NewTemporaryChannelFailure(nil))
+	ctx.expectP(1000, 0)
+
+	if ctx.mc.GetEstimator().String() != AprioriEstimatorName {
+		t.Fatalf("expected apriori estimator by default")
+	}
+
+	estimator, err := NewBimodalEstimator(BimodalConfig{
+		AprioriHopProbability: testAprioriHopProbability,
+		ScaleMAtoms:           100,
+		NodeWeight:            DefaultBimodalNodeWeight,
+		DecayTime:             DefaultBimodalDecayTime,
+	})
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+	ctx.mc.SetEstimator(estimator)
+
+	if ctx.mc.GetEstimator() != estimator {
+		t.Fatalf("expected bimodal estimator to be set")
+	}
+
+	// The failure is still known to the new estimator, which expects half
+	// the failed amount to have an even chance.
+	ctx.expectP(1000, 0)
+	ctx.expectP(500, 0.5)
+
+	// Untried local channels are expected to succeed.
+	selfP := ctx.mc.GetProbability(mcTestSelf, mcTestNode1, 100)
+	if selfP != 1 {
+		t.Fatalf("expected untried local channel to succeed, got %v",
+			selfP)
+	}
+}
+
+// TestMissionControlImportHistory tests importing the history of another
+// mission control instance.
+func TestMissionControlImportHistory(t *testing.T) {
+	ctx := createMcTestContext(t)
+	defer ctx.cleanup()
+
+	ctx.now = testTime
+
+	pair := NewDirectedNodePair(mcTestNode1, mcTestNode2)
+	snapshot := &MissionControlSnapshot{
+		Pairs: []MissionControlPairSnapshot{{
+			Pair: pair,
+			TimedPairResult: TimedPairResult{
+				FailTime: testTime,
+				FailAmt:  1000,
+			},
+		}},
+	}
+
+	imported, err := ctx.mc.ImportHistory(snapshot, false)
+	if err != nil {
+		t.Fatalf("unable to import history: %v", err)
+	}
+	if imported != 1 {
+		t.Fatalf("expected 1 imported pair, got %v", imported)
+	}
+
+	// The imported failure is expected to be applied like a reported one.
+	ctx.expectP(1000, 0)
+
+	// The imported failure is expected to survive a restart.
+	ctx.restartMc()
+	ctx.expectP(1000, 0)
+
+	result := ctx.mc.GetPairHistorySnapshot(mcTestNode1, mcTestNode2)
+	if !result.FailTime.Equal(testTime) || result.FailAmt != 1000 ||
+		!result.SuccessTime.IsZero() {
+
+		t.Fatalf("unexpected pair result after restart: %v", result)
+	}
+
+	// Importing the same history again doesn't change anything.
+	imported, err = ctx.mc.ImportHistory(snapshot, false)
+	if err != nil {
+		t.Fatalf("unable to import history: %v", err)
+	}
+	if imported != 0 {
+		t.Fatalf("expected no imported pairs, got %v", imported)
+	}
+
+	// Resetting the history also removes the imported results.
+	if err := ctx.mc.ResetHistory(); err != nil {
+		t.Fatalf("unable to reset history: %v", err)
+	}
+	ctx.restartMc()
+	ctx.expectP(1000, testAprioriHopProbability)
+
+	// Pairs without results are rejected.
+	snapshot.Pairs[0].TimedPairResult = TimedPairResult{}
+	if _, err := ctx.mc.ImportHistory(snapshot, false); err == nil {
+		t.Fatalf("expected pair without results to be rejected")
+	}
+}
+
 // TestMissionControlChannelUpdate tests that the first channel update is not
 // penalizing the channel yet.
 func TestMissionControlChannelUpdate(t *testing.T) {
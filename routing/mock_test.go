@@ -426,6 +426,29 @@ func (m *mockControlTower) FetchPayment(phash lntypes.Hash) (
 	return mp, nil
 }
 
+func (m *mockControlTower) DeletePayment(phash lntypes.Hash) error {
+	m.Lock()
+	defer m.Unlock()
+
+	// Payment must be known.
+	if _, ok := m.payments[phash]; !ok {
+		return channeldb.ErrPaymentNotInitiated
+	}
+
+	// Only completed payments can be deleted.
+	_, succeeded := m.successful[phash]
+	_, failed := m.failed[phash]
+	if !succeeded && !failed {
+		return channeldb.ErrPaymentInFlight
+	}
+
+	delete(m.payments, phash)
+	delete(m.successful, phash)
+	delete(m.failed, phash)
+
+	return nil
+}
+
 func (m *mockControlTower) FetchInFlightPayments() (
 	[]*channeldb.InFlightPayment, error) {
 
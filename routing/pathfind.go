@@ -42,7 +42,7 @@ const (
 type pathFinder = func(g *graphParams, r *RestrictParams,
 	cfg *PathFindingConfig, source, target route.Vertex,
 	amt lnwire.MilliAtom, finalHtlcExpiry int32) (
-	[]*channeldb.ChannelEdgePolicy, error)
+	[]*unifiedPolicyEdge, error)
 
 var (
 	// DefaultPaymentAttemptPenalty is the virtual cost in path finding weight
@@ -91,7 +91,7 @@ type finalHopParams struct {
 // that any feature vectors on all hops have been validated for transitive
 // dependencies.
 func newRoute(sourceVertex route.Vertex,
-	pathEdges []*channeldb.ChannelEdgePolicy, currentHeight uint32,
+	pathEdges []*unifiedPolicyEdge, currentHeight uint32,
 	finalHop finalHopParams) (*route.Route, error) {
 
 	var (
@@ -113,7 +113,7 @@ func newRoute(sourceVertex route.Vertex,
 	for i := pathLength - 1; i >= 0; i-- {
 		// Now we'll start to calculate the items within the per-hop
 		// payload for the hop this edge is leading to.
-		edge := pathEdges[i]
+		edge := pathEdges[i].policy
 
 		// We'll calculate the amounts, timelocks, and fees for each
 		// hop in the route. The base case is the final hop which
@@ -192,13 +192,28 @@ func newRoute(sourceVertex route.Vertex,
 			// and its policy for the outgoing channel. This policy
 			// is stored as part of the incoming channel of
 			// the next hop.
-			fee = pathEdges[i+1].ComputeFee(amtToForward)
+			outboundFee := pathEdges[i+1].policy.ComputeFee(
+				amtToForward,
+			)
+
+			// On top of that, the current hop may charge an
+			// inbound fee for the channel the HTLC arrives over.
+			// A negative inbound fee can at most cancel out the
+			// outbound fee.
+			inboundFee := pathEdges[i].inboundFee.CalcFee(
+				amtToForward + outboundFee,
+			)
+			totalFee := int64(outboundFee) + inboundFee
+			if totalFee > 0 {
+				fee = lnwire.MilliAtom(totalFee)
+			}
 
 			// We'll take the total timelock of the preceding hop as
 			// the outgoing timelock or this hop. Then we'll
 			// increment the total timelock incurred by this hop.
 			outgoingTimeLock = totalTimeLock
-			totalTimeLock += uint32(pathEdges[i+1].TimeLockDelta)
+			nextPolicy := pathEdges[i+1].policy
+			totalTimeLock += uint32(nextPolicy.TimeLockDelta)
 		}
 
 		// Since we're traversing the path backwards atm, we prepend
@@ -390,7 +405,7 @@ func getOutgoingBalance(node route.Vertex, outgoingChans map[uint64]struct{},
 // available bandwidth.
 func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	source, target route.Vertex, amt lnwire.MilliAtom,
-	finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+	finalHtlcExpiry int32) ([]*unifiedPolicyEdge, error) {
 
 	// Pathfinding can be a significant portion of the total payment
 	// latency, especially on low-powered devices. Log several metrics to
@@ -553,13 +568,29 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// satisfy our specific requirements.
 	processEdge := func(fromVertex route.Vertex,
 		fromFeatures *lnwire.FeatureVector,
-		edge *channeldb.ChannelEdgePolicy, toNodeDist *nodeWithDist) {
+		edge *unifiedPolicyEdge, toNodeDist *nodeWithDist) {
 
 		edgesExpanded++
 
+		// Unless the to node is the target, it may charge an inbound
+		// fee for HTLCs that arrive over this edge. The total fee of
+		// the to node can't become negative, so a discount is limited
+		// to its outbound fee.
+		var inboundFee int64
+		if toNodeDist.node != target {
+			inboundFee = edge.inboundFee.CalcFee(
+				toNodeDist.amountToReceive,
+			)
+			if inboundFee < -int64(toNodeDist.outboundFee) {
+				inboundFee = -int64(toNodeDist.outboundFee)
+			}
+		}
+
 		// Calculate amount that the candidate node would have to send
 		// out.
-		amountToSend := toNodeDist.amountToReceive
+		amountToSend := lnwire.MilliAtom(
+			int64(toNodeDist.amountToReceive) + inboundFee,
+		)
 
 		// Request the success probability for this edge.
 		edgeProbability := r.ProbabilitySource(
@@ -591,8 +622,8 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		var fee lnwire.MilliAtom
 		var timeLockDelta uint16
 		if fromVertex != source {
-			fee = edge.ComputeFee(amountToSend)
-			timeLockDelta = edge.TimeLockDelta
+			fee = edge.policy.ComputeFee(amountToSend)
+			timeLockDelta = edge.policy.TimeLockDelta
 		}
 
 		incomingCltv := toNodeDist.incomingCltv + int32(timeLockDelta)
@@ -631,8 +662,14 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		// By adding fromVertex in the route, there will be an extra
 		// weight composed of the fee that this node will charge and
 		// the amount that will be locked for timeLockDelta blocks in
-		// the HTLC that is handed out to fromVertex.
-		weight := edgeWeight(amountToReceive, fee, timeLockDelta)
+		// the HTLC that is handed out to fromVertex. The inbound fee of
+		// the to node is accounted for here as well, but the weight
+		// can't become negative.
+		var weightFee lnwire.MilliAtom
+		if edgeFee := int64(fee) + inboundFee; edgeFee > 0 {
+			weightFee = lnwire.MilliAtom(edgeFee)
+		}
+		weight := edgeWeight(amountToReceive, weightFee, timeLockDelta)
 
 		// Compute the tentative weight to this new channel/edge
 		// which is the weight from our toNode to the target node
@@ -669,9 +706,9 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 		// Every edge should have a positive time lock delta. If we
 		// encounter a zero delta, log a warning line.
-		if edge.TimeLockDelta == 0 {
+		if edge.policy.TimeLockDelta == 0 {
 			log.Warnf("Channel %v has zero cltv delta",
-				edge.ChannelID)
+				edge.policy.ChannelID)
 		}
 
 		// Calculate the total routing info size if this hop were to be
@@ -692,7 +729,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 				LegacyPayload: !supportsTlv,
 			}
 
-			payloadSize = hop.PayloadSize(edge.ChannelID)
+			payloadSize = hop.PayloadSize(edge.policy.ChannelID)
 		}
 
 		routingInfoSize := toNodeDist.routingInfoSize + payloadSize
@@ -713,6 +750,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 			amountToReceive: amountToReceive,
 			incomingCltv:    incomingCltv,
 			probability:     probability,
+			outboundFee:     fee,
 			nextHop:         edge,
 			routingInfoSize: routingInfoSize,
 		}
@@ -783,7 +821,10 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		}
 
 		for _, reverseEdge := range additionalEdgesWithSrc[pivot] {
-			u.addPolicy(reverseEdge.sourceNode, reverseEdge.edge, 0)
+			u.addPolicy(
+				reverseEdge.sourceNode, reverseEdge.edge,
+				lnwire.InboundFee{}, 0,
+			)
 		}
 
 		amtToSend := partialPath.amountToReceive
@@ -849,7 +890,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 
 	// Use the distance map to unravel the forward path from source to
 	// target.
-	var pathEdges []*channeldb.ChannelEdgePolicy
+	var pathEdges []*unifiedPolicyEdge
 	currentNode := source
 	for {
 		// Determine the next hop forward using the next map.
@@ -863,7 +904,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 		pathEdges = append(pathEdges, currentNodeWithDist.nextHop)
 
 		// Advance current node.
-		currentNode = currentNodeWithDist.nextHop.Node.PubKeyBytes
+		currentNode = currentNodeWithDist.nextHop.policy.Node.PubKeyBytes
 
 		// Check stop condition at the end of this loop. This prevents
 		// breaking out too soon for self-payments that have target set
@@ -884,7 +925,7 @@ func findPath(g *graphParams, r *RestrictParams, cfg *PathFindingConfig,
 	// route construction does not care where the features are actually
 	// taken from. In the future we may wish to do route construction within
 	// findPath, and avoid using ChannelEdgePolicy altogether.
-	pathEdges[len(pathEdges)-1].Node.Features = features
+	pathEdges[len(pathEdges)-1].policy.Node.Features = features
 
 	log.Debugf("Found route: probability=%v, hops=%v, fee=%v",
 		distance[source].probability, len(pathEdges),
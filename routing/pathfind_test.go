@@ -999,7 +999,7 @@ func TestPathFindingWithAdditionalEdges(t *testing.T) {
 	}
 
 	find := func(r *RestrictParams) (
-		[]*channeldb.ChannelEdgePolicy, error) {
+		[]*unifiedPolicyEdge, error) {
 
 		return dbFindPath(
 			graph.graph, additionalEdges, nil,
@@ -1066,27 +1066,41 @@ func TestNewRoute(t *testing.T) {
 	createHop := func(baseFee lnwire.MilliAtom,
 		feeRate lnwire.MilliAtom,
 		bandwidth lnwire.MilliAtom,
-		timeLockDelta uint16) *channeldb.ChannelEdgePolicy {
-
-		return &channeldb.ChannelEdgePolicy{
-			Node: &channeldb.LightningNode{
-				Features: lnwire.NewFeatureVector(
-					nil, nil,
-				),
+		timeLockDelta uint16) *unifiedPolicyEdge {
+
+		return &unifiedPolicyEdge{
+			policy: &channeldb.ChannelEdgePolicy{
+				Node: &channeldb.LightningNode{
+					Features: lnwire.NewFeatureVector(
+						nil, nil,
+					),
+				},
+				FeeProportionalMillionths: feeRate,
+				FeeBaseMAtoms:             baseFee,
+				TimeLockDelta:             timeLockDelta,
 			},
-			FeeProportionalMillionths: feeRate,
-			FeeBaseMAtoms:             baseFee,
-			TimeLockDelta:             timeLockDelta,
 		}
 	}
 
+	// withInboundFee sets the inbound fee that the to node of the given
+	// hop charges.
+	withInboundFee := func(hop *unifiedPolicyEdge, baseFee,
+		feeRate int32) *unifiedPolicyEdge {
+
+		hop.inboundFee = lnwire.InboundFee{
+			BaseFee: baseFee,
+			FeeRate: feeRate,
+		}
+		return hop
+	}
+
 	testCases := []struct {
 		// name identifies the test case in the test output.
 		name string
 
 		// hops is the list of hops (the route) that gets passed into
 		// the call to newRoute.
-		hops []*channeldb.ChannelEdgePolicy
+		hops []*unifiedPolicyEdge
 
 		// paymentAmount is the amount that is send into the route
 		// indicated by hops.
@@ -1135,7 +1149,7 @@ func TestNewRoute(t *testing.T) {
 			// For a single hop payment, no fees are expected to be paid.
 			name:          "single hop",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(100, 1000, 1000000, 10),
 			},
 			expectedFees:          []lnwire.MilliAtom{0},
@@ -1148,7 +1162,7 @@ func TestNewRoute(t *testing.T) {
 			// a fee to receive the payment.
 			name:          "two hop",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(0, 1000, 1000000, 10),
 				createHop(30, 1000, 1000000, 5),
 			},
@@ -1163,7 +1177,7 @@ func TestNewRoute(t *testing.T) {
 			name:          "two hop tlv onion feature",
 			destFeatures:  tlvFeatures,
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(0, 1000, 1000000, 10),
 				createHop(30, 1000, 1000000, 5),
 			},
@@ -1180,7 +1194,7 @@ func TestNewRoute(t *testing.T) {
 			destFeatures:  tlvPayAddrFeatures,
 			paymentAddr:   &testPaymentAddr,
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(0, 1000, 1000000, 10),
 				createHop(30, 1000, 1000000, 5),
 			},
@@ -1200,7 +1214,7 @@ func TestNewRoute(t *testing.T) {
 			// gets rounded down to 1.
 			name:          "three hop",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(0, 10, 1000000, 10),
 				createHop(0, 10, 1000000, 5),
 				createHop(0, 10, 1000000, 3),
@@ -1215,7 +1229,7 @@ func TestNewRoute(t *testing.T) {
 			// because of the increase amount to forward.
 			name:          "three hop with fee carry over",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(0, 10000, 1000000, 10),
 				createHop(0, 10000, 1000000, 5),
 				createHop(0, 10000, 1000000, 3),
@@ -1230,7 +1244,7 @@ func TestNewRoute(t *testing.T) {
 			// effect.
 			name:          "three hop with minimal fees for carry over",
 			paymentAmount: 100000,
-			hops: []*channeldb.ChannelEdgePolicy{
+			hops: []*unifiedPolicyEdge{
 				createHop(0, 10000, 1000000, 10),
 
 				// First hop charges 0.1% so the second hop fee
@@ -1245,6 +1259,45 @@ func TestNewRoute(t *testing.T) {
 			expectedTotalAmount:   101101,
 			expectedTimeLocks:     []uint32{4, 1, 1},
 			expectedTotalTimeLock: 9,
+		}, {
+			// A three hop payment where the second hop charges an
+			// inbound fee on top of its outbound fee. The inbound
+			// fee of the final hop is ignored.
+			name:          "three hop with inbound fee",
+			paymentAmount: 100000,
+			hops: []*unifiedPolicyEdge{
+				createHop(0, 10000, 1000000, 10),
+				withInboundFee(
+					createHop(0, 10000, 1000000, 5),
+					500, 0,
+				),
+				withInboundFee(
+					createHop(1000, 0, 1000000, 3),
+					500, 0,
+				),
+			},
+			expectedFees:          []lnwire.MilliAtom{1015, 1500, 0},
+			expectedTotalAmount:   102515,
+			expectedTimeLocks:     []uint32{4, 1, 1},
+			expectedTotalTimeLock: 9,
+		}, {
+			// A three hop payment where the inbound discount of the
+			// second hop exceeds its outbound fee. The fee of the
+			// second hop can't become negative.
+			name:          "three hop with inbound discount",
+			paymentAmount: 100000,
+			hops: []*unifiedPolicyEdge{
+				createHop(0, 10000, 1000000, 10),
+				withInboundFee(
+					createHop(0, 10000, 1000000, 5),
+					-2000, 0,
+				),
+				createHop(1000, 0, 1000000, 3),
+			},
+			expectedFees:          []lnwire.MilliAtom{1000, 0, 0},
+			expectedTotalAmount:   101000,
+			expectedTimeLocks:     []uint32{4, 1, 1},
+			expectedTotalTimeLock: 9,
 		}}
 
 	for _, testCase := range testCases {
@@ -1254,7 +1307,7 @@ func TestNewRoute(t *testing.T) {
 		// custom feature vector.
 		if testCase.destFeatures != nil {
 			finalHop := testCase.hops[len(testCase.hops)-1]
-			finalHop.Node.Features = testCase.destFeatures
+			finalHop.policy.Node.Features = testCase.destFeatures
 		}
 
 		assertRoute := func(t *testing.T, route *route.Route) {
@@ -1481,7 +1534,7 @@ func TestDestTLVGraphFallback(t *testing.T) {
 	}
 
 	find := func(r *RestrictParams,
-		target route.Vertex) ([]*channeldb.ChannelEdgePolicy, error) {
+		target route.Vertex) ([]*unifiedPolicyEdge, error) {
 
 		return dbFindPath(
 			ctx.graph, nil, nil,
@@ -2213,16 +2266,17 @@ func TestPathFindSpecExample(t *testing.T) {
 }
 
 func assertExpectedPath(t *testing.T, aliasMap map[string]route.Vertex,
-	path []*channeldb.ChannelEdgePolicy, nodeAliases ...string) {
+	path []*unifiedPolicyEdge, nodeAliases ...string) {
 
 	if len(path) != len(nodeAliases) {
 		t.Fatal("number of hops and number of aliases do not match")
 	}
 
 	for i, hop := range path {
-		if hop.Node.PubKeyBytes != aliasMap[nodeAliases[i]] {
+		node := hop.policy.Node
+		if node.PubKeyBytes != aliasMap[nodeAliases[i]] {
 			t.Fatalf("expected %v to be pos #%v in hop, instead "+
-				"%v was", nodeAliases[i], i, hop.Node.Alias)
+				"%v was", nodeAliases[i], i, node.Alias)
 		}
 	}
 }
@@ -2296,10 +2350,10 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 
 	// Assert that the route starts with channel chanSourceB1, in line with
 	// the specified restriction.
-	if path[0].ChannelID != chanSourceB1 {
+	if path[0].policy.ChannelID != chanSourceB1 {
 		t.Fatalf("expected route to pass through channel %v, "+
 			"but channel %v was selected instead", chanSourceB1,
-			path[0].ChannelID)
+			path[0].policy.ChannelID)
 	}
 
 	// If a direct channel to target is allowed as well, that channel is
@@ -2311,7 +2365,7 @@ func TestRestrictOutgoingChannel(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
-	if path[0].ChannelID != chanSourceTarget {
+	if path[0].policy.ChannelID != chanSourceTarget {
 		t.Fatalf("expected route to pass through channel %v",
 			chanSourceTarget)
 	}
@@ -2355,10 +2409,10 @@ func TestRestrictLastHop(t *testing.T) {
 	if err != nil {
 		t.Fatalf("unable to find path: %v", err)
 	}
-	if path[0].ChannelID != 3 {
+	if path[0].policy.ChannelID != 3 {
 		t.Fatalf("expected route to pass through channel 3, "+
 			"but channel %v was selected instead",
-			path[0].ChannelID)
+			path[0].policy.ChannelID)
 	}
 }
 
@@ -2594,10 +2648,10 @@ func testProbabilityRouting(t *testing.T, p10, p11, p20, minProbability float64,
 	}
 
 	// Assert that the route passes through the expected channel.
-	if path[1].ChannelID != expectedChan {
+	if path[1].policy.ChannelID != expectedChan {
 		t.Fatalf("expected route to pass through channel %v, "+
 			"but channel %v was selected instead", expectedChan,
-			path[1].ChannelID)
+			path[1].policy.ChannelID)
 	}
 }
 
@@ -2660,10 +2714,10 @@ func TestEqualCostRouteSelection(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if path[1].ChannelID != 2 {
+	if path[1].policy.ChannelID != 2 {
 		t.Fatalf("expected route to pass through channel %v, "+
 			"but channel %v was selected instead", 2,
-			path[1].ChannelID)
+			path[1].policy.ChannelID)
 	}
 }
 
@@ -2843,7 +2897,7 @@ func (c *pathFindingTestContext) cleanup() {
 }
 
 func (c *pathFindingTestContext) findPath(target route.Vertex,
-	amt lnwire.MilliAtom) ([]*channeldb.ChannelEdgePolicy,
+	amt lnwire.MilliAtom) ([]*unifiedPolicyEdge,
 	error) {
 
 	return dbFindPath(
@@ -2852,16 +2906,17 @@ func (c *pathFindingTestContext) findPath(target route.Vertex,
 	)
 }
 
-func (c *pathFindingTestContext) assertPath(path []*channeldb.ChannelEdgePolicy, expected []uint64) {
+func (c *pathFindingTestContext) assertPath(path []*unifiedPolicyEdge, expected []uint64) {
 	if len(path) != len(expected) {
 		c.t.Fatalf("expected path of length %v, but got %v",
 			len(expected), len(path))
 	}
 
 	for i, edge := range path {
-		if edge.ChannelID != expected[i] {
+		if edge.policy.ChannelID != expected[i] {
 			c.t.Fatalf("expected hop %v to be channel %v, "+
-				"but got %v", i, expected[i], edge.ChannelID)
+				"but got %v", i, expected[i],
+				edge.policy.ChannelID)
 		}
 	}
 }
@@ -2873,7 +2928,7 @@ func dbFindPath(graph *channeldb.ChannelGraph,
 	bandwidthHints map[uint64]lnwire.MilliAtom,
 	r *RestrictParams, cfg *PathFindingConfig,
 	source, target route.Vertex, amt lnwire.MilliAtom,
-	finalHtlcExpiry int32) ([]*channeldb.ChannelEdgePolicy, error) {
+	finalHtlcExpiry int32) ([]*unifiedPolicyEdge, error) {
 
 	routingTx, err := newDbRoutingTx(graph)
 	if err != nil {
@@ -0,0 +1,276 @@
+package routing
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// BimodalEstimatorName is the name of the bimodal probability
+	// estimator.
+	BimodalEstimatorName = "bimodal"
+
+	// DefaultBimodalScaleMAtoms is the default value for the scale of the
+	// liquidity distribution of the bimodal estimator.
+	DefaultBimodalScaleMAtoms = lnwire.MilliAtom(300000000)
+
+	// DefaultBimodalNodeWeight is the default value for the weight of the
+	// results of the other channels of a node in the bimodal estimator.
+	DefaultBimodalNodeWeight = 0.2
+
+	// DefaultBimodalDecayTime is the default value for the time after
+	// which the liquidity bounds learned by the bimodal estimator have
+	// relaxed halfway.
+	DefaultBimodalDecayTime = 7 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidScale is returned when we get a scale of zero.
+	ErrInvalidScale = errors.New("scale must be > 0")
+
+	// ErrInvalidNodeWeight is returned when we get a node weight that is
+	// out of range.
+	ErrInvalidNodeWeight = errors.New("node weight must be in [0, 1]")
+
+	// ErrInvalidDecayTime is returned when we get a decay time of zero.
+	ErrInvalidDecayTime = errors.New("decay time must be > 0")
+)
+
+// BimodalConfig contains configuration for the BimodalEstimator.
+type BimodalConfig struct {
+	// AprioriHopProbability is the assumed success probability of a hop in
+	// a route when no other information is available.
+	AprioriHopProbability float64
+
+	// ScaleMAtoms describes how far from the bounds of the possible range
+	// the liquidity of a channel is expected to be. Liquidity tends to
+	// accumulate at either end of a channel, so the smaller the scale, the
+	// more the estimate favors amounts close to a known success.
+	ScaleMAtoms lnwire.MilliAtom
+
+	// NodeWeight is a value in the range [0, 1] that defines to what
+	// extent the results of the other channels of a node are taken into
+	// account for its untried channels. Setting it to zero will only use
+	// the a priori probability for untried channels.
+	NodeWeight float64
+
+	// DecayTime is the time after which the liquidity bounds learned from
+	// a success or failure have relaxed halfway back to unknown.
+	DecayTime time.Duration
+}
+
+// validate checks the configuration of the estimator for allowed values.
+func (c BimodalConfig) validate() error {
+	if c.AprioriHopProbability < 0 || c.AprioriHopProbability > 1 {
+		return ErrInvalidHopProbability
+	}
+
+	if c.ScaleMAtoms == 0 {
+		return ErrInvalidScale
+	}
+
+	if c.NodeWeight < 0 || c.NodeWeight > 1 {
+		return ErrInvalidNodeWeight
+	}
+
+	if c.DecayTime <= 0 {
+		return ErrInvalidDecayTime
+	}
+
+	return nil
+}
+
+// BimodalEstimator returns node and pair probabilities based on the liquidity
+// bounds of channels that are learned from historical payment results. The
+// highest amount that succeeded is a lower bound of the liquidity and the
+// lowest amount that failed an upper bound. Within the bounds, the liquidity
+// is assumed to follow a bimodal distribution, because the liquidity of most
+// channels sits close to either of their ends. The learned bounds relax over
+// time, as the liquidity of the channel shifts.
+type BimodalEstimator struct {
+	// BimodalConfig contains configuration options for the estimator.
+	BimodalConfig
+}
+
+// NewBimodalEstimator creates a new BimodalEstimator.
+func NewBimodalEstimator(cfg BimodalConfig) (*BimodalEstimator, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	return &BimodalEstimator{
+		BimodalConfig: cfg,
+	}, nil
+}
+
+// A compile time assertion to ensure BimodalEstimator meets the Estimator
+// interface.
+var _ Estimator = (*BimodalEstimator)(nil)
+
+// String returns the name of the estimator.
+//
+// NOTE: This is part of the Estimator interface.
+func (p *BimodalEstimator) String() string {
+	return BimodalEstimatorName
+}
+
+// Config returns the configuration of the estimator.
+func (p *BimodalEstimator) Config() BimodalConfig {
+	return p.BimodalConfig
+}
+
+// PairProbability estimates the probability of successfully traversing to
+// toNode based on historical payment outcomes for the from node. Those outcomes
+// are passed in via the results parameter.
+//
+// NOTE: This is part of the Estimator interface.
+func (p *BimodalEstimator) PairProbability(now time.Time,
+	results NodeResults, toNode route.Vertex,
+	amt lnwire.MilliAtom) float64 {
+
+	nodeProbability := p.nodeProbability(now, results, toNode, amt)
+
+	result, ok := results[toNode]
+	if !ok {
+		return nodeProbability
+	}
+
+	return p.probabilityWithinBounds(now, &result, nodeProbability, amt)
+}
+
+// LocalPairProbability estimates the probability of successfully traversing
+// our own local channels to toNode.
+//
+// NOTE: This is part of the Estimator interface.
+func (p *BimodalEstimator) LocalPairProbability(now time.Time,
+	results NodeResults, toNode route.Vertex) float64 {
+
+	// We have accurate balance and online status information on our own
+	// channels, so we only need to account for a recent failure that
+	// the balance information didn't predict.
+	result, ok := results[toNode]
+	if !ok || result.FailTime.IsZero() {
+		return 1
+	}
+
+	return 1 - p.decayFactor(now.Sub(result.FailTime))
+}
+
+// decayFactor returns a factor in the range [0, 1] that expresses how much of
+// what was learned from a result of the given age still holds. It starts at 1
+// when the result is fresh and asymptotically approaches zero over time.
+func (p *BimodalEstimator) decayFactor(age time.Duration) float64 {
+	return math.Pow(2, -float64(age)/float64(p.DecayTime))
+}
+
+// nodeProbability calculates the probability for connections from a node that
+// have not been tried before, which is the a priori probability mixed with
+// the probabilities of the other tried connections of the node for the given
+// amount. More recent results weigh more.
+func (p *BimodalEstimator) nodeProbability(now time.Time,
+	results NodeResults, toNode route.Vertex,
+	amt lnwire.MilliAtom) float64 {
+
+	if p.NodeWeight == 0 {
+		return p.AprioriHopProbability
+	}
+
+	var probabilitiesTotal, totalWeight float64
+	for peer, result := range results {
+		if peer == toNode {
+			continue
+		}
+
+		lastResult := result.SuccessTime
+		if result.FailTime.After(lastResult) {
+			lastResult = result.FailTime
+		}
+		weight := p.decayFactor(now.Sub(lastResult))
+
+		result := result
+		probabilitiesTotal += weight * p.probabilityWithinBounds(
+			now, &result, p.AprioriHopProbability, amt,
+		)
+		totalWeight += weight
+	}
+
+	if totalWeight == 0 {
+		return p.AprioriHopProbability
+	}
+
+	return (1-p.NodeWeight)*p.AprioriHopProbability +
+		p.NodeWeight*probabilitiesTotal/totalWeight
+}
+
+// probabilityWithinBounds estimates the probability that the liquidity of a
+// channel covers the given amount, based on the bounds learned from its last
+// results. If the bounds don't tell anything about the amount, the fall-back
+// probability is returned.
+func (p *BimodalEstimator) probabilityWithinBounds(now time.Time,
+	result *TimedPairResult, fallback float64,
+	amt lnwire.MilliAtom) float64 {
+
+	// The success amount is a lower bound of the liquidity, that relaxes
+	// towards zero over time.
+	var lower float64
+	if !result.SuccessTime.IsZero() {
+		lower = float64(result.SuccessAmt) *
+			p.decayFactor(now.Sub(result.SuccessTime))
+	}
+
+	if float64(amt) <= lower {
+		return 1
+	}
+
+	// Without a failure, nothing is known about the liquidity beyond the
+	// lower bound.
+	if result.FailTime.IsZero() {
+		return fallback
+	}
+
+	failDecay := p.decayFactor(now.Sub(result.FailTime))
+
+	// A failure that is independent of the amount doesn't bound the
+	// liquidity. The connection is penalized until it recovers to the
+	// fall-back probability.
+	if result.FailAmt == 0 {
+		return fallback * (1 - failDecay)
+	}
+
+	// The failure amount is an upper bound of the liquidity, that relaxes
+	// towards infinity over time.
+	upper := float64(result.FailAmt) / failDecay
+	if float64(amt) >= upper {
+		return 0
+	}
+
+	return bimodalProbability(
+		lower, upper, float64(amt), float64(p.ScaleMAtoms),
+	)
+}
+
+// bimodalProbability returns the probability that the liquidity of a channel
+// is at least the given amount, if it is known to be between the lower and
+// the upper bound. The capacity of the channel isn't known to mission
+// control, so the upper bound stands in for it: the liquidity is assumed to
+// follow a distribution with a density proportional to
+// exp(-x/scale) + exp((x-upper)/scale), which peaks at either end of the
+// channel.
+func bimodalProbability(lower, upper, amt, scale float64) float64 {
+	// primitive returns the integral of the density up to x, up to a
+	// constant factor that cancels out.
+	primitive := func(x float64) float64 {
+		return -math.Exp(-x/scale) + math.Exp((x-upper)/scale)
+	}
+
+	total := primitive(upper) - primitive(lower)
+	if total <= 0 {
+		return 0
+	}
+
+	return (primitive(upper) - primitive(amt)) / total
+}
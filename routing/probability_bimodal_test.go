@@ -0,0 +1,248 @@
+package routing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+const (
+	// Define test bimodal estimator parameters.
+	bimodalScale      = lnwire.MilliAtom(100)
+	bimodalNodeWeight = 0.5
+	bimodalDecayTime  = time.Hour
+)
+
+type bimodalTestContext struct {
+	t         *testing.T
+	estimator *BimodalEstimator
+
+	// results contains the last results towards the nodes, keyed by the
+	// node id.
+	results map[int]TimedPairResult
+}
+
+func newBimodalTestContext(t *testing.T) *bimodalTestContext {
+	estimator, err := NewBimodalEstimator(BimodalConfig{
+		AprioriHopProbability: aprioriHopProb,
+		ScaleMAtoms:           bimodalScale,
+		NodeWeight:            bimodalNodeWeight,
+		DecayTime:             bimodalDecayTime,
+	})
+	if err != nil {
+		t.Fatalf("unable to create estimator: %v", err)
+	}
+
+	return &bimodalTestContext{
+		t:         t,
+		estimator: estimator,
+	}
+}
+
+// nodeResults returns the results of the context keyed by node.
+func (c *bimodalTestContext) nodeResults() NodeResults {
+	results := make(NodeResults)
+	for i, r := range c.results {
+		results[route.Vertex{byte(i)}] = r
+	}
+
+	return results
+}
+
+// assertPairProbability asserts that the calculated success probability is
+// correct.
+func (c *bimodalTestContext) assertPairProbability(toNode byte,
+	amt lnwire.MilliAtom, expectedProb float64) {
+
+	c.t.Helper()
+
+	const tolerance = 0.01
+
+	p := c.estimator.PairProbability(
+		testTime, c.nodeResults(), route.Vertex{toNode}, amt,
+	)
+	diff := p - expectedProb
+	if diff > tolerance || diff < -tolerance {
+		c.t.Fatalf("expected probability %v for node %v and amount "+
+			"%v, but got %v", expectedProb, toNode, amt, p)
+	}
+}
+
+// assertLocalPairProbability asserts that the calculated success probability
+// of a local channel is correct.
+func (c *bimodalTestContext) assertLocalPairProbability(toNode byte,
+	expectedProb float64) {
+
+	c.t.Helper()
+
+	p := c.estimator.LocalPairProbability(
+		testTime, c.nodeResults(), route.Vertex{toNode},
+	)
+	if p != expectedProb {
+		c.t.Fatalf("expected local probability %v for node %v, but "+
+			"got %v", expectedProb, toNode, p)
+	}
+}
+
+// TestBimodalEstimatorConfig tests that invalid configurations are rejected.
+func TestBimodalEstimatorConfig(t *testing.T) {
+	valid := BimodalConfig{
+		AprioriHopProbability: aprioriHopProb,
+		ScaleMAtoms:           bimodalScale,
+		NodeWeight:            bimodalNodeWeight,
+		DecayTime:             bimodalDecayTime,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*BimodalConfig)
+		err    error
+	}{
+		{
+			name:   "valid",
+			modify: func(*BimodalConfig) {},
+		},
+		{
+			name: "hop probability",
+			modify: func(c *BimodalConfig) {
+				c.AprioriHopProbability = 1.5
+			},
+			err: ErrInvalidHopProbability,
+		},
+		{
+			name: "scale",
+			modify: func(c *BimodalConfig) {
+				c.ScaleMAtoms = 0
+			},
+			err: ErrInvalidScale,
+		},
+		{
+			name: "node weight",
+			modify: func(c *BimodalConfig) {
+				c.NodeWeight = -0.1
+			},
+			err: ErrInvalidNodeWeight,
+		},
+		{
+			name: "decay time",
+			modify: func(c *BimodalConfig) {
+				c.DecayTime = 0
+			},
+			err: ErrInvalidDecayTime,
+		},
+	}
+
+	for _, test := range tests {
+		cfg := valid
+		test.modify(&cfg)
+
+		_, err := NewBimodalEstimator(cfg)
+		if err != test.err {
+			t.Fatalf("%v: expected error %v, got %v", test.name,
+				test.err, err)
+		}
+	}
+}
+
+// TestBimodalEstimatorSuccess tests that a success is a lower bound of the
+// liquidity that relaxes over time.
+func TestBimodalEstimatorSuccess(t *testing.T) {
+	ctx := newBimodalTestContext(t)
+
+	// Without any results, the a priori probability is returned.
+	ctx.assertPairProbability(untriedNode, 100, aprioriHopProb)
+
+	ctx.results = map[int]TimedPairResult{
+		node1: {
+			SuccessTime: testTime,
+			SuccessAmt:  1000,
+		},
+	}
+
+	// Amounts up to the success amount are expected to succeed, while
+	// nothing is known about larger amounts.
+	ctx.assertPairProbability(node1, 1000, 1)
+	ctx.assertPairProbability(node1, 2000, aprioriHopProb)
+
+	// Untried channels of the node are influenced by the success according
+	// to the node weight.
+	ctx.assertPairProbability(untriedNode, 500, 0.5*aprioriHopProb+0.5)
+
+	// After the decay time, the lower bound has relaxed halfway.
+	ctx.results[node1] = TimedPairResult{
+		SuccessTime: testTime.Add(-bimodalDecayTime),
+		SuccessAmt:  1000,
+	}
+	ctx.assertPairProbability(node1, 400, 1)
+	ctx.assertPairProbability(node1, 600, aprioriHopProb)
+}
+
+// TestBimodalEstimatorFailure tests that a failure is an upper bound of the
+// liquidity that relaxes over time, and that the probability within the
+// bounds follows the bimodal distribution.
+func TestBimodalEstimatorFailure(t *testing.T) {
+	ctx := newBimodalTestContext(t)
+
+	ctx.results = map[int]TimedPairResult{
+		node1: {
+			FailTime: testTime,
+			FailAmt:  1000,
+		},
+	}
+
+	// The failed amount is not expected to succeed again. The distribution
+	// is symmetric, so half the failed amount has an even chance, while
+	// the chance is higher for amounts close to the lower end.
+	ctx.assertPairProbability(node1, 1000, 0)
+	ctx.assertPairProbability(node1, 500, 0.5)
+	ctx.assertPairProbability(node1, 100, 0.68)
+
+	// After the decay time, the upper bound has relaxed to twice the
+	// failed amount.
+	ctx.results[node1] = TimedPairResult{
+		FailTime: testTime.Add(-bimodalDecayTime),
+		FailAmt:  1000,
+	}
+	ctx.assertPairProbability(node1, 1000, 0.5)
+
+	// With both bounds known, the liquidity is known to be above the lower
+	// bound, which makes larger amounts more likely to succeed.
+	ctx.results[node1] = TimedPairResult{
+		SuccessTime: testTime,
+		SuccessAmt:  200,
+		FailTime:    testTime,
+		FailAmt:     1000,
+	}
+	ctx.assertPairProbability(node1, 200, 1)
+	ctx.assertPairProbability(node1, 800, 0.76)
+
+	// A failure that is independent of the amount penalizes the channel
+	// until it recovers.
+	ctx.results[node1] = TimedPairResult{
+		FailTime: testTime.Add(-bimodalDecayTime),
+	}
+	ctx.assertPairProbability(node1, 100, aprioriHopProb/2)
+}
+
+// TestBimodalEstimatorLocal tests the probability estimation for local
+// channels.
+func TestBimodalEstimatorLocal(t *testing.T) {
+	ctx := newBimodalTestContext(t)
+
+	ctx.results = map[int]TimedPairResult{
+		node1: {
+			SuccessTime: testTime,
+			SuccessAmt:  1000,
+		},
+		node2: {
+			FailTime: testTime.Add(-bimodalDecayTime),
+			FailAmt:  1000,
+		},
+	}
+
+	ctx.assertLocalPairProbability(untriedNode, 1)
+	ctx.assertLocalPairProbability(node1, 1)
+	ctx.assertLocalPairProbability(node2, 0.5)
+}
@@ -0,0 +1,252 @@
+package routing
+
+import (
+	"crypto/rand"
+	"errors"
+	"math"
+
+	"github.com/decred/dcrlnd/htlcswitch"
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/routing/route"
+)
+
+// ProbeRequest describes a payment whose feasibility should be probed.
+type ProbeRequest struct {
+	// Target is the node that the probed payment is destined for.
+	Target route.Vertex
+
+	// Amount is the amount of the probed payment.
+	Amount lnwire.MilliAtom
+
+	// FeeLimit is the maximum fee that the routes of the probed payment
+	// may charge.
+	FeeLimit lnwire.MilliAtom
+
+	// CltvLimit is the maximum time lock of the probed routes, including
+	// the final CLTV delta. If it is zero, the time lock isn't limited.
+	CltvLimit uint32
+
+	// FinalCLTVDelta is the CLTV delta of the final hop of the probed
+	// routes.
+	FinalCLTVDelta uint16
+
+	// MaxRoutes is the maximum number of candidate routes that are probed
+	// before giving up.
+	MaxRoutes int
+}
+
+// ProbeHopResult describes what a probe learned about the liquidity of the
+// channel that leads to a hop of the probed route.
+type ProbeHopResult struct {
+	// Pair is the node pair of the channel.
+	Pair DirectedNodePair
+
+	// ChannelID is the id of the channel.
+	ChannelID uint64
+
+	// Amount is the amount that the probe tried to send over the channel.
+	Amount lnwire.MilliAtom
+
+	// MinLiquidity is a lower bound of the liquidity of the channel. It is
+	// zero if the probe didn't make it over the channel.
+	MinLiquidity lnwire.MilliAtom
+
+	// MaxLiquidity is an upper bound of the liquidity of the channel. It
+	// is zero if the probe didn't fail for a lack of liquidity on the
+	// channel.
+	MaxLiquidity lnwire.MilliAtom
+}
+
+// ProbeRouteResult describes the outcome of probing a single route.
+type ProbeRouteResult struct {
+	// Route is the probed route.
+	Route *route.Route
+
+	// Success is true if the probe reached the target, which means that
+	// a payment along the route would likely have succeeded.
+	Success bool
+
+	// FailureSourceIdx is the index of the node in the route that failed
+	// the probe, where zero is the local node. It is -1 if the source of
+	// the failure is unknown.
+	FailureSourceIdx int
+
+	// Failure is the failure message the probe failed with. It is nil if
+	// the failure message couldn't be decoded.
+	Failure lnwire.FailureMessage
+
+	// Hops describes what the probe learned about the liquidity of the
+	// channels of the route.
+	Hops []*ProbeHopResult
+}
+
+// ProbePayment probes the feasibility of a payment, without ever settling
+// it. HTLCs with a random payment hash are sent along up to MaxRoutes
+// candidate routes to the target, until one of them reaches the target. As the
+// target doesn't know the payment hash, it fails the HTLC, which tells us that
+// the payment would have gone through. Like for regular payments, the outcomes
+// are fed into mission control, so that the routes that failed aren't tried
+// again and subsequent payments benefit from the probes. Failed probes are
+// removed from the payments database once they are resolved, so that they don't
+// show up as failed payments.
+func (r *ChannelRouter) ProbePayment(req *ProbeRequest) ([]*ProbeRouteResult,
+	error) {
+
+	if req.MaxRoutes <= 0 {
+		return nil, errors.New("at least one route must be probed")
+	}
+
+	// Path finding is unaware of the final CLTV delta, so it needs to be
+	// subtracted from the limit.
+	cltvLimit := uint32(math.MaxUint32)
+	if req.CltvLimit != 0 {
+		if req.CltvLimit <= uint32(req.FinalCLTVDelta) {
+			return nil, errors.New("cltv limit must exceed the " +
+				"final cltv delta")
+		}
+
+		cltvLimit = req.CltvLimit - uint32(req.FinalCLTVDelta)
+	}
+
+	restrictions := &RestrictParams{
+		ProbabilitySource: r.cfg.MissionControl.GetProbability,
+		FeeLimit:          req.FeeLimit,
+		CltvLimit:         cltvLimit,
+	}
+
+	var results []*ProbeRouteResult
+	for len(results) < req.MaxRoutes {
+		rt, err := r.FindRoute(
+			r.selfNode.PubKeyBytes, req.Target, req.Amount,
+			restrictions, nil, nil, req.FinalCLTVDelta,
+		)
+
+		// Once all candidate routes have failed, the results collected
+		// so far are all we can learn.
+		if err == errNoPathFound && len(results) > 0 {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := r.probeRoute(rt)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, result)
+		if result.Success {
+			break
+		}
+	}
+
+	return results, nil
+}
+
+// probeRoute sends an HTLC with a random payment hash along the given route
+// and interprets the outcome.
+func (r *ChannelRouter) probeRoute(rt *route.Route) (*ProbeRouteResult,
+	error) {
+
+	var hash lntypes.Hash
+	if _, err := rand.Read(hash[:]); err != nil {
+		return nil, err
+	}
+
+	log.Debugf("Probing route with hash %v: %v", hash, rt)
+
+	result := &ProbeRouteResult{
+		Route:            rt,
+		FailureSourceIdx: -1,
+	}
+
+	// A route that is settled is a success as well, although that would
+	// mean that the target knows the preimage of our random hash. As we
+	// actually paid in that case, the payment is kept.
+	_, sendErr := r.SendToRoute(hash, rt)
+	if sendErr == nil {
+		result.Success = true
+		result.Hops = probeHopResults(rt, len(rt.Hops), nil)
+
+		return result, nil
+	}
+
+	// The outcome of the probe has been reported to mission control, so
+	// the failed payment that SendToRoute recorded isn't needed anymore.
+	if err := r.cfg.Control.DeletePayment(hash); err != nil {
+		log.Errorf("Unable to delete probe payment %v: %v", hash, err)
+	}
+
+	switch err := sendErr.(type) {
+	case *htlcswitch.ForwardingError:
+		result.FailureSourceIdx = err.FailureSourceIdx
+		result.Failure = err.WireMessage()
+
+	// Any other readable failure originates from our own node.
+	case htlcswitch.ClearTextError:
+		result.FailureSourceIdx = 0
+		result.Failure = err.WireMessage()
+
+	// The source of an unreadable failure is unknown.
+	default:
+		if sendErr != htlcswitch.ErrUnreadableFailureMessage {
+			return nil, sendErr
+		}
+	}
+
+	// The target failing the probe because it doesn't know the payment
+	// hash is what we are after.
+	finalIdx := len(rt.Hops)
+	if result.FailureSourceIdx == finalIdx {
+		switch result.Failure.(type) {
+		case *lnwire.FailIncorrectDetails,
+			*lnwire.FailIncorrectPaymentAmount:
+
+			result.Success = true
+		}
+	}
+
+	result.Hops = probeHopResults(
+		rt, result.FailureSourceIdx, result.Failure,
+	)
+
+	log.Debugf("Probe with hash %v finished: success=%v, "+
+		"failure_source_idx=%v, failure=%v", hash, result.Success,
+		result.FailureSourceIdx, result.Failure)
+
+	return result, nil
+}
+
+// probeHopResults derives the liquidity bounds of the channels of a probed
+// route from the index of the node that failed the probe. All channels up to
+// that node carried the probe, while its outgoing channel is known to lack the
+// liquidity if it failed with a temporary channel failure.
+func probeHopResults(rt *route.Route, failureSourceIdx int,
+	failure lnwire.FailureMessage) []*ProbeHopResult {
+
+	hops := make([]*ProbeHopResult, len(rt.Hops))
+	for i, hop := range rt.Hops {
+		pair, amt := getPair(rt, i)
+
+		hops[i] = &ProbeHopResult{
+			Pair:      pair,
+			ChannelID: hop.ChannelID,
+			Amount:    amt,
+		}
+
+		switch {
+		case i < failureSourceIdx:
+			hops[i].MinLiquidity = amt
+
+		case i == failureSourceIdx:
+			_, ok := failure.(*lnwire.FailTemporaryChannelFailure)
+			if ok {
+				hops[i].MaxLiquidity = amt
+			}
+		}
+	}
+
+	return hops
+}
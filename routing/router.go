@@ -15,6 +15,8 @@ import (
 	sphinx "github.com/decred/lightning-onion/v3"
 	"github.com/go-errors/errors"
 
+	"github.com/decred/dcrlnd/aliasmgr"
+	"github.com/decred/dcrlnd/amp"
 	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/channeldb/kvdb"
 	"github.com/decred/dcrlnd/clock"
@@ -28,6 +30,7 @@ import (
 	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/routing/chainview"
 	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/routing/shards"
 	"github.com/decred/dcrlnd/ticker"
 	"github.com/decred/dcrlnd/zpay32"
 )
@@ -224,6 +227,12 @@ type FeeSchema struct {
 	// the effective fee rate charged per milli-atoms will be: (amount *
 	// FeeRate/1,000,000).
 	FeeRate uint32
+
+	// InboundFee is the fee that will be charged for payments that arrive
+	// over the channel, on top of the fee of the channel they are
+	// forwarded over. It may be negative to grant a discount on the
+	// outgoing fee. A zero inbound fee isn't announced.
+	InboundFee lnwire.InboundFee
 }
 
 // ChannelPolicy holds the parameters that determine the policy we enforce
@@ -549,6 +558,23 @@ func (r *ChannelRouter) Start() error {
 			// result for the in-flight attempt is received.
 			paySession := r.cfg.SessionSource.NewPaymentSessionEmpty()
 
+			// We create a new shard tracker that we'll use to
+			// resume the in-flight attempts, mapping each attempt
+			// to the hash it was sent with. Legacy attempts that
+			// didn't store a hash used the payment hash.
+			hashes := make(map[uint64]lntypes.Hash)
+			for _, a := range payment.Attempts {
+				hash := payment.Info.PaymentHash
+				if a.Hash != nil {
+					hash = *a.Hash
+				}
+
+				hashes[a.AttemptID] = hash
+			}
+			shardTracker := shards.NewSimpleShardTracker(
+				payment.Info.PaymentHash, hashes,
+			)
+
 			// We pass in a zero timeout value, to indicate we
 			// don't need it to timeout. It will stop immediately
 			// after the existing attempt has finished anyway. We
@@ -557,6 +583,7 @@ func (r *ChannelRouter) Start() error {
 			_, _, err := r.sendPayment(
 				payment.Info.Value, 0,
 				payment.Info.PaymentHash, 0, paySession,
+				shardTracker,
 			)
 			if err != nil {
 				log.Errorf("Resuming payment with hash %v "+
@@ -1200,10 +1227,19 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 			break
 		}
 
+		// Edges of zero-conf channels that use an alias can't be
+		// validated against the chain, as their funding transaction
+		// may not even be confirmed yet. These edges are only ever
+		// added by the funding manager for our own channels, so we
+		// trust the funding outpoint and capacity it provides.
+		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
+		if aliasmgr.IsAlias(channelID) {
+			return r.addAliasEdge(msg)
+		}
+
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
-		channelID := lnwire.NewShortChanIDFromInt(msg.ChannelID)
 		fundingTx, err := r.fetchFundingTx(&channelID)
 		if err != nil {
 			return errors.Errorf("unable to fetch funding tx for "+
@@ -1376,6 +1412,47 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 	return nil
 }
 
+// addAliasEdge adds the edge of a zero-conf channel that is identified by an
+// alias to the graph and updates the chain view so we're notified when the
+// channel is closed.
+func (r *ChannelRouter) addAliasEdge(edge *channeldb.ChannelEdgeInfo) error {
+	witnessScript, err := input.GenMultiSigScript(
+		edge.DecredKey1Bytes[:], edge.DecredKey2Bytes[:],
+	)
+	if err != nil {
+		return err
+	}
+	fundingPkScript, err := input.ScriptHashPkScript(witnessScript)
+	if err != nil {
+		return err
+	}
+
+	if err := r.cfg.Graph.AddChannelEdge(edge); err != nil {
+		return errors.Errorf("unable to add edge: %v", err)
+	}
+
+	log.Tracef("New alias channel added! Link connects %x and %x with "+
+		"ChannelPoint(%v): chan_id=%v, capacity=%v",
+		edge.NodeKey1Bytes, edge.NodeKey2Bytes, edge.ChannelPoint,
+		edge.ChannelID, edge.Capacity)
+	r.stats.incNumEdgesDiscovered()
+
+	filterUpdate := []channeldb.EdgePoint{
+		{
+			FundingPkScript: fundingPkScript,
+			OutPoint:        edge.ChannelPoint,
+		},
+	}
+	err = r.cfg.ChainView.UpdateFilter(
+		filterUpdate, int64(atomic.LoadUint32(&r.bestHeight)),
+	)
+	if err != nil {
+		return errors.Errorf("unable to update chain view: %v", err)
+	}
+
+	return nil
+}
+
 // fetchFundingTx returns the funding transaction identified by the passed
 // short channel ID.
 //
@@ -1647,6 +1724,45 @@ type LightningPayment struct {
 	// MaxParts is the maximum number of partial payments that may be used
 	// to complete the full amount.
 	MaxParts uint32
+
+	// amp is an optional field that is set if and only if this is am AMP
+	// payment.
+	amp *AMPOptions
+}
+
+// AMPOptions houses information that must be known in order to send an AMP
+// payment.
+type AMPOptions struct {
+	// SetID is the identifier shared by all HTLCs of the AMP payment. It
+	// is used as the payment's identifier in the control tower.
+	SetID [32]byte
+
+	// RootShare is the secret from which the shares carried by each HTLC
+	// and all the child preimages are derived.
+	RootShare [32]byte
+}
+
+// SetAMP sets the given AMP options for the payment. AMP payments don't use a
+// static payment hash, so this will fail if a payment hash was already set.
+func (l *LightningPayment) SetAMP(amp *AMPOptions) error {
+	if l.PaymentHash != [32]byte{} {
+		return fmt.Errorf("cannot set AMP options for payment " +
+			"with payment hash")
+	}
+
+	l.amp = amp
+	return nil
+}
+
+// Identifier returns a 32-byte slice that uniquely identifies this single
+// payment. For non-AMP payments this will be the payment hash, for AMP
+// payments this will be the used SetID.
+func (l *LightningPayment) Identifier() [32]byte {
+	if l.amp != nil {
+		return l.amp.SetID
+	}
+
+	return l.PaymentHash
 }
 
 // SendPayment attempts to send a payment as described within the passed
@@ -1659,7 +1775,7 @@ type LightningPayment struct {
 func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	*route.Route, error) {
 
-	paySession, err := r.preparePayment(payment)
+	paySession, shardTracker, err := r.preparePayment(payment)
 	if err != nil {
 		return [32]byte{}, nil, err
 	}
@@ -1670,15 +1786,15 @@ func (r *ChannelRouter) SendPayment(payment *LightningPayment) ([32]byte,
 	// Since this is the first time this payment is being made, we pass nil
 	// for the existing attempt.
 	return r.sendPayment(
-		payment.Amount, payment.FeeLimit, payment.PaymentHash,
-		payment.PayAttemptTimeout, paySession,
+		payment.Amount, payment.FeeLimit, payment.Identifier(),
+		payment.PayAttemptTimeout, paySession, shardTracker,
 	)
 }
 
 // SendPaymentAsync is the non-blocking version of SendPayment. The payment
 // result needs to be retrieved via the control tower.
 func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
-	paySession, err := r.preparePayment(payment)
+	paySession, shardTracker, err := r.preparePayment(payment)
 	if err != nil {
 		return err
 	}
@@ -1693,12 +1809,12 @@ func (r *ChannelRouter) SendPaymentAsync(payment *LightningPayment) error {
 			spewPayment(payment))
 
 		_, _, err := r.sendPayment(
-			payment.Amount, payment.FeeLimit, payment.PaymentHash,
-			payment.PayAttemptTimeout, paySession,
+			payment.Amount, payment.FeeLimit, payment.Identifier(),
+			payment.PayAttemptTimeout, paySession, shardTracker,
 		)
 		if err != nil {
-			log.Errorf("Payment with hash %x failed: %v",
-				payment.PaymentHash, err)
+			log.Errorf("Payment %x failed: %v",
+				payment.Identifier(), err)
 		}
 	}()
 
@@ -1729,33 +1845,61 @@ func spewPayment(payment *LightningPayment) logClosure {
 // preparePayment creates the payment session and registers the payment with the
 // control tower.
 func (r *ChannelRouter) preparePayment(payment *LightningPayment) (
-	PaymentSession, error) {
+	PaymentSession, shards.ShardTracker, error) {
+
+	// AMP payments are identified by the receiver through the payment
+	// address, so one must be provided.
+	if payment.amp != nil && payment.PaymentAddr == nil {
+		return nil, nil, fmt.Errorf("AMP payments require a " +
+			"payment address")
+	}
 
 	// Before starting the HTLC routing attempt, we'll create a fresh
 	// payment session which will report our errors back to mission
 	// control.
 	paySession, err := r.cfg.SessionSource.NewPaymentSession(payment)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	// Record this payment hash with the ControlTower, ensuring it is not
-	// already in-flight.
+	// already in-flight. For AMP payments, the set id is used as the
+	// payment's identifier.
 	//
 	// TODO(roasbeef): store records as part of creation info?
 	info := &channeldb.PaymentCreationInfo{
-		PaymentHash:    payment.PaymentHash,
+		PaymentHash:    payment.Identifier(),
 		Value:          payment.Amount,
 		CreationTime:   r.cfg.Clock.Now(),
 		PaymentRequest: payment.PaymentRequest,
 	}
 
-	err = r.cfg.Control.InitPayment(payment.PaymentHash, info)
+	// Create a new ShardTracker that we'll use during the life cycle of
+	// this payment.
+	var shardTracker shards.ShardTracker
+	switch {
+
+	// If this is an AMP payment, we'll use the AMP shard tracker.
+	case payment.amp != nil:
+		shardTracker = amp.NewShardTracker(
+			payment.amp.RootShare, payment.amp.SetID,
+			*payment.PaymentAddr, payment.Amount,
+		)
+
+	// Otherwise we'll use the simple tracker that will map each attempt
+	// to the same payment hash.
+	default:
+		shardTracker = shards.NewSimpleShardTracker(
+			payment.Identifier(), nil,
+		)
+	}
+
+	err = r.cfg.Control.InitPayment(payment.Identifier(), info)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return paySession, nil
+	return paySession, shardTracker, nil
 }
 
 // SendToRoute attempts to send a payment with the given hash through the
@@ -1805,12 +1949,13 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, rt *route.Route) (
 
 	// Launch a shard along the given route.
 	sh := &shardHandler{
-		router:      r,
-		paymentHash: hash,
+		router:       r,
+		identifier:   hash,
+		shardTracker: shards.NewSimpleShardTracker(hash, nil),
 	}
 
 	var shardError error
-	attempt, outcome, err := sh.launchShard(rt)
+	attempt, outcome, err := sh.launchShard(rt, false)
 
 	// With SendToRoute, it can happen that the route exceeds protocol
 	// constraints. Mark the payment as failed with an internal error.
@@ -1894,9 +2039,9 @@ func (r *ChannelRouter) SendToRoute(hash lntypes.Hash, rt *route.Route) (
 // router will call this method for every payment still in-flight according to
 // the ControlTower.
 func (r *ChannelRouter) sendPayment(
-	totalAmt, feeLimit lnwire.MilliAtom, paymentHash lntypes.Hash,
-	timeout time.Duration,
-	paySession PaymentSession) ([32]byte, *route.Route, error) {
+	totalAmt, feeLimit lnwire.MilliAtom, identifier lntypes.Hash,
+	timeout time.Duration, paySession PaymentSession,
+	shardTracker shards.ShardTracker) ([32]byte, *route.Route, error) {
 
 	// We'll also fetch the current block height so we can properly
 	// calculate the required HTLC time locks within the route.
@@ -1911,8 +2056,9 @@ func (r *ChannelRouter) sendPayment(
 		router:        r,
 		totalAmount:   totalAmt,
 		feeLimit:      feeLimit,
-		paymentHash:   paymentHash,
+		identifier:    identifier,
 		paySession:    paySession,
+		shardTracker:  shardTracker,
 		currentHeight: currentHeight,
 	}
 
@@ -2460,7 +2606,10 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 	}()
 
 	// Traverse hops backwards to accumulate fees in the running amounts.
+	// The outbound fee of the previous hop bounds the discount that its
+	// inbound fee can grant.
 	source := r.selfNode.PubKeyBytes
+	var outboundFee lnwire.MilliAtom
 	for i := len(hops) - 1; i >= 0; i-- {
 		toNode := hops[i]
 
@@ -2501,20 +2650,35 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 
 		// Get a forwarding policy for the specific amount that we want
 		// to forward.
-		policy := unifiedPolicy.getPolicy(runningAmt, bandwidthHints)
-		if policy == nil {
+		edge := unifiedPolicy.getPolicy(runningAmt, bandwidthHints)
+		if edge == nil {
 			return nil, ErrNoChannel{
 				fromNode: fromNode,
 				position: i,
 			}
 		}
 
+		// Add the inbound fee of the to node, unless it is the final
+		// hop.
+		if i != len(hops)-1 {
+			inboundFee := edge.inboundFee.CalcFee(runningAmt)
+			if inboundFee < -int64(outboundFee) {
+				inboundFee = -int64(outboundFee)
+			}
+			runningAmt = lnwire.MilliAtom(
+				int64(runningAmt) + inboundFee,
+			)
+		}
+
 		// Add fee for this hop.
+		outboundFee = 0
 		if !localChan {
-			runningAmt += policy.ComputeFee(runningAmt)
+			outboundFee = edge.policy.ComputeFee(runningAmt)
+			runningAmt += outboundFee
 		}
 
-		log.Tracef("Select channel %v at position %v", policy.ChannelID, i)
+		log.Tracef("Select channel %v at position %v",
+			edge.policy.ChannelID, i)
 
 		edges[i] = unifiedPolicy
 	}
@@ -2523,11 +2687,11 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 	// total amount, we make a forward pass. Because the amount may have
 	// been increased in the backward pass, fees need to be recalculated and
 	// amount ranges re-checked.
-	var pathEdges []*channeldb.ChannelEdgePolicy
+	var pathEdges []*unifiedPolicyEdge
 	receiverAmt := runningAmt
 	for i, edge := range edges {
-		policy := edge.getPolicy(receiverAmt, bandwidthHints)
-		if policy == nil {
+		pathEdge := edge.getPolicy(receiverAmt, bandwidthHints)
+		if pathEdge == nil {
 			return nil, ErrNoChannel{
 				fromNode: hops[i-1],
 				position: i,
@@ -2535,13 +2699,25 @@ func (r *ChannelRouter) BuildRoute(amt *lnwire.MilliAtom,
 		}
 
 		if i > 0 {
-			// Decrease the amount to send while going forward.
-			receiverAmt -= policy.ComputeFeeFromIncoming(
+			// Decrease the amount to send while going forward. The
+			// inbound fee of the previous hop is approximated based
+			// on the amount that remains after its outbound fee.
+			outboundFee := pathEdge.policy.ComputeFeeFromIncoming(
 				receiverAmt,
 			)
+			inboundFee := pathEdges[i-1].inboundFee.CalcFee(
+				receiverAmt - outboundFee,
+			)
+			if inboundFee < -int64(outboundFee) {
+				inboundFee = -int64(outboundFee)
+			}
+			receiverAmt = lnwire.MilliAtom(
+				int64(receiverAmt) - int64(outboundFee) -
+					inboundFee,
+			)
 		}
 
-		pathEdges = append(pathEdges, policy)
+		pathEdges = append(pathEdges, pathEdge)
 	}
 
 	// Build and return the final route.
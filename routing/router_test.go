@@ -2195,8 +2195,8 @@ func TestFindPathFeeWeighting(t *testing.T) {
 	if len(path) != 1 {
 		t.Fatalf("expected path length of 1, instead was: %v", len(path))
 	}
-	if path[0].Node.Alias != "luoji" {
-		t.Fatalf("wrong node: %v", path[0].Node.Alias)
+	if path[0].policy.Node.Alias != "luoji" {
+		t.Fatalf("wrong node: %v", path[0].policy.Node.Alias)
 	}
 }
 
@@ -3060,3 +3060,143 @@ func TestBuildRoute(t *testing.T) {
 		t.Fatalf("unexpected no channel error node")
 	}
 }
+
+// TestProbePayment tests that probing a payment tries candidate routes until
+// one of them reaches the target, and reports the liquidity bounds that the
+// probes learned.
+func TestProbePayment(t *testing.T) {
+	t.Parallel()
+
+	// Setup a network. It contains two paths to c: a->b->c and a more
+	// expensive alternative a->d->c.
+	chanCapSat := dcrutil.Amount(100000)
+	testChannels := []*testChannel{
+		symmetricTestChannel("a", "b", chanCapSat, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+			MaxHTLC: lnwire.NewMAtomsFromAtoms(chanCapSat),
+		}, 1),
+		symmetricTestChannel("b", "c", chanCapSat, &testChannelPolicy{
+			Expiry:  144,
+			FeeRate: 400,
+			MinHTLC: 1,
+			MaxHTLC: lnwire.NewMAtomsFromAtoms(chanCapSat),
+		}, 3),
+		symmetricTestChannel("a", "d", chanCapSat, &testChannelPolicy{
+			Expiry:        144,
+			FeeRate:       400,
+			FeeBaseMAtoms: 100000,
+			MinHTLC:       1,
+			MaxHTLC:       lnwire.NewMAtomsFromAtoms(chanCapSat),
+		}, 2),
+		symmetricTestChannel("d", "c", chanCapSat, &testChannelPolicy{
+			Expiry:        144,
+			FeeRate:       400,
+			FeeBaseMAtoms: 100000,
+			MinHTLC:       1,
+			MaxHTLC:       lnwire.NewMAtomsFromAtoms(chanCapSat),
+		}, 4),
+	}
+
+	testGraph, err := createTestGraphFromChannels(testChannels, "a")
+	defer testGraph.cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	const startingBlockHeight = 101
+
+	ctx, cleanUp, err := createTestCtxFromGraphInstance(startingBlockHeight,
+		testGraph)
+
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	// Channel b->c lacks the liquidity for the probe, while the probe
+	// reaches c via d.
+	ctx.router.cfg.Payer.(*mockPaymentAttemptDispatcher).setPaymentResult(
+		func(firstHop lnwire.ShortChannelID) ([32]byte, error) {
+			var failure *htlcswitch.ForwardingError
+			if firstHop.ToUint64() == 1 {
+				failure = htlcswitch.NewForwardingError(
+					&lnwire.FailTemporaryChannelFailure{},
+					1,
+				)
+			} else {
+				failure = htlcswitch.NewForwardingError(
+					lnwire.NewFailIncorrectDetails(0, 0), 2,
+				)
+			}
+
+			return [32]byte{}, failure
+		})
+
+	amt := lnwire.NewMAtomsFromAtoms(1000)
+	results, err := ctx.router.ProbePayment(&ProbeRequest{
+		Target:         ctx.aliases["c"],
+		Amount:         amt,
+		FeeLimit:       noFeeLimit,
+		FinalCLTVDelta: 40,
+		MaxRoutes:      3,
+	})
+	if err != nil {
+		t.Fatalf("unable to probe payment: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("expected 2 probed routes, got %v", len(results))
+	}
+
+	// The first probe is expected to have failed at b, which tells us
+	// that channel a->b carried the probe while b->c couldn't.
+	failed := results[0]
+	if failed.Success || failed.FailureSourceIdx != 1 {
+		t.Fatalf("expected first probe to fail at b")
+	}
+	if failed.Hops[0].MinLiquidity != failed.Route.TotalAmount {
+		t.Fatalf("expected lower bound %v for channel a->b, got %v",
+			failed.Route.TotalAmount, failed.Hops[0].MinLiquidity)
+	}
+	if failed.Hops[1].MinLiquidity != 0 ||
+		failed.Hops[1].MaxLiquidity != amt {
+
+		t.Fatalf("expected upper bound %v for channel b->c, got %v",
+			amt, failed.Hops[1].MaxLiquidity)
+	}
+
+	// The second probe is expected to have reached c via d.
+	succeeded := results[1]
+	if !succeeded.Success {
+		t.Fatalf("expected second probe to succeed")
+	}
+	if succeeded.Route.Hops[0].PubKeyBytes != ctx.aliases["d"] {
+		t.Fatalf("expected second probe to go through d")
+	}
+	for _, hop := range succeeded.Hops {
+		if hop.MinLiquidity != hop.Amount {
+			t.Fatalf("expected lower bound %v for channel %v, "+
+				"got %v", hop.Amount, hop.ChannelID,
+				hop.MinLiquidity)
+		}
+	}
+
+	// Mission control is expected to have learned from the probes.
+	p := ctx.router.cfg.MissionControl.GetProbability(
+		ctx.aliases["b"], ctx.aliases["c"], amt,
+	)
+	if p > 0.01 {
+		t.Fatalf("expected channel b->c to be penalized, got %v", p)
+	}
+
+	// The probes are expected not to be kept as failed payments.
+	control := ctx.router.cfg.Control.(*mockControlTower)
+	control.Lock()
+	numPayments := len(control.payments)
+	control.Unlock()
+	if numPayments != 0 {
+		t.Fatalf("expected probes to be deleted, got %v payments",
+			numPayments)
+	}
+}
@@ -0,0 +1,63 @@
+package shards_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/decred/dcrlnd/lntypes"
+	"github.com/decred/dcrlnd/routing/shards"
+)
+
+// TestSimpleShardTracker tests that the simple tracker that keeps a map from
+// attemptID-> payment hash works.
+func TestSimpleShardTracker(t *testing.T) {
+	var testHashes [2]lntypes.Hash
+	for i := range testHashes {
+		if _, err := rand.Read(testHashes[i][:]); err != nil {
+			t.Fatalf("unable to read random hash: %v", err)
+		}
+	}
+
+	startAttempts := map[uint64]lntypes.Hash{
+		1: testHashes[1],
+	}
+
+	tracker := shards.NewSimpleShardTracker(testHashes[0], startAttempts)
+
+	// Trying to retrieve a hash for id 0 should result in an error.
+	if _, err := tracker.GetHash(0); err == nil {
+		t.Fatalf("expected error for unknown attempt id")
+	}
+
+	// Getting id 1 should work.
+	hash1, err := tracker.GetHash(1)
+	if err != nil {
+		t.Fatalf("unable to get hash: %v", err)
+	}
+	if hash1 != testHashes[1] {
+		t.Fatalf("expected hash %v, got %v", testHashes[1], hash1)
+	}
+
+	// Finally, create a new shard and immediately retrieve the hash.
+	shard, err := tracker.NewShard(2, false)
+	if err != nil {
+		t.Fatalf("unable to create shard: %v", err)
+	}
+
+	// It's hash should be the tracker's overall payment hash.
+	hash2, err := tracker.GetHash(2)
+	if err != nil {
+		t.Fatalf("unable to get hash: %v", err)
+	}
+	if hash2 != testHashes[0] || shard.Hash() != testHashes[0] {
+		t.Fatalf("expected hash %v, got %v", testHashes[0], hash2)
+	}
+
+	// Canceling the shard should make it unknown to the tracker.
+	if err := tracker.CancelShard(2); err != nil {
+		t.Fatalf("unable to cancel shard: %v", err)
+	}
+	if _, err := tracker.GetHash(2); err == nil {
+		t.Fatalf("expected error for canceled attempt id")
+	}
+}
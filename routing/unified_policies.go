@@ -37,10 +37,12 @@ func newUnifiedPolicies(sourceNode, toNode route.Vertex,
 	}
 }
 
-// addPolicy adds a single channel policy. Capacity may be zero if unknown
-// (light clients).
+// addPolicy adds a single channel policy, along with the inbound fee that the
+// to node charges for HTLCs arriving over the channel. Capacity may be zero if
+// unknown (light clients).
 func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
-	edge *channeldb.ChannelEdgePolicy, capacity dcrutil.Amount) {
+	edge *channeldb.ChannelEdgePolicy, inboundFee lnwire.InboundFee,
+	capacity dcrutil.Amount) {
 
 	localChan := fromNode == u.sourceNode
 
@@ -61,15 +63,16 @@ func (u *unifiedPolicies) addPolicy(fromNode route.Vertex,
 	}
 
 	policy.edges = append(policy.edges, &unifiedPolicyEdge{
-		policy:   edge,
-		capacity: capacity,
+		policy:     edge,
+		capacity:   capacity,
+		inboundFee: inboundFee,
 	})
 }
 
 // addGraphPolicies adds all policies that are known for the toNode in the
 // graph.
 func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
-	cb := func(edgeInfo *channeldb.ChannelEdgeInfo, _,
+	cb := func(edgeInfo *channeldb.ChannelEdgeInfo, outEdge,
 		inEdge *channeldb.ChannelEdgePolicy) error {
 
 		// If there is no edge policy for this candidate node, skip.
@@ -85,8 +88,19 @@ func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
 			return err
 		}
 
+		// The inbound fee of the to node is announced in its own
+		// policy of the channel. Inbound fees that can't be parsed are
+		// ignored.
+		var inboundFee lnwire.InboundFee
+		if outEdge != nil {
+			fee, err := outEdge.InboundFee()
+			if err == nil && fee != nil {
+				inboundFee = *fee
+			}
+		}
+
 		// Add this policy to the unified policies map.
-		u.addPolicy(fromNode, inEdge, edgeInfo.Capacity)
+		u.addPolicy(fromNode, inEdge, inboundFee, edgeInfo.Capacity)
 
 		return nil
 	}
@@ -100,6 +114,10 @@ func (u *unifiedPolicies) addGraphPolicies(g routingGraph) error {
 type unifiedPolicyEdge struct {
 	policy   *channeldb.ChannelEdgePolicy
 	capacity dcrutil.Amount
+
+	// inboundFee is the inbound fee that the to node charges for HTLCs
+	// arriving over the channel.
+	inboundFee lnwire.InboundFee
 }
 
 // amtInRange checks whether an amount falls within the valid range for a
@@ -139,7 +157,7 @@ type unifiedPolicy struct {
 // specific amount to send. It differentiates between local and network
 // channels.
 func (u *unifiedPolicy) getPolicy(amt lnwire.MilliAtom,
-	bandwidthHints map[uint64]lnwire.MilliAtom) *channeldb.ChannelEdgePolicy {
+	bandwidthHints map[uint64]lnwire.MilliAtom) *unifiedPolicyEdge {
 
 	if u.localChan {
 		return u.getPolicyLocal(amt, bandwidthHints)
@@ -151,10 +169,10 @@ func (u *unifiedPolicy) getPolicy(amt lnwire.MilliAtom,
 // getPolicyLocal returns the optimal policy to use for this local connection
 // given a specific amount to send.
 func (u *unifiedPolicy) getPolicyLocal(amt lnwire.MilliAtom,
-	bandwidthHints map[uint64]lnwire.MilliAtom) *channeldb.ChannelEdgePolicy {
+	bandwidthHints map[uint64]lnwire.MilliAtom) *unifiedPolicyEdge {
 
 	var (
-		bestPolicy   *channeldb.ChannelEdgePolicy
+		bestPolicy   *unifiedPolicyEdge
 		maxBandwidth lnwire.MilliAtom
 	)
 
@@ -196,7 +214,7 @@ func (u *unifiedPolicy) getPolicyLocal(amt lnwire.MilliAtom,
 		maxBandwidth = bandwidth
 
 		// Update best policy.
-		bestPolicy = edge.policy
+		bestPolicy = edge
 	}
 
 	return bestPolicy
@@ -206,12 +224,13 @@ func (u *unifiedPolicy) getPolicyLocal(amt lnwire.MilliAtom,
 // a specific amount to send. The goal is to return a policy that maximizes the
 // probability of a successful forward in a non-strict forwarding context.
 func (u *unifiedPolicy) getPolicyNetwork(
-	amt lnwire.MilliAtom) *channeldb.ChannelEdgePolicy {
+	amt lnwire.MilliAtom) *unifiedPolicyEdge {
 
 	var (
-		bestPolicy  *channeldb.ChannelEdgePolicy
-		maxFee      lnwire.MilliAtom
-		maxTimelock uint16
+		bestPolicy    *unifiedPolicyEdge
+		maxFee        lnwire.MilliAtom
+		maxTimelock   uint16
+		maxInboundFee *lnwire.InboundFee
 	)
 
 	for _, edge := range u.edges {
@@ -233,6 +252,14 @@ func (u *unifiedPolicy) getPolicyNetwork(
 			maxTimelock = edge.policy.TimeLockDelta
 		}
 
+		// Likewise, track the highest inbound fee that the to node
+		// charges for any of the candidate channels.
+		if maxInboundFee == nil || edge.inboundFee.CalcFee(amt) >
+			maxInboundFee.CalcFee(amt) {
+
+			maxInboundFee = &edge.inboundFee
+		}
+
 		// Use the policy that results in the highest fee for this
 		// specific amount.
 		fee := edge.policy.ComputeFee(amt)
@@ -241,7 +268,7 @@ func (u *unifiedPolicy) getPolicyNetwork(
 		}
 		maxFee = fee
 
-		bestPolicy = edge.policy
+		bestPolicy = edge
 	}
 
 	// Return early if no channel matches.
@@ -258,11 +285,16 @@ func (u *unifiedPolicy) getPolicyNetwork(
 	// The reason we do this, is that we try to maximize the chance that we
 	// get forwarded. Because we penalize pair-wise, there won't be a second
 	// chance for this node pair. But this is all only needed for nodes that
-	// have distinct policies for channels to the same peer.
-	modifiedPolicy := *bestPolicy
+	// have distinct policies for channels to the same peer. For the same
+	// reason, the highest inbound fee of the to node is used.
+	modifiedPolicy := *bestPolicy.policy
 	modifiedPolicy.TimeLockDelta = maxTimelock
 
-	return &modifiedPolicy
+	return &unifiedPolicyEdge{
+		policy:     &modifiedPolicy,
+		capacity:   bestPolicy.capacity,
+		inboundFee: *maxInboundFee,
+	}
 }
 
 // minAmt returns the minimum amount that can be forwarded on this connection.
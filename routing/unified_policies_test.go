@@ -36,15 +36,25 @@ func TestUnifiedPolicies(t *testing.T) {
 		MaxHTLC:                   400,
 		MinHTLC:                   100,
 	}
-	u.addPolicy(fromNode, &p1, 7)
-	u.addPolicy(fromNode, &p2, 7)
+	u.addPolicy(fromNode, &p1, lnwire.InboundFee{BaseFee: -5}, 7)
+	u.addPolicy(fromNode, &p2, lnwire.InboundFee{BaseFee: 20}, 7)
 
-	checkPolicy := func(policy *channeldb.ChannelEdgePolicy,
+	checkPolicy := func(edge *unifiedPolicyEdge,
 		feeBase lnwire.MilliAtom, feeRate lnwire.MilliAtom,
 		timeLockDelta uint16) {
 
 		t.Helper()
 
+		// The highest inbound fee is expected to be used, because the
+		// unified policy may be used to forward over either channel.
+		expectedInboundFee := lnwire.InboundFee{BaseFee: 20}
+		if edge.inboundFee != expectedInboundFee {
+			t.Fatalf("expected inbound fee %v, got %v",
+				expectedInboundFee, edge.inboundFee)
+		}
+
+		policy := edge.policy
+
 		if policy.FeeBaseMAtoms != feeBase {
 			t.Fatalf("expected fee base %v, got %v",
 				feeBase, policy.FeeBaseMAtoms)
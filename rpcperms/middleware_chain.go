@@ -0,0 +1,399 @@
+package rpcperms
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	// RegisterMiddlewareURI is the full URI of the RPC middleware
+	// registration method. Calls to it are never intercepted, as the
+	// middleware would otherwise intercept its own registration.
+	RegisterMiddlewareURI = "/lnrpc.Lightning/RegisterRPCMiddleware"
+)
+
+// MiddlewareChain holds all middlewares that registered through the
+// RegisterRPCMiddleware RPC and provides the gRPC interceptors that hand the
+// messages of every RPC call to them.
+type MiddlewareChain struct {
+	// lastRequestID is the ID of the last intercepted RPC call.
+	//
+	// NOTE: This must be used atomically.
+	lastRequestID uint64
+
+	// mandatoryMiddleware is the list of middlewares that must be
+	// registered before any RPC call is accepted.
+	mandatoryMiddleware []string
+
+	// registeredMiddleware holds the registered middlewares in the order
+	// they registered in, which is the order they intercept messages in.
+	registeredMiddleware []*MiddlewareHandler
+
+	sync.RWMutex
+}
+
+// NewMiddlewareChain creates a new, empty middleware chain. RPC calls are
+// rejected as long as any of the mandatory middlewares isn't registered.
+func NewMiddlewareChain(mandatory []string) *MiddlewareChain {
+	return &MiddlewareChain{
+		mandatoryMiddleware: mandatory,
+	}
+}
+
+// RegisterMiddleware adds a middleware to the end of the chain. An error is
+// returned if a middleware with the same name is already registered.
+func (c *MiddlewareChain) RegisterMiddleware(mw *MiddlewareHandler) error {
+	c.Lock()
+	defer c.Unlock()
+
+	for _, existing := range c.registeredMiddleware {
+		if existing.Name() == mw.Name() {
+			return fmt.Errorf("a middleware with the name '%s' is "+
+				"already registered", mw.Name())
+		}
+	}
+
+	log.Infof("Registering RPC middleware %s (read_only=%v, "+
+		"custom_caveat=%q)", mw.Name(), mw.ReadOnly(),
+		mw.customCaveatName)
+
+	c.registeredMiddleware = append(c.registeredMiddleware, mw)
+	return nil
+}
+
+// RemoveMiddleware removes the middleware with the given name from the chain.
+func (c *MiddlewareChain) RemoveMiddleware(name string) {
+	c.Lock()
+	defer c.Unlock()
+
+	for idx, mw := range c.registeredMiddleware {
+		if mw.Name() != name {
+			continue
+		}
+
+		log.Infof("Removing RPC middleware %s", name)
+
+		c.registeredMiddleware = append(
+			c.registeredMiddleware[:idx],
+			c.registeredMiddleware[idx+1:]...,
+		)
+		return
+	}
+}
+
+// middleware returns a copy of the registered middlewares. An error is
+// returned if any of the mandatory middlewares isn't registered.
+func (c *MiddlewareChain) middleware() ([]*MiddlewareHandler, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	for _, name := range c.mandatoryMiddleware {
+		found := false
+		for _, mw := range c.registeredMiddleware {
+			if mw.Name() == name {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("missing mandatory middleware "+
+				"'%s', refusing request", name)
+		}
+	}
+
+	middleware := make([]*MiddlewareHandler, len(c.registeredMiddleware))
+	copy(middleware, c.registeredMiddleware)
+
+	return middleware, nil
+}
+
+// CustomCaveatSupported returns nil if a middleware that enforces custom
+// caveats with the given name is registered.
+//
+// NOTE: This is part of the macaroons.CustomCaveatAcceptor interface.
+func (c *MiddlewareChain) CustomCaveatSupported(customCaveatName string) error {
+	c.RLock()
+	defer c.RUnlock()
+
+	return customCaveatSupported(c.registeredMiddleware, customCaveatName)
+}
+
+// customCaveatSupported returns an error if none of the given middlewares
+// enforces custom caveats with the given name.
+func customCaveatSupported(middleware []*MiddlewareHandler,
+	customCaveatName string) error {
+
+	for _, mw := range middleware {
+		if mw.customCaveatName == customCaveatName {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot accept macaroon with custom caveat '%s', "+
+		"no middleware registered to handle it", customCaveatName)
+}
+
+// checkCustomCaveats returns an error if the macaroon carries a custom caveat
+// that none of the given middlewares enforces. Such a caveat would otherwise
+// go unenforced.
+func checkCustomCaveats(mac *macaroon.Macaroon,
+	middleware []*MiddlewareHandler) error {
+
+	if mac == nil {
+		return nil
+	}
+
+	for _, caveat := range mac.Caveats() {
+		name, _, err := macaroons.ParseCustomCaveat(string(caveat.Id))
+		if err != nil {
+			continue
+		}
+
+		if err := customCaveatSupported(middleware, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// intercept hands the message described by the request to every middleware
+// of the chain that is interested in it. Each middleware sees the message as
+// modified by the middlewares before it. The final message is returned, which
+// is nil if no middleware replaced the message.
+func (c *MiddlewareChain) intercept(
+	req *InterceptionRequest) (proto.Message, error) {
+
+	middleware, err := c.middleware()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkCustomCaveats(req.Macaroon, middleware); err != nil {
+		return nil, err
+	}
+
+	var replacement proto.Message
+	for _, mw := range middleware {
+		if !mw.shouldIntercept(req.Macaroon) {
+			continue
+		}
+
+		resp, err := mw.sendInterceptRequest(req)
+
+		// A read-only middleware only observes the messages, so
+		// neither its failures nor its feedback affect the call.
+		if mw.ReadOnly() {
+			switch {
+			case err != nil:
+				log.Warnf("Read-only middleware %s failed to "+
+					"intercept message: %v", mw.Name(), err)
+
+			case resp.Err != nil || resp.Replacement != nil:
+				log.Warnf("Ignoring feedback of read-only "+
+					"middleware %s", mw.Name())
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("error intercepting message "+
+				"with middleware %s: %v", mw.Name(), err)
+		}
+		if resp.Err != nil {
+			return nil, resp.Err
+		}
+
+		if resp.Replacement != nil {
+			replacement = resp.Replacement
+			req.ProtoMessage = resp.Replacement
+		}
+	}
+
+	return replacement, nil
+}
+
+// UnaryServerInterceptor returns a gRPC interceptor that hands the request and
+// the response of every unary RPC call to the middleware chain.
+func (c *MiddlewareChain) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler) (interface{}, error) {
+
+		msg, ok := req.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("request of type %T is not a "+
+				"proto message", req)
+		}
+
+		mac, rawMac, err := macaroons.MacaroonFromContext(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		interceptReq := &InterceptionRequest{
+			Type:         TypeRequest,
+			RequestID:    atomic.AddUint64(&c.lastRequestID, 1),
+			Macaroon:     mac,
+			RawMacaroon:  rawMac,
+			FullURI:      info.FullMethod,
+			ProtoMessage: msg,
+		}
+		replacement, err := c.intercept(interceptReq)
+		if err != nil {
+			return nil, err
+		}
+		if replacement != nil {
+			req = replacement
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		respMsg, ok := resp.(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("response of type %T is not a "+
+				"proto message", resp)
+		}
+
+		interceptReq.Type = TypeResponse
+		interceptReq.ProtoMessage = respMsg
+		replacement, err = c.intercept(interceptReq)
+		if err != nil {
+			return nil, err
+		}
+		if replacement != nil {
+			resp = replacement
+		}
+
+		return resp, nil
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor that hands the opening of
+// every streaming RPC and all of its messages to the middleware chain.
+func (c *MiddlewareChain) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream,
+		info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+
+		// The middleware registration stream itself is never
+		// intercepted.
+		if info.FullMethod == RegisterMiddlewareURI {
+			return handler(srv, ss)
+		}
+
+		mac, rawMac, err := macaroons.MacaroonFromContext(ss.Context())
+		if err != nil {
+			return err
+		}
+
+		requestID := atomic.AddUint64(&c.lastRequestID, 1)
+		_, err = c.intercept(&InterceptionRequest{
+			Type:        TypeStreamAuth,
+			RequestID:   requestID,
+			StreamRPC:   true,
+			Macaroon:    mac,
+			RawMacaroon: rawMac,
+			FullURI:     info.FullMethod,
+		})
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &interceptedStream{
+			ServerStream: ss,
+			chain:        c,
+			requestID:    requestID,
+			fullURI:      info.FullMethod,
+			mac:          mac,
+			rawMac:       rawMac,
+		})
+	}
+}
+
+// interceptedStream wraps a server stream and hands every message sent or
+// received over it to the middleware chain.
+type interceptedStream struct {
+	grpc.ServerStream
+
+	chain     *MiddlewareChain
+	requestID uint64
+	fullURI   string
+	mac       *macaroon.Macaroon
+	rawMac    []byte
+}
+
+// interceptMessage hands a single message of the stream to the middleware
+// chain.
+func (s *interceptedStream) interceptMessage(msgType InterceptType,
+	m interface{}) (proto.Message, error) {
+
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("message of type %T is not a proto "+
+			"message", m)
+	}
+
+	return s.chain.intercept(&InterceptionRequest{
+		Type:         msgType,
+		RequestID:    s.requestID,
+		StreamRPC:    true,
+		Macaroon:     s.mac,
+		RawMacaroon:  s.rawMac,
+		FullURI:      s.fullURI,
+		ProtoMessage: msg,
+	})
+}
+
+// RecvMsg receives a message from the client and hands it to the middleware
+// chain before passing it on to the RPC handler.
+//
+// NOTE: This is part of the grpc.ServerStream interface.
+func (s *interceptedStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	replacement, err := s.interceptMessage(TypeRequest, m)
+	if err != nil {
+		return err
+	}
+
+	// The received message is an out parameter, so a replacement must be
+	// copied into it.
+	if replacement != nil {
+		msg := m.(proto.Message)
+		msg.Reset()
+		proto.Merge(msg, replacement)
+	}
+
+	return nil
+}
+
+// SendMsg hands a message of the RPC handler to the middleware chain before
+// sending it to the client.
+//
+// NOTE: This is part of the grpc.ServerStream interface.
+func (s *interceptedStream) SendMsg(m interface{}) error {
+	replacement, err := s.interceptMessage(TypeResponse, m)
+	if err != nil {
+		return err
+	}
+
+	if replacement != nil {
+		return s.ServerStream.SendMsg(replacement)
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,351 @@
+package rpcperms
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon.v2"
+)
+
+const (
+	testMethod  = "/lnrpc.Lightning/GetInfo"
+	testTimeout = time.Second
+)
+
+// feedbackFunc returns the feedback of a mock middleware to an intercept
+// message.
+type feedbackFunc func(*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback
+
+// mockMiddlewareStream is a middleware registration stream that is served by
+// a feedback function instead of a remote middleware.
+type mockMiddlewareStream struct {
+	grpc.ServerStream
+
+	feedback feedbackFunc
+
+	requests  chan *lnrpc.RPCMiddlewareRequest
+	responses chan *lnrpc.RPCMiddlewareResponse
+	quit      chan struct{}
+}
+
+func newMockMiddlewareStream(feedback feedbackFunc,
+	quit chan struct{}) *mockMiddlewareStream {
+
+	s := &mockMiddlewareStream{
+		feedback:  feedback,
+		requests:  make(chan *lnrpc.RPCMiddlewareRequest, 10),
+		responses: make(chan *lnrpc.RPCMiddlewareResponse, 10),
+		quit:      quit,
+	}
+	go s.serve()
+
+	return s
+}
+
+// serve answers every intercept message with the feedback of the feedback
+// function.
+func (s *mockMiddlewareStream) serve() {
+	for {
+		select {
+		case req := <-s.requests:
+			if req.GetRegComplete() {
+				continue
+			}
+
+			feedback := &lnrpc.RPCMiddlewareResponse_Feedback{
+				Feedback: s.feedback(req),
+			}
+			s.responses <- &lnrpc.RPCMiddlewareResponse{
+				RefMsgId:          req.MsgId,
+				MiddlewareMessage: feedback,
+			}
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+func (s *mockMiddlewareStream) Send(req *lnrpc.RPCMiddlewareRequest) error {
+	s.requests <- req
+	return nil
+}
+
+func (s *mockMiddlewareStream) Recv() (*lnrpc.RPCMiddlewareResponse, error) {
+	select {
+	case resp := <-s.responses:
+		return resp, nil
+
+	case <-s.quit:
+		return nil, io.EOF
+	}
+}
+
+// registerMiddleware registers a middleware that is served by the given
+// feedback function with the chain. The middleware stops once quit is
+// closed.
+func registerMiddleware(t *testing.T, chain *MiddlewareChain,
+	quit chan struct{}, name, caveatName string, readOnly bool,
+	feedback feedbackFunc) {
+
+	stream := newMockMiddlewareStream(feedback, quit)
+	mw := NewMiddlewareHandler(
+		name, caveatName, readOnly, testTimeout, stream,
+	)
+	require.NoError(t, chain.RegisterMiddleware(mw))
+
+	go func() {
+		_ = mw.Run()
+	}()
+}
+
+// macaroonContext returns a context that carries a macaroon with the given
+// custom caveat, or no custom caveat if the name is empty.
+func macaroonContext(t *testing.T, caveatName,
+	condition string) context.Context {
+
+	mac, err := macaroon.New(
+		[]byte("root-key"), []byte("id"), "lnd", macaroon.LatestVersion,
+	)
+	require.NoError(t, err)
+
+	if caveatName != "" {
+		constraint := macaroons.CustomConstraint(caveatName, condition)
+		require.NoError(t, constraint(mac))
+	}
+
+	macBytes, err := mac.MarshalBinary()
+	require.NoError(t, err)
+
+	md := metadata.Pairs("macaroon", hex.EncodeToString(macBytes))
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// callUnary calls a unary RPC through the interceptor of the chain. The RPC
+// handler returns the alias of the request as the alias of the node.
+func callUnary(ctx context.Context, chain *MiddlewareChain,
+	req *lnrpc.GetInfoRequest) (*lnrpc.GetInfoResponse, error) {
+
+	handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return &lnrpc.GetInfoResponse{Alias: "alice"}, nil
+	}
+
+	resp, err := chain.UnaryServerInterceptor()(
+		ctx, req, &grpc.UnaryServerInfo{FullMethod: testMethod},
+		handler,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.(*lnrpc.GetInfoResponse), nil
+}
+
+// chainSetup registers the middlewares of a test case with the chain. The
+// middlewares stop once q is closed.
+type chainSetup func(t *testing.T, c *MiddlewareChain, q chan struct{})
+
+// TestMiddlewareChainUnary tests that middlewares can approve, reject and
+// replace the messages of unary RPC calls.
+func TestMiddlewareChainUnary(t *testing.T) {
+	t.Parallel()
+
+	approve := func(*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+		return &lnrpc.InterceptFeedback{}
+	}
+	reject := func(*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+		return &lnrpc.InterceptFeedback{Error: "rejected"}
+	}
+
+	// replaceResponse replaces the alias in every response.
+	replacement, err := proto.Marshal(&lnrpc.GetInfoResponse{
+		Alias: "mallory",
+	})
+	require.NoError(t, err)
+	replaceResponse := func(
+		req *lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+
+		if req.GetResponse() == nil {
+			return &lnrpc.InterceptFeedback{}
+		}
+
+		return &lnrpc.InterceptFeedback{
+			ReplaceMessage:        true,
+			ReplacementSerialized: replacement,
+		}
+	}
+
+	// The request must carry the macaroon and the condition of the
+	// custom caveat.
+	checkCaveat := func(
+		req *lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+
+		if req.CustomCaveatCondition != "max=10" ||
+			len(req.RawMacaroon) == 0 {
+
+			return &lnrpc.InterceptFeedback{Error: "bad caveat"}
+		}
+
+		return &lnrpc.InterceptFeedback{}
+	}
+
+	testCases := []struct {
+		name       string
+		setup      chainSetup
+		caveatName string
+		mandatory  []string
+		expectErr  bool
+		alias      string
+	}{{
+		name:  "no middleware",
+		alias: "alice",
+	}, {
+		name: "approve",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(t, c, q, "a", "", false, approve)
+		},
+		alias: "alice",
+	}, {
+		name: "reject",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(t, c, q, "a", "", false, reject)
+		},
+		expectErr: true,
+	}, {
+		name: "replace response",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(
+				t, c, q, "a", "", false, replaceResponse,
+			)
+		},
+		alias: "mallory",
+	}, {
+		name: "read-only feedback ignored",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(t, c, q, "a", "", true, reject)
+			registerMiddleware(
+				t, c, q, "b", "", true, replaceResponse,
+			)
+		},
+		alias: "alice",
+	}, {
+		name: "macaroon without custom caveat not intercepted",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(
+				t, c, q, "a", "limits", false, reject,
+			)
+		},
+		alias: "alice",
+	}, {
+		name: "macaroon with custom caveat intercepted",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(
+				t, c, q, "a", "limits", false, checkCaveat,
+			)
+			registerMiddleware(
+				t, c, q, "b", "other", false, reject,
+			)
+		},
+		caveatName: "limits",
+		alias:      "alice",
+	}, {
+		name:       "custom caveat without middleware rejected",
+		caveatName: "limits",
+		expectErr:  true,
+	}, {
+		name: "custom caveat of other middleware rejected",
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(
+				t, c, q, "a", "other", false, checkCaveat,
+			)
+		},
+		caveatName: "limits",
+		expectErr:  true,
+	}, {
+		name:      "mandatory middleware missing",
+		mandatory: []string{"b"},
+		setup: func(t *testing.T, c *MiddlewareChain, q chan struct{}) {
+			registerMiddleware(t, c, q, "a", "", false, approve)
+		},
+		expectErr: true,
+	}}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			quit := make(chan struct{})
+			defer close(quit)
+
+			chain := NewMiddlewareChain(tc.mandatory)
+			if tc.setup != nil {
+				tc.setup(t, chain, quit)
+			}
+
+			ctx := macaroonContext(t, tc.caveatName, "max=10")
+			resp, err := callUnary(
+				ctx, chain, &lnrpc.GetInfoRequest{},
+			)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.alias, resp.Alias)
+		})
+	}
+}
+
+// TestMiddlewareChainDuplicateName tests that two middlewares can't register
+// with the same name.
+func TestMiddlewareChainDuplicateName(t *testing.T) {
+	t.Parallel()
+
+	quit := make(chan struct{})
+	defer close(quit)
+
+	chain := NewMiddlewareChain(nil)
+	registerMiddleware(
+		t, chain, quit, "a", "", false,
+		func(*lnrpc.RPCMiddlewareRequest) *lnrpc.InterceptFeedback {
+			return &lnrpc.InterceptFeedback{}
+		},
+	)
+
+	mw := NewMiddlewareHandler("a", "", false, testTimeout, nil)
+	require.Error(t, chain.RegisterMiddleware(mw))
+}
+
+// TestMiddlewareHandlerTimeout tests that a message is rejected if the
+// middleware doesn't send its feedback in time.
+func TestMiddlewareHandlerTimeout(t *testing.T) {
+	t.Parallel()
+
+	stream := &mockMiddlewareStream{
+		requests:  make(chan *lnrpc.RPCMiddlewareRequest, 10),
+		responses: make(chan *lnrpc.RPCMiddlewareResponse),
+		quit:      make(chan struct{}),
+	}
+	defer close(stream.quit)
+
+	mw := NewMiddlewareHandler("a", "", false, 10*time.Millisecond, stream)
+	_, err := mw.sendInterceptRequest(&InterceptionRequest{
+		Type:         TypeRequest,
+		FullURI:      testMethod,
+		ProtoMessage: &lnrpc.GetInfoRequest{},
+	})
+	require.True(t, errors.Is(err, ErrMiddlewareTimeout))
+}
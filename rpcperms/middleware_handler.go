@@ -0,0 +1,345 @@
+package rpcperms
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/decred/dcrlnd/lnrpc"
+	"github.com/decred/dcrlnd/macaroons"
+	"github.com/golang/protobuf/proto"
+	"gopkg.in/macaroon.v2"
+)
+
+var (
+	// ErrMiddlewareTimeout is returned when a middleware doesn't respond
+	// to an intercept message within the configured timeout.
+	ErrMiddlewareTimeout = errors.New("timeout while waiting for RPC " +
+		"middleware feedback")
+
+	// ErrMiddlewareGone is returned when a middleware disconnects while a
+	// message is waiting for its feedback.
+	ErrMiddlewareGone = errors.New("RPC middleware disconnected")
+)
+
+// InterceptType is the type of a message that is sent to a middleware for
+// interception.
+type InterceptType uint8
+
+const (
+	// TypeStreamAuth is the type of the message that is sent once a client
+	// opens a streaming RPC.
+	TypeStreamAuth InterceptType = 1
+
+	// TypeRequest is the type of a request sent by a client, either of a
+	// unary call or of a client stream.
+	TypeRequest InterceptType = 2
+
+	// TypeResponse is the type of a response sent to a client, either of
+	// a unary call or of a server stream.
+	TypeResponse InterceptType = 3
+)
+
+// InterceptionRequest is a message of an RPC call that is sent to a
+// middleware for interception.
+type InterceptionRequest struct {
+	// Type is the type of the intercepted message.
+	Type InterceptType
+
+	// RequestID is the ID of the RPC call the message belongs to.
+	RequestID uint64
+
+	// StreamRPC is true if the message belongs to a streaming RPC.
+	StreamRPC bool
+
+	// Macaroon is the macaroon the call was made with, if any.
+	Macaroon *macaroon.Macaroon
+
+	// RawMacaroon is the serialized macaroon the call was made with, if
+	// any.
+	RawMacaroon []byte
+
+	// FullURI is the full URI of the RPC method that was called.
+	FullURI string
+
+	// ProtoMessage is the intercepted message. It is nil for messages of
+	// the TypeStreamAuth type.
+	ProtoMessage proto.Message
+}
+
+// toRPC converts the interception request into the message sent to the
+// middleware with the given custom caveat name.
+func (r *InterceptionRequest) toRPC(msgID uint64,
+	customCaveatName string) (*lnrpc.RPCMiddlewareRequest, error) {
+
+	rpcRequest := &lnrpc.RPCMiddlewareRequest{
+		RequestId:   r.RequestID,
+		RawMacaroon: r.RawMacaroon,
+		MsgId:       msgID,
+	}
+
+	if r.Macaroon != nil {
+		for _, caveat := range r.Macaroon.Caveats() {
+			rpcRequest.Caveats = append(
+				rpcRequest.Caveats, string(caveat.Id),
+			)
+		}
+
+		if customCaveatName != "" {
+			condition, _ := macaroons.GetCustomCaveatCondition(
+				r.Macaroon, customCaveatName,
+			)
+			rpcRequest.CustomCaveatCondition = condition
+		}
+	}
+
+	if r.Type == TypeStreamAuth {
+		streamAuth := &lnrpc.StreamAuth{
+			MethodFullUri: r.FullURI,
+		}
+		rpcRequest.InterceptType = &lnrpc.RPCMiddlewareRequest_StreamAuth{
+			StreamAuth: streamAuth,
+		}
+
+		return rpcRequest, nil
+	}
+
+	serialized, err := proto.Marshal(r.ProtoMessage)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize intercepted "+
+			"message: %v", err)
+	}
+
+	rpcMessage := &lnrpc.RPCMessage{
+		MethodFullUri: r.FullURI,
+		StreamRpc:     r.StreamRPC,
+		TypeName:      proto.MessageName(r.ProtoMessage),
+		Serialized:    serialized,
+	}
+
+	switch r.Type {
+	case TypeRequest:
+		rpcRequest.InterceptType = &lnrpc.RPCMiddlewareRequest_Request{
+			Request: rpcMessage,
+		}
+
+	case TypeResponse:
+		rpcRequest.InterceptType = &lnrpc.RPCMiddlewareRequest_Response{
+			Response: rpcMessage,
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown intercept type %v", r.Type)
+	}
+
+	return rpcRequest, nil
+}
+
+// InterceptionResponse is the feedback of a middleware to an intercepted
+// message.
+type InterceptionResponse struct {
+	// Err is the error the RPC call is rejected with. It is nil if the
+	// middleware approved the message.
+	Err error
+
+	// Replacement is the message that replaces the intercepted message. It
+	// is nil if the message isn't replaced.
+	Replacement proto.Message
+}
+
+// parseFeedback converts the feedback of a middleware into an interception
+// response for the given request.
+func parseFeedback(feedback *lnrpc.InterceptFeedback,
+	req *InterceptionRequest) (*InterceptionResponse, error) {
+
+	resp := &InterceptionResponse{}
+	if feedback.Error != "" {
+		resp.Err = errors.New(feedback.Error)
+	}
+
+	if !feedback.ReplaceMessage {
+		return resp, nil
+	}
+
+	if req.ProtoMessage == nil {
+		return nil, fmt.Errorf("message of type %v can't be replaced",
+			req.Type)
+	}
+
+	// The replacement must be of the same type as the intercepted
+	// message, so we decode it into an empty copy of that message.
+	replacement := proto.Clone(req.ProtoMessage)
+	replacement.Reset()
+	err := proto.Unmarshal(feedback.ReplacementSerialized, replacement)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode replacement "+
+			"message: %v", err)
+	}
+	resp.Replacement = replacement
+
+	return resp, nil
+}
+
+// MiddlewareHandler is the handler of a single middleware that registered
+// through the RegisterRPCMiddleware RPC. It sends the intercepted messages to
+// the middleware and relays its feedback.
+type MiddlewareHandler struct {
+	// lastMsgID is the ID of the last message sent to the middleware.
+	//
+	// NOTE: This must be used atomically.
+	lastMsgID uint64
+
+	middlewareName   string
+	customCaveatName string
+	readOnly         bool
+	timeout          time.Duration
+
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer
+
+	// sendMtx serializes the messages sent over the stream.
+	sendMtx sync.Mutex
+
+	// pending holds the channels the feedback of the middleware is
+	// delivered on, keyed by the ID of the message it refers to.
+	pending    map[uint64]chan *lnrpc.InterceptFeedback
+	pendingMtx sync.Mutex
+
+	done chan struct{}
+}
+
+// NewMiddlewareHandler creates a new handler for the middleware that is
+// connected through the given stream. The middleware only intercepts calls
+// made with a macaroon that carries the given custom caveat, unless the
+// caveat name is empty. A read-only middleware can't reject or modify any
+// message.
+func NewMiddlewareHandler(name, customCaveatName string, readOnly bool,
+	timeout time.Duration,
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) *MiddlewareHandler {
+
+	return &MiddlewareHandler{
+		middlewareName:   name,
+		customCaveatName: customCaveatName,
+		readOnly:         readOnly,
+		timeout:          timeout,
+		stream:           stream,
+		done:             make(chan struct{}),
+		pending: make(
+			map[uint64]chan *lnrpc.InterceptFeedback,
+		),
+	}
+}
+
+// Name returns the name the middleware registered with.
+func (h *MiddlewareHandler) Name() string {
+	return h.middlewareName
+}
+
+// ReadOnly returns true if the middleware can't reject or modify messages.
+func (h *MiddlewareHandler) ReadOnly() bool {
+	return h.readOnly
+}
+
+// Run confirms the registration to the middleware and relays its feedback
+// until the stream is closed.
+//
+// NOTE: This blocks until the middleware disconnects.
+func (h *MiddlewareHandler) Run() error {
+	defer close(h.done)
+
+	err := h.send(&lnrpc.RPCMiddlewareRequest{
+		InterceptType: &lnrpc.RPCMiddlewareRequest_RegComplete{
+			RegComplete: true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := h.stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		feedback := resp.GetFeedback()
+		if feedback == nil {
+			return fmt.Errorf("unexpected middleware message %T",
+				resp.MiddlewareMessage)
+		}
+
+		h.pendingMtx.Lock()
+		feedbackChan, ok := h.pending[resp.RefMsgId]
+		h.pendingMtx.Unlock()
+		if !ok {
+			log.Warnf("Middleware %s sent feedback for unknown "+
+				"message %d", h.middlewareName, resp.RefMsgId)
+			continue
+		}
+
+		// The channel is buffered and only a single feedback is
+		// expected, so additional feedback is dropped.
+		select {
+		case feedbackChan <- feedback:
+		default:
+		}
+	}
+}
+
+// shouldIntercept returns true if calls made with the given macaroon are
+// intercepted by the middleware.
+func (h *MiddlewareHandler) shouldIntercept(mac *macaroon.Macaroon) bool {
+	if h.customCaveatName == "" {
+		return true
+	}
+
+	_, ok := macaroons.GetCustomCaveatCondition(mac, h.customCaveatName)
+	return ok
+}
+
+// sendInterceptRequest sends the intercepted message to the middleware and
+// waits for its feedback.
+func (h *MiddlewareHandler) sendInterceptRequest(
+	req *InterceptionRequest) (*InterceptionResponse, error) {
+
+	msgID := atomic.AddUint64(&h.lastMsgID, 1)
+	rpcRequest, err := req.toRPC(msgID, h.customCaveatName)
+	if err != nil {
+		return nil, err
+	}
+
+	feedbackChan := make(chan *lnrpc.InterceptFeedback, 1)
+	h.pendingMtx.Lock()
+	h.pending[msgID] = feedbackChan
+	h.pendingMtx.Unlock()
+
+	defer func() {
+		h.pendingMtx.Lock()
+		delete(h.pending, msgID)
+		h.pendingMtx.Unlock()
+	}()
+
+	if err := h.send(rpcRequest); err != nil {
+		return nil, err
+	}
+
+	select {
+	case feedback := <-feedbackChan:
+		return parseFeedback(feedback, req)
+
+	case <-time.After(h.timeout):
+		return nil, ErrMiddlewareTimeout
+
+	case <-h.done:
+		return nil, ErrMiddlewareGone
+	}
+}
+
+// send sends a message to the middleware.
+func (h *MiddlewareHandler) send(msg *lnrpc.RPCMiddlewareRequest) error {
+	h.sendMtx.Lock()
+	defer h.sendMtx.Unlock()
+
+	return h.stream.Send(msg)
+}
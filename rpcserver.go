@@ -28,6 +28,7 @@ import (
 	"github.com/decred/dcrd/txscript/v4"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/accounts"
 	"github.com/decred/dcrlnd/autopilot"
 	"github.com/decred/dcrlnd/build"
 	"github.com/decred/dcrlnd/chanacceptor"
@@ -39,6 +40,7 @@ import (
 	"github.com/decred/dcrlnd/contractcourt"
 	"github.com/decred/dcrlnd/discovery"
 	"github.com/decred/dcrlnd/feature"
+	"github.com/decred/dcrlnd/funding"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/internal/psbt"
@@ -58,10 +60,10 @@ import (
 	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/monitoring"
 	"github.com/decred/dcrlnd/peer"
-	"github.com/decred/dcrlnd/peernotifier"
 	"github.com/decred/dcrlnd/record"
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/rpcperms"
 	"github.com/decred/dcrlnd/signal"
 	"github.com/decred/dcrlnd/sweep"
 	"github.com/decred/dcrlnd/watchtower"
@@ -270,6 +272,13 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/BatchOpenChannel": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/OpenChannelSync": {{
 			Entity: "onchain",
 			Action: "write",
@@ -328,6 +337,10 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ChannelUptime": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/SendPayment": {{
 			Entity: "offchain",
 			Action: "write",
@@ -424,6 +437,14 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "offchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/FeeManagerStatus": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SetFeeManagerOverride": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ForwardingHistory": {{
 			Entity: "offchain",
 			Action: "read",
@@ -467,14 +488,42 @@ func MainRPCServerPermissions() map[string][]bakery.Op {
 			Entity: "macaroon",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/CreateAccount": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListAccounts": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/RemoveAccount": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/ListPermissions": {{
 			Entity: "info",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/RegisterRPCMiddleware": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
 		"/lnrpc.Lightning/SubscribePeerEvents": {{
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/PeerFitness": {{
+			Entity: "peers",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SendCustomMessage": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SubscribeCustomMessages": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/FundingStateStep": {{
 			Entity: "onchain",
 			Action: "write",
@@ -548,6 +597,10 @@ type rpcServer struct {
 	// allPermissions is a map of all registered gRPC URIs (including
 	// internal and external subservers) to the permissions they require.
 	allPermissions map[string][]bakery.Op
+
+	// middlewareChain holds the RPC middlewares that intercept all calls.
+	// It is nil if RPC middleware is disabled.
+	middlewareChain *rpcperms.MiddlewareChain
 }
 
 // A compile time check to ensure that rpcServer fully implements the
@@ -565,7 +618,8 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 	atpl *autopilot.Manager, invoiceRegistry *invoices.InvoiceRegistry,
 	tower *watchtower.Standalone, tlsCfg *tls.Config,
 	getListeners rpcListeners,
-	chanPredicate *chanacceptor.ChainedAcceptor) (*rpcServer, error) {
+	chanPredicate *chanacceptor.ChainedAcceptor,
+	middlewareChain *rpcperms.MiddlewareChain) (*rpcServer, error) {
 
 	// Set up router rpc backend.
 	channelGraph := s.localChanDB.ChannelGraph()
@@ -609,11 +663,15 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 		DefaultFinalCltvDelta:  uint16(cfg.TimeLockDelta),
 		SubscribeHtlcEvents:    s.htlcNotifier.SubscribeHtlcEvents,
 		InterceptableForwarder: s.interceptableSwitch,
+		ReserveAccountPayment:  s.accounts.ReservePayment,
 	}
 
 	genInvoiceFeatures := func() *lnwire.FeatureVector {
 		return s.featureMgr.Get(feature.SetInvoice)
 	}
+	genAmpInvoiceFeatures := func() *lnwire.FeatureVector {
+		return s.featureMgr.Get(feature.SetInvoiceAmp)
+	}
 
 	var (
 		subServers     []lnrpc.SubServer
@@ -629,8 +687,8 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 		cfg, s.cc, cfg.networkDir, macService, atpl, invoiceRegistry,
 		s.htlcSwitch, activeNetParams.Params, s.chanRouter,
 		routerBackend, s.nodeSigner, s.remoteChanDB, s.sweeper, tower,
-		s.towerClient, cfg.net.ResolveTCPAddr, genInvoiceFeatures,
-		rpcsLog,
+		s.towerClient, s.anchorTowerClient, cfg.net.ResolveTCPAddr,
+		genInvoiceFeatures, genAmpInvoiceFeatures, rpcsLog,
 	)
 	if err != nil {
 		return nil, err
@@ -728,6 +786,19 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 		macStrmInterceptors = append(macStrmInterceptors, strmInterceptor)
 	}
 
+	// If RPC middleware is enabled, the middleware chain intercepts every
+	// call once it passed the macaroon checks.
+	if middlewareChain != nil {
+		macUnaryInterceptors = append(
+			macUnaryInterceptors,
+			middlewareChain.UnaryServerInterceptor(),
+		)
+		macStrmInterceptors = append(
+			macStrmInterceptors,
+			middlewareChain.StreamServerInterceptor(),
+		)
+	}
+
 	// Get interceptors for Prometheus to gather gRPC performance metrics.
 	// If monitoring is disabled, GetPromInterceptors() will return empty
 	// slices.
@@ -776,6 +847,7 @@ func newRPCServer(cfg *Config, s *server, macService *macaroons.Service,
 		macService:      macService,
 		selfNode:        selfNode.PubKeyBytes,
 		allPermissions:  permissions,
+		middlewareChain: middlewareChain,
 	}
 	lnrpc.RegisterLightningServer(grpcServer, rootRPCServer)
 
@@ -1806,6 +1878,16 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 			"channel size is: %v atoms", int64(minChanFundingSize))
 	}
 
+	// Zero-conf channels can only be requested if we signal support for
+	// them.
+	zeroConfEnabled := globalFeatureSet.HasFeature(
+		lnwire.ZeroConfOptional,
+	)
+	if in.ZeroConf && !zeroConfEnabled {
+		return nil, fmt.Errorf("zero-conf channels are not enabled, " +
+			"start dcrlnd with --protocol.zero-conf")
+	}
+
 	// Prevent users from submitting a max-htlc value that would exceed the
 	// protocol maximum.
 	if maxHtlcs > input.MaxHTLCNumber/2 {
@@ -1900,6 +1982,7 @@ func (r *rpcServer) parseOpenChannelReq(in *lnrpc.OpenChannelRequest,
 		shutdownScript:   script,
 		maxValueInFlight: maxValue,
 		maxHtlcs:         maxHtlcs,
+		zeroConf:         in.ZeroConf,
 	}, nil
 }
 
@@ -2003,6 +2086,73 @@ out:
 	return nil
 }
 
+// BatchOpenChannel opens multiple channels with a single funding transaction.
+// Either all channels are opened or all funding flows are rolled back if any
+// of them fails.
+func (r *rpcServer) BatchOpenChannel(ctx context.Context,
+	in *lnrpc.BatchOpenChannelRequest) (*lnrpc.BatchOpenChannelResponse,
+	error) {
+
+	if err := r.canOpenChannel(); err != nil {
+		return nil, err
+	}
+
+	// Every channel of the batch is funded through a PSBT shim, so we only
+	// need to translate the request of each channel into a funding flow
+	// with the PSBT assembler.
+	netParams := &r.server.cc.wallet.Cfg.NetParams
+	openChannel := func(req *lnrpc.OpenChannelRequest) (
+		chan *lnrpc.OpenStatusUpdate, chan error, error) {
+
+		openReq, err := r.parseOpenChannelReq(req, false)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		psbtShim := req.FundingShim.GetPsbtShim()
+		copy(openReq.pendingChanID[:], psbtShim.PendingChanId)
+		openReq.chanFunder, err = newPsbtAssembler(
+			req, openReq.minConfs, psbtShim, netParams,
+		)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		updateChan, errChan := r.server.OpenChannel(openReq)
+		return updateChan, errChan, nil
+	}
+
+	// Channels that were already negotiated when the batch fails were
+	// never funded, so they can safely be abandoned.
+	abandonChannel := func(chanPoint *wire.OutPoint) error {
+		_, bestHeight, err := r.server.cc.chainIO.GetBestBlock()
+		if err != nil {
+			return err
+		}
+
+		return r.abandonChan(chanPoint, uint32(bestHeight))
+	}
+
+	batcher := funding.NewBatcher(&funding.BatchConfig{
+		ChannelOpener:    openChannel,
+		ChannelAbandoner: abandonChannel,
+		Wallet:           r.server.cc.wallet,
+		FeeEstimator:     r.server.cc.feeEstimator,
+		NetParams:        netParams,
+		Quit:             r.quit,
+	})
+	pendingChannels, err := batcher.BatchFund(ctx, in)
+	if err != nil {
+		rpcsLog.Errorf("unable to open batch of %d channels: %v",
+			len(in.Channels), err)
+		return nil, err
+	}
+
+	return &lnrpc.BatchOpenChannelResponse{
+		PendingChannels: pendingChannels,
+	}, nil
+}
+
 // OpenChannelSync is a synchronous version of the OpenChannel RPC call. This
 // call is meant to be consumed by clients to the REST proxy. As with all other
 // sync calls, all byte slices are instead to be populated as hex encoded
@@ -2255,6 +2405,16 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		rpcsLog.Debugf("Target atom/kB for closing transaction: %v",
 			int64(feeRate))
 
+		// The max fee rate caps the fee negotiated with the remote
+		// peer, so it can't be lower than the fee rate we start the
+		// negotiation with.
+		maxFeeRate := chainfee.AtomPerKByte(in.MaxFeePerByte * 1000)
+		if maxFeeRate != 0 && maxFeeRate < feeRate {
+			return fmt.Errorf("max fee rate of %v atom/kB is lower "+
+				"than target fee rate of %v atom/kB",
+				int64(maxFeeRate), int64(feeRate))
+		}
+
 		// Before we attempt the cooperative channel closure, we'll
 		// examine the channel to ensure that it doesn't have a
 		// lingering HTLC.
@@ -2288,7 +2448,8 @@ func (r *rpcServer) CloseChannel(in *lnrpc.CloseChannelRequest,
 		}
 
 		updateChan, errChan = r.server.htlcSwitch.CloseLink(
-			chanPoint, htlcswitch.CloseRegular, feeRate, deliveryScript,
+			chanPoint, htlcswitch.CloseRegular, feeRate, maxFeeRate,
+			deliveryScript,
 		)
 	}
 out:
@@ -2450,24 +2611,37 @@ func (r *rpcServer) AbandonChannel(_ context.Context,
 		return nil, err
 	}
 
+	// Remove the channel from the database, graph and contract court.
+	if err := r.abandonChan(chanPoint, uint32(bestHeight)); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.AbandonChannelResponse{}, nil
+}
+
+// abandonChan removes a channel from the database, the graph and the contract
+// court, keeping only a close summary of it.
+func (r *rpcServer) abandonChan(chanPoint *wire.OutPoint,
+	bestHeight uint32) error {
+
 	// Abandoning a channel is a three step process: remove from the open
 	// channel state, remove from the graph, remove from the contract
 	// court. Between any step it's possible that the users restarts the
 	// process all over again. As a result, each of the steps below are
 	// intended to be idempotent.
-	err = r.server.remoteChanDB.AbandonChannel(chanPoint, uint32(bestHeight))
+	err := r.server.remoteChanDB.AbandonChannel(chanPoint, bestHeight)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = abandonChanFromGraph(
 		r.server.localChanDB.ChannelGraph(), chanPoint,
 	)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	err = r.server.chainArb.ResolveContract(*chanPoint)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	// If this channel was in the process of being closed, but didn't fully
@@ -2476,14 +2650,14 @@ func (r *rpcServer) AbandonChannel(_ context.Context,
 	// state for this channel from the nursery.
 	err = r.server.utxoNursery.cfg.Store.RemoveChannel(chanPoint)
 	if err != nil && err != ErrContractNotFound {
-		return nil, err
+		return err
 	}
 
 	// Finally, notify the backup listeners that the channel can be removed
 	// from any channel backups.
 	r.server.channelNotifier.NotifyClosedChannelEvent(*chanPoint)
 
-	return &lnrpc.AbandonChannelResponse{}, nil
+	return nil
 }
 
 // GetInfo returns general information concerning the lightning node including
@@ -2725,11 +2899,19 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 }
 
 // SubscribePeerEvents returns a uni-directional stream (server -> client)
-// for notifying the client of peer online and offline events.
+// for notifying the client of peer online and offline events. The events are
+// sent once the channel event store has recorded them, along with the updated
+// fitness of peers we have open channels with.
 func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
 	eventStream lnrpc.Lightning_SubscribePeerEventsServer) error {
 
-	peerEventSub, err := r.server.peerNotifier.SubscribePeerEvents()
+	// The channel event store only accepts subscriptions once it has been
+	// started along with the server.
+	if !r.server.Started() {
+		return ErrServerNotActive
+	}
+
+	peerEventSub, err := r.server.chanEventStore.SubscribeFitnessEvents()
 	if err != nil {
 		return err
 	}
@@ -2737,32 +2919,186 @@ func (r *rpcServer) SubscribePeerEvents(req *lnrpc.PeerEventSubscription,
 
 	for {
 		select {
-		// A new update has been sent by the peer notifier, we'll
+		// A new update has been sent by the channel event store, we'll
 		// marshal it into the form expected by the gRPC client, then
 		// send it off to the client.
 		case e := <-peerEventSub.Updates():
-			var event *lnrpc.PeerEvent
+			peerEvent, ok := e.(chanfitness.PeerFitnessEvent)
+			if !ok {
+				return fmt.Errorf("unexpected peer event: %v", e)
+			}
 
-			switch peerEvent := e.(type) {
-			case peernotifier.PeerOfflineEvent:
-				event = &lnrpc.PeerEvent{
-					PubKey: hex.EncodeToString(peerEvent.PubKey[:]),
-					Type:   lnrpc.PeerEvent_PEER_OFFLINE,
-				}
+			event := &lnrpc.PeerEvent{
+				PubKey: hex.EncodeToString(peerEvent.Peer[:]),
+				Type:   lnrpc.PeerEvent_PEER_OFFLINE,
+			}
+			if peerEvent.Online {
+				event.Type = lnrpc.PeerEvent_PEER_ONLINE
+			}
+			if peerEvent.Fitness != nil {
+				event.Fitness = marshalPeerFitness(
+					peerEvent.Fitness,
+				)
+			}
 
-			case peernotifier.PeerOnlineEvent:
-				event = &lnrpc.PeerEvent{
-					PubKey: hex.EncodeToString(peerEvent.PubKey[:]),
-					Type:   lnrpc.PeerEvent_PEER_ONLINE,
-				}
+			if err := eventStream.Send(event); err != nil {
+				return err
+			}
+		case <-r.quit:
+			return nil
+		}
+	}
+}
 
-			default:
-				return fmt.Errorf("unexpected peer event: %v", event)
+// PeerFitness returns the fitness of the peers we have open channels with,
+// along with the fitness of each of their channels.
+func (r *rpcServer) PeerFitness(ctx context.Context,
+	req *lnrpc.PeerFitnessRequest) (*lnrpc.PeerFitnessResponse, error) {
+
+	// The channel event store won't consume any requests until it has been
+	// started along with the server.
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	var (
+		peers []*chanfitness.PeerFitness
+		err   error
+	)
+	if len(req.PubKey) == 0 {
+		peers, err = r.server.chanEventStore.GetAllPeerFitness()
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		peer, err := route.NewVertexFromBytes(req.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		fitness, err := r.server.chanEventStore.GetPeerFitness(peer)
+		if err != nil {
+			return nil, err
+		}
+		peers = append(peers, fitness)
+	}
+
+	resp := &lnrpc.PeerFitnessResponse{
+		Peers: make([]*lnrpc.PeerFitnessInfo, 0, len(peers)),
+	}
+	for _, fitness := range peers {
+		resp.Peers = append(resp.Peers, marshalPeerFitness(fitness))
+	}
+
+	return resp, nil
+}
+
+// marshalPeerFitness converts the fitness of a peer and its channels into
+// its RPC representation.
+func marshalPeerFitness(
+	fitness *chanfitness.PeerFitness) *lnrpc.PeerFitnessInfo {
+
+	unixTime := func(t time.Time) int64 {
+		if t.IsZero() {
+			return 0
+		}
+		return t.Unix()
+	}
+
+	rpcFitness := &lnrpc.PeerFitnessInfo{
+		PubKey:         hex.EncodeToString(fitness.Peer[:]),
+		Online:         fitness.Online,
+		FlapCount:      fitness.FlapCount,
+		LastFlap:       unixTime(fitness.LastFlap),
+		OfflineTime:    int64(fitness.OfflineTime.Seconds()),
+		LongestOffline: int64(fitness.LongestOffline.Seconds()),
+		OfflineSince:   unixTime(fitness.OfflineSince),
+		Channels: make(
+			[]*lnrpc.ChannelFitnessInfo, 0, len(fitness.Channels),
+		),
+		Score: fitness.Score,
+	}
+
+	for _, channel := range fitness.Channels {
+		rpcFitness.Channels = append(
+			rpcFitness.Channels, &lnrpc.ChannelFitnessInfo{
+				ChannelPoint: channel.ChannelPoint.String(),
+				Lifetime:     int64(channel.Lifespan.Seconds()),
+				Uptime:       int64(channel.Uptime.Seconds()),
+				FlapCount:    channel.FlapCount,
+				Score:        channel.Score,
+			},
+		)
+	}
+
+	return rpcFitness
+}
+
+// SendCustomMessage sends a message within the custom message type range to a
+// connected peer.
+func (r *rpcServer) SendCustomMessage(ctx context.Context,
+	req *lnrpc.SendCustomMessageRequest) (*lnrpc.SendCustomMessageResponse,
+	error) {
+
+	if len(req.Peer) != 33 {
+		return nil, fmt.Errorf("invalid peer public key length %d, "+
+			"must be 33", len(req.Peer))
+	}
+	if req.Type > math.MaxUint16 {
+		return nil, fmt.Errorf("message type %d exceeds the maximum "+
+			"of %d", req.Type, math.MaxUint16)
+	}
+
+	var peer [33]byte
+	copy(peer[:], req.Peer)
+
+	err := r.server.SendCustomMessage(
+		peer, lnwire.MessageType(req.Type), req.Data,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SendCustomMessageResponse{}, nil
+}
+
+// SubscribeCustomMessages returns a uni-directional stream (server -> client)
+// of the custom messages received from our peers.
+func (r *rpcServer) SubscribeCustomMessages(
+	req *lnrpc.SubscribeCustomMessageRequest,
+	stream lnrpc.Lightning_SubscribeCustomMessagesServer) error {
+
+	client, err := r.server.SubscribeCustomMessages()
+	if err != nil {
+		return err
+	}
+	defer client.Cancel()
+
+	for {
+		select {
+		case update := <-client.Updates():
+			msg, ok := update.(*CustomMessage)
+			if !ok {
+				return fmt.Errorf("unexpected custom message "+
+					"update: %T", update)
 			}
 
-			if err := eventStream.Send(event); err != nil {
+			err := stream.Send(&lnrpc.CustomMessage{
+				Peer: msg.Peer[:],
+				Type: uint32(msg.Msg.Type),
+				Data: msg.Msg.Data,
+			})
+			if err != nil {
 				return err
 			}
+
+		case <-client.Quit():
+			return errors.New("custom message subscription " +
+				"canceled")
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+
 		case <-r.quit:
 			return nil
 		}
@@ -3327,6 +3663,74 @@ func (r *rpcServer) ClosedChannels(ctx context.Context,
 	return resp, nil
 }
 
+// ChannelUptime returns the time the remote peer of a channel was online over
+// a time window, which is clipped to the channel's lifetime.
+func (r *rpcServer) ChannelUptime(ctx context.Context,
+	in *lnrpc.ChannelUptimeRequest) (*lnrpc.ChannelUptimeResponse, error) {
+
+	// The channel event store won't consume any requests until it has been
+	// started along with the server.
+	if !r.server.Started() {
+		return nil, ErrServerNotActive
+	}
+
+	if in.ChanPoint == nil {
+		return nil, fmt.Errorf("channel point must be specified")
+	}
+	txid, err := GetChanPointFundingTxid(in.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.NewOutPoint(
+		txid, in.ChanPoint.OutputIndex, wire.TxTreeRegular,
+	)
+
+	openedAt, closedAt, err := r.server.chanEventStore.GetLifespan(
+		*chanPoint,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if closedAt.IsZero() {
+		closedAt = time.Now()
+	}
+
+	startTime := openedAt
+	if in.StartTime != 0 {
+		start := time.Unix(in.StartTime, 0)
+		if start.After(startTime) {
+			startTime = start
+		}
+	}
+
+	endTime := closedAt
+	if in.EndTime != 0 {
+		end := time.Unix(in.EndTime, 0)
+		if end.Before(endTime) {
+			endTime = end
+		}
+	}
+
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("window is outside the channel's "+
+			"lifetime from %v to %v", openedAt, closedAt)
+	}
+
+	uptime, err := r.server.chanEventStore.GetUptime(
+		*chanPoint, startTime, endTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ChannelUptimeResponse{
+		Uptime:    int64(uptime.Seconds()),
+		Monitored: int64(endTime.Sub(startTime).Seconds()),
+		StartTime: startTime.Unix(),
+		EndTime:   endTime.Unix(),
+	}, nil
+}
+
 // ListChannels returns a description of all the open channels that this node
 // is a participant in.
 func (r *rpcServer) ListChannels(ctx context.Context,
@@ -3514,6 +3918,12 @@ func createRPCOpenChannel(r *rpcServer, graph *channeldb.ChannelGraph,
 		CsvDelay:               uint32(dbChannel.LocalChanCfg.CsvDelay),
 		LocalChanReserveAtoms:  int64(dbChannel.LocalChanCfg.ChanReserve),
 		RemoteChanReserveAtoms: int64(dbChannel.RemoteChanCfg.ChanReserve),
+		ZeroConf:               dbChannel.ChanType.IsZeroConf(),
+	}
+
+	if dbChannel.ZeroConfConfirmed() {
+		realScid := dbChannel.ZeroConfRealScid()
+		channel.ZeroConfConfirmedScid = realScid.ToUint64()
 	}
 
 	for i, htlc := range localCommit.Htlcs {
@@ -3936,6 +4346,7 @@ func (r *rpcServer) SubscribeChannelEvents(req *lnrpc.ChannelEventSubscription,
 // execute sendPayment. We use this struct as a sort of bridge to enable code
 // re-use between SendPayment and SendToRoute.
 type paymentStream struct {
+	ctx  context.Context
 	recv func() (*rpcPaymentRequest, error)
 	send func(*lnrpc.SendResponse) error
 }
@@ -3955,6 +4366,7 @@ func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error
 	var lock sync.Mutex
 
 	return r.sendPayment(&paymentStream{
+		ctx: stream.Context(),
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -3983,6 +4395,7 @@ func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error
 	var lock sync.Mutex
 
 	return r.sendPayment(&paymentStream{
+		ctx: stream.Context(),
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -4374,7 +4787,7 @@ func (r *rpcServer) checkCanSendPayment(payIntent *rpcPaymentIntent) error {
 // pre-built route. The first error this method returns denotes if we were
 // unable to save the payment. The second error returned denotes if the payment
 // didn't succeed.
-func (r *rpcServer) dispatchPaymentIntent(
+func (r *rpcServer) dispatchPaymentIntent(ctx context.Context,
 	payIntent *rpcPaymentIntent) (*paymentIntentResponse, error) {
 
 	// Perform a pre-flight check for sending this payment.
@@ -4384,6 +4797,21 @@ func (r *rpcServer) dispatchPaymentIntent(
 		}, nil
 	}
 
+	// If the call was made with a macaroon that is bound to an account,
+	// the maximum amount the payment can cost is reserved from it.
+	maxAmt := payIntent.mat + payIntent.feeLimit
+	if payIntent.route != nil {
+		maxAmt = payIntent.route.TotalAmount
+	}
+	reservation, err := r.server.accounts.ReservePayment(
+		ctx, payIntent.rHash, maxAmt,
+	)
+	if err != nil {
+		return &paymentIntentResponse{
+			Err: err,
+		}, nil
+	}
+
 	// Construct a payment request to send to the channel router. If the
 	// payment is successful, the route chosen will be returned. Otherwise,
 	// we'll get a non-nil error.
@@ -4437,12 +4865,15 @@ func (r *rpcServer) dispatchPaymentIntent(
 	// routing err.
 	if routerErr != nil {
 		rpcsLog.Warnf("Unable to send payment: %v", routerErr)
+		reservation.Release()
 
 		return &paymentIntentResponse{
 			Err: routerErr,
 		}, nil
 	}
 
+	reservation.Settle(route.TotalAmount)
+
 	return &paymentIntentResponse{
 		Route:    route,
 		Preimage: preImage,
@@ -4596,7 +5027,7 @@ sendLoop:
 				}()
 
 				resp, saveErr := r.dispatchPaymentIntent(
-					payIntent,
+					stream.ctx, payIntent,
 				)
 
 				switch {
@@ -4719,7 +5150,7 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 
 	// With the payment validated, we'll now attempt to dispatch the
 	// payment.
-	resp, saveErr := r.dispatchPaymentIntent(&payIntent)
+	resp, saveErr := r.dispatchPaymentIntent(ctx, &payIntent)
 	switch {
 	case saveErr != nil:
 		return nil, saveErr
@@ -4847,6 +5278,9 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		GenInvoiceFeatures: func() *lnwire.FeatureVector {
 			return r.server.featureMgr.Get(feature.SetInvoice)
 		},
+		GenAmpInvoiceFeatures: func() *lnwire.FeatureVector {
+			return r.server.featureMgr.Get(feature.SetInvoiceAmp)
+		},
 	}
 
 	value, err := lnrpc.UnmarshallAmt(invoice.Value, invoice.ValueMAtoms)
@@ -4862,6 +5296,7 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		FallbackAddr:    invoice.FallbackAddr,
 		CltvExpiry:      invoice.CltvExpiry,
 		Private:         invoice.Private,
+		Amp:             invoice.IsAmp,
 	}
 
 	if invoice.RPreimage != nil {
@@ -4872,6 +5307,17 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		addInvoiceData.Preimage = &preimage
 	}
 
+	// AMP invoices can't be credited to an account, as their payment
+	// hashes aren't known in advance.
+	_, isAccount, err := accounts.AccountFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if isAccount && invoice.IsAmp {
+		return nil, fmt.Errorf("AMP invoices can't be added with an " +
+			"account macaroon")
+	}
+
 	hash, dbInvoice, err := invoicesrpc.AddInvoice(
 		ctx, addInvoiceCfg, addInvoiceData,
 	)
@@ -4879,6 +5325,12 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 		return nil, err
 	}
 
+	// If the call was made with a macaroon that is bound to an account,
+	// the invoice is credited to the account once it is settled.
+	if err := r.server.accounts.AddInvoice(ctx, *hash); err != nil {
+		return nil, err
+	}
+
 	return &lnrpc.AddInvoiceResponse{
 		AddIndex:       dbInvoice.AddIndex,
 		PaymentRequest: string(dbInvoice.PaymentRequest),
@@ -5228,6 +5680,7 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			Disabled:           c1.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
 			LastUpdate:         uint32(c1.LastUpdate.Unix()),
 		}
+		marshalInboundFee(c1, edge.Node1Policy)
 	}
 
 	if c2 != nil {
@@ -5240,11 +5693,26 @@ func marshalDbEdge(edgeInfo *channeldb.ChannelEdgeInfo,
 			Disabled:           c2.ChannelFlags&lnwire.ChanUpdateDisabled != 0,
 			LastUpdate:         uint32(c2.LastUpdate.Unix()),
 		}
+		marshalInboundFee(c2, edge.Node2Policy)
 	}
 
 	return edge
 }
 
+// marshalInboundFee sets the inbound fee that is announced in the given policy
+// on its rpc representation. Inbound fees that can't be parsed are left out.
+func marshalInboundFee(policy *channeldb.ChannelEdgePolicy,
+	rpcPolicy *lnrpc.RoutingPolicy) {
+
+	inboundFee, err := policy.InboundFee()
+	if err != nil || inboundFee == nil {
+		return
+	}
+
+	rpcPolicy.InboundFeeBaseMAtoms = inboundFee.BaseFee
+	rpcPolicy.InboundFeeRateMilliMAtoms = inboundFee.FeeRate
+}
+
 // GetNodeMetrics returns all available node metrics calculated from the
 // current channel graph.
 func (r *rpcServer) GetNodeMetrics(ctx context.Context,
@@ -6047,6 +6515,10 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	feeSchema := routing.FeeSchema{
 		BaseFee: baseFeeMAtoms,
 		FeeRate: feeRateFixed,
+		InboundFee: lnwire.InboundFee{
+			BaseFee: req.InboundBaseFeeMAtoms,
+			FeeRate: req.InboundFeeRatePpm,
+		},
 	}
 
 	maxHtlc := lnwire.MilliAtom(req.MaxHtlcMAtoms)
@@ -6065,10 +6537,18 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 
 	rpcsLog.Debugf("[updatechanpolicy] updating channel policy base_fee=%v, "+
 		"rate_float=%v, rate_fixed=%v, time_lock_delta: %v, "+
-		"min_htlc=%v, max_htlc=%v, targets=%v",
+		"min_htlc=%v, max_htlc=%v, inbound_base_fee=%v, "+
+		"inbound_fee_rate=%v, targets=%v",
 		req.BaseFeeMAtoms, req.FeeRate, feeRateFixed, req.TimeLockDelta,
-		minHtlc, maxHtlc,
-		spew.Sdump(targetChans))
+		minHtlc, maxHtlc, req.InboundBaseFeeMAtoms,
+		req.InboundFeeRatePpm, spew.Sdump(targetChans))
+
+	// If the fee manager is active, we'll let it know that the policy of
+	// the target channel(s) is updated manually, so it doesn't overwrite
+	// the new policy right away.
+	if r.server.feeManager != nil {
+		r.server.feeManager.NotifyManualUpdate(targetChans...)
+	}
 
 	// With the scope resolved, we'll now send this to the local channel
 	// manager so it can propagate the new policy for our target channel(s).
@@ -6080,6 +6560,126 @@ func (r *rpcServer) UpdateChannelPolicy(ctx context.Context,
 	return &lnrpc.PolicyUpdateResponse{}, nil
 }
 
+// FeeManagerStatus returns the fees of all channels along with the decisions
+// of the automated fee manager about them.
+func (r *rpcServer) FeeManagerStatus(ctx context.Context,
+	_ *lnrpc.FeeManagerStatusRequest) (*lnrpc.FeeManagerStatusResponse,
+	error) {
+
+	if r.server.feeManager == nil {
+		return &lnrpc.FeeManagerStatusResponse{}, nil
+	}
+
+	statuses, err := r.server.feeManager.ChannelStatuses()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.FeeManagerStatusResponse{
+		Active:   true,
+		Channels: make([]*lnrpc.FeeManagerChannel, 0, len(statuses)),
+	}
+	for _, status := range statuses {
+		channel := &lnrpc.FeeManagerChannel{
+			ChanId:             status.ChanID.ToUint64(),
+			ChannelPoint:       status.ChanPoint.String(),
+			Capacity:           int64(status.Capacity),
+			LocalBalanceMAtoms: int64(status.LocalBalance),
+			ProjectedLocalBalanceMAtoms: int64(
+				status.ProjectedBalance,
+			),
+			BaseFeeMAtoms:       int64(status.BaseFee),
+			FeePerMil:           int64(status.FeeRate),
+			TargetBaseFeeMAtoms: int64(status.TargetBaseFee),
+			TargetFeePerMil:     int64(status.TargetFeeRate),
+			LastUpdate:          status.LastUpdate.Unix(),
+			UpdatePending:       status.UpdatePending,
+		}
+
+		if status.Override != nil {
+			mode := lnrpc.FeeOverrideMode_FEE_OVERRIDE_EXCLUDE
+			if status.Override.Mode == channeldb.FeeOverridePin {
+				mode = lnrpc.FeeOverrideMode_FEE_OVERRIDE_PIN
+			}
+			channel.OverrideMode = mode
+		}
+
+		if !status.HeldUntil.IsZero() {
+			channel.HeldUntil = status.HeldUntil.Unix()
+		}
+
+		resp.Channels = append(resp.Channels, channel)
+	}
+
+	return resp, nil
+}
+
+// SetFeeManagerOverride overrides the decisions of the automated fee manager
+// for a channel.
+func (r *rpcServer) SetFeeManagerOverride(ctx context.Context,
+	req *lnrpc.FeeManagerOverrideRequest) (*lnrpc.FeeManagerOverrideResponse,
+	error) {
+
+	if r.server.feeManager == nil {
+		return nil, fmt.Errorf("fee manager is not active")
+	}
+
+	if req.ChanPoint == nil {
+		return nil, fmt.Errorf("chan_point must be specified")
+	}
+	txid, err := GetChanPointFundingTxid(req.ChanPoint)
+	if err != nil {
+		return nil, err
+	}
+	chanPoint := wire.OutPoint{
+		Hash:  *txid,
+		Index: req.ChanPoint.OutputIndex,
+	}
+
+	var override *channeldb.FeeOverride
+	switch req.Mode {
+	// Removing the override returns the channel to automated fee
+	// management.
+	case lnrpc.FeeOverrideMode_FEE_OVERRIDE_AUTO:
+
+	case lnrpc.FeeOverrideMode_FEE_OVERRIDE_EXCLUDE:
+		override = &channeldb.FeeOverride{
+			Mode:      channeldb.FeeOverrideExclude,
+			CreatedAt: time.Now(),
+		}
+
+	case lnrpc.FeeOverrideMode_FEE_OVERRIDE_PIN:
+		if req.BaseFeeMAtoms < 0 {
+			return nil, fmt.Errorf("base fee cannot be negative")
+		}
+		if req.FeePerMil < 0 || req.FeePerMil > math.MaxUint32 {
+			return nil, fmt.Errorf("invalid fee rate of %v",
+				req.FeePerMil)
+		}
+
+		override = &channeldb.FeeOverride{
+			Mode:      channeldb.FeeOverridePin,
+			BaseFee:   lnwire.MilliAtom(req.BaseFeeMAtoms),
+			FeeRate:   uint32(req.FeePerMil),
+			CreatedAt: time.Now(),
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown override mode: %v", req.Mode)
+	}
+
+	rpcsLog.Debugf("[feemanageroverride] chan_point=%v, mode=%v, "+
+		"base_fee=%v, fee_rate=%v", chanPoint, req.Mode,
+		req.BaseFeeMAtoms, req.FeePerMil)
+
+	err = r.server.feeManager.SetOverride(chanPoint, override)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.FeeManagerOverrideResponse{}, nil
+}
+
 // ForwardingHistory allows the caller to query the htlcswitch for a record of
 // all HTLC's forwarded within the target time range, and integer offset within
 // that time range. If no time-range is specified, then the first chunk of the
@@ -6519,7 +7119,7 @@ func (r *rpcServer) SubscribeChannelBackups(req *lnrpc.ChannelBackupSubscription
 // RPCServer.
 type chanAcceptInfo struct {
 	chanReq      *chanacceptor.ChannelAcceptRequest
-	responseChan chan bool
+	responseChan chan *chanacceptor.ChannelAcceptResponse
 }
 
 // ChannelAcceptor dispatches a bi-directional streaming RPC in which
@@ -6541,8 +7141,11 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 
 	// demultiplexReq is a closure that will be passed to the RPCAcceptor and
 	// acts as an intermediary between the RPCAcceptor and the RPCServer.
-	demultiplexReq := func(req *chanacceptor.ChannelAcceptRequest) bool {
-		respChan := make(chan bool, 1)
+	demultiplexReq := func(
+		req *chanacceptor.ChannelAcceptRequest) *chanacceptor.ChannelAcceptResponse {
+
+		reject := &chanacceptor.ChannelAcceptResponse{}
+		respChan := make(chan *chanacceptor.ChannelAcceptResponse, 1)
 
 		newRequest := &chanAcceptInfo{
 			chanReq:      req,
@@ -6558,11 +7161,11 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		case <-timeout:
 			rpcsLog.Errorf("RPCAcceptor returned false - reached timeout of %d",
 				r.cfg.AcceptorTimeout)
-			return false
+			return reject
 		case <-quit:
-			return false
+			return reject
 		case <-r.quit:
-			return false
+			return reject
 		}
 
 		// Receive the response and return it. If no response has been received
@@ -6573,11 +7176,11 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		case <-timeout:
 			rpcsLog.Errorf("RPCAcceptor returned false - reached timeout of %d",
 				r.cfg.AcceptorTimeout)
-			return false
+			return reject
 		case <-quit:
-			return false
+			return reject
 		case <-r.quit:
-			return false
+			return reject
 		}
 	}
 
@@ -6610,6 +7213,7 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 			openChanResp := &lnrpc.ChannelAcceptResponse{
 				Accept:        resp.Accept,
 				PendingChanId: pendingID[:],
+				ZeroConf:      resp.ZeroConf,
 			}
 
 			// Now that we have the response from the RPC client, send it to
@@ -6624,7 +7228,9 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 		}
 	}()
 
-	acceptRequests := make(map[[32]byte]chan bool)
+	acceptRequests := make(
+		map[[32]byte]chan *chanacceptor.ChannelAcceptResponse,
+	)
 
 	for {
 		select {
@@ -6650,6 +7256,7 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 				CsvDelay:         uint32(req.OpenChanMsg.CsvDelay),
 				MaxAcceptedHtlcs: uint32(req.OpenChanMsg.MaxAcceptedHTLCs),
 				ChannelFlags:     uint32(req.OpenChanMsg.ChannelFlags),
+				WantsZeroConf:    req.WantsZeroConf(),
 			}
 
 			if err := stream.Send(chanAcceptReq); err != nil {
@@ -6665,8 +7272,11 @@ func (r *rpcServer) ChannelAcceptor(stream lnrpc.Lightning_ChannelAcceptorServer
 				continue
 			}
 
-			// Send the response boolean over the buffered response channel.
-			respChan <- resp.Accept
+			// Send the response over the buffered response channel.
+			respChan <- &chanacceptor.ChannelAcceptResponse{
+				Accept:   resp.Accept,
+				ZeroConf: resp.ZeroConf,
+			}
 
 			// Delete the channel from the acceptRequests map.
 			delete(acceptRequests, pendingID)
@@ -6847,6 +7457,183 @@ func (r *rpcServer) ListPermissions(_ context.Context,
 	}, nil
 }
 
+// RegisterRPCMiddleware adds a new gRPC middleware to the interceptor chain.
+// The middleware is removed from the chain once the stream is closed.
+func (r *rpcServer) RegisterRPCMiddleware(
+	stream lnrpc.Lightning_RegisterRPCMiddlewareServer) error {
+
+	if r.middlewareChain == nil {
+		return fmt.Errorf("RPC middleware not enabled in config")
+	}
+
+	// The first message the middleware sends must be its registration.
+	// We wait for it in a goroutine, so we can time out if the
+	// middleware never sends it.
+	registerChan := make(chan *lnrpc.MiddlewareRegistration, 1)
+	errChan := make(chan error, 1)
+	go func() {
+		msg, err := stream.Recv()
+		if err != nil {
+			errChan <- err
+			return
+		}
+
+		registration := msg.GetRegister()
+		if registration == nil {
+			errChan <- fmt.Errorf("invalid initial middleware " +
+				"registration message")
+			return
+		}
+
+		registerChan <- registration
+	}()
+
+	var registration *lnrpc.MiddlewareRegistration
+	select {
+	case registration = <-registerChan:
+	case err := <-errChan:
+		return err
+	case <-time.After(r.cfg.RPCMiddleware.InterceptTimeout):
+		return fmt.Errorf("didn't receive middleware registration " +
+			"in time")
+	case <-r.quit:
+		return ErrServerShuttingDown
+	}
+
+	switch {
+	case registration.MiddlewareName == "":
+		return fmt.Errorf("middleware name cannot be empty")
+
+	case registration.ReadOnlyMode &&
+		registration.CustomMacaroonCaveatName != "":
+
+		return fmt.Errorf("read-only middleware cannot register for " +
+			"a custom macaroon caveat")
+	}
+
+	rpcsLog.Debugf("[registerrpcmiddleware] name=%v, caveat=%v, "+
+		"read_only=%v", registration.MiddlewareName,
+		registration.CustomMacaroonCaveatName,
+		registration.ReadOnlyMode)
+
+	middleware := rpcperms.NewMiddlewareHandler(
+		registration.MiddlewareName,
+		registration.CustomMacaroonCaveatName,
+		registration.ReadOnlyMode, r.cfg.RPCMiddleware.InterceptTimeout,
+		stream,
+	)
+	err := r.middlewareChain.RegisterMiddleware(middleware)
+	if err != nil {
+		return err
+	}
+	defer r.middlewareChain.RemoveMiddleware(registration.MiddlewareName)
+
+	return middleware.Run()
+}
+
+// CreateAccount creates a new account with the given balance that macaroons
+// can be bound to.
+func (r *rpcServer) CreateAccount(_ context.Context,
+	req *lnrpc.CreateAccountRequest) (*lnrpc.Account, error) {
+
+	rpcsLog.Debugf("[createaccount] balance=%v, expiration_date=%v",
+		req.AccountBalanceMAtoms, req.ExpirationDate)
+
+	if req.ExpirationDate < 0 {
+		return nil, fmt.Errorf("expiration date cannot be negative")
+	}
+
+	var expirationDate time.Time
+	if req.ExpirationDate != 0 {
+		expirationDate = time.Unix(req.ExpirationDate, 0)
+		if expirationDate.Before(time.Now()) {
+			return nil, fmt.Errorf("expiration date must be in " +
+				"the future")
+		}
+	}
+
+	account, err := r.server.accounts.NewAccount(
+		lnwire.MilliAtom(req.AccountBalanceMAtoms), expirationDate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return marshallAccount(account), nil
+}
+
+// ListAccounts returns all accounts along with their current balance.
+func (r *rpcServer) ListAccounts(_ context.Context,
+	_ *lnrpc.ListAccountsRequest) (*lnrpc.ListAccountsResponse, error) {
+
+	rpcsLog.Debugf("[listaccounts]")
+
+	allAccounts, err := r.server.accounts.Accounts()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.ListAccountsResponse{
+		Accounts: make([]*lnrpc.Account, 0, len(allAccounts)),
+	}
+	for _, account := range allAccounts {
+		resp.Accounts = append(resp.Accounts, marshallAccount(account))
+	}
+
+	return resp, nil
+}
+
+// RemoveAccount removes an account. Macaroons bound to the account can't make
+// any payments afterwards.
+func (r *rpcServer) RemoveAccount(_ context.Context,
+	req *lnrpc.RemoveAccountRequest) (*lnrpc.RemoveAccountResponse, error) {
+
+	rpcsLog.Debugf("[removeaccount] id=%v", req.Id)
+
+	idBytes, err := hex.DecodeString(req.Id)
+	if err != nil || len(idBytes) != len(channeldb.AccountID{}) {
+		return nil, fmt.Errorf("invalid account ID %q", req.Id)
+	}
+
+	var id channeldb.AccountID
+	copy(id[:], idBytes)
+	if err := r.server.accounts.RemoveAccount(id); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.RemoveAccountResponse{}, nil
+}
+
+// marshallAccount converts an account into its RPC representation.
+func marshallAccount(account *channeldb.Account) *lnrpc.Account {
+	rpcAccount := &lnrpc.Account{
+		Id:                   hex.EncodeToString(account.ID[:]),
+		InitialBalanceMAtoms: uint64(account.InitialBalance),
+		CurrentBalanceMAtoms: uint64(account.CurrentBalance),
+		LastUpdate:           account.LastUpdate.Unix(),
+	}
+
+	if !account.ExpirationDate.IsZero() {
+		rpcAccount.ExpirationDate = account.ExpirationDate.Unix()
+	}
+
+	for hash := range account.Invoices {
+		hash := hash
+		rpcAccount.PendingInvoices = append(
+			rpcAccount.PendingInvoices, hash[:],
+		)
+	}
+
+	for hash := range account.Payments {
+		hash := hash
+		rpcAccount.PendingPayments = append(
+			rpcAccount.PendingPayments, hash[:],
+		)
+	}
+
+	return rpcAccount
+}
+
 // FundingStateStep is an advanced funding related call that allows the caller
 // to either execute some preparatory steps for a funding workflow, or manually
 // progress a funding workflow. The primary way a funding flow is identified is
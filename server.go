@@ -22,6 +22,7 @@ import (
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/accounts"
 	"github.com/decred/dcrlnd/autopilot"
 	"github.com/decred/dcrlnd/brontide"
 	"github.com/decred/dcrlnd/chanacceptor"
@@ -34,6 +35,7 @@ import (
 	"github.com/decred/dcrlnd/contractcourt"
 	"github.com/decred/dcrlnd/discovery"
 	"github.com/decred/dcrlnd/feature"
+	"github.com/decred/dcrlnd/feemanager"
 	"github.com/decred/dcrlnd/healthcheck"
 	"github.com/decred/dcrlnd/htlcswitch"
 	"github.com/decred/dcrlnd/htlcswitch/hop"
@@ -57,10 +59,12 @@ import (
 	"github.com/decred/dcrlnd/routing"
 	"github.com/decred/dcrlnd/routing/localchans"
 	"github.com/decred/dcrlnd/routing/route"
+	"github.com/decred/dcrlnd/subscribe"
 	"github.com/decred/dcrlnd/sweep"
 	"github.com/decred/dcrlnd/ticker"
 	"github.com/decred/dcrlnd/tor"
 	"github.com/decred/dcrlnd/walletunlocker"
+	"github.com/decred/dcrlnd/watchtower/blob"
 	"github.com/decred/dcrlnd/watchtower/wtclient"
 	"github.com/decred/dcrlnd/watchtower/wtdb"
 	"github.com/decred/dcrlnd/watchtower/wtpolicy"
@@ -90,6 +94,11 @@ const (
 	// value used or a particular peer will be chosen between 0s and this
 	// value.
 	maxInitReconnectDelay = 30
+
+	// autoCloseConfTarget is the confirmation target used to determine the
+	// fee rate of cooperative closes that aren't requested by the user, but
+	// by the channel event store or the autopilot agent.
+	autoCloseConfTarget = 6
 )
 
 var (
@@ -208,16 +217,27 @@ type server struct {
 
 	remoteChanDB *channeldb.DB
 
+	// aliasStore stores the alias short channel ids of private channels.
+	aliasStore *channeldb.AliasStore
+
 	htlcSwitch *htlcswitch.Switch
 
 	interceptableSwitch *htlcswitch.InterceptableSwitch
 
 	invoices *invoices.InvoiceRegistry
 
+	// accounts enforces the balances of the accounts macaroons can be
+	// bound to.
+	accounts *accounts.Service
+
 	channelNotifier *channelnotifier.ChannelNotifier
 
 	peerNotifier *peernotifier.PeerNotifier
 
+	// customMessageServer notifies subscribers of custom messages that
+	// are received from our peers.
+	customMessageServer *subscribe.Server
+
 	htlcNotifier *htlcswitch.HtlcNotifier
 
 	witnessBeacon contractcourt.WitnessBeacon
@@ -244,6 +264,8 @@ type server struct {
 
 	towerClient wtclient.Client
 
+	anchorTowerClient wtclient.Client
+
 	connMgr *connmgr.ConnManager
 
 	sigPool *lnwallet.SigPool
@@ -274,6 +296,10 @@ type server struct {
 	// provide insights into their health and performance.
 	chanEventStore *chanfitness.ChannelEventStore
 
+	// feeManager adjusts the fees of our channels according to their
+	// balance and forwarding history. It is nil if it isn't active.
+	feeManager *feemanager.Manager
+
 	hostAnn *netann.HostAnnouncer
 
 	// livelinessMonitor monitors that lnd has access to critical resources.
@@ -399,6 +425,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NoStaticRemoteKey: cfg.ProtocolOptions.NoStaticRemoteKey(),
 		NoAnchors:         !cfg.ProtocolOptions.AnchorCommitments(),
 		NoWumbo:           !cfg.ProtocolOptions.Wumbo(),
+		NoZeroConf:        !cfg.ProtocolOptions.ZeroConf(),
 	})
 	if err != nil {
 		return nil, err
@@ -409,6 +436,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		HtlcHoldDuration:     invoices.DefaultHtlcHoldDuration,
 		Clock:                clock.NewDefaultClock(),
 		AcceptKeySend:        cfg.AcceptKeySend,
+		AcceptAMP:            cfg.AcceptAMP,
 		KeysendHoldTime:      cfg.KeysendHoldTime,
 	}
 
@@ -416,6 +444,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		cfg:            cfg,
 		localChanDB:    localChanDB,
 		remoteChanDB:   remoteChanDB,
+		aliasStore:     channeldb.NewAliasStore(remoteChanDB),
 		cc:             cc,
 		sigPool:        lnwallet.NewSigPool(cfg.Workers.Sig, cc.signer),
 		writePool:      writePool,
@@ -490,6 +519,8 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		SwitchPackager:         channeldb.NewSwitchPackager(),
 		ExtractErrorEncrypter:  s.sphinx.ExtractErrorEncrypter,
 		FetchLastChannelUpdate: s.fetchLastChanUpdate(),
+		FetchLocalAlias:        s.aliasStore.LocalAlias,
+		SignAliasUpdate:        s.signAliasUpdate,
 		Notifier:               s.cc.chainNotifier,
 		HtlcNotifier:           s.htlcNotifier,
 		FwdEventTicker:         ticker.New(htlcswitch.DefaultFwdEventInterval),
@@ -704,6 +735,12 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	// servers, the mission control instance itself can be moved there too.
 	routingConfig := routerrpc.GetRoutingConfig(cfg.SubRPCServers.RouterRPC)
 
+	estimator, err := routerrpc.GetEstimator(routingConfig)
+	if err != nil {
+		return nil, fmt.Errorf("can't create probability estimator: "+
+			"%v", err)
+	}
+
 	s.missionControl, err = routing.NewMissionControl(
 		remoteChanDB,
 		&routing.MissionControlConfig{
@@ -713,6 +750,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			AprioriWeight:           routingConfig.AprioriWeight,
 			SelfNode:                selfNode.PubKeyBytes,
 			MinFailureRelaxInterval: routing.DefaultMinFailureRelaxInterval,
+			Estimator:               estimator,
 		},
 	)
 	if err != nil {
@@ -742,6 +780,17 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 
 	s.controlTower = routing.NewControlTower(paymentControl)
 
+	s.accounts = accounts.NewService(&accounts.Config{
+		Store:            channeldb.NewAccountStore(remoteChanDB),
+		SubscribePayment: s.controlTower.SubscribePayment,
+		SubscribeInvoices: func() (*invoices.InvoiceSubscription,
+			error) {
+
+			return s.invoices.SubscribeNotifications(0, 0)
+		},
+		LookupInvoice: s.invoices.LookupInvoice,
+	})
+
 	s.chanRouter, err = routing.New(routing.Config{
 		Graph:              chanGraph,
 		Chain:              cc.chainIO,
@@ -826,8 +875,12 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		return nil, err
 	}
 
+	// The sweeper tracks its transactions in the mempool if the chain
+	// backend provides access to it.
+	mempool, _ := cc.chainIO.(sweep.Mempool)
 	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
 		FeeEstimator:   cc.feeEstimator,
+		Mempool:        mempool,
 		GenSweepScript: newSweepPkScriptGen(cc.wallet),
 		Signer:         cc.wallet.Cfg.Signer,
 		Wallet:         cc.wallet,
@@ -864,7 +917,7 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		// Instruct the switch to close the channel.  Provide no close out
 		// delivery script or target fee per kw because user input is not
 		// available when the remote peer closes the channel.
-		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, nil)
+		s.htlcSwitch.CloseLink(chanPoint, closureType, 0, 0, nil)
 	}
 
 	// We will use the following channel to reliably hand off contract
@@ -1160,6 +1213,12 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 		NotifyPendingOpenChannelEvent: s.channelNotifier.NotifyPendingOpenChannelEvent,
 		EnableUpfrontShutdown:         cfg.EnableUpfrontShutdown,
 		RegisteredChains:              cfg.registeredChains,
+		DeleteAliasEdge: func(alias lnwire.ShortChannelID) error {
+			return chanGraph.DeleteChannelEdges(alias.ToUint64())
+		},
+		NewLocalAlias:   s.aliasStore.NewLocalAlias,
+		PutPeerAlias:    s.aliasStore.PutPeerAlias,
+		AddAliasForLink: s.htlcSwitch.AddAliasForLink,
 	})
 	if err != nil {
 		return nil, err
@@ -1187,12 +1246,53 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 	// to peer online and offline events.
 	s.peerNotifier = peernotifier.New()
 
-	// Create a channel event store which monitors all open channels.
-	s.chanEventStore = chanfitness.NewChannelEventStore(&chanfitness.Config{
+	// Custom messages received from our peers are handed to the clients
+	// subscribed to the custom message server.
+	s.customMessageServer = subscribe.NewServer()
+
+	// Create a channel event store which monitors all open channels. If
+	// enabled, it closes channels whose peers turn out to be unreliable.
+	fitnessCfg := &chanfitness.Config{
 		SubscribeChannelEvents: s.channelNotifier.SubscribeChannelEvents,
 		SubscribePeerEvents:    s.peerNotifier.SubscribePeerEvents,
 		GetOpenChannels:        s.remoteChanDB.FetchAllOpenChannels,
-	})
+		FitnessDB:              channeldb.NewFitnessStore(remoteChanDB),
+		FlushTicker:            ticker.New(cfg.Fitness.FlushInterval),
+		ForceCloseOffline:      cfg.Fitness.ForceClose,
+		MinFitnessScore:        cfg.Fitness.MinScore,
+		MinCloseLifespan:       cfg.Fitness.MinLifespan,
+		UptimeRetention:        cfg.Fitness.UptimeRetention,
+		CompactionGap:          cfg.Fitness.CompactionGap,
+	}
+	if cfg.Fitness.AutoClose {
+		fitnessCfg.CloseChannel = s.autoCloseChannel
+	}
+	s.chanEventStore = chanfitness.NewChannelEventStore(fitnessCfg)
+
+	// Create the fee manager if enabled, which adjusts the fees of our
+	// channels according to their balance and forwarding history.
+	if cfg.FeeManager.Active {
+		feeCfg := cfg.FeeManager
+		feeStore := channeldb.NewFeeManagerStore(remoteChanDB)
+		s.feeManager = feemanager.New(&feemanager.Config{
+			FetchChannels:   s.remoteChanDB.FetchAllOpenChannels,
+			QueryForwards:   remoteChanDB.ForwardingLog().Query,
+			UpdatePolicy:    s.localChanMgr.UpdatePolicy,
+			Store:           feeStore,
+			Ticker:          ticker.New(feeCfg.Interval),
+			Clock:           clock.NewDefaultClock(),
+			MinBaseFee:      lnwire.MilliAtom(feeCfg.MinBaseFee),
+			MaxBaseFee:      lnwire.MilliAtom(feeCfg.MaxBaseFee),
+			MinFeeRate:      feeCfg.MinFeeRate,
+			MaxFeeRate:      feeCfg.MaxFeeRate,
+			FlowWindow:      feeCfg.FlowWindow,
+			UpdateInterval:  feeCfg.UpdateInterval,
+			ChangeThreshold: feeCfg.ChangeThreshold,
+			ManualHold:      feeCfg.ManualHold,
+			ForAllOutgoingChannels: s.chanRouter.
+				ForAllOutgoingChannels,
+		})
+	}
 
 	if cfg.WtClient.Active {
 		policy := wtpolicy.DefaultPolicy()
@@ -1223,6 +1323,43 @@ func newServer(cfg *Config, listenAddrs []net.Addr,
 			MinBackoff:     10 * time.Second,
 			MaxBackoff:     5 * time.Minute,
 			ForceQuitDelay: wtclient.DefaultForceQuitDelay,
+
+			SubscribeChannelEvents: s.channelNotifier.SubscribeChannelEvents,
+			FetchClosedChannel:     s.remoteChanDB.FetchClosedChannelForID,
+			ChainNotifier:          s.cc.chainNotifier,
+			SessionCloseRange:      cfg.WtClient.SessionCloseRange,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// Anchor channels are backed up in separate sessions, since
+		// the tower needs to know about the different to-remote
+		// output when sweeping a breach.
+		anchorPolicy := policy
+		anchorPolicy.TxPolicy.BlobType = blob.TypeAltruistAnchorCommit
+		if err := anchorPolicy.Validate(); err != nil {
+			return nil, err
+		}
+
+		s.anchorTowerClient, err = wtclient.New(&wtclient.Config{
+			ChainParams:    activeNetParams.Params,
+			Signer:         cc.wallet.Cfg.Signer,
+			NewAddress:     newSweepPkScriptGen(cc.wallet),
+			SecretKeyRing:  s.cc.keyRing,
+			Dial:           cfg.net.Dial,
+			AuthDial:       wtclient.AuthDial,
+			DB:             towerClientDB,
+			Policy:         anchorPolicy,
+			ChainHash:      activeNetParams.GenesisHash,
+			MinBackoff:     10 * time.Second,
+			MaxBackoff:     5 * time.Minute,
+			ForceQuitDelay: wtclient.DefaultForceQuitDelay,
+
+			SubscribeChannelEvents: s.channelNotifier.SubscribeChannelEvents,
+			FetchClosedChannel:     s.remoteChanDB.FetchClosedChannelForID,
+			ChainNotifier:          s.cc.chainNotifier,
+			SessionCloseRange:      cfg.WtClient.SessionCloseRange,
 		})
 		if err != nil {
 			return nil, err
@@ -1385,6 +1522,10 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if err := s.customMessageServer.Start(); err != nil {
+			startErr = err
+			return
+		}
 		if err := s.htlcNotifier.Start(); err != nil {
 			startErr = err
 			return
@@ -1399,6 +1540,13 @@ func (s *server) Start() error {
 				return
 			}
 		}
+		if s.anchorTowerClient != nil {
+			err := s.anchorTowerClient.Start()
+			if err != nil {
+				startErr = err
+				return
+			}
+		}
 		if err := s.htlcSwitch.Start(); err != nil {
 			startErr = err
 			return
@@ -1435,6 +1583,10 @@ func (s *server) Start() error {
 			startErr = err
 			return
 		}
+		if err := s.accounts.Start(); err != nil {
+			startErr = err
+			return
+		}
 		if err := s.chanStatusMgr.Start(); err != nil {
 			startErr = err
 			return
@@ -1445,6 +1597,13 @@ func (s *server) Start() error {
 			return
 		}
 
+		if s.feeManager != nil {
+			if err := s.feeManager.Start(); err != nil {
+				startErr = err
+				return
+			}
+		}
+
 		// Before we start the connMgr, we'll check to see if we have
 		// any backups to recover. We do this now as we want to ensure
 		// that have all the information we need to handle channel
@@ -1553,15 +1712,20 @@ func (s *server) Stop() error {
 		s.sweeper.Stop()
 		s.channelNotifier.Stop()
 		s.peerNotifier.Stop()
+		s.customMessageServer.Stop()
 		s.htlcNotifier.Stop()
 		s.cc.wallet.Shutdown()
 		s.cc.chainView.Stop()
 		s.connMgr.Stop()
 		s.cc.feeEstimator.Stop()
+		s.accounts.Stop()
 		s.invoices.Stop()
 		s.fundingMgr.Stop()
 		s.chanSubSwapper.Stop()
 		s.chanEventStore.Stop()
+		if s.feeManager != nil {
+			s.feeManager.Stop()
+		}
 
 		// Disconnect from each active peers to ensure that
 		// peerTerminationWatchers signal completion to each peer.
@@ -1582,6 +1746,9 @@ func (s *server) Stop() error {
 		if s.towerClient != nil {
 			s.towerClient.Stop()
 		}
+		if s.anchorTowerClient != nil {
+			s.anchorTowerClient.Stop()
+		}
 
 		if s.hostAnn != nil {
 			if err := s.hostAnn.Stop(); err != nil {
@@ -2554,6 +2721,71 @@ func (s *server) NotifyWhenOffline(peerPubKey [33]byte) <-chan struct{} {
 	return c
 }
 
+// autoCloseChannel closes a channel that the channel event store deemed unfit
+// or the autopilot agent deemed underperforming. Channels are closed
+// cooperatively unless force is set, in which case the channel's peer is
+// offline. The close is carried out in the background.
+func (s *server) autoCloseChannel(chanPoint wire.OutPoint, force bool) error {
+	if force {
+		// Make sure the switch no longer forwards HTLCs over the
+		// channel before we broadcast our commitment.
+		chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
+		s.htlcSwitch.RemoveLink(chanID)
+
+		closingTx, err := s.chainArb.ForceCloseContract(chanPoint)
+		if err != nil {
+			return err
+		}
+
+		srvrLog.Infof("Force closed ChannelPoint(%v) with tx %v",
+			chanPoint, closingTx.TxHash())
+
+		return nil
+	}
+
+	feeRate, err := sweep.DetermineFeePerKB(
+		s.cc.feeEstimator, sweep.FeePreference{
+			ConfTarget: autoCloseConfTarget,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	updateChan, errChan := s.htlcSwitch.CloseLink(
+		&chanPoint, htlcswitch.CloseRegular, feeRate, 0, nil,
+	)
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+
+		for {
+			select {
+			case err := <-errChan:
+				srvrLog.Errorf("Unable to cooperatively close "+
+					"ChannelPoint(%v): %v", chanPoint, err)
+				return
+
+			case update := <-updateChan:
+				_, ok := update.(*peer.ChannelCloseUpdate)
+				if !ok {
+					continue
+				}
+
+				srvrLog.Infof("Cooperatively closed "+
+					"ChannelPoint(%v)", chanPoint)
+				return
+
+			case <-s.quit:
+				return
+			}
+		}
+	}()
+
+	return nil
+}
+
 // FindPeer will return the peer that corresponds to the passed in public key.
 // This function is used by the funding manager, allowing it to update the
 // daemon's local representation of the remote peer.
@@ -2971,18 +3203,22 @@ func (s *server) peerConnected(conn net.Conn, connReq *connmgr.ConnReq,
 		ChannelNotifier:         s.channelNotifier,
 		HtlcNotifier:            s.htlcNotifier,
 		TowerClient:             s.towerClient,
+		AnchorTowerClient:       s.anchorTowerClient,
 		DisconnectPeer:          s.DisconnectPeer,
 		GenNodeAnnouncement:     s.genNodeAnnouncement,
 
 		PrunePersistentPeerConnection: s.prunePersistentPeerConnection,
 
 		FetchLastChanUpdate:   s.fetchLastChanUpdate(),
+		FetchLocalAlias:       s.aliasStore.LocalAlias,
+		SignAliasUpdate:       s.signAliasUpdate,
 		ProcessFundingOpen:    s.fundingMgr.processFundingOpen,
 		ProcessFundingAccept:  s.fundingMgr.processFundingAccept,
 		ProcessFundingCreated: s.fundingMgr.processFundingCreated,
 		ProcessFundingSigned:  s.fundingMgr.processFundingSigned,
 		ProcessFundingLocked:  s.fundingMgr.processFundingLocked,
 		ProcessFundingError:   s.fundingMgr.processFundingError,
+		HandleCustomMessage:   s.handleCustomMessage,
 		IsPendingChannel:      s.fundingMgr.IsPendingChannel,
 
 		Hodl:                    s.cfg.Hodl,
@@ -3373,6 +3609,10 @@ type openChanReq struct {
 
 	maxHtlcs uint16
 
+	// zeroConf indicates that a zero-conf channel is requested, which can
+	// be used before its funding transaction confirms.
+	zeroConf bool
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	// chanFunder is an optional channel funder that allows the caller to
@@ -3523,6 +3763,69 @@ func (s *server) DisconnectPeer(pubKey *secp256k1.PublicKey) error {
 	return nil
 }
 
+// CustomMessage is a message within the custom message type range that was
+// received from one of our peers.
+type CustomMessage struct {
+	// Peer is the compressed public key of the peer that sent the message.
+	Peer [33]byte
+
+	// Msg is the custom message that was received.
+	Msg *lnwire.Custom
+}
+
+// SendCustomMessage sends a message within the custom message type range to
+// the given peer. Only odd message types are allowed, so peers that don't
+// understand the message ignore it instead of closing the connection.
+//
+// NOTE: This function is safe for concurrent access.
+func (s *server) SendCustomMessage(peerPub [33]byte, msgType lnwire.MessageType,
+	data []byte) error {
+
+	if msgType%2 == 0 {
+		return fmt.Errorf("custom message type %d is not odd", msgType)
+	}
+
+	msg, err := lnwire.NewCustom(msgType, data)
+	if err != nil {
+		return err
+	}
+
+	peer, err := s.FindPeerByPubStr(string(peerPub[:]))
+	if err != nil {
+		return err
+	}
+
+	// Wait until the peer is fully started, as messages can't be sent
+	// before that.
+	select {
+	case <-peer.ActiveSignal():
+	case <-peer.QuitSignal():
+		return ErrPeerNotConnected
+	case <-s.quit:
+		return ErrServerShuttingDown
+	}
+
+	return peer.SendMessageLazy(true, msg)
+}
+
+// SubscribeCustomMessages returns a subscribe.Client that receives a
+// CustomMessage for every custom message received from any of our peers.
+func (s *server) SubscribeCustomMessages() (*subscribe.Client, error) {
+	return s.customMessageServer.Subscribe()
+}
+
+// handleCustomMessage hands a custom message received from a peer to the
+// clients subscribed to custom messages.
+func (s *server) handleCustomMessage(peer [33]byte, msg *lnwire.Custom) error {
+	srvrLog.Debugf("Received custom message of type %d from peer %x",
+		uint16(msg.Type), peer)
+
+	return s.customMessageServer.SendUpdate(&CustomMessage{
+		Peer: peer,
+		Msg:  msg,
+	})
+}
+
 // OpenChannel sends a request to the server to open a channel to the specified
 // peer identified by nodeKey with the passed channel funding parameters.
 //
@@ -3686,6 +3989,15 @@ func (s *server) fetchLastChanUpdate() func(lnwire.ShortChannelID) (
 	}
 }
 
+// signAliasUpdate signs the given channel update, which carries the alias we
+// handed out for a private channel instead of its real short channel id, with
+// our node key.
+func (s *server) signAliasUpdate(update *lnwire.ChannelUpdate) error {
+	return netann.SignChannelUpdate(
+		s.nodeSigner, s.identityECDH.PubKey(), update,
+	)
+}
+
 // applyChannelUpdate applies the channel update to the different sub-systems of
 // the server.
 func (s *server) applyChannelUpdate(update *lnwire.ChannelUpdate) error {
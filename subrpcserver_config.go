@@ -94,8 +94,10 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config, cc *chainControl
 	sweeper *sweep.UtxoSweeper,
 	tower *watchtower.Standalone,
 	towerClient wtclient.Client,
+	anchorTowerClient wtclient.Client,
 	tcpResolver lncfg.TCPResolver,
 	genInvoiceFeatures func() *lnwire.FeatureVector,
+	genAmpInvoiceFeatures func() *lnwire.FeatureVector,
 	rpcLogger slog.Logger) error {
 
 	// First, we'll use reflect to obtain a version of the config struct
@@ -150,6 +152,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config, cc *chainControl
 			subCfgValue.FieldByName("FeeEstimator").Set(
 				reflect.ValueOf(cc.feeEstimator),
 			)
+			subCfgValue.FieldByName("FeeEstimators").Set(
+				reflect.ValueOf(cc.feeEstimators),
+			)
 			subCfgValue.FieldByName("Wallet").Set(
 				reflect.ValueOf(cc.wallet),
 			)
@@ -220,6 +225,9 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config, cc *chainControl
 			subCfgValue.FieldByName("GenInvoiceFeatures").Set(
 				reflect.ValueOf(genInvoiceFeatures),
 			)
+			subCfgValue.FieldByName("GenAmpInvoiceFeatures").Set(
+				reflect.ValueOf(genAmpInvoiceFeatures),
+			)
 
 		// RouterRPC isn't conditionally compiled and doesn't need to be
 		// populated using reflection.
@@ -245,7 +253,13 @@ func (s *subRPCServerConfigs) PopulateDependencies(cfg *Config, cc *chainControl
 				subCfgValue.FieldByName("Client").Set(
 					reflect.ValueOf(towerClient),
 				)
+				subCfgValue.FieldByName("AnchorClient").Set(
+					reflect.ValueOf(anchorTowerClient),
+				)
 			}
+			subCfgValue.FieldByName("KeyRing").Set(
+				reflect.ValueOf(cc.keyRing),
+			)
 			subCfgValue.FieldByName("Resolver").Set(
 				reflect.ValueOf(tcpResolver),
 			)
@@ -140,6 +140,31 @@ func (b *mockBackend) mine() {
 	}
 }
 
+// MempoolSpends returns the unconfirmed transactions that spend any of the
+// given outpoints.
+func (b *mockBackend) MempoolSpends(outpoints []wire.OutPoint) (
+	map[wire.OutPoint]chainhash.Hash, error) {
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	wanted := make(map[wire.OutPoint]struct{}, len(outpoints))
+	for _, op := range outpoints {
+		wanted[op] = struct{}{}
+	}
+
+	spends := make(map[wire.OutPoint]chainhash.Hash)
+	for txHash, tx := range b.unconfirmedTxes {
+		for _, in := range tx.TxIn {
+			if _, ok := wanted[in.PreviousOutPoint]; ok {
+				spends[in.PreviousOutPoint] = txHash
+			}
+		}
+	}
+
+	return spends, nil
+}
+
 func (b *mockBackend) isDone() bool {
 	return len(b.unconfirmedTxes) == 0
 }
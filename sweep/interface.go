@@ -1,6 +1,7 @@
 package sweep
 
 import (
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/wire"
 	"github.com/decred/dcrlnd/lnwallet"
 )
@@ -32,3 +33,14 @@ type Wallet interface {
 	// selection lock.
 	WithCoinSelectLock(f func() error) error
 }
+
+// Mempool provides a view of the mempool of the chain backend. It allows the
+// sweeper to track which of its sweep transactions are still in the mempool
+// and to spot third-party transactions that conflict with them.
+type Mempool interface {
+	// MempoolSpends returns the hashes of the mempool transactions that
+	// spend any of the given outpoints, keyed by the outpoint they spend.
+	// Outpoints that aren't spent within the mempool are omitted.
+	MempoolSpends(outpoints []wire.OutPoint) (
+		map[wire.OutPoint]chainhash.Hash, error)
+}
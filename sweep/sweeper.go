@@ -37,6 +37,10 @@ const (
 	//   #1: min = 1 atom/KB, max = 10 atom/KB
 	//   #2: min = 11 atom/KB, max = 20 atom/KB...
 	DefaultFeeRateBucketSize = 10
+
+	// DefaultBudgetRatio is the share of an input's value that may be
+	// spent on fees to meet its deadline if no explicit budget is given.
+	DefaultBudgetRatio = 0.5
 )
 
 var (
@@ -82,6 +86,22 @@ type Params struct {
 	// ExclusiveGroup is an identifier that, if set, prevents other inputs
 	// with the same identifier from being batched together.
 	ExclusiveGroup *uint64
+
+	// DeadlineHeight is the block height by which the input should be
+	// confirmed. If set, the input is swept at the fee rate estimated to
+	// confirm it by the deadline, up to the fee rate at which the whole
+	// Budget is spent on the input. As the backend doesn't accept
+	// replacements of mempool transactions, the estimate is only revised
+	// when the input is swept again, e.g. after its sweep transaction left
+	// the mempool. The fee preference then only determines the minimum fee
+	// rate and may be omitted, in which case the minimum relay fee rate is
+	// used. A value of zero means that the input has no deadline.
+	DeadlineHeight int32
+
+	// Budget is the maximum amount of fees that may be spent on the input
+	// to meet its deadline. If zero, DefaultBudgetRatio of the input's
+	// value is used. It is ignored for inputs without a deadline.
+	Budget dcrutil.Amount
 }
 
 // ParamsUpdate contains a new set of parameters to update a pending sweep with.
@@ -98,8 +118,14 @@ type ParamsUpdate struct {
 
 // String returns a human readable interpretation of the sweep parameters.
 func (p Params) String() string {
-	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v",
-		p.Fee, p.Force, p.ExclusiveGroup)
+	return fmt.Sprintf("fee=%v, force=%v, exclusive_group=%v, "+
+		"deadline=%v, budget=%v", p.Fee, p.Force, p.ExclusiveGroup,
+		p.DeadlineHeight, p.Budget)
+}
+
+// hasFeePreference returns whether the parameters specify a fee preference.
+func (p Params) hasFeePreference() bool {
+	return p.Fee.FeeRate != 0 || p.Fee.ConfTarget != 0
 }
 
 // pendingInput is created when an input reaches the main loop for the first
@@ -130,6 +156,20 @@ type pendingInput struct {
 	// lastFeeRate is the most recent fee rate used for this input within a
 	// transaction broadcast to the network.
 	lastFeeRate chainfee.AtomPerKByte
+
+	// sweepTx is the hash of the last sweep transaction spending this
+	// input that was accepted by the chain backend.
+	sweepTx *chainhash.Hash
+
+	// inMempool indicates whether a sweep transaction of ours spending
+	// this input was found in the mempool by the last mempool check.
+	inMempool bool
+
+	// conflictTx is the hash of a third-party transaction in the mempool
+	// that spends this input, as found by the last mempool check. Inputs
+	// with a conflict aren't swept until the conflict either confirms or
+	// leaves the mempool.
+	conflictTx *chainhash.Hash
 }
 
 // parameters returns the sweep parameters for this input.
@@ -182,6 +222,18 @@ type PendingInput struct {
 
 	// Params contains the sweep parameters for this pending request.
 	Params Params
+
+	// SweepTx is the hash of the last sweep transaction spending the input
+	// that was accepted by the chain backend, if any.
+	SweepTx *chainhash.Hash
+
+	// InMempool indicates whether a sweep transaction spending the input
+	// was found in the mempool of the chain backend.
+	InMempool bool
+
+	// ConflictTx is the hash of a third-party transaction in the mempool
+	// that spends the input, if any.
+	ConflictTx *chainhash.Hash
 }
 
 // updateReq is an internal message we'll use to represent an external caller's
@@ -250,6 +302,12 @@ type UtxoSweeperConfig struct {
 	// transaction.
 	FeeEstimator chainfee.Estimator
 
+	// Mempool is an optional view of the mempool of the chain backend. If
+	// set, the sweeper checks with every block which of its sweep
+	// transactions are still in the mempool, and which of its inputs are
+	// spent by conflicting third-party transactions.
+	Mempool Mempool
+
 	// Wallet contains the wallet functions that sweeper requires.
 	Wallet Wallet
 
@@ -439,9 +497,13 @@ func (s *UtxoSweeper) SweepInput(input input.Input,
 		return nil, errors.New("nil input received")
 	}
 
-	// Ensure the client provided a sane fee preference.
-	if _, err := s.feeRateForPreference(params.Fee); err != nil {
-		return nil, err
+	// Ensure the client provided a sane fee preference. Inputs with a
+	// deadline may omit it, as their fee rate is determined by their
+	// deadline.
+	if params.hasFeePreference() || params.DeadlineHeight == 0 {
+		if _, err := s.feeRateForPreference(params.Fee); err != nil {
+			return nil, err
+		}
 	}
 
 	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
@@ -492,6 +554,109 @@ func (s *UtxoSweeper) feeRateForPreference(
 	return feeRate, nil
 }
 
+// feeRateForInput returns the fee rate the given input should be swept with
+// at the given height. Inputs without a deadline are swept with the fee rate
+// of their fee preference. Inputs with a deadline are swept with the fee rate
+// estimated to confirm them by their deadline, bounded by their fee preference
+// and their budget.
+func (s *UtxoSweeper) feeRateForInput(input *pendingInput,
+	currentHeight int32) (chainfee.AtomPerKByte, error) {
+
+	params := input.params
+	if params.DeadlineHeight == 0 {
+		return s.feeRateForPreference(params.Fee)
+	}
+
+	minFeeRate := s.relayFeeRate
+	if params.hasFeePreference() {
+		var err error
+		minFeeRate, err = s.feeRateForPreference(params.Fee)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	// The budget caps the fee rate of the input, but we'll never go below
+	// the minimum relay fee rate, as the input couldn't be swept at all
+	// otherwise.
+	maxFeeRate, err := s.budgetFeeRate(input)
+	if err != nil {
+		return 0, err
+	}
+	if maxFeeRate < s.relayFeeRate {
+		maxFeeRate = s.relayFeeRate
+	}
+
+	return s.deadlineFeeRate(
+		minFeeRate, maxFeeRate, params.DeadlineHeight, currentHeight,
+	)
+}
+
+// budgetFeeRate returns the fee rate at which the fees for the given input
+// amount to its budget, capped at the maximum fee rate of the UtxoSweeper.
+func (s *UtxoSweeper) budgetFeeRate(
+	input *pendingInput) (chainfee.AtomPerKByte, error) {
+
+	budget := input.params.Budget
+	if budget == 0 {
+		value := input.SignDesc().Output.Value
+		budget = dcrutil.Amount(float64(value) * DefaultBudgetRatio)
+	}
+
+	size, err := inputSize(input)
+	if err != nil {
+		return 0, err
+	}
+
+	feeRate := chainfee.AtomPerKByte(budget * 1000 / dcrutil.Amount(size))
+	if feeRate > s.cfg.MaxFeeRate {
+		feeRate = s.cfg.MaxFeeRate
+	}
+
+	return feeRate, nil
+}
+
+// inputSize returns the upper bound of the serialized size of the given input
+// within a transaction.
+func inputSize(inp input.Input) (int64, error) {
+	sigScriptSize, _, err := inp.WitnessType().SizeUpperBound()
+	if err != nil {
+		return 0, err
+	}
+
+	return input.InputSize + int64(wire.VarIntSerializeSize(
+		uint64(sigScriptSize),
+	)) + sigScriptSize, nil
+}
+
+// deadlineFeeRate returns the fee rate that is estimated to confirm a
+// transaction published at the current height by the deadline, bounded by
+// minFeeRate and maxFeeRate. Once the deadline is reached, maxFeeRate is
+// returned.
+func (s *UtxoSweeper) deadlineFeeRate(minFeeRate,
+	maxFeeRate chainfee.AtomPerKByte, deadline,
+	currentHeight int32) (chainfee.AtomPerKByte, error) {
+
+	if currentHeight >= deadline {
+		return maxFeeRate, nil
+	}
+
+	confTarget := uint32(deadline - currentHeight)
+	feeRate, err := s.cfg.FeeEstimator.EstimateFeePerKB(confTarget)
+	if err != nil {
+		return 0, fmt.Errorf("unable to query fee estimator: %v", err)
+	}
+
+	if feeRate < minFeeRate {
+		feeRate = minFeeRate
+	}
+	if feeRate > maxFeeRate {
+		feeRate = maxFeeRate
+	}
+
+	return feeRate, nil
+}
+
 // collector is the sweeper main loop. It processes new inputs, spend
 // notifications and counts down to publication of the sweep tx.
 func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
@@ -684,7 +849,7 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			// this to ensure any inputs which have had their fee
 			// rate bumped are broadcast first in order enforce the
 			// RBF policy.
-			inputClusters := s.clusterBySweepFeeRate()
+			inputClusters := s.clusterBySweepFeeRate(bestHeight)
 			sort.Slice(inputClusters, func(i, j int) bool {
 				return inputClusters[i].sweepFeeRate >
 					inputClusters[j].sweepFeeRate
@@ -709,6 +874,8 @@ func (s *UtxoSweeper) collector(blockEpochs <-chan *chainntnfs.BlockEpoch) {
 			log.Debugf("New block: height=%v, sha=%v",
 				epoch.Height, epoch.Hash)
 
+			s.updateMempoolState(bestHeight)
+
 			if err := s.scheduleSweep(bestHeight); err != nil {
 				log.Errorf("schedule sweep: %v", err)
 			}
@@ -790,15 +957,17 @@ func (s *UtxoSweeper) bucketForFeeRate(
 // clusterBySweepFeeRate takes the set of pending inputs within the UtxoSweeper
 // and clusters those together with similar fee rates. Each cluster contains a
 // sweep fee rate, which is determined by calculating the average fee rate of
-// all inputs within that cluster.
-func (s *UtxoSweeper) clusterBySweepFeeRate() []inputCluster {
+// all inputs within that cluster at the given height.
+func (s *UtxoSweeper) clusterBySweepFeeRate(
+	currentHeight int32) []inputCluster {
+
 	bucketInputs := make(map[int]*bucketList)
 	inputFeeRates := make(map[wire.OutPoint]chainfee.AtomPerKByte)
 
 	// First, we'll group together all inputs with similar fee rates. This
 	// is done by determining the fee rate bucket they should belong in.
 	for op, input := range s.pendingInputs {
-		feeRate, err := s.feeRateForPreference(input.params.Fee)
+		feeRate, err := s.feeRateForInput(input, currentHeight)
 		if err != nil {
 			log.Warnf("Skipping input %v: %v", op, err)
 			continue
@@ -853,7 +1022,7 @@ func (s *UtxoSweeper) scheduleSweep(currentHeight int32) error {
 
 	// We'll only start our timer once we have inputs we're able to sweep.
 	startTimer := false
-	for _, cluster := range s.clusterBySweepFeeRate() {
+	for _, cluster := range s.clusterBySweepFeeRate(currentHeight) {
 		// Examine pending inputs and try to construct lists of inputs.
 		// We don't need to obtain the coin selection lock, because we
 		// just need an indication as to whether we can sweep. More
@@ -953,6 +1122,13 @@ func (s *UtxoSweeper) getInputLists(cluster inputCluster,
 			continue
 		}
 
+		// Skip inputs that are spent by a transaction in the mempool,
+		// as the backend rejects any other transaction spending them
+		// until it either confirms or leaves the mempool.
+		if input.inMempool || input.conflictTx != nil {
+			continue
+		}
+
 		// Add input to the either one of the lists.
 		if input.publishAttempts == 0 {
 			newInputs = append(newInputs, input)
@@ -1048,6 +1224,8 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.AtomPerKByte,
 	if err == nil {
 		s.currentOutputScript = nil
 	}
+	published := err == nil
+	txHash := tx.TxHash()
 
 	// Reschedule sweep.
 	for _, input := range tx.TxIn {
@@ -1064,6 +1242,42 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.AtomPerKByte,
 		// Record another publish attempt.
 		pi.publishAttempts++
 
+		// Track the sweep transaction if it was accepted, so that we
+		// can tell whether it's still in the mempool later on.
+		if published {
+			pi.sweepTx = &txHash
+			pi.inMempool = s.cfg.Mempool != nil
+		}
+
+		// Inputs with a deadline aren't given up on, as the deadline
+		// requires them to be swept no matter how many attempts it
+		// takes. If publishing failed, they are retried with the next
+		// block.
+		//
+		// Note(decred): dcrd doesn't accept replacements of mempool
+		// transactions, so sweeping a published input again at a
+		// higher fee rate would be rejected. With a view of the
+		// mempool, the input is only swept again once its sweep
+		// transaction left the mempool. Otherwise, it is retried with
+		// the usual back-off.
+		if pi.params.DeadlineHeight != 0 {
+			pi.minPublishHeight = currentHeight + 1
+			if published && s.cfg.Mempool == nil {
+				pi.minPublishHeight = currentHeight +
+					s.cfg.NextAttemptDeltaFunc(
+						pi.publishAttempts,
+					)
+			}
+
+			log.Debugf("Rescheduling input %v with deadline %v "+
+				"after %v attempts at height %v",
+				input.PreviousOutPoint,
+				pi.params.DeadlineHeight, pi.publishAttempts,
+				pi.minPublishHeight)
+
+			continue
+		}
+
 		// We don't care what the result of the publish call was. Even
 		// if it is published successfully, it can still be that it
 		// needs to be retried. Call NextAttemptDeltaFunc to calculate
@@ -1087,9 +1301,86 @@ func (s *UtxoSweeper) sweep(inputs inputSet, feeRate chainfee.AtomPerKByte,
 		}
 	}
 
+	// A double spend means that at least one of the inputs is already
+	// spent by another transaction. We'll check the mempool right away to
+	// find the conflicting inputs, so that the remaining inputs can be
+	// swept without them.
+	if err == lnwallet.ErrDoubleSpend {
+		s.updateMempoolState(currentHeight)
+	}
+
 	return nil
 }
 
+// updateMempoolState queries the mempool of the chain backend for spends of
+// the pending inputs. Inputs spent by one of our sweep transactions are marked
+// as being in the mempool, while inputs spent by a third-party transaction are
+// marked as conflicted, which excludes them from new sweep transactions. Inputs
+// whose sweep transaction or conflict left the mempool are retried with the
+// next block, rather than waiting for their back-off to expire, so that the
+// remaining inputs of a sweep transaction invalidated by a conflict are
+// swept again without the conflicting input.
+func (s *UtxoSweeper) updateMempoolState(currentHeight int32) {
+	if s.cfg.Mempool == nil || len(s.pendingInputs) == 0 {
+		return
+	}
+
+	outpoints := make([]wire.OutPoint, 0, len(s.pendingInputs))
+	for op := range s.pendingInputs {
+		outpoints = append(outpoints, op)
+	}
+
+	spends, err := s.cfg.Mempool.MempoolSpends(outpoints)
+	if err != nil {
+		log.Warnf("Unable to query mempool spends: %v", err)
+		return
+	}
+
+	for op, pi := range s.pendingInputs {
+		spendHash, spent := spends[op]
+
+		// The input isn't spent within the mempool. If it was before,
+		// the spending transaction was either confirmed, in which case
+		// the spend notification will remove the input, or evicted.
+		if !spent {
+			if pi.inMempool || pi.conflictTx != nil {
+				log.Infof("Spend of input %v left the "+
+					"mempool, retrying at height %v", op,
+					currentHeight+1)
+
+				pi.minPublishHeight = currentHeight + 1
+			}
+			pi.inMempool = false
+			pi.conflictTx = nil
+
+			continue
+		}
+
+		isOurTx, err := s.cfg.Store.IsOurTx(spendHash)
+		if err != nil {
+			log.Errorf("Cannot determine if tx %v is ours: %v",
+				spendHash, err)
+			continue
+		}
+
+		if isOurTx {
+			pi.sweepTx = &spendHash
+			pi.inMempool = true
+			pi.conflictTx = nil
+
+			continue
+		}
+
+		if pi.conflictTx == nil || *pi.conflictTx != spendHash {
+			log.Infof("Input %v is spent by conflicting mempool "+
+				"tx %v", op, spendHash)
+		}
+
+		pi.inMempool = false
+		pi.conflictTx = &spendHash
+	}
+}
+
 // waitForSpend registers a spend notification with the chain notifier. It
 // returns a cancel function that can be used to cancel the registration.
 func (s *UtxoSweeper) waitForSpend(outpoint wire.OutPoint,
@@ -1171,6 +1462,9 @@ func (s *UtxoSweeper) handlePendingSweepsReq(
 			BroadcastAttempts:   pendingInput.publishAttempts,
 			NextBroadcastHeight: uint32(pendingInput.minPublishHeight),
 			Params:              pendingInput.params,
+			SweepTx:             pendingInput.sweepTx,
+			InMempool:           pendingInput.inMempool,
+			ConflictTx:          pendingInput.conflictTx,
 		}
 	}
 
@@ -1236,8 +1530,8 @@ func (s *UtxoSweeper) handleUpdateReq(req *updateReq, bestHeight int32) (
 		return nil, lnwallet.ErrNotMine
 	}
 
-	// Create the updated parameters struct. Leave the exclusive group
-	// unchanged.
+	// Create the updated parameters struct. Leave the exclusive group,
+	// deadline and budget unchanged.
 	newParams := pendingInput.params
 	newParams.Fee = req.params.Fee
 	newParams.Force = req.params.Force
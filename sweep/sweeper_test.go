@@ -1320,3 +1320,231 @@ func TestExclusiveGroup(t *testing.T) {
 		t.Fatal("expected third input to be canceled")
 	}
 }
+
+// TestDeadlineFeeRate asserts that inputs with a deadline are swept at the fee
+// rate estimated to confirm them by the deadline, bounded by their minimum and
+// maximum fee rate.
+func TestDeadlineFeeRate(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minFeeRate chainfee.AtomPerKByte = 3e4
+		maxFeeRate chainfee.AtomPerKByte = 11e4
+		deadline                         = 110
+	)
+
+	estimator := newMockFeeEstimator(1e4, chainfee.FeePerKBFloor)
+	estimator.blocksToFee[10] = 2e4
+	estimator.blocksToFee[5] = 6e4
+	estimator.blocksToFee[1] = 15e4
+
+	s := &UtxoSweeper{
+		cfg: &UtxoSweeperConfig{
+			FeeEstimator: estimator,
+		},
+	}
+
+	testCases := []struct {
+		height  int32
+		feeRate chainfee.AtomPerKByte
+	}{
+		{height: 100, feeRate: minFeeRate},
+		{height: 105, feeRate: 6e4},
+		{height: 109, feeRate: maxFeeRate},
+		{height: 110, feeRate: maxFeeRate},
+		{height: 120, feeRate: maxFeeRate},
+	}
+	for _, tc := range testCases {
+		feeRate, err := s.deadlineFeeRate(
+			minFeeRate, maxFeeRate, deadline, tc.height,
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if feeRate != tc.feeRate {
+			t.Fatalf("expected fee rate %v at height %v, got %v",
+				tc.feeRate, tc.height, feeRate)
+		}
+	}
+}
+
+// TestDeadline asserts that the sweeper sweeps an input with a deadline at the
+// fee rate estimated to confirm it in time, that it doesn't try to replace the
+// sweep transaction while it is in the mempool, and that it doesn't give up on
+// the input.
+func TestDeadline(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Restart the sweeper with a view of the mempool of the backend.
+	ctx.sweeper.Stop()
+	ctx.sweeper.cfg.Mempool = ctx.backend
+	ctx.sweeper = New(ctx.sweeper.cfg)
+	ctx.sweeper.Start()
+
+	ctx.estimator.blocksToFee[10] = 2e4
+	ctx.estimator.blocksToFee[4] = 6e4
+
+	input := createTestInput(dcrutil.AtomsPerCoin, input.CommitmentTimeLock)
+
+	// We'll set the budget such that the input is swept at a fee rate of
+	// 11e4 once its deadline is reached.
+	size, err := inputSize(&input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	budget := dcrutil.Amount(11e4) * dcrutil.Amount(size) / 1000
+	endFeeRate := chainfee.AtomPerKByte(
+		budget * 1000 / dcrutil.Amount(size),
+	)
+
+	// At the current height of 100, the input is swept at the fee rate
+	// estimated to confirm within the 10 blocks left until its deadline.
+	resultChan, err := ctx.sweeper.SweepInput(&input, Params{
+		DeadlineHeight: 110,
+		Budget:         budget,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	tx := ctx.receiveTx()
+	assertTxFeeRate(t, &tx, 2e4, &input)
+
+	// The backend doesn't accept replacements, so the input isn't swept
+	// again while its sweep transaction is in the mempool, even though
+	// the estimate for the remaining blocks rises.
+	ctx.estimator.blocksToFee[7] = 5e4
+	ctx.notifier.NotifyEpoch(101)
+	ctx.assertNoNewTimer()
+	ctx.notifier.NotifyEpoch(103)
+	ctx.assertNoNewTimer()
+
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !pendingInputs[*input.OutPoint()].InMempool {
+		t.Fatalf("expected input to be in the mempool")
+	}
+
+	// Once the sweep transaction left the mempool, the input is swept
+	// again with the block after, at the fee rate estimated for the
+	// remaining blocks.
+	ctx.backend.deleteUnconfirmed(tx.TxHash())
+	ctx.notifier.NotifyEpoch(105)
+	ctx.assertNoNewTimer()
+
+	ctx.notifier.NotifyEpoch(106)
+	ctx.tick()
+	tx = ctx.receiveTx()
+	assertTxFeeRate(t, &tx, 6e4, &input)
+
+	// Past the deadline, the input is swept again using the whole budget,
+	// even though the maximum number of sweep attempts has been reached.
+	ctx.backend.deleteUnconfirmed(tx.TxHash())
+	ctx.notifier.NotifyEpoch(110)
+	ctx.assertNoNewTimer()
+
+	ctx.notifier.NotifyEpoch(111)
+	ctx.tick()
+	tx = ctx.receiveTx()
+	assertTxFeeRate(t, &tx, endFeeRate, &input)
+
+	ctx.backend.mine()
+	ctx.expectResult(resultChan, nil)
+
+	ctx.finish(1)
+}
+
+// TestMempoolConflict asserts that the sweeper tracks its sweep transactions in
+// the mempool and resweeps the remaining inputs of a sweep transaction that
+// was replaced by a conflicting third-party spend of one of its inputs.
+func TestMempoolConflict(t *testing.T) {
+	ctx := createSweeperTestContext(t)
+
+	// Restart the sweeper with a view of the mempool of the backend.
+	ctx.sweeper.Stop()
+	ctx.sweeper.cfg.Mempool = ctx.backend
+	ctx.sweeper = New(ctx.sweeper.cfg)
+	ctx.sweeper.Start()
+
+	inputA := spendableInputs[0]
+	inputB := spendableInputs[1]
+
+	resultChanA, err := ctx.sweeper.SweepInput(inputA, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resultChanB, err := ctx.sweeper.SweepInput(inputB, defaultFeePref)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertTxSweepsInputs(t, &sweepTx, inputA, inputB)
+	sweepHash := sweepTx.TxHash()
+
+	// With the sweep transaction in the mempool, the inputs aren't swept
+	// again on the next block.
+	ctx.notifier.NotifyEpoch(101)
+	ctx.assertNoNewTimer()
+
+	pendingInputs, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pendingInput := range pendingInputs {
+		if !pendingInput.InMempool ||
+			*pendingInput.SweepTx != sweepHash {
+
+			t.Fatalf("expected input %v to be in the mempool",
+				pendingInput.OutPoint)
+		}
+	}
+
+	// A third party now replaces our sweep transaction with one spending
+	// input A.
+	ctx.backend.deleteUnconfirmed(sweepHash)
+	conflictTx := &wire.MsgTx{
+		TxIn: []*wire.TxIn{{
+			PreviousOutPoint: *inputA.OutPoint(),
+		}},
+		TxOut: []*wire.TxOut{{Value: 1000}},
+	}
+	if err := ctx.backend.publishTransaction(conflictTx); err != nil {
+		t.Fatal(err)
+	}
+	conflictHash := conflictTx.TxHash()
+
+	// The conflict is spotted with the next block, and input B is swept
+	// again on its own with the block after.
+	ctx.notifier.NotifyEpoch(102)
+	ctx.assertNoNewTimer()
+
+	pendingInputs, err = ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conflict := pendingInputs[*inputA.OutPoint()].ConflictTx
+	if conflict == nil || *conflict != conflictHash {
+		t.Fatalf("expected conflict %v, got %v", conflictHash,
+			conflict)
+	}
+	if pendingInputs[*inputB.OutPoint()].InMempool {
+		t.Fatalf("expected input B to have left the mempool")
+	}
+
+	ctx.notifier.NotifyEpoch(103)
+	ctx.tick()
+	sweepTx = ctx.receiveTx()
+	assertTxSweepsInputs(t, &sweepTx, inputB)
+
+	// Once mined, input A is reported as spent by the remote party.
+	ctx.backend.mine()
+	ctx.expectResult(resultChanA, ErrRemoteSpend)
+	ctx.expectResult(resultChanB, nil)
+
+	ctx.finish(1)
+}
@@ -157,6 +157,13 @@ const (
 	// kgtnOutputConfTarget is the default confirmation target we'll use for
 	// sweeps of CSV delayed outputs.
 	kgtnOutputConfTarget = 6
+
+	// htlcTimeoutDeadlineDelta is the number of blocks past the expiry of
+	// an outgoing HTLC on the remote party's commitment by which we want
+	// its timeout sweep to be confirmed. Until then, the remote party can
+	// still claim the HTLC with the preimage, while the corresponding
+	// incoming HTLC draws closer to its own expiry.
+	htlcTimeoutDeadlineDelta = 10
 )
 
 var (
@@ -777,9 +784,20 @@ func (u *utxoNursery) sweepMatureOutputs(classHeight uint32,
 		// passed in with disastrous consequences.
 		local := output
 
-		resultChan, err := u.cfg.SweepInput(
-			&local, sweep.Params{Fee: feePref},
-		)
+		// The timeout sweeps of outgoing HTLCs on the remote party's
+		// commitment race against the remote party claiming them
+		// with the preimage, so we'll give them a deadline, which
+		// makes the sweeper pick a fee rate that should confirm them
+		// in time.
+		params := sweep.Params{Fee: feePref}
+		if local.WitnessType() == input.HtlcOfferedRemoteTimeout {
+			params.DeadlineHeight = int32(
+				local.absoluteMaturity +
+					htlcTimeoutDeadlineDelta,
+			)
+		}
+
+		resultChan, err := u.cfg.SweepInput(&local, params)
 		if err != nil {
 			return err
 		}
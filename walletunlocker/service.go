@@ -19,6 +19,7 @@ import (
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lnwallet"
+	"github.com/decred/dcrlnd/macaroons"
 
 	pb "decred.org/dcrwallet/v2/rpc/walletrpc"
 	"decred.org/dcrwallet/v2/wallet"
@@ -28,6 +29,14 @@ import (
 	"google.golang.org/grpc/credentials"
 )
 
+var (
+	// ErrStatelessInitNoMacaroons is returned when a stateless init or a
+	// macaroon root key rotation is requested while macaroons are
+	// disabled.
+	ErrStatelessInitNoMacaroons = errors.New("macaroons must be enabled " +
+		"to use stateless init or rotate the macaroon root key")
+)
+
 // ChannelsToRecover wraps any set of packed (serialized+encrypted) channel
 // back ups together. These can be passed in when unlocking the wallet, or
 // creating a new wallet for the first time with an existing seed.
@@ -64,6 +73,11 @@ type WalletInitMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been initialized.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to not
+	// create any macaroon files. The admin macaroon must then be sent
+	// over the MacResponseChan instead.
+	StatelessInit bool
 }
 
 // WalletUnlockMsg is a message sent by the UnlockerService when a user wishes
@@ -97,6 +111,17 @@ type WalletUnlockMsg struct {
 	// ChanBackups a set of static channel backups that should be received
 	// after the wallet has been unlocked.
 	ChanBackups ChannelsToRecover
+
+	// StatelessInit signals that the user requested the daemon to not
+	// create any macaroon files. If the message was sent by
+	// ChangePassword, the admin macaroon must be sent over the
+	// MacResponseChan.
+	StatelessInit bool
+
+	// PasswordChanged signals that the message was sent by ChangePassword
+	// rather than UnlockWallet. Only then does the sender wait for the
+	// admin macaroon in case of a stateless init.
+	PasswordChanged bool
 }
 
 // UnlockerService implements the WalletUnlocker service used to provide lnd
@@ -112,11 +137,17 @@ type UnlockerService struct {
 	// sent.
 	UnlockMsgs chan *WalletUnlockMsg
 
+	// MacResponseChan is the channel over which the daemon sends the admin
+	// macaroon once the wallet was initialized or its password was changed
+	// with the stateless_init flag set, so it can be returned to the user.
+	MacResponseChan chan []byte
+
 	chainDir       string
 	noFreelistSync bool
 	netParams      *chaincfg.Params
 	db             *channeldb.DB
 	macaroonFiles  []string
+	macaroonDir    string
 
 	dcrwHost       string
 	dcrwCert       string
@@ -125,24 +156,30 @@ type UnlockerService struct {
 	dcrwAccount    int32
 }
 
-// New creates and returns a new UnlockerService.
+// New creates and returns a new UnlockerService. The macaroonDir is the
+// directory of the macaroon database, which is re-encrypted whenever the
+// wallet password is changed. An empty macaroonDir means macaroons are
+// disabled.
 func New(chainDir string, params *chaincfg.Params, noFreelistSync bool,
-	macaroonFiles []string, db *channeldb.DB, dcrwHost, dcrwCert, dcrwClientKey,
-	dcrwClientCert string, dcrwAccount int32) *UnlockerService {
+	macaroonFiles []string, macaroonDir string, db *channeldb.DB, dcrwHost,
+	dcrwCert, dcrwClientKey, dcrwClientCert string,
+	dcrwAccount int32) *UnlockerService {
 
 	return &UnlockerService{
-		InitMsgs:       make(chan *WalletInitMsg, 1),
-		UnlockMsgs:     make(chan *WalletUnlockMsg, 1),
-		chainDir:       chainDir,
-		noFreelistSync: noFreelistSync,
-		netParams:      params,
-		db:             db,
-		macaroonFiles:  macaroonFiles,
-		dcrwHost:       dcrwHost,
-		dcrwCert:       dcrwCert,
-		dcrwClientKey:  dcrwClientKey,
-		dcrwClientCert: dcrwClientCert,
-		dcrwAccount:    dcrwAccount,
+		InitMsgs:        make(chan *WalletInitMsg, 1),
+		UnlockMsgs:      make(chan *WalletUnlockMsg, 1),
+		MacResponseChan: make(chan []byte, 1),
+		chainDir:        chainDir,
+		noFreelistSync:  noFreelistSync,
+		netParams:       params,
+		db:              db,
+		macaroonFiles:   macaroonFiles,
+		macaroonDir:     macaroonDir,
+		dcrwHost:        dcrwHost,
+		dcrwCert:        dcrwCert,
+		dcrwClientKey:   dcrwClientKey,
+		dcrwClientCert:  dcrwClientCert,
+		dcrwAccount:     dcrwAccount,
 	}
 }
 
@@ -270,6 +307,9 @@ func extractChanBackups(chanBackups *lnrpc.ChanBackupSnapshot) *ChannelsToRecove
 // Alternatively, this can be used along with the GenSeed RPC to obtain a
 // seed, then present it to the user. Once it has been verified by the user,
 // the seed can be fed into this RPC in order to commit the new wallet.
+//
+// If stateless initialization is requested, no macaroon files are written to
+// disk and the admin macaroon is returned in the response instead.
 func (u *UnlockerService) InitWallet(ctx context.Context,
 	in *lnrpc.InitWalletRequest) (*lnrpc.InitWalletResponse, error) {
 
@@ -279,6 +319,10 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		return nil, err
 	}
 
+	if in.StatelessInit && u.macaroonDir == "" {
+		return nil, ErrStatelessInitNoMacaroons
+	}
+
 	// Require that the recovery window be non-negative.
 	recoveryWindow := in.RecoveryWindow
 	if recoveryWindow < 0 {
@@ -329,6 +373,7 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 		Passphrase:     password,
 		WalletSeed:     cipherSeed,
 		RecoveryWindow: gapLimit,
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -340,7 +385,34 @@ func (u *UnlockerService) InitWallet(ctx context.Context,
 
 	u.InitMsgs <- initMsg
 
-	return &lnrpc.InitWalletResponse{}, nil
+	// Without stateless initialization, the macaroons are written to disk
+	// and there's nothing to return.
+	if !in.StatelessInit {
+		return &lnrpc.InitWalletResponse{}, nil
+	}
+
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.InitWalletResponse{
+		AdminMacaroon: adminMac,
+	}, nil
+}
+
+// waitForAdminMacaroon waits for the daemon to send the admin macaroon after
+// the wallet was initialized or unlocked with the stateless_init flag set.
+func (u *UnlockerService) waitForAdminMacaroon(ctx context.Context) ([]byte,
+	error) {
+
+	select {
+	case adminMac := <-u.MacResponseChan:
+		return adminMac, nil
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
 }
 
 func tlsCertFromFile(fname string) (*x509.CertPool, error) {
@@ -463,8 +535,9 @@ func (u *UnlockerService) unlockRemoteWallet(ctx context.Context,
 	// We successfully opened the wallet and pass the instance back to
 	// avoid it needing to be unlocked again.
 	walletUnlockMsg := &WalletUnlockMsg{
-		Passphrase: in.WalletPassword,
-		Conn:       conn,
+		Passphrase:    in.WalletPassword,
+		Conn:          conn,
+		StatelessInit: in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -528,6 +601,7 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 		RecoveryWindow: gapLimit,
 		Wallet:         unlockedWallet,
 		Loader:         loader,
+		StatelessInit:  in.StatelessInit,
 	}
 
 	// Before we return the unlock payload, we'll check if we can extract
@@ -545,12 +619,17 @@ func (u *UnlockerService) UnlockWallet(ctx context.Context,
 	return &lnrpc.UnlockWalletResponse{}, nil
 }
 
-// ChangePassword changes the password of the wallet and sends the new password
-// across the UnlockPasswords channel to automatically unlock the wallet if
-// successful.
+// ChangePassword changes the password of the wallet and the macaroon database
+// and sends the new password across the UnlockPasswords channel to
+// automatically unlock the wallet if successful. Optionally, the macaroon
+// root key is rotated as well, which invalidates all existing macaroons.
 func (u *UnlockerService) ChangePassword(ctx context.Context,
 	in *lnrpc.ChangePasswordRequest) (*lnrpc.ChangePasswordResponse, error) {
 
+	if (in.StatelessInit || in.NewMacaroonRootKey) && u.macaroonDir == "" {
+		return nil, ErrStatelessInitNoMacaroons
+	}
+
 	netDir := dcrwallet.NetworkDir(u.chainDir, u.netParams)
 	loader := walletloader.NewLoader(u.netParams, netDir, wallet.DefaultGapLimit)
 
@@ -588,11 +667,11 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 	// Unload the wallet to allow lnd to open it later on.
 	defer loader.UnloadWallet()
 
-	// Since the macaroon database is also encrypted with the wallet's
-	// password, we'll remove all of the macaroon files so that they're
-	// re-generated at startup using the new password. We'll make sure to do
-	// this after unlocking the wallet to ensure macaroon files don't get
-	// deleted with incorrect password attempts.
+	// We'll remove all of the macaroon files so that they're re-generated
+	// at startup, unless a stateless init was requested, in which case
+	// none should be left on disk. We'll make sure to do this after
+	// unlocking the wallet to ensure macaroon files don't get deleted with
+	// incorrect password attempts.
 	for _, file := range u.macaroonFiles {
 		err := os.Remove(file)
 		if err != nil && !os.IsNotExist(err) {
@@ -617,11 +696,103 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 			"%v", err)
 	}
 
+	// Since the macaroon database is also encrypted with the wallet's
+	// password, we'll re-encrypt it with the new password as well. If
+	// that fails, we'll roll back the wallet's password change, so that
+	// the macaroon database remains accessible with the wallet's password.
+	if u.macaroonDir != "" {
+		err := changeMacaroonPassword(
+			u.macaroonDir, privatePw, in.NewPassword,
+		)
+		if err != nil {
+			rbErr := w.ChangePrivatePassphrase(
+				ctx, in.NewPassword, privatePw,
+			)
+			if rbErr == nil {
+				rbErr = w.ChangePublicPassphrase(
+					ctx, in.NewPassword, publicPw,
+				)
+			}
+			if rbErr != nil {
+				log.Errorf("Unable to roll back wallet "+
+					"password change: %v", rbErr)
+			}
+
+			return nil, err
+		}
+	}
+
+	// Now that the password change is complete, the macaroon root key can
+	// be rotated if requested.
+	if u.macaroonDir != "" && in.NewMacaroonRootKey {
+		err := rotateMacaroonRootKey(u.macaroonDir, in.NewPassword)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Finally, send the new password across the UnlockPasswords channel to
 	// automatically unlock the wallet.
-	u.UnlockMsgs <- &WalletUnlockMsg{Passphrase: in.NewPassword}
+	u.UnlockMsgs <- &WalletUnlockMsg{
+		Passphrase:      in.NewPassword,
+		StatelessInit:   in.StatelessInit,
+		PasswordChanged: true,
+	}
+
+	if !in.StatelessInit {
+		return &lnrpc.ChangePasswordResponse{}, nil
+	}
 
-	return &lnrpc.ChangePasswordResponse{}, nil
+	adminMac, err := u.waitForAdminMacaroon(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ChangePasswordResponse{
+		AdminMacaroon: adminMac,
+	}, nil
+}
+
+// changeMacaroonPassword re-encrypts the macaroon database in the given
+// directory with the new password.
+func changeMacaroonPassword(macaroonDir string, oldPw, newPw []byte) error {
+	macaroonService, err := macaroons.NewService(macaroonDir, "lnd")
+	if err != nil {
+		return err
+	}
+	defer macaroonService.Close()
+
+	if err := macaroonService.CreateUnlock(&oldPw); err != nil {
+		return fmt.Errorf("unable to unlock macaroon DB: %v", err)
+	}
+
+	if err := macaroonService.ChangePassword(oldPw, newPw); err != nil {
+		return fmt.Errorf("unable to change macaroon DB password: %v",
+			err)
+	}
+
+	return nil
+}
+
+// rotateMacaroonRootKey replaces the macaroon root key in the database in the
+// given directory, which invalidates all macaroons baked before.
+func rotateMacaroonRootKey(macaroonDir string, pw []byte) error {
+	macaroonService, err := macaroons.NewService(macaroonDir, "lnd")
+	if err != nil {
+		return err
+	}
+	defer macaroonService.Close()
+
+	if err := macaroonService.CreateUnlock(&pw); err != nil {
+		return fmt.Errorf("unable to unlock macaroon DB: %v", err)
+	}
+
+	if err := macaroonService.GenerateNewRootKey(); err != nil {
+		return fmt.Errorf("unable to generate new macaroon root key: %v",
+			err)
+	}
+
+	return nil
 }
 
 // ValidatePassword assures the password meets all of our constraints.
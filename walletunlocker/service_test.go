@@ -3,6 +3,7 @@ package walletunlocker_test
 import (
 	"bytes"
 	"context"
+	"encoding/hex"
 	"io/ioutil"
 	"os"
 	"strings"
@@ -17,7 +18,10 @@ import (
 	"github.com/decred/dcrlnd/lnrpc"
 	"github.com/decred/dcrlnd/lnwallet/dcrwallet"
 	walletloader "github.com/decred/dcrlnd/lnwallet/dcrwallet/loader"
+	"github.com/decred/dcrlnd/macaroons"
 	"github.com/decred/dcrlnd/walletunlocker"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/macaroon-bakery.v2/bakery"
 )
 
 var (
@@ -66,7 +70,9 @@ func TestGenSeed(t *testing.T) {
 	defer os.RemoveAll(testDir)
 
 	service := walletunlocker.New(
-		testDir, testNetParams, true, nil, &channeldb.DB{}, "", "", "", "", 0)
+		testDir, testNetParams, true, nil, "", &channeldb.DB{}, "", "",
+		"", "", 0,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase.
@@ -108,7 +114,9 @@ func TestGenSeedGenerateEntropy(t *testing.T) {
 		os.RemoveAll(testDir)
 	}()
 	service := walletunlocker.New(
-		testDir, testNetParams, true, nil, &channeldb.DB{}, "", "", "", "", 0)
+		testDir, testNetParams, true, nil, "", &channeldb.DB{}, "", "",
+		"", "", 0,
+	)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. Note that we don't actually
@@ -149,7 +157,7 @@ func TestGenSeedInvalidEntropy(t *testing.T) {
 		os.RemoveAll(testDir)
 	}()
 	service := walletunlocker.New(testDir, testNetParams, true, nil,
-		&channeldb.DB{}, "", "", "", "", 0)
+		"", &channeldb.DB{}, "", "", "", "", 0)
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. However, we'll be using an
@@ -188,7 +196,7 @@ func TestInitWallet(t *testing.T) {
 
 	// Create new UnlockerService.
 	service := walletunlocker.New(testDir, testNetParams, true, nil,
-		&channeldb.DB{}, "", "", "", "", 0)
+		"", &channeldb.DB{}, "", "", "", "", 0)
 
 	// Once we have the unlocker service created, we'll now instantiate a
 	// new cipher seed instance.
@@ -290,7 +298,7 @@ func TestCreateWalletInvalidEntropy(t *testing.T) {
 
 	// Create new UnlockerService.
 	service := walletunlocker.New(testDir, testNetParams, true, nil,
-		&channeldb.DB{}, "", "", "", "", 0)
+		"", &channeldb.DB{}, "", "", "", "", 0)
 
 	// We'll attempt to init the wallet with an invalid cipher seed and
 	// passphrase.
@@ -324,7 +332,7 @@ func TestUnlockWallet(t *testing.T) {
 
 	// Create new UnlockerService.
 	service := walletunlocker.New(testDir, testNetParams, true, nil,
-		&channeldb.DB{}, "", "", "", "", 0)
+		"", &channeldb.DB{}, "", "", "", "", 0)
 
 	ctx := context.Background()
 	req := &lnrpc.UnlockWalletRequest{
@@ -399,7 +407,7 @@ func TestChangeWalletPassword(t *testing.T) {
 
 	// Create a new UnlockerService with our temp files.
 	service := walletunlocker.New(testDir, testNetParams, true, tempFiles,
-		&channeldb.DB{}, "", "", "", "", 0)
+		testDir, &channeldb.DB{}, "", "", "", "", 0)
 
 	ctx := context.Background()
 	newPassword := []byte("hunter2???")
@@ -471,3 +479,182 @@ func TestChangeWalletPassword(t *testing.T) {
 		t.Fatalf("password not received")
 	}
 }
+
+// TestChangeWalletPasswordRollback tests that the wallet's password change is
+// rolled back if the macaroon DB can't be re-encrypted with the new password.
+func TestChangeWalletPasswordRollback(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testchangepasswordrollback")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	// Create a macaroon DB that is encrypted with a different password
+	// than the wallet, so that it can't be re-encrypted.
+	macaroonService, err := macaroons.NewService(testDir, "lnd")
+	if err != nil {
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	otherPassword := []byte("other-password")
+	if err := macaroonService.CreateUnlock(&otherPassword); err != nil {
+		t.Fatalf("unable to unlock macaroon service: %v", err)
+	}
+	macaroonService.Close()
+
+	createTestWallet(t, testDir, testNetParams)
+
+	service := walletunlocker.New(testDir, testNetParams, true, nil,
+		testDir, &channeldb.DB{}, "", "", "", "", 0)
+
+	ctx := context.Background()
+	req := &lnrpc.ChangePasswordRequest{
+		CurrentPassword: testPassword,
+		NewPassword:     []byte("hunter2???"),
+	}
+	if _, err := service.ChangePassword(ctx, req); err == nil {
+		t.Fatal("expected call to ChangePassword to fail")
+	}
+
+	// The wallet must still be accessible with its current password.
+	unlockReq := &lnrpc.UnlockWalletRequest{
+		WalletPassword: testPassword,
+	}
+	if _, err := service.UnlockWallet(ctx, unlockReq); err != nil {
+		t.Fatalf("unable to unlock wallet with current password: %v",
+			err)
+	}
+
+	unlockMsg := <-service.UnlockMsgs
+	defer unlockMsg.Loader.UnloadWallet()
+
+	if err := unlockMsg.Wallet.Unlock(ctx, testPassword, nil); err != nil {
+		t.Fatalf("private passphrase change wasn't rolled back: %v",
+			err)
+	}
+	if unlockMsg.PasswordChanged {
+		t.Fatalf("expected unlock not to signal a password change")
+	}
+}
+
+// TestChangeWalletPasswordStateless tests that a password change with the
+// stateless_init flag set rotates the macaroon root key and returns the admin
+// macaroon sent by the daemon.
+func TestChangeWalletPasswordStateless(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testchangepasswordstateless")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	// Stateless init isn't possible if macaroons are disabled.
+	service := walletunlocker.New(testDir, testNetParams, true, nil,
+		"", &channeldb.DB{}, "", "", "", "", 0)
+
+	newPassword := []byte("hunter2???")
+	req := &lnrpc.ChangePasswordRequest{
+		CurrentPassword:    testPassword,
+		NewPassword:        newPassword,
+		StatelessInit:      true,
+		NewMacaroonRootKey: true,
+	}
+	_, err = service.ChangePassword(context.Background(), req)
+	if err != walletunlocker.ErrStatelessInitNoMacaroons {
+		t.Fatalf("expected ErrStatelessInitNoMacaroons, got: %v", err)
+	}
+
+	// Create a macaroon DB and bake a macaroon before the password change.
+	macaroonService, err := macaroons.NewService(testDir, "lnd")
+	if err != nil {
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	if err := macaroonService.CreateUnlock(&testPassword); err != nil {
+		t.Fatalf("unable to unlock macaroon service: %v", err)
+	}
+	testOp := bakery.Op{Entity: "testEntity", Action: "read"}
+	oldMac, err := macaroonService.NewMacaroon(
+		context.Background(), macaroons.DefaultRootKeyID, testOp,
+	)
+	if err != nil {
+		t.Fatalf("unable to bake macaroon: %v", err)
+	}
+	oldMacBytes, err := oldMac.M().MarshalBinary()
+	if err != nil {
+		t.Fatalf("unable to serialize macaroon: %v", err)
+	}
+	macaroonService.Close()
+
+	createTestWallet(t, testDir, testNetParams)
+
+	service = walletunlocker.New(testDir, testNetParams, true, nil,
+		testDir, &channeldb.DB{}, "", "", "", "", 0)
+
+	// The call only returns once the daemon sent the admin macaroon, so
+	// we'll do that in the background.
+	testMac := []byte("fake-macaroon")
+	errChan := make(chan error, 1)
+	respChan := make(chan *lnrpc.ChangePasswordResponse, 1)
+	go func() {
+		resp, err := service.ChangePassword(context.Background(), req)
+		if err != nil {
+			errChan <- err
+			return
+		}
+		respChan <- resp
+	}()
+
+	select {
+	case unlockMsg := <-service.UnlockMsgs:
+		if !unlockMsg.StatelessInit || !unlockMsg.PasswordChanged {
+			t.Fatalf("expected stateless password change")
+		}
+		service.MacResponseChan <- testMac
+
+	case err := <-errChan:
+		t.Fatalf("unable to change wallet's password: %v", err)
+
+	case <-time.After(3 * time.Second):
+		t.Fatalf("password not received")
+	}
+
+	select {
+	case resp := <-respChan:
+		if !bytes.Equal(resp.AdminMacaroon, testMac) {
+			t.Fatalf("expected admin macaroon %x, got %x", testMac,
+				resp.AdminMacaroon)
+		}
+
+	case err := <-errChan:
+		t.Fatalf("unable to change wallet's password: %v", err)
+
+	case <-time.After(3 * time.Second):
+		t.Fatalf("response not received")
+	}
+
+	// The macaroon DB must now be encrypted with the new password and the
+	// root key must have been replaced, so the old macaroon is no longer
+	// valid.
+	macaroonService, err = macaroons.NewService(testDir, "lnd")
+	if err != nil {
+		t.Fatalf("unable to create macaroon service: %v", err)
+	}
+	defer macaroonService.Close()
+
+	if err := macaroonService.CreateUnlock(&newPassword); err != nil {
+		t.Fatalf("unable to unlock macaroon service: %v", err)
+	}
+
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(oldMacBytes),
+	})
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	err = macaroonService.ValidateMacaroon(
+		ctx, []bakery.Op{testOp}, "FooMethod",
+	)
+	if err == nil {
+		t.Fatalf("macaroon root key wasn't rotated")
+	}
+}
@@ -114,6 +114,15 @@ func isCompressedPubKey(pubKey []byte) bool {
 // and for a watchtower to later decrypt if action must be taken. The encoding
 // format is versioned to allow future extensions.
 type JusticeKit struct {
+	// BlobType encodes a bitfield that inform the tower of various features
+	// requested by the client when resolving a breach. Examples include
+	// whether the justice transaction contains a reward for the tower, or
+	// whether the channel is an anchor channel.
+	//
+	// NOTE: This value is not serialized in the encrypted payload. It is
+	// stored separately and added to the JusticeKit after decryption.
+	BlobType Type
+
 	// SweepAddress is the witness program of the output where the client's
 	// fund will be deposited. This value is included in the blobs, as
 	// opposed to the session info, such that the sweep addresses can't be
@@ -197,17 +206,33 @@ func (b *JusticeKit) HasCommitToRemoteOutput() bool {
 }
 
 // CommitToRemoteWitnessScript returns the serialized pubkey for the commitment
-// to-remote p2pkh output.
+// to-remote p2pkh output. For anchor channels, the redeem script of the
+// to-remote p2sh output is returned instead.
 func (b *JusticeKit) CommitToRemoteWitnessScript() ([]byte, error) {
 	if !isCompressedPubKey(b.CommitToRemotePubKey[:]) {
 		return nil, ErrNoCommitToRemoteOutput
 	}
 
+	// If this is a blob for an anchor channel, we'll return the p2sh
+	// redeem script, otherwise we'll return the pubkey of the p2pkh
+	// output.
+	if b.BlobType.IsAnchorChannel() {
+		toRemotePk, err := secp256k1.ParsePubKey(
+			b.CommitToRemotePubKey[:],
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		return input.CommitScriptToRemoteConfirmed(toRemotePk)
+	}
+
 	return b.CommitToRemotePubKey[:], nil
 }
 
 // CommitToRemoteWitnessStack returns a witness stack spending the commitment
-// to-remote output, which is a regular p2wkh.
+// to-remote output, which is a regular p2pkh or, for anchor channels, a p2sh
+// output that can only be spent after one confirmation.
 //   <to-remote-sig>
 func (b *JusticeKit) CommitToRemoteWitnessStack() ([][]byte, error) {
 	toRemoteSig, err := b.CommitToRemoteSig.ToSignature()
@@ -294,7 +319,9 @@ func Decrypt(key BreachKey, ciphertext []byte,
 
 	// If decryption succeeded, we will then decode the plaintext bytes
 	// using the specified blob version.
-	boj := &JusticeKit{}
+	boj := &JusticeKit{
+		BlobType: blobType,
+	}
 	err = boj.decode(bytes.NewReader(plaintext), blobType)
 	if err != nil {
 		return nil, err
@@ -81,6 +81,29 @@ var descriptorTests = []descriptorTest{
 		commitToRemotePubKey: makePubKey(2),
 		commitToRemoteSig:    makeSig(2),
 	},
+	{
+		name:             "anchor to-local only",
+		encVersion:       blob.TypeAltruistAnchorCommit,
+		decVersion:       blob.TypeAltruistAnchorCommit,
+		sweepAddr:        makeAddr(22),
+		revPubKey:        makePubKey(0),
+		delayPubKey:      makePubKey(1),
+		csvDelay:         144,
+		commitToLocalSig: makeSig(1),
+	},
+	{
+		name:                 "anchor to-local and p2sh to-remote",
+		encVersion:           blob.TypeRewardAnchorCommit,
+		decVersion:           blob.TypeRewardAnchorCommit,
+		sweepAddr:            makeAddr(22),
+		revPubKey:            makePubKey(0),
+		delayPubKey:          makePubKey(1),
+		csvDelay:             144,
+		commitToLocalSig:     makeSig(1),
+		hasCommitToRemote:    true,
+		commitToRemotePubKey: makePubKey(2),
+		commitToRemoteSig:    makeSig(2),
+	},
 	{
 		name:             "unknown encrypt version",
 		encVersion:       0,
@@ -150,6 +173,7 @@ func TestBlobJusticeKitEncryptDecrypt(t *testing.T) {
 
 func testBlobJusticeKitEncryptDecrypt(t *testing.T, test descriptorTest) {
 	boj := &blob.JusticeKit{
+		BlobType:             test.encVersion,
 		SweepAddress:         test.sweepAddr,
 		RevocationPubKey:     test.revPubKey,
 		LocalDelayPubKey:     test.delayPubKey,
@@ -214,10 +238,47 @@ func testBlobJusticeKitEncryptDecrypt(t *testing.T, test descriptorTest) {
 	}
 }
 
+type remoteWitnessTest struct {
+	name             string
+	blobType         blob.Type
+	expWitnessScript func(pk *secp256k1.PublicKey) []byte
+}
+
 // TestJusticeKitRemoteWitnessConstruction tests that a JusticeKit returns the
 // proper to-remote witnes script and to-remote witness stack. This should be
-// equivalent to p2wkh spend.
+// equivalent to a p2pkh spend for legacy channels and to a p2sh spend of the
+// one block delayed to-remote output for anchor channels.
 func TestJusticeKitRemoteWitnessConstruction(t *testing.T) {
+	tests := []remoteWitnessTest{
+		{
+			name:     "legacy commitment",
+			blobType: blob.TypeAltruistCommit,
+			expWitnessScript: func(pk *secp256k1.PublicKey) []byte {
+				return pk.SerializeCompressed()
+			},
+		},
+		{
+			name:     "anchor commitment",
+			blobType: blob.TypeAltruistAnchorCommit,
+			expWitnessScript: func(pk *secp256k1.PublicKey) []byte {
+				script, _ := input.CommitScriptToRemoteConfirmed(
+					pk,
+				)
+				return script
+			},
+		},
+	}
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			testJusticeKitRemoteWitnessConstruction(t, test)
+		})
+	}
+}
+
+func testJusticeKitRemoteWitnessConstruction(t *testing.T,
+	test remoteWitnessTest) {
+
 	// Generate the to-remote pubkey.
 	toRemotePrivKey, err := secp256k1.GeneratePrivateKey()
 	if err != nil {
@@ -243,6 +304,7 @@ func TestJusticeKitRemoteWitnessConstruction(t *testing.T) {
 
 	// Populate the justice kit fields relevant to the to-remote output.
 	justiceKit := &blob.JusticeKit{
+		BlobType:             test.blobType,
 		CommitToRemotePubKey: toRemotePubKey,
 		CommitToRemoteSig:    commitToRemoteSig,
 	}
@@ -254,15 +316,15 @@ func TestJusticeKitRemoteWitnessConstruction(t *testing.T) {
 		t.Fatalf("unable to compute to-remote witness script: %v", err)
 	}
 
-	// Assert this is exactly the to-remote, compressed pubkey.
-	if !bytes.Equal(toRemoteScript, toRemotePubKey[:]) {
-		t.Fatalf("to-remote witness script should be equal to "+
-			"to-remote pubkey, want: %x, got %x",
-			toRemotePubKey[:], toRemoteScript)
+	// Assert this is exactly the expected to-remote script.
+	expScript := test.expWitnessScript(toRemotePrivKey.PubKey())
+	if !bytes.Equal(toRemoteScript, expScript) {
+		t.Fatalf("mismatched to-remote witness script, want: %x, "+
+			"got %x", expScript, toRemoteScript)
 	}
 
-	// Next, compute the to-remote witness stack, which should be a p2wkh
-	// witness stack consisting solely of a signature.
+	// Next, compute the to-remote witness stack, which should consist
+	// solely of a signature.
 	toRemoteWitnessStack, err := justiceKit.CommitToRemoteWitnessStack()
 	if err != nil {
 		t.Fatalf("unable to compute to-remote witness stack: %v", err)
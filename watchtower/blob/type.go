@@ -19,6 +19,11 @@ const (
 	// FlagCommitOutputs signals that the blob contains the information
 	// required to sweep commitment outputs.
 	FlagCommitOutputs
+
+	// FlagAnchorChannel signals that this blob is meant to spend an anchor
+	// channel, and therefore must expect a P2SH-style to-remote output
+	// encumbered by a CSV delay of one block.
+	FlagAnchorChannel
 )
 
 // Type returns a Type consisting solely of this flag enabled.
@@ -33,6 +38,8 @@ func (f Flag) String() string {
 		return "FlagReward"
 	case FlagCommitOutputs:
 		return "FlagCommitOutputs"
+	case FlagAnchorChannel:
+		return "FlagAnchorChannel"
 	default:
 		return "FlagUnknown"
 	}
@@ -53,6 +60,18 @@ const (
 	// TypeRewardCommit sweeps only commitment outputs to a sweep address
 	// controlled by the user, and pays a negotiated reward to the tower.
 	TypeRewardCommit = Type(FlagCommitOutputs | FlagReward)
+
+	// TypeAltruistAnchorCommit sweeps only commitment outputs of an anchor
+	// channel to a sweep address controlled by the user, and does not give
+	// the tower a reward.
+	TypeAltruistAnchorCommit = Type(FlagCommitOutputs | FlagAnchorChannel)
+
+	// TypeRewardAnchorCommit sweeps only commitment outputs of an anchor
+	// channel to a sweep address controlled by the user, and pays a
+	// negotiated reward to the tower.
+	TypeRewardAnchorCommit = Type(
+		FlagCommitOutputs | FlagAnchorChannel | FlagReward,
+	)
 )
 
 // Has returns true if the Type has the passed flag enabled.
@@ -60,6 +79,11 @@ func (t Type) Has(flag Flag) bool {
 	return Flag(t)&flag == flag
 }
 
+// IsAnchorChannel returns true when the blob type is for an anchor channel.
+func (t Type) IsAnchorChannel() bool {
+	return t.Has(FlagAnchorChannel)
+}
+
 // TypeFromFlags creates a single Type from an arbitrary list of flags.
 func TypeFromFlags(flags ...Flag) Type {
 	var typ Type
@@ -74,6 +98,7 @@ func TypeFromFlags(flags ...Flag) Type {
 var knownFlags = map[Flag]struct{}{
 	FlagReward:        {},
 	FlagCommitOutputs: {},
+	FlagAnchorChannel: {},
 }
 
 // String returns a human readable description of a Type.
@@ -120,8 +145,10 @@ func (t Type) String() string {
 // supportedTypes is the set of all configurations known to be supported by the
 // package.
 var supportedTypes = map[Type]struct{}{
-	TypeAltruistCommit: {},
-	TypeRewardCommit:   {},
+	TypeAltruistCommit:       {},
+	TypeRewardCommit:         {},
+	TypeAltruistAnchorCommit: {},
+	TypeRewardAnchorCommit:   {},
 }
 
 // IsSupportedType returns true if the given type is supported by the package.
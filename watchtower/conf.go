@@ -21,6 +21,22 @@ type Conf struct {
 	// WriteTimeout specifies the duration the tower will wait when trying
 	// to write a message from a client before hanging up.
 	WriteTimeout time.Duration `long:"writetimeout" description:"Duration the watchtower server will wait for messages to be written before hanging up on client connections"`
+
+	// MaxSessionsPerClient limits the number of sessions a single client
+	// key may create on the tower.
+	MaxSessionsPerClient uint32 `long:"maxsessionsperclient" description:"Maximum number of sessions a single client key may create before its usage is reset, 0 means unlimited"`
+
+	// MaxUpdatesPerClient limits the number of state updates a single
+	// client key may store on the tower.
+	MaxUpdatesPerClient uint64 `long:"maxupdatesperclient" description:"Maximum number of state updates a single client key may store before its usage is reset, 0 means unlimited"`
+
+	// MaxSessionsPerAddress limits the number of sessions the clients
+	// connecting from a single address may create on the tower.
+	MaxSessionsPerAddress uint32 `long:"maxsessionsperaddress" description:"Maximum number of sessions the clients connecting from a single IP address or IPv6 /64 prefix may create before its usage is reset, 0 means unlimited. Clients connecting through a loopback address, e.g. through Tor, are exempt"`
+
+	// MaxUpdatesPerAddress limits the number of state updates the clients
+	// connecting from a single address may store on the tower.
+	MaxUpdatesPerAddress uint64 `long:"maxupdatesperaddress" description:"Maximum number of state updates the clients connecting from a single IP address or IPv6 /64 prefix may store before its usage is reset, 0 means unlimited. Clients connecting through a loopback address, e.g. through Tor, are exempt"`
 }
 
 // Apply completes the passed Config struct by applying any parsed Conf options.
@@ -86,5 +102,20 @@ func (c *Conf) Apply(cfg *Config,
 		cfg.WriteTimeout = c.WriteTimeout
 	}
 
+	// If the Config has no client quotas, we will use the parsed Conf
+	// values.
+	if cfg.MaxSessionsPerClient == 0 {
+		cfg.MaxSessionsPerClient = c.MaxSessionsPerClient
+	}
+	if cfg.MaxUpdatesPerClient == 0 {
+		cfg.MaxUpdatesPerClient = c.MaxUpdatesPerClient
+	}
+	if cfg.MaxSessionsPerAddress == 0 {
+		cfg.MaxSessionsPerAddress = c.MaxSessionsPerAddress
+	}
+	if cfg.MaxUpdatesPerAddress == 0 {
+		cfg.MaxUpdatesPerAddress = c.MaxUpdatesPerAddress
+	}
+
 	return cfg, nil
 }
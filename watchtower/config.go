@@ -106,4 +106,26 @@ type Config struct {
 	// Type specifies the hidden service type (V2 or V3) that the watchtower
 	// will create.
 	Type tor.OnionType
+
+	// MaxSessionsPerClient is the maximum number of sessions a single
+	// client key may create before its usage is reset. A value of zero
+	// means that the number of sessions is unlimited.
+	MaxSessionsPerClient uint32
+
+	// MaxUpdatesPerClient is the maximum number of state updates a single
+	// client key may store before its usage is reset. A value of zero
+	// means that the number of state updates is unlimited.
+	MaxUpdatesPerClient uint64
+
+	// MaxSessionsPerAddress is the maximum number of sessions the clients
+	// connecting from a single address may create before its usage is
+	// reset. A value of zero means that the number of sessions is
+	// unlimited.
+	MaxSessionsPerAddress uint32
+
+	// MaxUpdatesPerAddress is the maximum number of state updates the
+	// clients connecting from a single address may store before its usage
+	// is reset. A value of zero means that the number of state updates is
+	// unlimited.
+	MaxUpdatesPerAddress uint64
 }
@@ -53,6 +53,7 @@ type breachedInput struct {
 	txOut    *wire.TxOut
 	outPoint wire.OutPoint
 	witness  [][]byte
+	sequence uint32
 }
 
 // commitToLocalInput extracts the information required to spend the commit
@@ -104,25 +105,42 @@ func (p *JusticeDescriptor) commitToLocalInput() (*breachedInput, error) {
 // to-remote output.
 func (p *JusticeDescriptor) commitToRemoteInput() (*breachedInput, error) {
 	// Retrieve the to-remote witness script from the justice kit.
-	toRemoteSerPubKey, err := p.JusticeKit.CommitToRemoteWitnessScript()
+	toRemoteScript, err := p.JusticeKit.CommitToRemoteWitnessScript()
 	if err != nil {
 		return nil, err
 	}
 
-	// Since the to-remote witness script should just be a regular p2wkh
-	// output, we'll parse it to retrieve the public key.
-	toRemotePubKey, err := secp256k1.ParsePubKey(toRemoteSerPubKey)
-	if err != nil {
-		return nil, err
-	}
-
-	// Compute the witness script hash from the to-remote pubkey, which will
-	// be used to locate the input on the breach commitment transaction.
-	toRemotePkScript, err := input.CommitScriptUnencumbered(
-		toRemotePubKey,
+	var (
+		toRemotePkScript []byte
+		sequence         uint32
 	)
-	if err != nil {
-		return nil, err
+	switch {
+	// Anchor channels pay to a p2sh output that can only be spent after
+	// one confirmation, so we'll compute the script hash of the redeem
+	// script and signal the relative locktime in the input's sequence.
+	case p.JusticeKit.BlobType.IsAnchorChannel():
+		toRemotePkScript, err = input.ScriptHashPkScript(toRemoteScript)
+		if err != nil {
+			return nil, err
+		}
+		sequence = 1
+
+	// Otherwise, the to-remote witness script should just be a regular
+	// p2pkh output, so we'll parse it to retrieve the public key and
+	// compute the pkscript used to locate the input on the breach
+	// commitment transaction.
+	default:
+		toRemotePubKey, err := secp256k1.ParsePubKey(toRemoteScript)
+		if err != nil {
+			return nil, err
+		}
+
+		toRemotePkScript, err = input.CommitScriptUnencumbered(
+			toRemotePubKey,
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// Locate the to-remote output on the breaching commitment transaction.
@@ -150,7 +168,8 @@ func (p *JusticeDescriptor) commitToRemoteInput() (*breachedInput, error) {
 	return &breachedInput{
 		txOut:    toRemoteTxOut,
 		outPoint: toRemoteOutPoint,
-		witness:  buildWitness(witnessStack, toRemoteSerPubKey),
+		witness:  buildWitness(witnessStack, toRemoteScript),
+		sequence: sequence,
 	}, nil
 }
 
@@ -171,6 +190,7 @@ func (p *JusticeDescriptor) assembleJusticeTxn(txSize int64,
 		justiceTxn.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: input.outPoint,
 			ValueIn:          input.txOut.Value,
+			Sequence:         input.sequence,
 		})
 	}
 
@@ -286,7 +306,13 @@ func (p *JusticeDescriptor) CreateJusticeTxn() (*wire.MsgTx, error) {
 		if err != nil {
 			return nil, err
 		}
-		sizeEstimate.AddP2PKHInput()
+		if p.JusticeKit.BlobType.IsAnchorChannel() {
+			sizeEstimate.AddCustomInput(
+				input.ToRemoteConfirmedWitnessSize,
+			)
+		} else {
+			sizeEstimate.AddP2PKHInput()
+		}
 		sweepInputs = append(sweepInputs, toRemoteInput)
 	}
 
@@ -54,6 +54,14 @@ var (
 	)
 
 	altruistCommitType = blob.FlagCommitOutputs.Type()
+
+	rewardAnchorCommitType = blob.TypeFromFlags(
+		blob.FlagReward, blob.FlagCommitOutputs, blob.FlagAnchorChannel,
+	)
+
+	altruistAnchorCommitType = blob.TypeFromFlags(
+		blob.FlagCommitOutputs, blob.FlagAnchorChannel,
+	)
 )
 
 // TestJusticeDescriptor asserts that a JusticeDescriptor is able to produce the
@@ -71,6 +79,14 @@ func TestJusticeDescriptor(t *testing.T) {
 			name:     "altruist and commit type",
 			blobType: altruistCommitType,
 		},
+		{
+			name:     "reward and anchor commit type",
+			blobType: rewardAnchorCommitType,
+		},
+		{
+			name:     "altruist and anchor commit type",
+			blobType: altruistAnchorCommitType,
+		},
 	}
 
 	for _, test := range tests {
@@ -119,10 +135,37 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 		t.Fatalf("unable to create to-local witness script hash: %v", err)
 	}
 
-	// Compute the to-remote witness script hash.
-	toRemoteScriptHash, err := input.CommitScriptUnencumbered(toRemotePK)
-	if err != nil {
-		t.Fatalf("unable to create to-remote script: %v", err)
+	// Compute the to-remote pkscript. For anchor channels, this is the
+	// script hash of a redeem script with a one block CSV delay, otherwise
+	// it pays directly to the to-remote pubkey.
+	var (
+		toRemoteScript     []byte
+		toRemoteScriptHash []byte
+	)
+	if blobType.IsAnchorChannel() {
+		toRemoteScript, err = input.CommitScriptToRemoteConfirmed(
+			toRemotePK,
+		)
+		if err != nil {
+			t.Fatalf("unable to create to-remote script: %v", err)
+		}
+
+		toRemoteScriptHash, err = input.ScriptHashPkScript(
+			toRemoteScript,
+		)
+		if err != nil {
+			t.Fatalf("unable to create to-remote script hash: %v",
+				err)
+		}
+	} else {
+		toRemoteScriptHash, err = input.CommitScriptUnencumbered(
+			toRemotePK,
+		)
+		if err != nil {
+			t.Fatalf("unable to create to-remote script: %v", err)
+		}
+
+		toRemoteScript = toRemoteScriptHash
 	}
 
 	// Construct the breaching commitment txn, containing the to-local and
@@ -146,7 +189,11 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	// Compute the size estimate for our justice transaction.
 	var sizeEstimate input.TxSizeEstimator
 	sizeEstimate.AddCustomInput(input.ToLocalPenaltySigScriptSize)
-	sizeEstimate.AddP2PKHInput()
+	if blobType.IsAnchorChannel() {
+		sizeEstimate.AddCustomInput(input.ToRemoteConfirmedWitnessSize)
+	} else {
+		sizeEstimate.AddP2PKHInput()
+	}
 	sizeEstimate.AddP2PKHOutput()
 	if blobType.Has(blob.FlagReward) {
 		sizeEstimate.AddP2PKHOutput()
@@ -171,6 +218,7 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	// Begin to assemble the justice kit, starting with the sweep address,
 	// pubkeys, and csv delay.
 	justiceKit := &blob.JusticeKit{
+		BlobType:     blobType,
 		SweepAddress: makeRandomP2PKHPkScript(),
 		CSVDelay:     csvDelay,
 	}
@@ -181,7 +229,12 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	// Create a transaction spending from the outputs of the breach
 	// transaction created earlier. The inputs are always ordered w/
 	// to-local and then to-remote. The outputs are always added as the
-	// sweep address then reward address.
+	// sweep address then reward address. The to-remote output of anchor
+	// channels can only be spent after one confirmation.
+	var toRemoteSequence uint32
+	if blobType.IsAnchorChannel() {
+		toRemoteSequence = 1
+	}
 	justiceTxn := &wire.MsgTx{
 		Version: 2,
 		TxIn: []*wire.TxIn{
@@ -197,7 +250,8 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 					Hash:  breachTxID,
 					Index: 1,
 				},
-				ValueIn: breachTxn.TxOut[1].Value,
+				ValueIn:  breachTxn.TxOut[1].Value,
+				Sequence: toRemoteSequence,
 			},
 		},
 	}
@@ -231,7 +285,7 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 			KeyLocator: toRemoteKeyLoc,
 			PubKey:     toRemotePK,
 		},
-		WitnessScript: toRemoteScriptHash,
+		WitnessScript: toRemoteScript,
 		Output:        breachTxn.TxOut[1],
 		InputIndex:    1,
 		HashType:      txscript.SigHashAll,
@@ -251,9 +305,16 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	// Compute the witness for the to-remote input. The first element is a
 	// DER-encoded signature under the to-remote pubkey. The sighash flag is
 	// also present, so we trim it.
-	toRemoteWitness, err := input.CommitSpendNoDelay(
-		signer, toRemoteSignDesc, justiceTxn, false,
-	)
+	var toRemoteWitness input.TxWitness
+	if blobType.IsAnchorChannel() {
+		toRemoteWitness, err = input.CommitSpendToRemoteConfirmed(
+			signer, toRemoteSignDesc, justiceTxn,
+		)
+	} else {
+		toRemoteWitness, err = input.CommitSpendNoDelay(
+			signer, toRemoteSignDesc, justiceTxn, false,
+		)
+	}
 	if err != nil {
 		t.Fatalf("unable to sign to-remote input: %v", err)
 	}
@@ -321,6 +382,9 @@ func testJusticeDescriptor(t *testing.T, blobType blob.Type) {
 	wstack1 := make([][]byte, 2)
 	wstack1[0] = append(toRemoteSigRaw, byte(txscript.SigHashAll))
 	wstack1[1] = toRemotePK.SerializeCompressed()
+	if blobType.IsAnchorChannel() {
+		wstack1[1] = toRemoteScript
+	}
 	justiceTxn.TxIn[1].SignatureScript, err = input.WitnessStackToSigScript(wstack1)
 	if err != nil {
 		t.Fatalf("error assembling wstack1: %v", err)
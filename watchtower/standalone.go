@@ -8,6 +8,7 @@ import (
 	"github.com/decred/dcrlnd/brontide"
 	"github.com/decred/dcrlnd/tor"
 	"github.com/decred/dcrlnd/watchtower/lookout"
+	"github.com/decred/dcrlnd/watchtower/wtdb"
 	"github.com/decred/dcrlnd/watchtower/wtserver"
 )
 
@@ -84,14 +85,18 @@ func New(cfg *Config) (*Standalone, error) {
 
 	// Initialize the server with its required resources.
 	server, err := wtserver.New(&wtserver.Config{
-		ChainHash:     cfg.ChainHash,
-		DB:            cfg.DB,
-		NodeKeyECDH:   cfg.NodeKeyECDH,
-		Listeners:     listeners,
-		ReadTimeout:   cfg.ReadTimeout,
-		WriteTimeout:  cfg.WriteTimeout,
-		NewAddress:    cfg.NewAddress,
-		DisableReward: true,
+		ChainHash:             cfg.ChainHash,
+		DB:                    cfg.DB,
+		NodeKeyECDH:           cfg.NodeKeyECDH,
+		Listeners:             listeners,
+		ReadTimeout:           cfg.ReadTimeout,
+		WriteTimeout:          cfg.WriteTimeout,
+		NewAddress:            cfg.NewAddress,
+		DisableReward:         true,
+		MaxSessionsPerClient:  cfg.MaxSessionsPerClient,
+		MaxUpdatesPerClient:   cfg.MaxUpdatesPerClient,
+		MaxSessionsPerAddress: cfg.MaxSessionsPerAddress,
+		MaxUpdatesPerAddress:  cfg.MaxUpdatesPerAddress,
 	})
 	if err != nil {
 		return nil, err
@@ -217,3 +222,43 @@ func (w *Standalone) ExternalIPs() []net.Addr {
 
 	return addrs
 }
+
+// ClientQuotas returns the maximum number of sessions and state updates a
+// single client key may store on the watchtower. A value of zero means that the
+// respective resource is unlimited.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ClientQuotas() (uint32, uint64) {
+	return w.cfg.MaxSessionsPerClient, w.cfg.MaxUpdatesPerClient
+}
+
+// AddressQuotas returns the maximum number of sessions and state updates the
+// clients connecting from a single address may store on the watchtower. A
+// value of zero means that the respective resource is unlimited.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) AddressQuotas() (uint32, uint64) {
+	return w.cfg.MaxSessionsPerAddress, w.cfg.MaxUpdatesPerAddress
+}
+
+// ClientUsage returns the resources consumed by the client with the given id
+// since its usage was last reset.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ClientUsage(id wtdb.ClientID) (*wtdb.ClientUsage, error) {
+	return w.cfg.DB.GetClientUsage(id)
+}
+
+// ResetClientUsage clears the resources accounted to the client with the given
+// id, allowing it to consume its full quota again.
+//
+// NOTE: Part of the watchtowerrpc.WatchtowerBackend interface.
+func (w *Standalone) ResetClientUsage(id wtdb.ClientID) error {
+	if err := w.cfg.DB.ResetClientUsage(id); err != nil {
+		return err
+	}
+
+	log.Infof("Reset usage of client %s", id)
+
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/decred/dcrd/dcrutil/v4"
 	"github.com/decred/dcrd/dcrutil/v4/txsort"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
@@ -58,7 +59,7 @@ func newBackupTask(chanID *lnwire.ChannelID,
 	breachInfo *lnwallet.BreachRetribution,
 	sweepPkScript []byte,
 	chainParams *chaincfg.Params,
-	isTweakless bool) *backupTask {
+	chanType channeldb.ChannelType) *backupTask {
 
 	// Parse the non-dust outputs from the breach transaction,
 	// simultaneously computing the total amount contained in the inputs
@@ -89,18 +90,35 @@ func newBackupTask(chanID *lnwire.ChannelID,
 		totalAmt += breachInfo.RemoteOutputSignDesc.Output.Value
 	}
 	if breachInfo.LocalOutputSignDesc != nil {
-		witnessType := input.CommitmentNoDelay
-		if isTweakless {
-			witnessType = input.CommitSpendNoDelayTweakless
+		switch {
+		// Anchor channels pay to a p2sh to-remote output that can only
+		// be spent after one confirmation, so the input must signal
+		// the relative locktime.
+		case chanType.HasAnchors():
+			toRemoteInput = input.NewCsvInput(
+				&breachInfo.LocalOutpoint,
+				input.CommitmentToRemoteConfirmed,
+				breachInfo.LocalOutputSignDesc,
+				0, 1,
+			)
+
+		case chanType.IsTweakless():
+			toRemoteInput = input.NewBaseInput(
+				&breachInfo.LocalOutpoint,
+				input.CommitSpendNoDelayTweakless,
+				breachInfo.LocalOutputSignDesc,
+				0,
+			)
+
+		default:
+			toRemoteInput = input.NewBaseInput(
+				&breachInfo.LocalOutpoint,
+				input.CommitmentNoDelay,
+				breachInfo.LocalOutputSignDesc,
+				0,
+			)
 		}
 
-		toRemoteInput = input.NewBaseInput(
-			&breachInfo.LocalOutpoint,
-			witnessType,
-			breachInfo.LocalOutputSignDesc,
-			0,
-		)
-
 		totalAmt += breachInfo.LocalOutputSignDesc.Output.Value
 	}
 
@@ -149,7 +167,15 @@ func (t *backupTask) bindSession(session *wtdb.ClientSessionBody) error {
 		sizeEstimate.AddCustomInput(input.ToLocalPenaltySigScriptSize)
 	}
 	if t.toRemoteInput != nil {
-		sizeEstimate.AddP2PKHInput()
+		switch t.toRemoteInput.WitnessType() {
+		case input.CommitmentToRemoteConfirmed:
+			sizeEstimate.AddCustomInput(
+				input.ToRemoteConfirmedWitnessSize,
+			)
+
+		default:
+			sizeEstimate.AddP2PKHInput()
+		}
 	}
 
 	// All justice transactions have a p2pkh output paying to the victim.
@@ -192,6 +218,7 @@ func (t *backupTask) craftSessionPayload(
 	// to-local script, and the remote CSV delay.
 	keyRing := t.breachInfo.KeyRing
 	justiceKit := &blob.JusticeKit{
+		BlobType:         t.blobType,
 		SweepAddress:     t.sweepPkScript,
 		RevocationPubKey: toBlobPubKey(keyRing.RevocationKey),
 		LocalDelayPubKey: toBlobPubKey(keyRing.ToLocalKey),
@@ -215,11 +242,13 @@ func (t *backupTask) craftSessionPayload(
 
 	// Next, add the non-dust inputs that were derived from the breach
 	// information. This will either be contain both the to-local and
-	// to-remote outputs, or only be the to-local output.
+	// to-remote outputs, or only be the to-local output. The sequence of
+	// each input signals the relative locktime it requires, if any.
 	inputs := t.inputs()
-	for prevOutPoint := range inputs {
+	for prevOutPoint, inp := range inputs {
 		justiceTxn.AddTxIn(&wire.TxIn{
 			PreviousOutPoint: prevOutPoint,
+			Sequence:         inp.BlocksToMaturity(),
 		})
 	}
 
@@ -282,6 +311,8 @@ func (t *backupTask) craftSessionPayload(
 		case input.CommitSpendNoDelayTweakless:
 			fallthrough
 		case input.CommitmentNoDelay:
+			fallthrough
+		case input.CommitmentToRemoteConfirmed:
 			copy(justiceKit.CommitToRemoteSig[:], signature[:])
 		}
 	}
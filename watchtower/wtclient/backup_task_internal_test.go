@@ -14,6 +14,7 @@ import (
 	"github.com/decred/dcrd/txscript/v4"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/channeldb"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnwallet"
@@ -84,7 +85,7 @@ type backupTaskTest struct {
 	bindErr          error
 	expSweepScript   []byte
 	signer           input.Signer
-	tweakless        bool
+	chanType         channeldb.ChannelType
 }
 
 func privKeyFromBytes(b []byte) (*secp256k1.PrivateKey, *secp256k1.PublicKey) {
@@ -107,7 +108,7 @@ func genTaskTest(
 	expSweepAmt int64,
 	expRewardAmt int64,
 	bindErr error,
-	tweakless bool) backupTaskTest {
+	chanType channeldb.ChannelType) backupTaskTest {
 
 	// Parse the key pairs for all keys used in the test.
 	revSK, revPK := privKeyFromBytes(
@@ -175,6 +176,15 @@ func genTaskTest(
 			},
 			HashType: txscript.SigHashAll,
 		}
+		if chanType.HasAnchors() {
+			script, err := input.CommitScriptToRemoteConfirmed(
+				toRemotePK,
+			)
+			if err != nil {
+				panic(err)
+			}
+			toRemoteSignDesc.WitnessScript = script
+		}
 		breachInfo.LocalOutputSignDesc = toRemoteSignDesc
 		breachTxn.AddTxOut(toRemoteSignDesc.Output)
 	}
@@ -208,17 +218,31 @@ func genTaskTest(
 			Index: index,
 		}
 
-		witnessType := input.CommitmentNoDelay
-		if tweakless {
-			witnessType = input.CommitSpendNoDelayTweakless
+		switch {
+		case chanType.HasAnchors():
+			toRemoteInput = input.NewCsvInput(
+				&breachInfo.LocalOutpoint,
+				input.CommitmentToRemoteConfirmed,
+				breachInfo.LocalOutputSignDesc,
+				0, 1,
+			)
+
+		case chanType.IsTweakless():
+			toRemoteInput = input.NewBaseInput(
+				&breachInfo.LocalOutpoint,
+				input.CommitSpendNoDelayTweakless,
+				breachInfo.LocalOutputSignDesc,
+				0,
+			)
+
+		default:
+			toRemoteInput = input.NewBaseInput(
+				&breachInfo.LocalOutpoint,
+				input.CommitmentNoDelay,
+				breachInfo.LocalOutputSignDesc,
+				0,
+			)
 		}
-
-		toRemoteInput = input.NewBaseInput(
-			&breachInfo.LocalOutpoint,
-			witnessType,
-			breachInfo.LocalOutputSignDesc,
-			0,
-		)
 	}
 
 	return backupTaskTest{
@@ -243,7 +267,7 @@ func genTaskTest(
 		bindErr:        bindErr,
 		expSweepScript: makeAddrSlice(22),
 		signer:         signer,
-		tweakless:      tweakless,
+		chanType:       chanType,
 	}
 }
 
@@ -252,6 +276,10 @@ var (
 
 	blobTypeCommitReward = (blob.FlagCommitOutputs | blob.FlagReward).Type()
 
+	blobTypeAnchorCommitNoReward = blob.TypeAltruistAnchorCommit
+
+	blobTypeAnchorCommitReward = blob.TypeRewardAnchorCommit
+
 	addr, _ = stdaddr.DecodeAddress(
 		"Tsi6gGYNSMmFwi7JoL5Li39SrERZTTMu6vY",
 		chaincfg.TestNet3Params(),
@@ -271,7 +299,11 @@ func TestBackupTask(t *testing.T) {
 	t.Parallel()
 
 	var backupTaskTests []backupTaskTest
-	for _, tweakless := range []bool{true, false} {
+	chanTypes := []channeldb.ChannelType{
+		channeldb.SingleFunderBit,
+		channeldb.SingleFunderTweaklessBit,
+	}
+	for _, chanType := range chanTypes {
 		backupTaskTests = append(backupTaskTests, []backupTaskTest{
 			genTaskTest(
 				"commit no-reward, both outputs",
@@ -284,7 +316,7 @@ func TestBackupTask(t *testing.T) {
 				299568,                 // expSweepAmt
 				0,                      // expRewardAmt
 				nil,                    // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit no-reward, to-local output only",
@@ -297,7 +329,7 @@ func TestBackupTask(t *testing.T) {
 				199734,                 // expSweepAmt
 				0,                      // expRewardAmt
 				nil,                    // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit no-reward, to-remote output only",
@@ -310,7 +342,7 @@ func TestBackupTask(t *testing.T) {
 				99783,                  // expSweepAmt
 				0,                      // expRewardAmt
 				nil,                    // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit no-reward, to-remote output only, creates dust",
@@ -323,7 +355,7 @@ func TestBackupTask(t *testing.T) {
 				0,                       // expSweepAmt
 				0,                       // expRewardAmt
 				wtpolicy.ErrCreatesDust, // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit no-reward, no outputs, fee rate exceeds inputs",
@@ -336,7 +368,7 @@ func TestBackupTask(t *testing.T) {
 				0,                            // expSweepAmt
 				0,                            // expRewardAmt
 				wtpolicy.ErrFeeExceedsInputs, // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit no-reward, no outputs, fee rate of 0 creates dust",
@@ -349,7 +381,7 @@ func TestBackupTask(t *testing.T) {
 				0,                       // expSweepAmt
 				0,                       // expRewardAmt
 				wtpolicy.ErrCreatesDust, // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit reward, both outputs",
@@ -362,7 +394,7 @@ func TestBackupTask(t *testing.T) {
 				296532,               // expSweepAmt
 				3000,                 // expRewardAmt
 				nil,                  // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit reward, to-local output only",
@@ -375,7 +407,7 @@ func TestBackupTask(t *testing.T) {
 				197698,               // expSweepAmt
 				2000,                 // expRewardAmt
 				nil,                  // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit reward, to-remote output only",
@@ -388,7 +420,7 @@ func TestBackupTask(t *testing.T) {
 				98747,                // expSweepAmt
 				1000,                 // expRewardAmt
 				nil,                  // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit reward, to-remote output only, creates dust",
@@ -401,7 +433,7 @@ func TestBackupTask(t *testing.T) {
 				0,                       // expSweepAmt
 				0,                       // expRewardAmt
 				wtpolicy.ErrCreatesDust, // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit reward, no outputs, fee rate exceeds inputs",
@@ -414,7 +446,7 @@ func TestBackupTask(t *testing.T) {
 				0,                            // expSweepAmt
 				0,                            // expRewardAmt
 				wtpolicy.ErrFeeExceedsInputs, // bindErr
-				tweakless,
+				chanType,
 			),
 			genTaskTest(
 				"commit reward, no outputs, fee rate of 0 creates dust",
@@ -427,11 +459,83 @@ func TestBackupTask(t *testing.T) {
 				0,                       // expSweepAmt
 				0,                       // expRewardAmt
 				wtpolicy.ErrCreatesDust, // bindErr
-				tweakless,
+				chanType,
 			),
 		}...)
 	}
 
+	// Anchor channels sweep their to-remote output through a p2sh script
+	// with a one block CSV delay, which is slightly larger to redeem.
+	anchorChanType := channeldb.SingleFunderTweaklessBit |
+		channeldb.AnchorOutputsBit
+	backupTaskTests = append(backupTaskTests, []backupTaskTest{
+		genTaskTest(
+			"anchor commit no-reward, both outputs",
+			100,                          // stateNum
+			200000,                       // toLocalAmt
+			100000,                       // toRemoteAmt
+			blobTypeAnchorCommitNoReward, // blobType
+			1000,                         // sweepFeeRate
+			nil,                          // rewardScript
+			299564,                       // expSweepAmt
+			0,                            // expRewardAmt
+			nil,                          // bindErr
+			anchorChanType,
+		),
+		genTaskTest(
+			"anchor commit no-reward, to-local output only",
+			1000,                         // stateNum
+			200000,                       // toLocalAmt
+			0,                            // toRemoteAmt
+			blobTypeAnchorCommitNoReward, // blobType
+			1000,                         // sweepFeeRate
+			nil,                          // rewardScript
+			199734,                       // expSweepAmt
+			0,                            // expRewardAmt
+			nil,                          // bindErr
+			anchorChanType,
+		),
+		genTaskTest(
+			"anchor commit no-reward, to-remote output only",
+			1,                            // stateNum
+			0,                            // toLocalAmt
+			100000,                       // toRemoteAmt
+			blobTypeAnchorCommitNoReward, // blobType
+			1000,                         // sweepFeeRate
+			nil,                          // rewardScript
+			99779,                        // expSweepAmt
+			0,                            // expRewardAmt
+			nil,                          // bindErr
+			anchorChanType,
+		),
+		genTaskTest(
+			"anchor commit reward, both outputs",
+			100,                        // stateNum
+			200000,                     // toLocalAmt
+			100000,                     // toRemoteAmt
+			blobTypeAnchorCommitReward, // blobType
+			1000,                       // sweepFeeRate
+			addrScript,                 // rewardScript
+			296528,                     // expSweepAmt
+			3000,                       // expRewardAmt
+			nil,                        // bindErr
+			anchorChanType,
+		),
+		genTaskTest(
+			"anchor commit reward, to-remote output only",
+			1,                          // stateNum
+			0,                          // toLocalAmt
+			100000,                     // toRemoteAmt
+			blobTypeAnchorCommitReward, // blobType
+			1000,                       // sweepFeeRate
+			addrScript,                 // rewardScript
+			98743,                      // expSweepAmt
+			1000,                       // expRewardAmt
+			nil,                        // bindErr
+			anchorChanType,
+		),
+	}...)
+
 	for _, test := range backupTaskTests {
 		test := test
 
@@ -447,7 +551,7 @@ func testBackupTask(t *testing.T, test backupTaskTest) {
 	// Create a new backupTask from the channel id and breach info.
 	task := newBackupTask(
 		&test.chanID, test.breachInfo, test.expSweepScript,
-		chaincfg.TestNet3Params(), test.tweakless,
+		chaincfg.TestNet3Params(), test.chanType,
 	)
 
 	// Assert that all parameters set during initialization are properly
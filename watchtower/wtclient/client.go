@@ -2,8 +2,10 @@ package wtclient
 
 import (
 	"bytes"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"math/big"
 	"net"
 	"sync"
 	"time"
@@ -11,10 +13,14 @@ import (
 	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
+	"github.com/decred/dcrlnd/chainntnfs"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/channelnotifier"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/subscribe"
 	"github.com/decred/dcrlnd/watchtower/wtdb"
 	"github.com/decred/dcrlnd/watchtower/wtpolicy"
 	"github.com/decred/dcrlnd/watchtower/wtserver"
@@ -38,6 +44,10 @@ const (
 	// client should abandon any pending updates or session negotiations
 	// before terminating.
 	DefaultForceQuitDelay = 10 * time.Second
+
+	// DefaultSessionCloseRange specifies the default range in blocks over
+	// which the deletion of a closable session is randomly delayed.
+	DefaultSessionCloseRange = 288
 )
 
 var (
@@ -90,6 +100,16 @@ type Client interface {
 	// Policy returns the active client policy configuration.
 	Policy() wtpolicy.Policy
 
+	// ExportSessions returns a backup of the client's towers and sessions,
+	// which allows a node restored from the same seed to keep using them.
+	ExportSessions() (*wtdb.ClientBackup, error)
+
+	// ImportSessions adds the towers and sessions of a backup to the
+	// client and returns the number of sessions that were imported. The
+	// towers with active sessions in the backup are considered for
+	// backups right away.
+	ImportSessions(*wtdb.ClientBackup) (int, error)
+
 	// RegisterChannel persistently initializes any channel-dependent
 	// parameters within the client. This should be called during link
 	// startup to ensure that the client is able to support the link during
@@ -100,10 +120,12 @@ type Client interface {
 	// state. If the method returns nil, the backup is guaranteed to be
 	// successful unless the client is force quit, or the justice
 	// transaction would create dust outputs when trying to abide by the
-	// negotiated policy. If the channel we're trying to back up doesn't
-	// have a tweak for the remote party's output, then isTweakless should
-	// be true.
-	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution, bool) error
+	// negotiated policy. The channel type determines the scripts of the
+	// outputs being swept, and must be compatible with the blob type of
+	// the client's policy, i.e. anchor channels must be backed up with a
+	// client using an anchor blob type.
+	BackupState(*lnwire.ChannelID, *lnwallet.BreachRetribution,
+		channeldb.ChannelType) error
 
 	// Start initializes the watchtower client, allowing it process requests
 	// to backup revoked channel states.
@@ -187,6 +209,41 @@ type Config struct {
 	// watchtowers. If the exponential backoff produces a timeout greater
 	// than this value, the backoff will be clamped to MaxBackoff.
 	MaxBackoff time.Duration
+
+	// SubscribeChannelEvents subscribes to the channel events of the node,
+	// allowing the client to learn about closed channels. The state kept
+	// for a closed channel is released, and the sessions that only hold
+	// updates of closed channels are deleted from their towers.
+	SubscribeChannelEvents func() (*subscribe.Client, error)
+
+	// FetchClosedChannel returns the close summary of the closed channel
+	// with the given ID, or channeldb.ErrClosedChannelNotFound if the
+	// channel isn't closed. It is used on startup to find the channels
+	// that were closed while the client was offline.
+	FetchClosedChannel func(lnwire.ChannelID) (
+		*channeldb.ChannelCloseSummary, error)
+
+	// ChainNotifier notifies the client of new blocks, which is when
+	// closable sessions are deleted from their towers.
+	ChainNotifier chainntnfs.ChainNotifier
+
+	// SessionCloseRange is the range in blocks over which the deletion of
+	// a closable session is randomly delayed, which makes it harder for a
+	// tower to link the session to the closing transactions of its
+	// channels. If the value is zero, the default will be used instead.
+	SessionCloseRange uint32
+}
+
+// closableSession is a session that only holds updates of closed channels and
+// is scheduled to be deleted from its tower.
+type closableSession struct {
+	// session is the closable session. It is nil if the session was
+	// negotiated for the other channel type, in which case it is deleted by
+	// the client handling that type.
+	session *wtdb.ClientSession
+
+	// deleteHeight is the height at which the session will be deleted.
+	deleteHeight uint32
 }
 
 // newTowerMsg is an internal message we'll use within the TowerClient to signal
@@ -252,7 +309,12 @@ type TowerClient struct {
 	newTowers   chan *newTowerMsg
 	staleTowers chan *staleTowerMsg
 
+	chanEventSub     *subscribe.Client
+	blockEpochs      *chainntnfs.BlockEpochEvent
+	closableSessions map[wtdb.SessionID]*closableSession
+
 	wg        sync.WaitGroup
+	quit      chan struct{}
 	forceQuit chan struct{}
 }
 
@@ -278,6 +340,11 @@ func New(config *Config) (*TowerClient, error) {
 		cfg.WriteTimeout = DefaultWriteTimeout
 	}
 
+	// Set the session close range to the default if none was provided.
+	if cfg.SessionCloseRange == 0 {
+		cfg.SessionCloseRange = DefaultSessionCloseRange
+	}
+
 	// Next, load all candidate sessions and towers from the database into
 	// the client. We will use any of these session if their policies match
 	// the current policy of the client, otherwise they will be ignored and
@@ -314,6 +381,8 @@ func New(config *Config) (*TowerClient, error) {
 		stats:             new(ClientStats),
 		newTowers:         make(chan *newTowerMsg),
 		staleTowers:       make(chan *staleTowerMsg),
+		closableSessions:  make(map[wtdb.SessionID]*closableSession),
+		quit:              make(chan struct{}),
 		forceQuit:         make(chan struct{}),
 	}
 	c.negotiator = newSessionNegotiator(&NegotiatorConfig{
@@ -358,6 +427,13 @@ func getClientSessions(db DB, keyRing ECDHKeyRing, forTower *wtdb.TowerID,
 	// requests. This prevents us from having to store the private keys on
 	// disk.
 	for _, s := range sessions {
+		// If an optional filter was provided, use it to filter out any
+		// undesired sessions.
+		if passesFilter != nil && !passesFilter(s) {
+			delete(sessions, s.ID)
+			continue
+		}
+
 		tower, err := db.LoadTowerByID(s.TowerID)
 		if err != nil {
 			return nil, err
@@ -372,12 +448,6 @@ func getClientSessions(db DB, keyRing ECDHKeyRing, forTower *wtdb.TowerID,
 			return nil, err
 		}
 		s.SessionKeyECDH = keychain.NewPubKeyECDH(towerKeyDesc, keyRing)
-
-		// If an optional filter was provided, use it to filter out any
-		// undesired sessions.
-		if passesFilter != nil && !passesFilter(s) {
-			delete(sessions, s.ID)
-		}
 	}
 
 	return sessions, nil
@@ -390,35 +460,42 @@ func getClientSessions(db DB, keyRing ECDHKeyRing, forTower *wtdb.TowerID,
 func (c *TowerClient) buildHighestCommitHeights() {
 	chanCommitHeights := make(map[lnwire.ChannelID]uint64)
 	for _, s := range c.candidateSessions {
-		// We only want to consider accepted updates that have been
-		// accepted under an identical policy to the client's current
-		// policy.
-		if s.Policy != c.cfg.Policy {
-			continue
-		}
+		c.addCommitHeights(chanCommitHeights, s)
+	}
 
-		// Take the highest commit height found in the session's
-		// committed updates.
-		for _, committedUpdate := range s.CommittedUpdates {
-			bid := committedUpdate.BackupID
+	c.chanCommitHeights = chanCommitHeights
+}
 
-			height, ok := chanCommitHeights[bid.ChanID]
-			if !ok || bid.CommitHeight > height {
-				chanCommitHeights[bid.ChanID] = bid.CommitHeight
-			}
-		}
+// addCommitHeights raises the commit heights of the channels the session holds
+// updates of to the highest commit height found in the session.
+func (c *TowerClient) addCommitHeights(
+	chanCommitHeights map[lnwire.ChannelID]uint64, s *wtdb.ClientSession) {
 
-		// Take the heights commit height found in the session's acked
-		// updates.
-		for _, bid := range s.AckedUpdates {
-			height, ok := chanCommitHeights[bid.ChanID]
-			if !ok || bid.CommitHeight > height {
-				chanCommitHeights[bid.ChanID] = bid.CommitHeight
-			}
+	// We only want to consider accepted updates that have been accepted
+	// under an identical policy to the client's current policy.
+	if s.Policy != c.cfg.Policy {
+		return
+	}
+
+	// Take the highest commit height found in the session's committed
+	// updates.
+	for _, committedUpdate := range s.CommittedUpdates {
+		bid := committedUpdate.BackupID
+
+		height, ok := chanCommitHeights[bid.ChanID]
+		if !ok || bid.CommitHeight > height {
+			chanCommitHeights[bid.ChanID] = bid.CommitHeight
 		}
 	}
 
-	c.chanCommitHeights = chanCommitHeights
+	// Take the heights commit height found in the session's acked
+	// updates.
+	for _, bid := range s.AckedUpdates {
+		height, ok := chanCommitHeights[bid.ChanID]
+		if !ok || bid.CommitHeight > height {
+			chanCommitHeights[bid.ChanID] = bid.CommitHeight
+		}
+	}
 }
 
 // Start initializes the watchtower client by loading or negotiating an active
@@ -441,11 +518,38 @@ func (c *TowerClient) Start() error {
 			}
 		}
 
+		// Subscribe to channel closures and new blocks, so that we can
+		// delete the sessions of closed channels from their towers.
+		c.chanEventSub, err = c.cfg.SubscribeChannelEvents()
+		if err != nil {
+			return
+		}
+
+		c.blockEpochs, err = c.cfg.ChainNotifier.RegisterBlockEpochNtfn(
+			nil,
+		)
+		if err != nil {
+			c.chanEventSub.Cancel()
+			return
+		}
+
+		// Channels may have been closed while we were offline, so
+		// we'll check all registered channels before handling new
+		// closures.
+		err = c.markClosedChannels()
+		if err != nil {
+			c.chanEventSub.Cancel()
+			c.blockEpochs.Cancel()
+			return
+		}
+
 		// Now start the session negotiator, which will allow us to
 		// request new session as soon as the backupDispatcher starts
 		// up.
 		err = c.negotiator.Start()
 		if err != nil {
+			c.chanEventSub.Cancel()
+			c.blockEpochs.Cancel()
 			return
 		}
 
@@ -456,6 +560,9 @@ func (c *TowerClient) Start() error {
 		c.wg.Add(1)
 		go c.backupDispatcher()
 
+		c.wg.Add(1)
+		go c.closableSessionManager()
+
 		log.Infof("Watchtower client started successfully")
 	})
 	return err
@@ -484,8 +591,10 @@ func (c *TowerClient) Stop() error {
 		// 2. Shutdown the backup queue, which will prevent any further
 		// updates from being accepted. In practice, the links should be
 		// shutdown before the client has been stopped, so all updates
-		// would have been added prior.
+		// would have been added prior. We'll also stop deleting closable
+		// sessions.
 		c.pipeline.Stop()
+		close(c.quit)
 
 		// 3. Once the backup queue has shutdown, wait for the main
 		// dispatcher to exit. The backup queue will signal it's
@@ -596,7 +705,15 @@ func (c *TowerClient) RegisterChannel(chanID lnwire.ChannelID) error {
 //  - breached outputs contain too little value to sweep at the target sweep fee
 //    rate.
 func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
-	breachInfo *lnwallet.BreachRetribution, isTweakless bool) error {
+	breachInfo *lnwallet.BreachRetribution,
+	chanType channeldb.ChannelType) error {
+
+	// The to-remote output of anchor channels can only be swept by towers
+	// that were told to expect an anchor channel, so the channel type must
+	// match the blob type of our policy.
+	if chanType.HasAnchors() != c.cfg.Policy.IsAnchorChannel() {
+		return ErrIncompatibleChannelType
+	}
 
 	// Retrieve the cached sweep pkscript used for this channel.
 	c.backupMu.Lock()
@@ -623,7 +740,7 @@ func (c *TowerClient) BackupState(chanID *lnwire.ChannelID,
 
 	task := newBackupTask(
 		chanID, breachInfo, summary.SweepPkScript, c.cfg.ChainParams,
-		isTweakless,
+		chanType,
 	)
 
 	return c.pipeline.QueueBackupTask(task)
@@ -1162,6 +1279,301 @@ func (c *TowerClient) handleStaleTower(msg *staleTowerMsg) error {
 	return nil
 }
 
+// markClosedChannels marks the registered channels that were closed while the
+// client was offline as closed.
+func (c *TowerClient) markClosedChannels() error {
+	c.backupMu.Lock()
+	chanIDs := make([]lnwire.ChannelID, 0, len(c.summaries))
+	for chanID, summary := range c.summaries {
+		// Channels that are already known to be closed only remain
+		// until their sessions have been deleted.
+		if summary.ClosedHeight != 0 {
+			delete(c.summaries, chanID)
+			continue
+		}
+
+		chanIDs = append(chanIDs, chanID)
+	}
+	c.backupMu.Unlock()
+
+	for _, chanID := range chanIDs {
+		closeSummary, err := c.cfg.FetchClosedChannel(chanID)
+		switch {
+		case err == channeldb.ErrClosedChannelNotFound:
+			continue
+
+		case err != nil:
+			return err
+		}
+
+		err = c.handleClosedChannel(chanID, closeSummary.CloseHeight)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleClosedChannel marks the channel as closed in the database and releases
+// the in-memory state kept for it, as its states no longer need to be backed
+// up.
+func (c *TowerClient) handleClosedChannel(chanID lnwire.ChannelID,
+	closeHeight uint32) error {
+
+	c.backupMu.Lock()
+	defer c.backupMu.Unlock()
+
+	// Channels that weren't registered with this client are handled by
+	// the client they were registered with.
+	if _, ok := c.summaries[chanID]; !ok {
+		return nil
+	}
+
+	closable, err := c.cfg.DB.MarkChannelClosed(chanID, closeHeight)
+	if err != nil {
+		return fmt.Errorf("unable to mark channel %v closed: %v",
+			chanID, err)
+	}
+
+	delete(c.summaries, chanID)
+	delete(c.chanCommitHeights, chanID)
+
+	log.Debugf("Marked channel %v closed at height %d, %d of its "+
+		"sessions are closable", chanID, closeHeight, len(closable))
+
+	return nil
+}
+
+// closableSessionManager handles the closures of the client's channels and
+// deletes the sessions that only hold updates of closed channels from their
+// towers once their random deletion height is reached.
+//
+// NOTE: This method MUST be run as a goroutine.
+func (c *TowerClient) closableSessionManager() {
+	defer c.wg.Done()
+	defer c.chanEventSub.Cancel()
+	defer c.blockEpochs.Cancel()
+
+	for {
+		select {
+		case update, ok := <-c.chanEventSub.Updates():
+			if !ok {
+				return
+			}
+
+			event, ok := update.(channelnotifier.ClosedChannelEvent)
+			if !ok {
+				continue
+			}
+
+			summary := event.CloseSummary
+			chanID := lnwire.NewChanIDFromOutPoint(
+				&summary.ChanPoint,
+			)
+			err := c.handleClosedChannel(chanID, summary.CloseHeight)
+			if err != nil {
+				log.Errorf("Unable to handle closed channel: %v",
+					err)
+			}
+
+		case epoch, ok := <-c.blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			c.deleteClosableSessions(uint32(epoch.Height))
+
+		case <-c.quit:
+			return
+
+		case <-c.forceQuit:
+			return
+		}
+	}
+}
+
+// deleteClosableSessions schedules newly closable sessions for deletion at a
+// random height within the session close range, and deletes the sessions whose
+// deletion height has been reached from their towers and the database. Failed
+// deletions are retried at the next block.
+func (c *TowerClient) deleteClosableSessions(height uint32) {
+	closable, err := c.cfg.DB.ListClosableSessions()
+	if err != nil {
+		log.Errorf("Unable to list closable sessions: %v", err)
+		return
+	}
+
+	// Forget about sessions that have been deleted in the meantime, e.g.
+	// by the client handling the other channel type.
+	for id := range c.closableSessions {
+		if _, ok := closable[id]; !ok {
+			delete(c.closableSessions, id)
+		}
+	}
+
+	newSessions := make(map[wtdb.SessionID]struct{})
+	for id := range closable {
+		if _, ok := c.closableSessions[id]; !ok {
+			newSessions[id] = struct{}{}
+		}
+	}
+
+	if len(newSessions) > 0 {
+		sessions, err := getClientSessions(
+			c.cfg.DB, c.cfg.SecretKeyRing, nil,
+			func(s *wtdb.ClientSession) bool {
+				_, ok := newSessions[s.ID]
+				return ok
+			},
+		)
+		if err != nil {
+			log.Errorf("Unable to load closable sessions: %v", err)
+			return
+		}
+
+		for id, s := range sessions {
+			c.scheduleSessionDeletion(id, s, height)
+		}
+	}
+
+	for id, cs := range c.closableSessions {
+		if cs.session == nil || cs.deleteHeight > height {
+			continue
+		}
+
+		err := c.deleteSessionFromTower(cs.session)
+		if err != nil {
+			log.Errorf("Unable to delete session %s from tower: %v",
+				id, err)
+			continue
+		}
+
+		if err := c.cfg.DB.DeleteSession(id); err != nil {
+			log.Errorf("Unable to delete session %s: %v", id, err)
+			continue
+		}
+
+		delete(c.closableSessions, id)
+
+		log.Infof("Deleted closable session %s from tower %x", id,
+			cs.session.Tower.IdentityKey.SerializeCompressed())
+	}
+}
+
+// scheduleSessionDeletion schedules the deletion of a closable session at a
+// random height within the session close range from the current height.
+func (c *TowerClient) scheduleSessionDeletion(id wtdb.SessionID,
+	s *wtdb.ClientSession, height uint32) {
+
+	// Both clients share the same database, so we leave the sessions of
+	// the other channel type to the client handling them.
+	if s.Policy.IsAnchorChannel() != c.cfg.Policy.IsAnchorChannel() {
+		c.closableSessions[id] = &closableSession{}
+		return
+	}
+
+	delay, err := rand.Int(
+		rand.Reader, big.NewInt(int64(c.cfg.SessionCloseRange)),
+	)
+	if err != nil {
+		log.Errorf("Unable to draw deletion delay for session %s: %v",
+			id, err)
+		return
+	}
+
+	deleteHeight := height + uint32(delay.Uint64())
+	c.closableSessions[id] = &closableSession{
+		session:      s,
+		deleteHeight: deleteHeight,
+	}
+
+	log.Debugf("Scheduled deletion of closable session %s at height %d",
+		id, deleteHeight)
+}
+
+// deleteSessionFromTower dials the tower of the session using the session's
+// key and requests the tower to delete all state it keeps for the session.
+func (c *TowerClient) deleteSessionFromTower(s *wtdb.ClientSession) error {
+	if len(s.Tower.Addresses) == 0 {
+		return ErrNoTowerAddrs
+	}
+
+	towerAddr := &lnwire.NetAddress{
+		IdentityKey: s.Tower.IdentityKey,
+		Address:     s.Tower.Addresses[0],
+	}
+
+	conn, err := c.dial(s.SessionKeyECDH, towerAddr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	features := []lnwire.FeatureBit{
+		wtwire.AltruistSessionsRequired,
+	}
+	if s.Policy.IsAnchorChannel() {
+		features = append(features, wtwire.AnchorCommitRequired)
+	}
+
+	localInit := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(features...),
+		c.cfg.ChainHash,
+	)
+
+	// Send Init to tower.
+	if err := c.sendMessage(conn, localInit); err != nil {
+		return err
+	}
+
+	// Receive Init from tower.
+	remoteMsg, err := c.readMessage(conn)
+	if err != nil {
+		return err
+	}
+
+	remoteInit, ok := remoteMsg.(*wtwire.Init)
+	if !ok {
+		return fmt.Errorf("watchtower %s responded with %T to Init",
+			towerAddr, remoteMsg)
+	}
+
+	// Validate Init.
+	err = localInit.CheckRemoteInit(remoteInit, wtwire.FeatureNames)
+	if err != nil {
+		return err
+	}
+
+	// Send DeleteSession to tower.
+	if err := c.sendMessage(conn, &wtwire.DeleteSession{}); err != nil {
+		return err
+	}
+
+	// Receive DeleteSessionReply from tower.
+	remoteMsg, err = c.readMessage(conn)
+	if err != nil {
+		return err
+	}
+
+	reply, ok := remoteMsg.(*wtwire.DeleteSessionReply)
+	if !ok {
+		return fmt.Errorf("watchtower %s responded with %T to "+
+			"DeleteSession", towerAddr, remoteMsg)
+	}
+
+	switch reply.Code {
+	// A tower that doesn't know the session may have deleted it already,
+	// without us receiving its reply.
+	case wtwire.CodeOK, wtwire.DeleteSessionCodeNotFound:
+		return nil
+
+	default:
+		return fmt.Errorf("watchtower %s rejected DeleteSession: %v",
+			towerAddr, reply.Code)
+	}
+}
+
 // RegisteredTowers retrieves the list of watchtowers registered with the
 // client.
 func (c *TowerClient) RegisteredTowers() ([]*RegisteredTower, error) {
@@ -1231,6 +1643,69 @@ func (c *TowerClient) Policy() wtpolicy.Policy {
 	return c.cfg.Policy
 }
 
+// ExportSessions returns a backup of the client's towers and sessions, which
+// allows a node restored from the same seed to keep using them.
+func (c *TowerClient) ExportSessions() (*wtdb.ClientBackup, error) {
+	return c.cfg.DB.ExportClientBackup()
+}
+
+// ImportSessions adds the towers and sessions of a backup to the client and
+// returns the number of sessions that were imported. The towers with active
+// sessions in the backup are added to the client, so that their sessions are
+// considered for backups right away.
+func (c *TowerClient) ImportSessions(backup *wtdb.ClientBackup) (int, error) {
+	numImported, err := c.cfg.DB.ImportClientBackup(backup)
+	if err != nil {
+		return 0, err
+	}
+
+	// The imported sessions may hold updates of channels that are still
+	// open, in which case we'll need their sweep pkscripts and must not
+	// back up the states they already hold again.
+	c.backupMu.Lock()
+	for chanID, summary := range backup.ChanSummaries {
+		if summary.ClosedHeight != 0 {
+			continue
+		}
+		if _, ok := c.summaries[chanID]; !ok {
+			c.summaries[chanID] = summary
+		}
+	}
+	for _, s := range backup.Sessions {
+		c.addCommitHeights(c.chanCommitHeights, s)
+	}
+	c.backupMu.Unlock()
+
+	activeTowers := make(map[wtdb.TowerID]struct{})
+	for _, s := range backup.Sessions {
+		if s.Status == wtdb.CSessionActive {
+			activeTowers[s.TowerID] = struct{}{}
+		}
+	}
+
+	for _, tower := range backup.Towers {
+		if _, ok := activeTowers[tower.ID]; !ok {
+			continue
+		}
+		if len(tower.Addresses) == 0 {
+			continue
+		}
+
+		err := c.AddTower(&lnwire.NetAddress{
+			IdentityKey: tower.IdentityKey,
+			Address:     tower.Addresses[0],
+		})
+		if err != nil {
+			return numImported, err
+		}
+	}
+
+	log.Infof("Imported %d sessions with %d towers", numImported,
+		len(backup.Towers))
+
+	return numImported, nil
+}
+
 // logMessage writes information about a message received from a remote peer,
 // using directional prepositions to signal whether the message was sent or
 // received.
@@ -9,15 +9,20 @@ import (
 	"testing"
 	"time"
 
+	"github.com/decred/dcrd/chaincfg/chainhash"
 	"github.com/decred/dcrd/chaincfg/v3"
 	"github.com/decred/dcrd/dcrec/secp256k1/v3"
 	"github.com/decred/dcrd/txscript/v4"
 	"github.com/decred/dcrd/txscript/v4/stdaddr"
 	"github.com/decred/dcrd/wire"
+	"github.com/decred/dcrlnd/chainntnfs"
+	"github.com/decred/dcrlnd/channeldb"
+	"github.com/decred/dcrlnd/channelnotifier"
 	"github.com/decred/dcrlnd/input"
 	"github.com/decred/dcrlnd/keychain"
 	"github.com/decred/dcrlnd/lnwallet"
 	"github.com/decred/dcrlnd/lnwire"
+	"github.com/decred/dcrlnd/subscribe"
 	"github.com/decred/dcrlnd/watchtower/blob"
 	"github.com/decred/dcrlnd/watchtower/wtclient"
 	"github.com/decred/dcrlnd/watchtower/wtdb"
@@ -367,6 +372,59 @@ func (c *mockChannel) getState(i uint64) (*wire.MsgTx, *lnwallet.BreachRetributi
 	return retribution.BreachTransaction, retribution
 }
 
+// mockNotifier is a chain notifier that delivers the block epochs sent by the
+// test harness to all registered clients.
+type mockNotifier struct {
+	chainntnfs.ChainNotifier
+
+	mu     sync.Mutex
+	nextID int
+	epochs map[int]chan *chainntnfs.BlockEpoch
+}
+
+func newMockNotifier() *mockNotifier {
+	return &mockNotifier{
+		epochs: make(map[int]chan *chainntnfs.BlockEpoch),
+	}
+}
+
+// RegisterBlockEpochNtfn registers a new client for block epochs.
+func (n *mockNotifier) RegisterBlockEpochNtfn(
+	*chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	id := n.nextID
+	n.nextID++
+
+	epochs := make(chan *chainntnfs.BlockEpoch, 1)
+	n.epochs[id] = epochs
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: epochs,
+		Cancel: func() {
+			n.mu.Lock()
+			delete(n.epochs, id)
+			n.mu.Unlock()
+		},
+	}, nil
+}
+
+// notifyBlock delivers a block epoch at the given height to all registered
+// clients whose previous epoch has been consumed.
+func (n *mockNotifier) notifyBlock(height int32) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for _, epochs := range n.epochs {
+		select {
+		case epochs <- &chainntnfs.BlockEpoch{Height: height}:
+		default:
+		}
+	}
+}
+
 type testHarness struct {
 	t          *testing.T
 	cfg        harnessCfg
@@ -380,6 +438,8 @@ type testHarness struct {
 	serverCfg  *wtserver.Config
 	server     *wtserver.Server
 	net        *mockNet
+	chanEvents *subscribe.Server
+	notifier   *mockNotifier
 
 	mu       sync.Mutex
 	channels map[lnwire.ChannelID]*mockChannel
@@ -435,6 +495,12 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 	mockNet := newMockNet(server.InboundPeerConnected)
 	clientDB := wtmock.NewClientDB()
 
+	chanEvents := subscribe.NewServer()
+	if err := chanEvents.Start(); err != nil {
+		t.Fatalf("Unable to start channel event server: %v", err)
+	}
+	notifier := newMockNotifier()
+
 	clientCfg := &wtclient.Config{
 		Signer: signer,
 		Dial: func(string, string) (net.Conn, error) {
@@ -453,6 +519,15 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		MaxBackoff:     10 * time.Millisecond,
 		ForceQuitDelay: 10 * time.Second,
 		ChainParams:    chaincfg.TestNet3Params(),
+
+		SubscribeChannelEvents: chanEvents.Subscribe,
+		FetchClosedChannel: func(lnwire.ChannelID) (
+			*channeldb.ChannelCloseSummary, error) {
+
+			return nil, channeldb.ErrClosedChannelNotFound
+		},
+		ChainNotifier:     notifier,
+		SessionCloseRange: 1,
 	}
 	client, err := wtclient.New(clientCfg)
 	if err != nil {
@@ -485,6 +560,8 @@ func newHarness(t *testing.T, cfg harnessCfg) *testHarness {
 		serverCfg:  serverCfg,
 		server:     server,
 		net:        mockNet,
+		chanEvents: chanEvents,
+		notifier:   notifier,
 		channels:   make(map[lnwire.ChannelID]*mockChannel),
 	}
 
@@ -635,7 +712,9 @@ func (h *testHarness) backupState(id, i uint64, expErr error) {
 	_, retribution := h.channel(id).getState(i)
 
 	chanID := chanIDFromInt(id)
-	err := h.client.BackupState(&chanID, retribution, false)
+	err := h.client.BackupState(
+		&chanID, retribution, channeldb.SingleFunderBit,
+	)
 	if err != expErr {
 		h.t.Fatalf("back error mismatch, want: %v, got: %v",
 			expErr, err)
@@ -789,6 +868,62 @@ func (h *testHarness) assertUpdatesForPolicy(hints []blob.BreachHint,
 	}
 }
 
+// closeChannel notifies the client that the channel was closed at the given
+// height.
+func (h *testHarness) closeChannel(id uint64, height uint32) {
+	h.t.Helper()
+
+	// The channel ID equals the txid of a funding outpoint at index 0.
+	chanPoint := wire.OutPoint{
+		Hash: chainhash.Hash(chanIDFromInt(id)),
+	}
+
+	err := h.chanEvents.SendUpdate(channelnotifier.ClosedChannelEvent{
+		CloseSummary: &channeldb.ChannelCloseSummary{
+			ChanPoint:   chanPoint,
+			CloseHeight: height,
+		},
+	})
+	if err != nil {
+		h.t.Fatalf("unable to send closed channel event: %v", err)
+	}
+}
+
+// waitSessionDeleted mines blocks starting at the given height until the
+// session has been deleted from both the tower and the client's database.
+func (h *testHarness) waitSessionDeleted(id wtdb.SessionID, height int32,
+	timeout time.Duration) {
+
+	h.t.Helper()
+
+	isDeleted := func() bool {
+		_, err := h.serverDB.GetSessionInfo(&id)
+		if err != wtdb.ErrSessionNotFound {
+			return false
+		}
+
+		sessions, err := h.clientDB.ListClientSessions(nil)
+		if err != nil {
+			h.t.Fatalf("unable to list client sessions: %v", err)
+		}
+		_, ok := sessions[id]
+
+		return !ok
+	}
+
+	failTimeout := time.After(timeout)
+	for !isDeleted() {
+		h.notifier.notifyBlock(height)
+		height++
+
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-failTimeout:
+			h.t.Fatalf("session %s not deleted", id)
+		}
+	}
+}
+
 // addTower adds a tower found at `addr` to the client.
 func (h *testHarness) addTower(addr *lnwire.NetAddress) {
 	h.t.Helper()
@@ -854,6 +989,40 @@ var clientTests = []clientTest{
 			)
 		},
 	},
+	{
+		// Asserts that the client will return the
+		// ErrIncompatibleChannelType error when trying to backup
+		// states of an anchor channel with a policy that doesn't
+		// support anchor channels.
+		name: "backup incompatible channel type",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 20000,
+			},
+		},
+		fn: func(h *testHarness) {
+			const chanID = 0
+
+			h.advanceChannelN(chanID, 1)
+			_, retribution := h.channel(chanID).getState(0)
+
+			anchorType := channeldb.SingleFunderTweaklessBit |
+				channeldb.AnchorOutputsBit
+
+			id := chanIDFromInt(chanID)
+			err := h.client.BackupState(&id, retribution, anchorType)
+			if err != wtclient.ErrIncompatibleChannelType {
+				h.t.Fatalf("expected incompatible channel "+
+					"type error, got: %v", err)
+			}
+		},
+	},
 	{
 		// Asserts that the client returns an ErrClientExiting when
 		// trying to backup channels after the Stop method has been
@@ -1531,6 +1700,82 @@ var clientTests = []clientTest{
 			require.Nil(h.t, err)
 		},
 	},
+	{
+		// Asserts that a session is deleted from the tower and the
+		// client's database once it is exhausted and all channels it
+		// backed up have been closed, while sessions holding updates
+		// of open channels are kept.
+		name: "delete closable sessions",
+		cfg: harnessCfg{
+			localBalance:  localBalance,
+			remoteBalance: remoteBalance,
+			policy: wtpolicy.Policy{
+				TxPolicy: wtpolicy.TxPolicy{
+					BlobType:     blob.TypeAltruistCommit,
+					SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+				},
+				MaxUpdates: 5,
+			},
+		},
+		fn: func(h *testHarness) {
+			const (
+				numUpdates = 5
+				chanID0    = 0
+				chanID1    = 1
+			)
+
+			// Fill the first session with the states of channel 0
+			// and the second session with a state of channel 1.
+			h.makeChannel(
+				chanID1, h.cfg.localBalance,
+				h.cfg.remoteBalance,
+			)
+			h.registerChannel(chanID1)
+
+			hints0 := h.advanceChannelN(chanID0, numUpdates)
+			hints1 := h.advanceChannelN(chanID1, 1)
+
+			h.backupStates(chanID0, 0, numUpdates, nil)
+			h.waitServerUpdates(hints0, 5*time.Second)
+
+			h.backupStates(chanID1, 0, 1, nil)
+			h.waitServerUpdates(
+				append(hints0, hints1...), 5*time.Second,
+			)
+
+			matches, err := h.serverDB.QueryMatches(hints0[:1])
+			require.NoError(h.t, err)
+			require.Len(h.t, matches, 1)
+			exhaustedID := matches[0].ID
+
+			matches, err = h.serverDB.QueryMatches(hints1)
+			require.NoError(h.t, err)
+			require.Len(h.t, matches, 1)
+			openID := matches[0].ID
+
+			// Once channel 0 is closed, its exhausted session is
+			// deleted along with the client's state for the
+			// channel.
+			h.closeChannel(chanID0, 100)
+			h.waitSessionDeleted(exhaustedID, 101, 5*time.Second)
+
+			summaries, err := h.clientDB.FetchChanSummaries()
+			require.NoError(h.t, err)
+			require.NotContains(
+				h.t, summaries, chanIDFromInt(chanID0),
+			)
+			require.Contains(h.t, summaries, chanIDFromInt(chanID1))
+
+			// The session backing up channel 1 can still accept
+			// updates, so it must be kept.
+			_, err = h.serverDB.GetSessionInfo(&openID)
+			require.NoError(h.t, err)
+
+			sessions, err := h.clientDB.ListClientSessions(nil)
+			require.NoError(h.t, err)
+			require.Contains(h.t, sessions, openID)
+		},
+	},
 }
 
 // TestClient executes the client test suite, asserting the ability to backup
@@ -1542,6 +1787,7 @@ func TestClient(t *testing.T) {
 			t.Parallel()
 
 			h := newHarness(t, tc.cfg)
+			defer h.chanEvents.Stop()
 			defer h.server.Stop()
 			defer h.client.ForceQuit()
 
@@ -32,4 +32,11 @@ var (
 	// revoked state because the channel had not been previously registered
 	// with the client.
 	ErrUnregisteredChannel = errors.New("channel is not registered")
+
+	// ErrIncompatibleChannelType signals that the client was asked to back
+	// up a revoked state of a channel whose type doesn't match the blob
+	// type of the client's policy, e.g. an anchor channel state sent to a
+	// client that only negotiates sessions for legacy channels.
+	ErrIncompatibleChannelType = errors.New("channel type is not " +
+		"supported by the client's policy")
 )
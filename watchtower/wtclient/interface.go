@@ -93,6 +93,32 @@ type DB interface {
 	// update identified by seqNum was received and saved. The returned
 	// lastApplied will be recorded.
 	AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) error
+
+	// MarkChannelClosed records that the channel was closed at the given
+	// height. Sessions that only hold updates of closed channels become
+	// closable, and the closable sessions holding updates of this channel
+	// are returned. Marking an unregistered channel is a no-op.
+	MarkChannelClosed(chanID lnwire.ChannelID,
+		blockHeight uint32) ([]wtdb.SessionID, error)
+
+	// ListClosableSessions returns the set of sessions that only hold
+	// updates of closed channels, mapped to the height at which the last
+	// of their channels was closed.
+	ListClosableSessions() (map[wtdb.SessionID]uint32, error)
+
+	// DeleteSession removes a closable session from the database, along
+	// with the summaries of its channels that aren't backed up by any
+	// other session.
+	DeleteSession(wtdb.SessionID) error
+
+	// ExportClientBackup returns a backup of all towers, sessions and
+	// channel summaries known to the database.
+	ExportClientBackup() (*wtdb.ClientBackup, error)
+
+	// ImportClientBackup adds the towers, sessions and channel summaries
+	// of the backup that don't exist yet to the database, and returns the
+	// number of sessions that were imported.
+	ImportClientBackup(*wtdb.ClientBackup) (int, error)
 }
 
 // Dial connects to an addr using the specified net and returns the connection
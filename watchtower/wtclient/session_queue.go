@@ -104,8 +104,15 @@ type sessionQueue struct {
 
 // newSessionQueue intiializes a fresh sessionQueue.
 func newSessionQueue(cfg *sessionQueueConfig) *sessionQueue {
+	features := []lnwire.FeatureBit{
+		wtwire.AltruistSessionsRequired,
+	}
+	if cfg.ClientSession.Policy.IsAnchorChannel() {
+		features = append(features, wtwire.AnchorCommitRequired)
+	}
+
 	localInit := wtwire.NewInitMessage(
-		lnwire.NewRawFeatureVector(wtwire.AltruistSessionsRequired),
+		lnwire.NewRawFeatureVector(features...),
 		cfg.ChainHash,
 	)
 
@@ -0,0 +1,292 @@
+package wtdb
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/decred/dcrlnd/chanbackup"
+	"github.com/decred/dcrlnd/keychain"
+	"github.com/decred/dcrlnd/lnwire"
+)
+
+// ClientBackupVersion denotes the version of the serialization format used
+// by a ClientBackup.
+type ClientBackupVersion byte
+
+const (
+	// DefaultClientBackupVersion is the default version of the client
+	// backup. It holds the towers, followed by the sessions along with
+	// their committed and acked updates, followed by the channel summaries.
+	DefaultClientBackupVersion ClientBackupVersion = 0
+)
+
+// ClientBackup holds the state of a watchtower client that is needed to keep
+// using the sessions it negotiated with its towers on another node. Session
+// private keys aren't part of the backup, since they are rederived from the
+// node's seed using each session's KeyIndex. The backup can thus only be used
+// by a node restored from the same seed.
+type ClientBackup struct {
+	// Version is the version of the backup's serialization format.
+	Version ClientBackupVersion
+
+	// Towers is the set of towers the sessions were negotiated with. The
+	// TowerIDs of the sessions reference the IDs of these towers, which
+	// are reassigned when the backup is imported.
+	Towers []*Tower
+
+	// Sessions is the set of sessions along with their committed and
+	// acked updates.
+	Sessions []*ClientSession
+
+	// ChanSummaries holds the summaries of the channels the sessions hold
+	// updates of, or that are still registered with the client.
+	ChanSummaries ChannelSummaries
+}
+
+// Encode writes the ClientBackup in plaintext to the passed io.Writer.
+func (b *ClientBackup) Encode(w io.Writer) error {
+	switch b.Version {
+	case DefaultClientBackupVersion:
+
+	default:
+		return fmt.Errorf("unable to encode unknown client backup "+
+			"version %v", b.Version)
+	}
+
+	err := WriteElements(w, byte(b.Version), uint32(len(b.Towers)))
+	if err != nil {
+		return err
+	}
+
+	// The tower ID isn't part of the tower's encoding, since it is used as
+	// the key on disk, so we'll write it out explicitly.
+	for _, tower := range b.Towers {
+		if err := WriteElement(w, uint64(tower.ID)); err != nil {
+			return err
+		}
+		if err := tower.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	if err := WriteElement(w, uint32(len(b.Sessions))); err != nil {
+		return err
+	}
+	for _, session := range b.Sessions {
+		if err := encodeBackupSession(w, session); err != nil {
+			return err
+		}
+	}
+
+	err = WriteElement(w, uint32(len(b.ChanSummaries)))
+	if err != nil {
+		return err
+	}
+	for chanID, summary := range b.ChanSummaries {
+		if err := WriteElement(w, chanID); err != nil {
+			return err
+		}
+		if err := summary.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Decode reads a plaintext ClientBackup from the passed io.Reader.
+func (b *ClientBackup) Decode(r io.Reader) error {
+	var (
+		version   byte
+		numTowers uint32
+	)
+	if err := ReadElement(r, &version); err != nil {
+		return err
+	}
+
+	b.Version = ClientBackupVersion(version)
+	switch b.Version {
+	case DefaultClientBackupVersion:
+
+	default:
+		return fmt.Errorf("unable to decode unknown client backup "+
+			"version %v", version)
+	}
+
+	if err := ReadElement(r, &numTowers); err != nil {
+		return err
+	}
+	b.Towers = make([]*Tower, 0, numTowers)
+	for ; numTowers != 0; numTowers-- {
+		var towerID uint64
+		if err := ReadElement(r, &towerID); err != nil {
+			return err
+		}
+
+		tower := &Tower{ID: TowerID(towerID)}
+		if err := tower.Decode(r); err != nil {
+			return err
+		}
+		b.Towers = append(b.Towers, tower)
+	}
+
+	var numSessions uint32
+	if err := ReadElement(r, &numSessions); err != nil {
+		return err
+	}
+	b.Sessions = make([]*ClientSession, 0, numSessions)
+	for ; numSessions != 0; numSessions-- {
+		session, err := decodeBackupSession(r)
+		if err != nil {
+			return err
+		}
+		b.Sessions = append(b.Sessions, session)
+	}
+
+	var numSummaries uint32
+	if err := ReadElement(r, &numSummaries); err != nil {
+		return err
+	}
+	b.ChanSummaries = make(ChannelSummaries, numSummaries)
+	for ; numSummaries != 0; numSummaries-- {
+		var (
+			chanID  lnwire.ChannelID
+			summary ClientChanSummary
+		)
+		if err := ReadElement(r, &chanID); err != nil {
+			return err
+		}
+
+		// The summary is decoded field by field, since its Decode
+		// method tolerates a missing closed height at the end of a
+		// record on disk.
+		err := ReadElements(r,
+			&summary.SweepPkScript,
+			&summary.ClosedHeight,
+		)
+		if err != nil {
+			return err
+		}
+		b.ChanSummaries[chanID] = summary
+	}
+
+	return nil
+}
+
+// encodeBackupSession writes a session, along with its ID and its committed
+// and acked updates, to the passed io.Writer.
+func encodeBackupSession(w io.Writer, session *ClientSession) error {
+	if err := WriteElement(w, session.ID); err != nil {
+		return err
+	}
+	if err := session.ClientSessionBody.Encode(w); err != nil {
+		return err
+	}
+
+	err := WriteElement(w, uint32(len(session.CommittedUpdates)))
+	if err != nil {
+		return err
+	}
+	for _, update := range session.CommittedUpdates {
+		if err := WriteElement(w, update.SeqNum); err != nil {
+			return err
+		}
+		if err := update.CommittedUpdateBody.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	err = WriteElement(w, uint32(len(session.AckedUpdates)))
+	if err != nil {
+		return err
+	}
+	for seqNum, backupID := range session.AckedUpdates {
+		if err := WriteElement(w, seqNum); err != nil {
+			return err
+		}
+		if err := backupID.Encode(w); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// decodeBackupSession reads a session written by encodeBackupSession from the
+// passed io.Reader.
+func decodeBackupSession(r io.Reader) (*ClientSession, error) {
+	session := &ClientSession{
+		AckedUpdates: make(map[uint16]BackupID),
+	}
+	if err := ReadElement(r, &session.ID); err != nil {
+		return nil, err
+	}
+	if err := session.ClientSessionBody.Decode(r); err != nil {
+		return nil, err
+	}
+
+	var numCommits uint32
+	if err := ReadElement(r, &numCommits); err != nil {
+		return nil, err
+	}
+	for ; numCommits != 0; numCommits-- {
+		var update CommittedUpdate
+		if err := ReadElement(r, &update.SeqNum); err != nil {
+			return nil, err
+		}
+		if err := update.CommittedUpdateBody.Decode(r); err != nil {
+			return nil, err
+		}
+		session.CommittedUpdates = append(
+			session.CommittedUpdates, update,
+		)
+	}
+
+	var numAcks uint32
+	if err := ReadElement(r, &numAcks); err != nil {
+		return nil, err
+	}
+	for ; numAcks != 0; numAcks-- {
+		var (
+			seqNum   uint16
+			backupID BackupID
+		)
+		if err := ReadElement(r, &seqNum); err != nil {
+			return nil, err
+		}
+		if err := backupID.Decode(r); err != nil {
+			return nil, err
+		}
+		session.AckedUpdates[seqNum] = backupID
+	}
+
+	return session, nil
+}
+
+// PackToWriter encrypts the ClientBackup and writes it to the passed
+// io.Writer. The encryption key is derived from the passed keyRing in the same
+// way as for static channel backups.
+func (b *ClientBackup) PackToWriter(w io.Writer,
+	keyRing keychain.KeyRing) error {
+
+	var plaintext bytes.Buffer
+	if err := b.Encode(&plaintext); err != nil {
+		return err
+	}
+
+	return chanbackup.EncryptPayloadToWriter(plaintext, w, keyRing)
+}
+
+// UnpackFromReader decrypts a ClientBackup written by PackToWriter from the
+// passed io.Reader, using the passed keyRing to derive the encryption key.
+func (b *ClientBackup) UnpackFromReader(r io.Reader,
+	keyRing keychain.KeyRing) error {
+
+	plaintext, err := chanbackup.DecryptPayloadFromReader(r, keyRing)
+	if err != nil {
+		return err
+	}
+
+	return b.Decode(bytes.NewReader(plaintext))
+}
@@ -17,16 +17,33 @@ type ClientChanSummary struct {
 	// deposit recovered funds for this particular channel.
 	SweepPkScript []byte
 
+	// ClosedHeight is the height at which the channel was closed, or zero
+	// if the channel is still open. Once a channel is closed, the sessions
+	// holding its updates no longer need to be kept by the tower.
+	ClosedHeight uint32
+
 	// TODO(conner): later extend with info about initial commit height,
 	// ineligible states, etc.
 }
 
 // Encode writes the ClientChanSummary to the passed io.Writer.
 func (s *ClientChanSummary) Encode(w io.Writer) error {
-	return WriteElement(w, s.SweepPkScript)
+	return WriteElements(w, s.SweepPkScript, s.ClosedHeight)
 }
 
 // Decode reads a ClientChanSummary form the passed io.Reader.
 func (s *ClientChanSummary) Decode(r io.Reader) error {
-	return ReadElement(r, &s.SweepPkScript)
+	err := ReadElement(r, &s.SweepPkScript)
+	if err != nil {
+		return err
+	}
+
+	// Summaries written before closed channels were tracked end after
+	// the sweep pkscript, which means the channel hasn't been closed yet.
+	err = ReadElement(r, &s.ClosedHeight)
+	if err == io.EOF {
+		return nil
+	}
+
+	return err
 }
@@ -52,6 +52,18 @@ var (
 	//    tower-pubkey -> tower-id.
 	cTowerIndexBkt = []byte("client-tower-index-bucket")
 
+	// cChanSessionIndexBkt is a top-level bucket storing:
+	//    channel-id => session-id -> empty.
+	// It indexes the sessions that hold committed or acked updates for each
+	// channel.
+	cChanSessionIndexBkt = []byte("client-channel-session-index-bucket")
+
+	// cClosableSessionsBkt is a top-level bucket storing:
+	//    session-id -> close-height (uint32).
+	// It holds the sessions whose channels have all been closed, along
+	// with the height at which the last of them was closed.
+	cClosableSessionsBkt = []byte("client-closable-sessions-bucket")
+
 	// ErrTowerNotFound signals that the target tower was not found in the
 	// database.
 	ErrTowerNotFound = errors.New("tower not found")
@@ -108,6 +120,11 @@ var (
 	// created because session key index differs from the reserved key
 	// index.
 	ErrIncorrectKeyIndex = errors.New("incorrect key index")
+
+	// ErrSessionNotClosable signals that a session can't be deleted
+	// because it can still accept updates, has unacked updates or holds
+	// updates of channels that are still open.
+	ErrSessionNotClosable = errors.New("session is not closable")
 )
 
 // ClientDB is single database providing a persistent storage engine for the
@@ -164,6 +181,8 @@ func initClientDBBuckets(tx kvdb.RwTx) error {
 		cSessionBkt,
 		cTowerBkt,
 		cTowerIndexBkt,
+		cChanSessionIndexBkt,
+		cClosableSessionsBkt,
 	}
 
 	for _, bucket := range buckets {
@@ -776,6 +795,13 @@ func (c *ClientDB) CommitUpdate(id *SessionID,
 			return err
 		}
 
+		// Index the session under the channel of the update, so that we
+		// can find the sessions affected by the channel's closure.
+		err = putChanSession(tx, update.BackupID.ChanID, id[:])
+		if err != nil {
+			return err
+		}
+
 		// Finally, capture the session's last applied value so it can
 		// be sent in the next state update to the tower.
 		lastApplied = session.TowerLastApplied
@@ -885,9 +911,605 @@ func (c *ClientDB) AckUpdate(id *SessionID, seqNum uint16,
 			return err
 		}
 
-		// Finally, insert the ack into the sessionAcks sub-bucket.
-		return sessionAcks.Put(seqNumBuf[:], b.Bytes())
+		// Insert the ack into the sessionAcks sub-bucket.
+		err = sessionAcks.Put(seqNumBuf[:], b.Bytes())
+		if err != nil {
+			return err
+		}
+
+		// Finally, the session may have become closable if this was
+		// its last update and all of its channels were closed while
+		// the update was in flight.
+		_, err = markSessionClosable(tx, id[:])
+		return err
+	})
+}
+
+// MarkChannelClosed records that the channel was closed at the given height.
+// Any session holding updates of the channel that now only holds updates of
+// closed channels is marked as closable, and the IDs of all closable sessions
+// holding updates of the channel are returned. If no session holds updates of
+// the channel, its summary is removed right away. Marking a channel that isn't
+// registered is a no-op, which allows this method to be called more than once
+// for the same channel.
+func (c *ClientDB) MarkChannelClosed(chanID lnwire.ChannelID,
+	blockHeight uint32) ([]SessionID, error) {
+
+	var closableSessions []SessionID
+	err := kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		// Reset the result in case the transaction is retried.
+		closableSessions = nil
+
+		chanSummaries := tx.ReadWriteBucket(cChanSummaryBkt)
+		if chanSummaries == nil {
+			return ErrUninitializedDB
+		}
+
+		chanSessionIndex := tx.ReadWriteBucket(cChanSessionIndexBkt)
+		if chanSessionIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		summary, err := getChanSummary(chanSummaries, chanID)
+		switch {
+		case err == ErrChannelNotRegistered:
+			return nil
+
+		case err != nil:
+			return err
+		}
+
+		if summary.ClosedHeight == 0 {
+			summary.ClosedHeight = blockHeight
+			err := putChanSummary(chanSummaries, chanID, summary)
+			if err != nil {
+				return err
+			}
+		}
+
+		// If the channel was never backed up, there's nothing left to
+		// keep for it.
+		chanSessions := chanSessionIndex.NestedReadBucket(chanID[:])
+		if chanSessions == nil {
+			return chanSummaries.Delete(chanID[:])
+		}
+
+		return chanSessions.ForEach(func(k, _ []byte) error {
+			closable, err := markSessionClosable(tx, k)
+			if err != nil || !closable {
+				return err
+			}
+
+			var id SessionID
+			copy(id[:], k)
+			closableSessions = append(closableSessions, id)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closableSessions, nil
+}
+
+// ListClosableSessions returns the set of sessions that only hold updates of
+// closed channels, mapped to the height at which the last of their channels
+// was closed. These sessions can be deleted from their towers.
+func (c *ClientDB) ListClosableSessions() (map[SessionID]uint32, error) {
+	closableSessions := make(map[SessionID]uint32)
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		closable := tx.ReadBucket(cClosableSessionsBkt)
+		if closable == nil {
+			return ErrUninitializedDB
+		}
+
+		return closable.ForEach(func(k, v []byte) error {
+			var id SessionID
+			copy(id[:], k)
+			closableSessions[id] = byteOrder.Uint32(v)
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return closableSessions, nil
+}
+
+// DeleteSession removes a closable session from the database, which should be
+// done once the tower has deleted it as well. The summaries of the session's
+// channels are removed along with the last session holding their updates.
+// ErrSessionNotClosable is returned if the session isn't closable.
+func (c *ClientDB) DeleteSession(id SessionID) error {
+	return kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		sessions := tx.ReadWriteBucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		chanSummaries := tx.ReadWriteBucket(cChanSummaryBkt)
+		if chanSummaries == nil {
+			return ErrUninitializedDB
+		}
+
+		chanSessionIndex := tx.ReadWriteBucket(cChanSessionIndexBkt)
+		if chanSessionIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		closable := tx.ReadWriteBucket(cClosableSessionsBkt)
+		if closable == nil {
+			return ErrUninitializedDB
+		}
+
+		if sessions.NestedReadBucket(id[:]) == nil {
+			return ErrClientSessionNotFound
+		}
+
+		if closable.Get(id[:]) == nil {
+			return ErrSessionNotClosable
+		}
+
+		ackedUpdates, err := getClientSessionAcks(sessions, id[:])
+		if err != nil {
+			return err
+		}
+
+		if err := sessions.DeleteNestedBucket(id[:]); err != nil {
+			return err
+		}
+
+		if err := closable.Delete(id[:]); err != nil {
+			return err
+		}
+
+		// Remove the session from the index of each of its channels.
+		// Since all of them are closed, a channel that isn't backed up
+		// by any other session no longer needs its summary.
+		for _, backupID := range ackedUpdates {
+			chanID := backupID.ChanID
+
+			chanSessions := chanSessionIndex.NestedReadWriteBucket(
+				chanID[:],
+			)
+			if chanSessions == nil {
+				continue
+			}
+
+			if err := chanSessions.Delete(id[:]); err != nil {
+				return err
+			}
+
+			if k, _ := chanSessions.ReadCursor().First(); k != nil {
+				continue
+			}
+
+			err := chanSessionIndex.DeleteNestedBucket(chanID[:])
+			if err != nil {
+				return err
+			}
+
+			if err := chanSummaries.Delete(chanID[:]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// ExportClientBackup returns a backup of all towers, sessions and channel
+// summaries known to the database, which can be imported into the database of
+// a node restored from the same seed to keep using the sessions.
+func (c *ClientDB) ExportClientBackup() (*ClientBackup, error) {
+	backup := &ClientBackup{
+		Version:       DefaultClientBackupVersion,
+		ChanSummaries: make(ChannelSummaries),
+	}
+	err := kvdb.View(c.db, func(tx kvdb.RTx) error {
+		towers := tx.ReadBucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		sessions := tx.ReadBucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		chanSummaries := tx.ReadBucket(cChanSummaryBkt)
+		if chanSummaries == nil {
+			return ErrUninitializedDB
+		}
+
+		err := towers.ForEach(func(towerIDBytes, _ []byte) error {
+			tower, err := getTower(towers, towerIDBytes)
+			if err != nil {
+				return err
+			}
+			backup.Towers = append(backup.Towers, tower)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		clientSessions, err := listClientSessions(sessions, nil)
+		if err != nil {
+			return err
+		}
+		for _, session := range clientSessions {
+			backup.Sessions = append(backup.Sessions, session)
+		}
+
+		return chanSummaries.ForEach(func(k, _ []byte) error {
+			var chanID lnwire.ChannelID
+			copy(chanID[:], k)
+
+			summary, err := getChanSummary(chanSummaries, chanID)
+			if err != nil {
+				return err
+			}
+			backup.ChanSummaries[chanID] = *summary
+
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return backup, nil
+}
+
+// ImportClientBackup adds the towers, sessions and channel summaries of the
+// backup to the database and returns the number of sessions that were
+// imported. Towers that are already known have the backup's addresses added
+// to them, while sessions and channel summaries that already exist are left
+// untouched, which makes importing the same backup twice a no-op. The session
+// key index is advanced past the key indexes of the imported sessions, so that
+// their session keys aren't reused for new sessions.
+func (c *ClientDB) ImportClientBackup(backup *ClientBackup) (int, error) {
+	var numImported int
+	err := kvdb.Update(c.db, func(tx kvdb.RwTx) error {
+		// Reset the count in case the transaction is retried.
+		numImported = 0
+
+		towerIndex := tx.ReadWriteBucket(cTowerIndexBkt)
+		if towerIndex == nil {
+			return ErrUninitializedDB
+		}
+
+		towers := tx.ReadWriteBucket(cTowerBkt)
+		if towers == nil {
+			return ErrUninitializedDB
+		}
+
+		sessions := tx.ReadWriteBucket(cSessionBkt)
+		if sessions == nil {
+			return ErrUninitializedDB
+		}
+
+		chanSummaries := tx.ReadWriteBucket(cChanSummaryBkt)
+		if chanSummaries == nil {
+			return ErrUninitializedDB
+		}
+
+		keyIndexes := tx.ReadWriteBucket(cSessionKeyIndexBkt)
+		if keyIndexes == nil {
+			return ErrUninitializedDB
+		}
+
+		// Add the towers of the backup, mapping the tower IDs used by
+		// the backup to the ones used by this database.
+		towerIDs := make(map[TowerID]TowerID, len(backup.Towers))
+		for _, backupTower := range backup.Towers {
+			identityKey := backupTower.IdentityKey
+			towerPubKey := identityKey.SerializeCompressed()
+
+			var tower *Tower
+			towerIDBytes := towerIndex.Get(towerPubKey)
+			if len(towerIDBytes) == 8 {
+				var err error
+				tower, err = getTower(towers, towerIDBytes)
+				if err != nil {
+					return err
+				}
+
+				// Add the addresses in reverse order, so that
+				// they keep their order at the front of the
+				// tower's addresses.
+				addrs := backupTower.Addresses
+				for i := len(addrs) - 1; i >= 0; i-- {
+					tower.AddAddress(addrs[i])
+				}
+			} else {
+				// The error is unhandled since NextSequence
+				// never fails in an Update.
+				towerID, _ := towerIndex.NextSequence()
+
+				tower = &Tower{
+					ID:          TowerID(towerID),
+					IdentityKey: identityKey,
+					Addresses:   backupTower.Addresses,
+				}
+
+				towerIDBytes = tower.ID.Bytes()
+				err := towerIndex.Put(towerPubKey, towerIDBytes)
+				if err != nil {
+					return err
+				}
+			}
+
+			if err := putTower(towers, tower); err != nil {
+				return err
+			}
+			towerIDs[backupTower.ID] = tower.ID
+		}
+
+		// Add the sessions that don't exist yet along with their
+		// committed and acked updates.
+		var (
+			imported    [][]byte
+			keyIndexSet = make(map[uint32]struct{})
+			maxKeyIndex uint32
+		)
+		for _, backupSession := range backup.Sessions {
+			idBytes := backupSession.ID[:]
+			if sessions.NestedReadBucket(idBytes) != nil {
+				continue
+			}
+
+			towerID, ok := towerIDs[backupSession.TowerID]
+			if !ok {
+				return ErrTowerNotFound
+			}
+
+			session := *backupSession
+			session.TowerID = towerID
+			err := putClientSessionBody(sessions, &session)
+			if err != nil {
+				return err
+			}
+
+			err = putClientSessionUpdates(tx, sessions, &session)
+			if err != nil {
+				return err
+			}
+
+			imported = append(imported, session.ID[:])
+			keyIndexSet[session.KeyIndex] = struct{}{}
+			if session.KeyIndex > maxKeyIndex {
+				maxKeyIndex = session.KeyIndex
+			}
+		}
+
+		for chanID, summary := range backup.ChanSummaries {
+			if chanSummaries.Get(chanID[:]) != nil {
+				continue
+			}
+
+			summary := summary
+			err := putChanSummary(chanSummaries, chanID, &summary)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Now that the channel summaries are in place, we can tell
+		// which of the imported sessions only hold updates of closed
+		// channels.
+		for _, idBytes := range imported {
+			_, err := markSessionClosable(tx, idBytes)
+			if err != nil {
+				return err
+			}
+		}
+
+		// Make sure that new sessions derive their keys from an index
+		// that isn't used by any of the imported sessions, dropping
+		// any reservation that collides with one of them.
+		if keyIndexes.Sequence() < uint64(maxKeyIndex) {
+			err := keyIndexes.SetSequence(uint64(maxKeyIndex))
+			if err != nil {
+				return err
+			}
+		}
+
+		var staleReservations [][]byte
+		err := keyIndexes.ForEach(func(k, v []byte) error {
+			if len(v) != 4 {
+				return nil
+			}
+			if _, ok := keyIndexSet[byteOrder.Uint32(v)]; ok {
+				staleReservations = append(staleReservations, k)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		for _, k := range staleReservations {
+			if err := keyIndexes.Delete(k); err != nil {
+				return err
+			}
+		}
+
+		numImported = len(imported)
+
+		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	return numImported, nil
+}
+
+// markSessionClosable adds the session identified by the serialized session id
+// to the set of closable sessions if it can't accept any more updates, has no
+// unacked updates and all channels it holds updates of are closed. It returns
+// true if the session is closable.
+func markSessionClosable(tx kvdb.RwTx, idBytes []byte) (bool, error) {
+	sessions := tx.ReadWriteBucket(cSessionBkt)
+	if sessions == nil {
+		return false, ErrUninitializedDB
+	}
+
+	chanSummaries := tx.ReadWriteBucket(cChanSummaryBkt)
+	if chanSummaries == nil {
+		return false, ErrUninitializedDB
+	}
+
+	closable := tx.ReadWriteBucket(cClosableSessionsBkt)
+	if closable == nil {
+		return false, ErrUninitializedDB
+	}
+
+	// Sessions that can still be used for backups must be kept. We check
+	// this on the body alone, since it is the common case.
+	session, err := getClientSessionBody(sessions, idBytes)
+	if err != nil {
+		return false, err
+	}
+
+	if session.SeqNum < session.Policy.MaxUpdates {
+		return false, nil
+	}
+
+	// The same goes for sessions with updates that haven't been acked by
+	// the tower yet.
+	committedUpdates, err := getClientSessionCommits(sessions, idBytes)
+	if err != nil {
+		return false, err
+	}
+
+	if len(committedUpdates) > 0 {
+		return false, nil
+	}
+
+	ackedUpdates, err := getClientSessionAcks(sessions, idBytes)
+	if err != nil {
+		return false, err
+	}
+
+	var closeHeight uint32
+	for _, backupID := range ackedUpdates {
+		summary, err := getChanSummary(chanSummaries, backupID.ChanID)
+		switch {
+		// We can't tell whether an unregistered channel was closed, so
+		// the session is kept.
+		case err == ErrChannelNotRegistered:
+			return false, nil
+
+		case err != nil:
+			return false, err
+
+		case summary.ClosedHeight == 0:
+			return false, nil
+		}
+
+		if summary.ClosedHeight > closeHeight {
+			closeHeight = summary.ClosedHeight
+		}
+	}
+
+	var heightBuf [4]byte
+	byteOrder.PutUint32(heightBuf[:], closeHeight)
+
+	return true, closable.Put(idBytes, heightBuf[:])
+}
+
+// putChanSession adds the session identified by the serialized session id to
+// the index of sessions holding updates of the channel.
+func putChanSession(tx kvdb.RwTx, chanID lnwire.ChannelID,
+	idBytes []byte) error {
+
+	chanSessionIndex := tx.ReadWriteBucket(cChanSessionIndexBkt)
+	if chanSessionIndex == nil {
+		return ErrUninitializedDB
+	}
+
+	chanSessions, err := chanSessionIndex.CreateBucketIfNotExists(
+		chanID[:],
+	)
+	if err != nil {
+		return err
+	}
+
+	return chanSessions.Put(idBytes, []byte{})
+}
+
+// putClientSessionUpdates stores the committed and acked updates of the
+// session and indexes the session under the channels of the updates.
+func putClientSessionUpdates(tx kvdb.RwTx, sessions kvdb.RwBucket,
+	session *ClientSession) error {
+
+	// Can't fail because the session's body has already been written.
+	sessionBkt := sessions.NestedReadWriteBucket(session.ID[:])
+
+	var seqNumBuf [2]byte
+	if len(session.CommittedUpdates) > 0 {
+		sessionCommits, err := sessionBkt.CreateBucketIfNotExists(
+			cSessionCommits,
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, update := range session.CommittedUpdates {
+			var b bytes.Buffer
+			if err := update.Encode(&b); err != nil {
+				return err
+			}
+
+			byteOrder.PutUint16(seqNumBuf[:], update.SeqNum)
+			err := sessionCommits.Put(seqNumBuf[:], b.Bytes())
+			if err != nil {
+				return err
+			}
+
+			err = putChanSession(
+				tx, update.BackupID.ChanID, session.ID[:],
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(session.AckedUpdates) > 0 {
+		sessionAcks, err := sessionBkt.CreateBucketIfNotExists(
+			cSessionAcks,
+		)
+		if err != nil {
+			return err
+		}
+
+		for seqNum, backupID := range session.AckedUpdates {
+			var b bytes.Buffer
+			if err := backupID.Encode(&b); err != nil {
+				return err
+			}
+
+			byteOrder.PutUint16(seqNumBuf[:], seqNum)
+			err := sessionAcks.Put(seqNumBuf[:], b.Bytes())
+			if err != nil {
+				return err
+			}
+
+			err = putChanSession(tx, backupID.ChanID, session.ID[:])
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
 // getClientSessionBody loads the body of a ClientSession from the sessions
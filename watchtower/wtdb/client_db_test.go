@@ -221,6 +221,40 @@ func (h *clientDBHarness) ackUpdate(id *wtdb.SessionID, seqNum uint16,
 	}
 }
 
+func (h *clientDBHarness) markChannelClosed(chanID lnwire.ChannelID,
+	height uint32) []wtdb.SessionID {
+
+	h.t.Helper()
+
+	closable, err := h.db.MarkChannelClosed(chanID, height)
+	if err != nil {
+		h.t.Fatalf("unable to mark channel closed: %v", err)
+	}
+
+	return closable
+}
+
+func (h *clientDBHarness) listClosableSessions() map[wtdb.SessionID]uint32 {
+	h.t.Helper()
+
+	closable, err := h.db.ListClosableSessions()
+	if err != nil {
+		h.t.Fatalf("unable to list closable sessions: %v", err)
+	}
+
+	return closable
+}
+
+func (h *clientDBHarness) deleteSession(id wtdb.SessionID, expErr error) {
+	h.t.Helper()
+
+	err := h.db.DeleteSession(id)
+	if err != expErr {
+		h.t.Fatalf("expected delete session error: %v, got: %v",
+			expErr, err)
+	}
+}
+
 // testCreateClientSession asserts various conditions regarding the creation of
 // a new ClientSession. The test asserts:
 //   - client sessions can only be created if a session key index is reserved.
@@ -705,6 +739,255 @@ func testAckUpdate(h *clientDBHarness) {
 	h.ackUpdate(&session.ID, 4, 3, wtdb.ErrUnallocatedLastApplied)
 }
 
+// testClosableSessions asserts that a session becomes closable once it is
+// exhausted, has no unacked updates and all of its channels are closed, and
+// that deleting it releases the state of its channels.
+func testClosableSessions(h *clientDBHarness) {
+	session := &wtdb.ClientSession{
+		ClientSessionBody: wtdb.ClientSessionBody{
+			TowerID: wtdb.TowerID(3),
+			Policy: wtpolicy.Policy{
+				MaxUpdates: 2,
+			},
+			RewardPkScript: []byte{0x01, 0x02, 0x03},
+		},
+		ID: wtdb.SessionID([33]byte{0x03}),
+	}
+	session.KeyIndex = h.nextKeyIndex(session.TowerID, nil)
+	h.insertSession(session, nil)
+
+	// Back up a state of each of the first two channels, leaving the
+	// second one unacked.
+	update1 := randCommittedUpdate(h.t, 1)
+	update2 := randCommittedUpdate(h.t, 2)
+	chanID1 := update1.BackupID.ChanID
+	chanID2 := update2.BackupID.ChanID
+	h.registerChan(chanID1, []byte{0x01}, nil)
+	h.registerChan(chanID2, []byte{0x02}, nil)
+
+	h.commitUpdate(&session.ID, update1, nil)
+	h.ackUpdate(&session.ID, 1, 1, nil)
+	h.commitUpdate(&session.ID, update2, nil)
+
+	// Marking an unregistered channel closed is a no-op.
+	var unknownChanID lnwire.ChannelID
+	closable := h.markChannelClosed(unknownChanID, 5)
+	if len(closable) != 0 {
+		h.t.Fatalf("expected no closable sessions, got: %v", closable)
+	}
+
+	// A closed channel that was never backed up doesn't need to be kept.
+	chanID3 := lnwire.ChannelID{0x03}
+	h.registerChan(chanID3, []byte{0x03}, nil)
+	h.markChannelClosed(chanID3, 5)
+	if _, ok := h.fetchChanSummaries()[chanID3]; ok {
+		h.t.Fatalf("summary of channel %v should be removed", chanID3)
+	}
+
+	// The session can't be closed while it holds updates of open channels
+	// or unacked updates.
+	if closable := h.markChannelClosed(chanID1, 10); len(closable) != 0 {
+		h.t.Fatalf("expected no closable sessions, got: %v", closable)
+	}
+	if closable := h.markChannelClosed(chanID2, 20); len(closable) != 0 {
+		h.t.Fatalf("expected no closable sessions, got: %v", closable)
+	}
+	h.deleteSession(session.ID, wtdb.ErrSessionNotClosable)
+
+	// Acking the last update makes the session closable at the height the
+	// last of its channels was closed.
+	h.ackUpdate(&session.ID, 2, 2, nil)
+	expClosable := map[wtdb.SessionID]uint32{session.ID: 20}
+	closableHeights := h.listClosableSessions()
+	if !reflect.DeepEqual(closableHeights, expClosable) {
+		h.t.Fatalf("closable sessions mismatch, want: %v, got: %v",
+			expClosable, closableHeights)
+	}
+
+	// Marking a channel closed again returns its closable sessions, but
+	// doesn't change its close height.
+	closable = h.markChannelClosed(chanID1, 30)
+	if !reflect.DeepEqual(closable, []wtdb.SessionID{session.ID}) {
+		h.t.Fatalf("expected closable session %v, got: %v",
+			session.ID, closable)
+	}
+	if height := h.fetchChanSummaries()[chanID1].ClosedHeight; height != 10 {
+		h.t.Fatalf("expected close height 10, got: %d", height)
+	}
+
+	// Deleting an unknown session fails, while deleting the closable
+	// session removes it along with the summaries of its channels.
+	h.deleteSession(wtdb.SessionID{0x04}, wtdb.ErrClientSessionNotFound)
+	h.deleteSession(session.ID, nil)
+
+	if _, ok := h.listSessions(nil)[session.ID]; ok {
+		h.t.Fatalf("session %v should be deleted", session.ID)
+	}
+	closableHeights = h.listClosableSessions()
+	if len(closableHeights) != 0 {
+		h.t.Fatalf("expected no closable sessions, got: %v",
+			closableHeights)
+	}
+	summaries := h.fetchChanSummaries()
+	if len(summaries) != 0 {
+		h.t.Fatalf("expected no channel summaries, got: %v", summaries)
+	}
+}
+
+// testExportImportSessions asserts that the sessions exported from one
+// database can be imported into another one, which keeps their updates and
+// doesn't reuse their session key indexes.
+func testExportImportSessions(h *clientDBHarness) {
+	// Populate a separate database with a tower and a session holding an
+	// acked and a committed update.
+	src := wtmock.NewClientDB()
+
+	pk, err := randPubKey()
+	if err != nil {
+		h.t.Fatalf("unable to generate pubkey: %v", err)
+	}
+	addr := &net.TCPAddr{IP: []byte{0x01, 0x00, 0x00, 0x00}, Port: 9911}
+	srcTower, err := src.CreateTower(&lnwire.NetAddress{
+		IdentityKey: pk,
+		Address:     addr,
+	})
+	if err != nil {
+		h.t.Fatalf("unable to create tower: %v", err)
+	}
+
+	keyIndex, err := src.NextSessionKeyIndex(srcTower.ID)
+	if err != nil {
+		h.t.Fatalf("unable to reserve session key index: %v", err)
+	}
+	session := &wtdb.ClientSession{
+		ClientSessionBody: wtdb.ClientSessionBody{
+			TowerID:  srcTower.ID,
+			KeyIndex: keyIndex,
+			Policy: wtpolicy.Policy{
+				MaxUpdates: 100,
+			},
+			RewardPkScript: []byte{0x01, 0x02, 0x03},
+		},
+		ID: wtdb.SessionID([33]byte{0x01}),
+	}
+	if err := src.CreateClientSession(session); err != nil {
+		h.t.Fatalf("unable to create client session: %v", err)
+	}
+
+	update1 := randCommittedUpdate(h.t, 1)
+	update2 := randCommittedUpdate(h.t, 2)
+	for _, update := range []*wtdb.CommittedUpdate{update1, update2} {
+		chanID := update.BackupID.ChanID
+		if err := src.RegisterChannel(chanID, []byte{0x01}); err != nil {
+			h.t.Fatalf("unable to register channel: %v", err)
+		}
+		if _, err := src.CommitUpdate(&session.ID, update); err != nil {
+			h.t.Fatalf("unable to commit update: %v", err)
+		}
+	}
+	if err := src.AckUpdate(&session.ID, 1, 1); err != nil {
+		h.t.Fatalf("unable to ack update: %v", err)
+	}
+
+	backup, err := src.ExportClientBackup()
+	if err != nil {
+		h.t.Fatalf("unable to export backup: %v", err)
+	}
+
+	// The backup must survive a round trip through its encoding.
+	var b bytes.Buffer
+	if err := backup.Encode(&b); err != nil {
+		h.t.Fatalf("unable to encode backup: %v", err)
+	}
+	var decoded wtdb.ClientBackup
+	if err := decoded.Decode(&b); err != nil {
+		h.t.Fatalf("unable to decode backup: %v", err)
+	}
+	if !reflect.DeepEqual(backup, &decoded) {
+		h.t.Fatalf("decoded backup mismatch, want: %v, got: %v",
+			backup, decoded)
+	}
+
+	// Add a different tower to the target database first, so that the
+	// imported tower is assigned a different ID, and reserve the key index
+	// used by the imported session for it.
+	otherPK, err := randPubKey()
+	if err != nil {
+		h.t.Fatalf("unable to generate pubkey: %v", err)
+	}
+	otherTower := h.createTower(&lnwire.NetAddress{
+		IdentityKey: otherPK,
+		Address:     addr,
+	}, nil)
+	if index := h.nextKeyIndex(otherTower.ID, nil); index != keyIndex {
+		h.t.Fatalf("expected key index %d, got %d", keyIndex, index)
+	}
+
+	numImported, err := h.db.ImportClientBackup(&decoded)
+	if err != nil {
+		h.t.Fatalf("unable to import backup: %v", err)
+	}
+	if numImported != 1 {
+		h.t.Fatalf("expected 1 imported session, got %d", numImported)
+	}
+
+	tower := h.loadTower(pk, nil)
+	if tower.ID == srcTower.ID {
+		h.t.Fatalf("expected imported tower to get a new id")
+	}
+	if !reflect.DeepEqual(tower.Addresses, srcTower.Addresses) {
+		h.t.Fatalf("tower addresses mismatch, want: %v, got: %v",
+			srcTower.Addresses, tower.Addresses)
+	}
+
+	sessions := h.listSessions(&tower.ID)
+	dbSession, ok := sessions[session.ID]
+	if !ok {
+		h.t.Fatalf("session %v not imported", session.ID)
+	}
+	if dbSession.SeqNum != 2 || dbSession.TowerLastApplied != 1 {
+		h.t.Fatalf("unexpected seqnum %d and last applied %d",
+			dbSession.SeqNum, dbSession.TowerLastApplied)
+	}
+	checkCommittedUpdates(h.t, dbSession, []wtdb.CommittedUpdate{*update2})
+	checkAckedUpdates(h.t, dbSession, map[uint16]wtdb.BackupID{
+		1: update1.BackupID,
+	})
+
+	summaries := wtdb.ChannelSummaries(h.fetchChanSummaries())
+	if !reflect.DeepEqual(summaries, backup.ChanSummaries) {
+		h.t.Fatalf("channel summaries mismatch, want: %v, got: %v",
+			backup.ChanSummaries, summaries)
+	}
+
+	// The colliding reservation must be dropped, and new sessions must not
+	// reuse the key index of the imported session.
+	if index := h.nextKeyIndex(otherTower.ID, nil); index <= keyIndex {
+		h.t.Fatalf("expected key index above %d, got %d", keyIndex,
+			index)
+	}
+
+	// Importing the backup again is a no-op.
+	numImported, err = h.db.ImportClientBackup(&decoded)
+	if err != nil {
+		h.t.Fatalf("unable to import backup: %v", err)
+	}
+	if numImported != 0 {
+		h.t.Fatalf("expected no imported sessions, got %d",
+			numImported)
+	}
+
+	// Exporting the target database yields the imported session.
+	exported, err := h.db.ExportClientBackup()
+	if err != nil {
+		h.t.Fatalf("unable to export backup: %v", err)
+	}
+	if len(exported.Towers) != 2 || len(exported.Sessions) != 1 {
+		h.t.Fatalf("expected 2 towers and 1 session, got %d and %d",
+			len(exported.Towers), len(exported.Sessions))
+	}
+}
+
 // checkCommittedUpdates asserts that the CommittedUpdates on session match the
 // expUpdates provided.
 func checkCommittedUpdates(t *testing.T, session *wtdb.ClientSession,
@@ -844,6 +1127,14 @@ func TestClientDB(t *testing.T) {
 			name: "ack update",
 			run:  testAckUpdate,
 		},
+		{
+			name: "closable sessions",
+			run:  testClosableSessions,
+		},
+		{
+			name: "export import sessions",
+			run:  testExportImportSessions,
+		},
 	}
 
 	for _, database := range dbs {
@@ -0,0 +1,64 @@
+package wtdb
+
+import (
+	"bytes"
+
+	"github.com/decred/dcrlnd/channeldb/kvdb"
+)
+
+// migrateChanSessionIndex creates the index of sessions holding updates of each
+// channel from the committed and acked updates of all existing sessions.
+func migrateChanSessionIndex(tx kvdb.RwTx) error {
+	log.Infof("Indexing watchtower client sessions by channel")
+
+	_, err := tx.CreateTopLevelBucket(cChanSessionIndexBkt)
+	if err != nil {
+		return err
+	}
+
+	// If no session was ever created, there's nothing to index.
+	sessions := tx.ReadBucket(cSessionBkt)
+	if sessions == nil {
+		return nil
+	}
+
+	return sessions.ForEach(func(idBytes, _ []byte) error {
+		sessionBkt := sessions.NestedReadBucket(idBytes)
+		if sessionBkt == nil {
+			return ErrCorruptClientSession
+		}
+
+		sessionCommits := sessionBkt.NestedReadBucket(cSessionCommits)
+		if sessionCommits != nil {
+			err := sessionCommits.ForEach(func(_, v []byte) error {
+				var update CommittedUpdate
+				err := update.Decode(bytes.NewReader(v))
+				if err != nil {
+					return err
+				}
+
+				return putChanSession(
+					tx, update.BackupID.ChanID, idBytes,
+				)
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		sessionAcks := sessionBkt.NestedReadBucket(cSessionAcks)
+		if sessionAcks == nil {
+			return nil
+		}
+
+		return sessionAcks.ForEach(func(_, v []byte) error {
+			var backupID BackupID
+			err := backupID.Decode(bytes.NewReader(v))
+			if err != nil {
+				return err
+			}
+
+			return putChanSession(tx, backupID.ChanID, idBytes)
+		})
+	})
+}
@@ -0,0 +1,101 @@
+package wtdb
+
+import (
+	"encoding/hex"
+	"io"
+	"net"
+	"strings"
+)
+
+// ClientID identifies a client of the tower for the purpose of accounting its
+// usage. A client is identified either by the public key it authenticates
+// with, or by the network address it connects from: the IP address for IPv4
+// clients and the /64 prefix for IPv6 clients, which are commonly assigned a
+// whole prefix.
+//
+// NOTE: Clients connecting through the same NAT share a single address
+// ClientID, whereas a client that changes its address is accounted a fresh
+// one.
+type ClientID string
+
+// NewClientIDFromKey derives the ClientID of a client from the public key it
+// authenticates with.
+func NewClientIDFromKey(key *SessionID) ClientID {
+	return ClientID(hex.EncodeToString(key[:]))
+}
+
+// NewClientIDFromIP derives the ClientID of a client connecting from the given
+// IP address.
+func NewClientIDFromIP(ip net.IP) ClientID {
+	if ip4 := ip.To4(); ip4 != nil {
+		return ClientID(ip4.String())
+	}
+
+	prefix := ip.Mask(net.CIDRMask(64, 128))
+	return ClientID(prefix.String() + "/64")
+}
+
+// NewClientIDFromAddr derives the ClientID of a client connecting from the
+// given network address. Addresses that do not carry an IP address, e.g.
+// onion addresses, are identified by their host. False is returned if the
+// address is unknown or a loopback address, which is shared by all clients
+// reaching the tower through a local proxy such as Tor.
+func NewClientIDFromAddr(addr net.Addr) (ClientID, bool) {
+	if addr == nil {
+		return "", false
+	}
+
+	if tcpAddr, ok := addr.(*net.TCPAddr); ok {
+		if tcpAddr.IP.IsLoopback() {
+			return "", false
+		}
+
+		return NewClientIDFromIP(tcpAddr.IP), true
+	}
+
+	return ParseClientID(addr.String()), true
+}
+
+// ParseClientID derives the ClientID of a client from its textual address,
+// which may include a port. IPv6 addresses may also be given as their /64
+// prefix, as reported for the ClientID itself.
+func ParseClientID(addr string) ClientID {
+	host := strings.TrimSuffix(addr, "/64")
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	if ip := net.ParseIP(host); ip != nil {
+		return NewClientIDFromIP(ip)
+	}
+
+	return ClientID(host)
+}
+
+// ClientUsage records the resources a client has consumed on the tower since
+// its usage was last reset.
+type ClientUsage struct {
+	// NumSessions is the number of sessions the client has created or
+	// recommitted.
+	NumSessions uint32
+
+	// NumUpdates is the number of state updates the client has stored.
+	// Retransmitted updates are only counted once.
+	NumUpdates uint64
+}
+
+// Encode serializes the client usage to the given io.Writer.
+func (u *ClientUsage) Encode(w io.Writer) error {
+	return WriteElements(w,
+		u.NumSessions,
+		u.NumUpdates,
+	)
+}
+
+// Decode deserializes the client usage from the given io.Reader.
+func (u *ClientUsage) Decode(r io.Reader) error {
+	return ReadElements(r,
+		&u.NumSessions,
+		&u.NumUpdates,
+	)
+}
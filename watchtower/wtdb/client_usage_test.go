@@ -0,0 +1,116 @@
+package wtdb_test
+
+import (
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/decred/dcrlnd/tor"
+	"github.com/decred/dcrlnd/watchtower/wtdb"
+)
+
+// TestClientID asserts that clients are identified by their IPv4 address or
+// the /64 prefix of their IPv6 address, regardless of the port they connect
+// from, and that addresses given via RPC map to the same ClientID.
+func TestClientID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		addr  net.Addr
+		str   string
+		expID wtdb.ClientID
+	}{
+		{
+			name: "ipv4",
+			addr: &net.TCPAddr{
+				IP:   net.ParseIP("203.0.113.1"),
+				Port: 9911,
+			},
+			str:   "203.0.113.1",
+			expID: "203.0.113.1",
+		},
+		{
+			name: "ipv6",
+			addr: &net.TCPAddr{
+				IP:   net.ParseIP("2001:db8:1:2:3:4:5:6"),
+				Port: 9911,
+			},
+			str:   "[2001:db8:1:2::1]:9911",
+			expID: "2001:db8:1:2::/64",
+		},
+		{
+			name: "ipv6 prefix",
+			addr: &net.TCPAddr{
+				IP:   net.ParseIP("2001:db8:1:2::1"),
+				Port: 9911,
+			},
+			str:   "2001:db8:1:2::/64",
+			expID: "2001:db8:1:2::/64",
+		},
+		{
+			name: "onion",
+			addr: &tor.OnionAddr{
+				OnionService: "3g2upl4pq6kufc4m.onion",
+				Port:         9911,
+			},
+			str:   "3g2upl4pq6kufc4m.onion",
+			expID: "3g2upl4pq6kufc4m.onion",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			id, ok := wtdb.NewClientIDFromAddr(test.addr)
+			if !ok {
+				t.Fatalf("expected id from addr %v", test.addr)
+			}
+			if id != test.expID {
+				t.Fatalf("expected id %v from addr, got %v",
+					test.expID, id)
+			}
+
+			id = wtdb.ParseClientID(test.str)
+			if id != test.expID {
+				t.Fatalf("expected id %v from string, got %v",
+					test.expID, id)
+			}
+		})
+	}
+}
+
+// TestClientIDExempt asserts that no ClientID is derived for clients with an
+// unknown or loopback address, which are shared by all clients reaching the
+// tower through a local proxy.
+func TestClientIDExempt(t *testing.T) {
+	t.Parallel()
+
+	addrs := []net.Addr{
+		nil,
+		&net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 9911},
+		&net.TCPAddr{IP: net.IPv6loopback, Port: 9911},
+	}
+	for _, addr := range addrs {
+		if id, ok := wtdb.NewClientIDFromAddr(addr); ok {
+			t.Fatalf("expected no id for addr %v, got %v", addr,
+				id)
+		}
+	}
+}
+
+// TestClientIDFromKey asserts that clients are identified by the hex encoding
+// of their key.
+func TestClientIDFromKey(t *testing.T) {
+	t.Parallel()
+
+	var key wtdb.SessionID
+	key[0] = 0x02
+	key[32] = 0xff
+
+	id := wtdb.NewClientIDFromKey(&key)
+	expID := wtdb.ClientID("02" + strings.Repeat("00", 31) + "ff")
+	if id != expID {
+		t.Fatalf("expected id %v, got %v", expID, id)
+	}
+}
@@ -43,6 +43,12 @@ var (
 	// epoch from the lookoutTipBkt.
 	lookoutTipKey = []byte("lookout-tip")
 
+	// clientUsageBkt is a bucket containing the resources consumed by each
+	// client since their usage was last reset. Clients are keyed by the
+	// ClientID derived from their key or the address they connect from.
+	//   client id -> client usage
+	clientUsageBkt = []byte("client-usage-bucket")
+
 	// ErrNoSessionHintIndex signals that an active session does not have an
 	// initialized index for tracking its own state updates.
 	ErrNoSessionHintIndex = errors.New("session hint index missing")
@@ -105,6 +111,7 @@ func initTowerDBBuckets(tx kvdb.RwTx) error {
 		updateIndexBkt,
 		updatesBkt,
 		lookoutTipBkt,
+		clientUsageBkt,
 	}
 
 	for _, bucket := range buckets {
@@ -167,9 +174,12 @@ func (t *TowerDB) GetSessionInfo(id *SessionID) (*SessionInfo, error) {
 	return session, nil
 }
 
-// InsertSessionInfo records a negotiated session in the tower database. An
-// error is returned if the session already exists.
-func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
+// InsertSessionInfo records a negotiated session in the tower database and
+// accounts it to each of the given clients. An error is returned if the session
+// already exists.
+func (t *TowerDB) InsertSessionInfo(session *SessionInfo,
+	clients ...ClientID) error {
+
 	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
 		sessions := tx.ReadWriteBucket(sessionsBkt)
 		if sessions == nil {
@@ -181,6 +191,11 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 			return ErrUninitializedDB
 		}
 
+		clientUsage := tx.ReadWriteBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
 		dbSession, err := getSession(sessions, session.ID[:])
 		switch {
 		case err == ErrSessionNotFound:
@@ -204,6 +219,20 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 			return err
 		}
 
+		// Account the session to the client that created it.
+		for _, client := range clients {
+			usage, err := getClientUsage(clientUsage, client)
+			if err != nil {
+				return err
+			}
+
+			usage.NumSessions++
+			err = putClientUsage(clientUsage, client, usage)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Initialize the session-hint index which will be used to track
 		// all updates added for this session. Upon deletion, we will
 		// consult the index to determine exactly which updates should
@@ -216,8 +245,11 @@ func (t *TowerDB) InsertSessionInfo(session *SessionInfo) error {
 // InsertStateUpdate stores an update sent by the client after validating that
 // the update is well-formed in the context of other updates sent for the same
 // session. This include verifying that the sequence number is incremented
-// properly and the last applied values echoed by the client are sane.
-func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error) {
+// properly and the last applied values echoed by the client are sane. Updates
+// that extend the session are accounted to each of the given clients.
+func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate,
+	clients ...ClientID) (uint16, error) {
+
 	var lastApplied uint16
 	err := kvdb.Update(t.db, func(tx kvdb.RwTx) error {
 		sessions := tx.ReadWriteBucket(sessionsBkt)
@@ -235,6 +267,11 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 			return ErrUninitializedDB
 		}
 
+		clientUsage := tx.ReadWriteBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
 		// Fetch the session corresponding to the update's session id.
 		// This will be used to validate that the update's sequence
 		// number and last applied values are sane.
@@ -250,6 +287,10 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 			return ErrInvalidBlobSize
 		}
 
+		// Only updates that extend the session are accounted to the
+		// client, retransmissions of the last update are free.
+		isNewUpdate := update.SeqNum == session.LastApplied+1
+
 		// Validate the update against the current state of the session.
 		err = session.AcceptUpdateSequence(
 			update.SeqNum, update.LastApplied,
@@ -270,6 +311,13 @@ func (t *TowerDB) InsertStateUpdate(update *SessionStateUpdate) (uint16, error)
 			return err
 		}
 
+		if isNewUpdate {
+			err := accountUpdate(clientUsage, clients)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Create or load the hint bucket for this state update's hint
 		// and write the given update.
 		hints, err := updates.CreateBucketIfNotExists(update.Hint[:])
@@ -384,6 +432,42 @@ func (t *TowerDB) DeleteSession(target SessionID) error {
 	})
 }
 
+// GetClientUsage retrieves the resources consumed by the client with the given
+// id since its usage was last reset. A zero usage is returned for unknown
+// clients.
+func (t *TowerDB) GetClientUsage(id ClientID) (*ClientUsage, error) {
+	var usage *ClientUsage
+	err := kvdb.View(t.db, func(tx kvdb.RTx) error {
+		clientUsage := tx.ReadBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		var err error
+		usage, err = getClientUsage(clientUsage, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// ResetClientUsage clears the resources accounted to the client with the given
+// id, allowing it to consume its full quota again. The client's sessions and
+// state updates are left untouched.
+func (t *TowerDB) ResetClientUsage(id ClientID) error {
+	return kvdb.Update(t.db, func(tx kvdb.RwTx) error {
+		clientUsage := tx.ReadWriteBucket(clientUsageBkt)
+		if clientUsage == nil {
+			return ErrUninitializedDB
+		}
+
+		return clientUsage.Delete([]byte(id))
+	})
+}
+
 // QueryMatches searches against all known state updates for any that match the
 // passed breachHints. More than one Match will be returned for a given hint if
 // they exist in the database.
@@ -532,6 +616,59 @@ func putSession(sessions kvdb.RwBucket, session *SessionInfo) error {
 	return sessions.Put(session.ID[:], b.Bytes())
 }
 
+// getClientUsage retrieves the usage of the client identified by its id from
+// the client usage bucket. A zero usage is returned if none is recorded yet.
+func getClientUsage(clientUsage kvdb.RBucket,
+	id ClientID) (*ClientUsage, error) {
+
+	var usage ClientUsage
+
+	usageBytes := clientUsage.Get([]byte(id))
+	if usageBytes == nil {
+		return &usage, nil
+	}
+
+	err := usage.Decode(bytes.NewReader(usageBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	return &usage, nil
+}
+
+// accountUpdate increments the number of state updates stored by each of the
+// given clients.
+func accountUpdate(clientUsage kvdb.RwBucket, clients []ClientID) error {
+	for _, client := range clients {
+		usage, err := getClientUsage(clientUsage, client)
+		if err != nil {
+			return err
+		}
+
+		usage.NumUpdates++
+		err = putClientUsage(clientUsage, client, usage)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// putClientUsage stores the usage of the client identified by its id in the
+// client usage bucket.
+func putClientUsage(clientUsage kvdb.RwBucket, id ClientID,
+	usage *ClientUsage) error {
+
+	var b bytes.Buffer
+	err := usage.Encode(&b)
+	if err != nil {
+		return err
+	}
+
+	return clientUsage.Put([]byte(id), b.Bytes())
+}
+
 // touchSessionHintBkt initializes the session-hint bucket for a particular
 // session id. This ensures that future calls to getHintsForSession or
 // putHintForSession can rely on the bucket already being created, and fail if
@@ -19,6 +19,10 @@ import (
 
 var (
 	testBlob = make([]byte, blob.Size(blob.TypeAltruistCommit))
+
+	// testClient is the client that sessions and state updates are
+	// accounted to unless specified otherwise.
+	testClient = wtdb.ClientID("203.0.113.1")
 )
 
 // dbInit is a closure used to initialize a watchtower.DB instance and its
@@ -49,7 +53,17 @@ func newTowerDBHarness(t *testing.T, init dbInit) (*towerDBHarness, func()) {
 func (h *towerDBHarness) insertSession(s *wtdb.SessionInfo, expErr error) {
 	h.t.Helper()
 
-	err := h.db.InsertSessionInfo(s)
+	h.insertClientSession(s, testClient, expErr)
+}
+
+// insertClientSession attempts to insert the passed session on behalf of the
+// given client and asserts that the error returned matches expErr.
+func (h *towerDBHarness) insertClientSession(s *wtdb.SessionInfo,
+	client wtdb.ClientID, expErr error) {
+
+	h.t.Helper()
+
+	err := h.db.InsertSessionInfo(s, client)
 	if err != expErr {
 		h.t.Fatalf("expected insert session error: %v, got : %v",
 			expErr, err)
@@ -80,7 +94,7 @@ func (h *towerDBHarness) insertUpdate(s *wtdb.SessionStateUpdate,
 
 	h.t.Helper()
 
-	lastApplied, err := h.db.InsertStateUpdate(s)
+	lastApplied, err := h.db.InsertStateUpdate(s, testClient)
 	if err != expErr {
 		h.t.Fatalf("expected insert update error: %v, got: %v",
 			expErr, err)
@@ -101,6 +115,24 @@ func (h *towerDBHarness) deleteSession(id wtdb.SessionID, expErr error) {
 	}
 }
 
+// assertClientUsage asserts that the usage recorded for the client with the
+// given id matches expUsage.
+func (h *towerDBHarness) assertClientUsage(id wtdb.ClientID,
+	expUsage *wtdb.ClientUsage) {
+
+	h.t.Helper()
+
+	usage, err := h.db.GetClientUsage(id)
+	if err != nil {
+		h.t.Fatalf("unable to fetch client usage: %v", err)
+	}
+
+	if !reflect.DeepEqual(usage, expUsage) {
+		h.t.Fatalf("expected client usage: %v, got: %v",
+			expUsage, usage)
+	}
+}
+
 // queryMatches queries that database for the passed breach hint, returning all
 // matches found.
 func (h *towerDBHarness) queryMatches(hint blob.BreachHint) []wtdb.Match {
@@ -180,6 +212,82 @@ func testInsertSession(h *towerDBHarness) {
 	h.insertSession(session, wtdb.ErrSessionAlreadyExists)
 }
 
+// testClientUsage asserts that sessions and new state updates are accounted to
+// the client that stored them across all of its sessions, that retransmitted
+// updates and deletions don't affect the usage, and that the usage can be
+// reset.
+func testClientUsage(h *towerDBHarness) {
+	const otherClient = wtdb.ClientID("2001:db8::/64")
+
+	id1, id2, id3 := id(1), id(2), id(3)
+	h.assertClientUsage(testClient, &wtdb.ClientUsage{})
+
+	session := &wtdb.SessionInfo{
+		ID: *id1,
+		Policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeAltruistCommit,
+				SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+			},
+			MaxUpdates: 100,
+		},
+	}
+
+	// Recommitting an unused session counts as another session, as does
+	// any other session negotiated by the same client.
+	session2 := *session
+	session2.ID = *id2
+	h.insertSession(session, nil)
+	h.insertSession(session, nil)
+	h.insertSession(&session2, nil)
+	h.assertClientUsage(testClient, &wtdb.ClientUsage{NumSessions: 3})
+
+	// Updates are accounted across all sessions of the client, while
+	// retransmitting the last update is free.
+	h.insertUpdate(updateFromInt(id1, 1, 0), nil)
+	h.insertUpdate(updateFromInt(id1, 2, 1), nil)
+	h.insertUpdate(updateFromInt(id1, 2, 1), nil)
+	h.insertUpdate(updateFromInt(id2, 1, 0), nil)
+	h.assertClientUsage(testClient, &wtdb.ClientUsage{
+		NumSessions: 3,
+		NumUpdates:  3,
+	})
+
+	// Rejected updates are not accounted either.
+	h.insertUpdate(updateFromInt(id1, 4, 2), wtdb.ErrUpdateOutOfOrder)
+	h.assertClientUsage(testClient, &wtdb.ClientUsage{
+		NumSessions: 3,
+		NumUpdates:  3,
+	})
+
+	// The usage of other clients is tracked independently. A session
+	// may be accounted to several clients at once, e.g. to the key and
+	// the address of the client.
+	session3 := *session
+	session3.ID = *id3
+	keyClient := wtdb.NewClientIDFromKey(id3)
+	err := h.db.InsertSessionInfo(&session3, otherClient, keyClient)
+	if err != nil {
+		h.t.Fatalf("unable to insert session: %v", err)
+	}
+	h.assertClientUsage(otherClient, &wtdb.ClientUsage{NumSessions: 1})
+	h.assertClientUsage(keyClient, &wtdb.ClientUsage{NumSessions: 1})
+
+	// Deleting a session doesn't reclaim the client's usage, only
+	// resetting it does.
+	h.deleteSession(*id1, nil)
+	h.assertClientUsage(testClient, &wtdb.ClientUsage{
+		NumSessions: 3,
+		NumUpdates:  3,
+	})
+
+	if err := h.db.ResetClientUsage(testClient); err != nil {
+		h.t.Fatalf("unable to reset client usage: %v", err)
+	}
+	h.assertClientUsage(testClient, &wtdb.ClientUsage{})
+	h.assertClientUsage(otherClient, &wtdb.ClientUsage{NumSessions: 1})
+}
+
 // testMultipleMatches asserts that if multiple sessions insert state updates
 // with the same breach hint that all will be returned from QueryMatches.
 func testMultipleMatches(h *towerDBHarness) {
@@ -757,6 +865,10 @@ func TestTowerDB(t *testing.T) {
 			name: "lookout tip",
 			run:  testLookoutTip,
 		},
+		{
+			name: "client usage",
+			run:  testClientUsage,
+		},
 	}
 
 	for _, database := range dbs {
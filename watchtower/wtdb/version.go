@@ -24,7 +24,14 @@ var towerDBVersions = []version{}
 // clientDBVersions stores all versions and migrations of the client database.
 // This list will be used when opening the database to determine if any
 // migrations must be applied.
-var clientDBVersions = []version{}
+var clientDBVersions = []version{
+	{
+		// The client starts to index its sessions by the channels they
+		// hold updates of, so that sessions can be deleted once all of
+		// their channels are closed.
+		migration: migrateChanSessionIndex,
+	},
+}
 
 // getLatestDBVersion returns the last known database version.
 func getLatestDBVersion(versions []version) uint32 {
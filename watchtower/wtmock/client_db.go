@@ -20,6 +20,7 @@ type ClientDB struct {
 	mu             sync.Mutex
 	summaries      map[lnwire.ChannelID]wtdb.ClientChanSummary
 	activeSessions map[wtdb.SessionID]wtdb.ClientSession
+	closable       map[wtdb.SessionID]uint32
 	towerIndex     map[towerPK]wtdb.TowerID
 	towers         map[wtdb.TowerID]*wtdb.Tower
 
@@ -32,6 +33,7 @@ func NewClientDB() *ClientDB {
 	return &ClientDB{
 		summaries:      make(map[lnwire.ChannelID]wtdb.ClientChanSummary),
 		activeSessions: make(map[wtdb.SessionID]wtdb.ClientSession),
+		closable:       make(map[wtdb.SessionID]uint32),
 		towerIndex:     make(map[towerPK]wtdb.TowerID),
 		towers:         make(map[wtdb.TowerID]*wtdb.Tower),
 		indexes:        make(map[wtdb.TowerID]uint32),
@@ -355,7 +357,7 @@ func (m *ClientDB) AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) err
 		// Remove the committed update from disk and mark the update as
 		// acked. The tower last applied value is also recorded to send
 		// along with the next update.
-		copy(updates[:i], updates[i+1:])
+		copy(updates[i:], updates[i+1:])
 		updates[len(updates)-1] = wtdb.CommittedUpdate{}
 		session.CommittedUpdates = updates[:len(updates)-1]
 
@@ -363,12 +365,263 @@ func (m *ClientDB) AckUpdate(id *wtdb.SessionID, seqNum, lastApplied uint16) err
 		session.TowerLastApplied = lastApplied
 
 		m.activeSessions[*id] = session
+		m.markSessionClosable(&session)
 		return nil
 	}
 
 	return wtdb.ErrCommittedUpdateNotFound
 }
 
+// MarkChannelClosed records that the channel was closed at the given height.
+// Any session holding updates of the channel that now only holds updates of
+// closed channels is marked as closable, and the IDs of all closable sessions
+// holding updates of the channel are returned. If no session holds updates of
+// the channel, its summary is removed right away. Marking a channel that isn't
+// registered is a no-op.
+func (m *ClientDB) MarkChannelClosed(chanID lnwire.ChannelID,
+	blockHeight uint32) ([]wtdb.SessionID, error) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	summary, ok := m.summaries[chanID]
+	if !ok {
+		return nil, nil
+	}
+
+	if summary.ClosedHeight == 0 {
+		summary.ClosedHeight = blockHeight
+		m.summaries[chanID] = summary
+	}
+
+	var (
+		closableSessions []wtdb.SessionID
+		backedUp         bool
+	)
+	for id, session := range m.activeSessions {
+		session := session
+		if !holdsChannel(&session, chanID) {
+			continue
+		}
+		backedUp = true
+
+		if m.markSessionClosable(&session) {
+			closableSessions = append(closableSessions, id)
+		}
+	}
+
+	if !backedUp {
+		delete(m.summaries, chanID)
+	}
+
+	return closableSessions, nil
+}
+
+// ListClosableSessions returns the set of sessions that only hold updates of
+// closed channels, mapped to the height at which the last of their channels
+// was closed.
+func (m *ClientDB) ListClosableSessions() (map[wtdb.SessionID]uint32, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	closableSessions := make(map[wtdb.SessionID]uint32, len(m.closable))
+	for id, height := range m.closable {
+		closableSessions[id] = height
+	}
+
+	return closableSessions, nil
+}
+
+// DeleteSession removes a closable session from the database. The summaries of
+// the session's channels are removed along with the last session holding their
+// updates. ErrSessionNotClosable is returned if the session isn't closable.
+func (m *ClientDB) DeleteSession(id wtdb.SessionID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, ok := m.activeSessions[id]
+	if !ok {
+		return wtdb.ErrClientSessionNotFound
+	}
+
+	if _, ok := m.closable[id]; !ok {
+		return wtdb.ErrSessionNotClosable
+	}
+
+	delete(m.activeSessions, id)
+	delete(m.closable, id)
+
+	for _, backupID := range session.AckedUpdates {
+		chanID := backupID.ChanID
+
+		var backedUp bool
+		for _, s := range m.activeSessions {
+			s := s
+			if holdsChannel(&s, chanID) {
+				backedUp = true
+				break
+			}
+		}
+
+		if !backedUp {
+			delete(m.summaries, chanID)
+		}
+	}
+
+	return nil
+}
+
+// ExportClientBackup returns a backup of all towers, sessions and channel
+// summaries known to the database.
+func (m *ClientDB) ExportClientBackup() (*wtdb.ClientBackup, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	backup := &wtdb.ClientBackup{
+		Version:       wtdb.DefaultClientBackupVersion,
+		ChanSummaries: make(wtdb.ChannelSummaries),
+	}
+	for _, tower := range m.towers {
+		backup.Towers = append(backup.Towers, copyTower(tower))
+	}
+	for _, session := range m.activeSessions {
+		backup.Sessions = append(backup.Sessions, copySession(&session))
+	}
+	for chanID, summary := range m.summaries {
+		backup.ChanSummaries[chanID] = wtdb.ClientChanSummary{
+			SweepPkScript: cloneBytes(summary.SweepPkScript),
+			ClosedHeight:  summary.ClosedHeight,
+		}
+	}
+
+	return backup, nil
+}
+
+// ImportClientBackup adds the towers, sessions and channel summaries of the
+// backup to the database and returns the number of sessions that were
+// imported. Existing sessions and channel summaries are left untouched.
+func (m *ClientDB) ImportClientBackup(backup *wtdb.ClientBackup) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	towerIDs := make(map[wtdb.TowerID]wtdb.TowerID, len(backup.Towers))
+	for _, backupTower := range backup.Towers {
+		identityKey := backupTower.IdentityKey
+
+		var towerPubKey towerPK
+		copy(towerPubKey[:], identityKey.SerializeCompressed())
+
+		towerID, ok := m.towerIndex[towerPubKey]
+		if ok {
+			tower := m.towers[towerID]
+			addrs := backupTower.Addresses
+			for i := len(addrs) - 1; i >= 0; i-- {
+				tower.AddAddress(addrs[i])
+			}
+		} else {
+			nextTowerID := atomic.AddUint64(&m.nextTowerID, 1)
+			towerID = wtdb.TowerID(nextTowerID)
+
+			tower := copyTower(backupTower)
+			tower.ID = towerID
+
+			m.towerIndex[towerPubKey] = towerID
+			m.towers[towerID] = tower
+		}
+		towerIDs[backupTower.ID] = towerID
+	}
+
+	var imported []wtdb.SessionID
+	for _, backupSession := range backup.Sessions {
+		if _, ok := m.activeSessions[backupSession.ID]; ok {
+			continue
+		}
+
+		towerID, ok := towerIDs[backupSession.TowerID]
+		if !ok {
+			return 0, wtdb.ErrTowerNotFound
+		}
+
+		session := copySession(backupSession)
+		session.TowerID = towerID
+		m.activeSessions[session.ID] = *session
+		imported = append(imported, session.ID)
+
+		if session.KeyIndex > m.nextIndex {
+			m.nextIndex = session.KeyIndex
+		}
+		for id, index := range m.indexes {
+			if index == session.KeyIndex {
+				delete(m.indexes, id)
+			}
+		}
+	}
+
+	for chanID, summary := range backup.ChanSummaries {
+		if _, ok := m.summaries[chanID]; ok {
+			continue
+		}
+		m.summaries[chanID] = wtdb.ClientChanSummary{
+			SweepPkScript: cloneBytes(summary.SweepPkScript),
+			ClosedHeight:  summary.ClosedHeight,
+		}
+	}
+
+	for _, id := range imported {
+		session := m.activeSessions[id]
+		m.markSessionClosable(&session)
+	}
+
+	return len(imported), nil
+}
+
+// markSessionClosable adds the session to the set of closable sessions if it
+// can't accept any more updates, has no unacked updates and all channels it
+// holds updates of are closed. It returns true if the session is closable.
+//
+// NOTE: This method requires the database's lock to be acquired.
+func (m *ClientDB) markSessionClosable(session *wtdb.ClientSession) bool {
+	if session.SeqNum < session.Policy.MaxUpdates ||
+		len(session.CommittedUpdates) > 0 {
+
+		return false
+	}
+
+	var closeHeight uint32
+	for _, backupID := range session.AckedUpdates {
+		summary, ok := m.summaries[backupID.ChanID]
+		if !ok || summary.ClosedHeight == 0 {
+			return false
+		}
+
+		if summary.ClosedHeight > closeHeight {
+			closeHeight = summary.ClosedHeight
+		}
+	}
+
+	m.closable[session.ID] = closeHeight
+
+	return true
+}
+
+// holdsChannel returns true if the session holds a committed or acked update of
+// the channel.
+func holdsChannel(session *wtdb.ClientSession, chanID lnwire.ChannelID) bool {
+	for _, update := range session.CommittedUpdates {
+		if update.BackupID.ChanID == chanID {
+			return true
+		}
+	}
+
+	for _, backupID := range session.AckedUpdates {
+		if backupID.ChanID == chanID {
+			return true
+		}
+	}
+
+	return false
+}
+
 // FetchChanSummaries loads a mapping from all registered channels to their
 // channel summaries.
 func (m *ClientDB) FetchChanSummaries() (wtdb.ChannelSummaries, error) {
@@ -379,6 +632,7 @@ func (m *ClientDB) FetchChanSummaries() (wtdb.ChannelSummaries, error) {
 	for chanID, summary := range m.summaries {
 		summaries[chanID] = wtdb.ClientChanSummary{
 			SweepPkScript: cloneBytes(summary.SweepPkScript),
+			ClosedHeight:  summary.ClosedHeight,
 		}
 	}
 
@@ -428,3 +682,21 @@ func copyTower(tower *wtdb.Tower) *wtdb.Tower {
 
 	return t
 }
+
+func copySession(session *wtdb.ClientSession) *wtdb.ClientSession {
+	s := &wtdb.ClientSession{
+		ID:                session.ID,
+		ClientSessionBody: session.ClientSessionBody,
+		CommittedUpdates: make(
+			[]wtdb.CommittedUpdate, len(session.CommittedUpdates),
+		),
+		AckedUpdates: make(map[uint16]wtdb.BackupID),
+	}
+	s.RewardPkScript = cloneBytes(session.RewardPkScript)
+	copy(s.CommittedUpdates, session.CommittedUpdates)
+	for seqNum, backupID := range session.AckedUpdates {
+		s.AckedUpdates[seqNum] = backupID
+	}
+
+	return s
+}
@@ -14,6 +14,7 @@ type TowerDB struct {
 	lastEpoch *chainntnfs.BlockEpoch
 	sessions  map[wtdb.SessionID]*wtdb.SessionInfo
 	blobs     map[blob.BreachHint]map[wtdb.SessionID]*wtdb.SessionStateUpdate
+	usage     map[wtdb.ClientID]wtdb.ClientUsage
 }
 
 // NewTowerDB initializes a fresh mock TowerDB.
@@ -21,14 +22,18 @@ func NewTowerDB() *TowerDB {
 	return &TowerDB{
 		sessions: make(map[wtdb.SessionID]*wtdb.SessionInfo),
 		blobs:    make(map[blob.BreachHint]map[wtdb.SessionID]*wtdb.SessionStateUpdate),
+		usage:    make(map[wtdb.ClientID]wtdb.ClientUsage),
 	}
 }
 
 // InsertStateUpdate stores an update sent by the client after validating that
 // the update is well-formed in the context of other updates sent for the same
 // session. This include verifying that the sequence number is incremented
-// properly and the last applied values echoed by the client are sane.
-func (db *TowerDB) InsertStateUpdate(update *wtdb.SessionStateUpdate) (uint16, error) {
+// properly and the last applied values echoed by the client are sane. Updates
+// that extend the session are accounted to each of the given clients.
+func (db *TowerDB) InsertStateUpdate(update *wtdb.SessionStateUpdate,
+	clients ...wtdb.ClientID) (uint16, error) {
+
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
@@ -42,11 +47,21 @@ func (db *TowerDB) InsertStateUpdate(update *wtdb.SessionStateUpdate) (uint16, e
 		return 0, wtdb.ErrInvalidBlobSize
 	}
 
+	isNewUpdate := update.SeqNum == info.LastApplied+1
+
 	err := info.AcceptUpdateSequence(update.SeqNum, update.LastApplied)
 	if err != nil {
 		return info.LastApplied, err
 	}
 
+	if isNewUpdate {
+		for _, client := range clients {
+			usage := db.usage[client]
+			usage.NumUpdates++
+			db.usage[client] = usage
+		}
+	}
+
 	sessionsToUpdates, ok := db.blobs[update.Hint]
 	if !ok {
 		sessionsToUpdates = make(map[wtdb.SessionID]*wtdb.SessionStateUpdate)
@@ -70,9 +85,12 @@ func (db *TowerDB) GetSessionInfo(id *wtdb.SessionID) (*wtdb.SessionInfo, error)
 	return nil, wtdb.ErrSessionNotFound
 }
 
-// InsertSessionInfo records a negotiated session in the tower database. An
-// error is returned if the session already exists.
-func (db *TowerDB) InsertSessionInfo(info *wtdb.SessionInfo) error {
+// InsertSessionInfo records a negotiated session in the tower database and
+// accounts it to each of the given clients. An error is returned if the
+// session already exists.
+func (db *TowerDB) InsertSessionInfo(info *wtdb.SessionInfo,
+	clients ...wtdb.ClientID) error {
+
 	db.mu.Lock()
 	defer db.mu.Unlock()
 
@@ -88,6 +106,12 @@ func (db *TowerDB) InsertSessionInfo(info *wtdb.SessionInfo) error {
 
 	db.sessions[info.ID] = info
 
+	for _, client := range clients {
+		usage := db.usage[client]
+		usage.NumSessions++
+		db.usage[client] = usage
+	}
+
 	return nil
 }
 
@@ -120,6 +144,29 @@ func (db *TowerDB) DeleteSession(target wtdb.SessionID) error {
 	return nil
 }
 
+// GetClientUsage retrieves the resources consumed by the client with the given
+// id since its usage was last reset. A zero usage is returned for unknown
+// clients.
+func (db *TowerDB) GetClientUsage(id wtdb.ClientID) (*wtdb.ClientUsage,
+	error) {
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	usage := db.usage[id]
+	return &usage, nil
+}
+
+// ResetClientUsage clears the resources accounted to the client with the given
+// id, allowing it to consume its full quota again.
+func (db *TowerDB) ResetClientUsage(id wtdb.ClientID) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	delete(db.usage, id)
+	return nil
+}
+
 // QueryMatches searches against all known state updates for any that match the
 // passed breachHints. More than one Match will be returned for a given hint if
 // they exist in the database.
@@ -103,6 +103,11 @@ type TxPolicy struct {
 	SweepFeeRate chainfee.AtomPerKByte
 }
 
+// IsAnchorChannel returns true if the session policy requires anchor channels.
+func (p *TxPolicy) IsAnchorChannel() bool {
+	return p.BlobType.IsAnchorChannel()
+}
+
 // Policy defines the negotiated parameters for a session between a client and
 // server. In addition to the TxPolicy that governs the shape of the justice
 // transaction, the Policy also includes features which only affect the
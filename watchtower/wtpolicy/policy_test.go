@@ -71,6 +71,38 @@ var validationTests = []struct {
 			MaxUpdates: 1,
 		},
 	},
+	{
+		name: "fail anchor altruist with reward rate",
+		policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:   blob.TypeAltruistAnchorCommit,
+				RewardRate: 1,
+			},
+		},
+		expErr: wtpolicy.ErrAltruistReward,
+	},
+	{
+		name: "valid anchor altruist policy",
+		policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeAltruistAnchorCommit,
+				SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+			},
+			MaxUpdates: 1,
+		},
+	},
+	{
+		name: "valid anchor reward policy",
+		policy: wtpolicy.Policy{
+			TxPolicy: wtpolicy.TxPolicy{
+				BlobType:     blob.TypeRewardAnchorCommit,
+				RewardBase:   1,
+				RewardRate:   1,
+				SweepFeeRate: wtpolicy.DefaultSweepFeeRate,
+			},
+			MaxUpdates: 1,
+		},
+	},
 	{
 		name:   "valid default policy",
 		policy: wtpolicy.DefaultPolicy(),
@@ -91,3 +123,21 @@ func TestPolicyValidate(t *testing.T) {
 		})
 	}
 }
+
+// TestPolicyIsAnchorChannel asserts that the anchor channel flag of a policy
+// follows its blob type.
+func TestPolicyIsAnchorChannel(t *testing.T) {
+	policy := wtpolicy.Policy{
+		TxPolicy: wtpolicy.TxPolicy{
+			BlobType: blob.TypeAltruistCommit,
+		},
+	}
+	if policy.IsAnchorChannel() {
+		t.Fatalf("legacy policy reported as anchor channel policy")
+	}
+
+	policy.BlobType = blob.TypeRewardAnchorCommit
+	if !policy.IsAnchorChannel() {
+		t.Fatalf("anchor policy not reported as anchor channel policy")
+	}
+}
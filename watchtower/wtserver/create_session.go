@@ -65,6 +65,24 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 		)
 	}
 
+	// Reject the request if the client, as identified by its key or its
+	// address, already created as many sessions as we are willing to
+	// store on its behalf.
+	quotas := s.clientQuotas(peer, id)
+	err = s.checkSessionQuota(id, quotas)
+	switch {
+	case err == errSessionQuotaExceeded:
+		return s.replyCreateSession(
+			peer, id, wtwire.CodeQuotaExceeded, 0, nil,
+		)
+
+	case err != nil:
+		log.Errorf("Unable to load client usage for %s: %v", id, err)
+		return s.replyCreateSession(
+			peer, id, wtwire.CodeTemporaryFailure, 0, nil,
+		)
+	}
+
 	// Now that we've established that this session does not exist in the
 	// database, retrieve the sweep address that will be given to the
 	// client. This address is to be included by the client when signing
@@ -113,7 +131,7 @@ func (s *Server) handleCreateSession(peer Peer, id *wtdb.SessionID,
 
 	// Insert the session info into the watchtower's database. If
 	// successful, the session will now be ready for use.
-	err = s.cfg.DB.InsertSessionInfo(&info)
+	err = s.cfg.DB.InsertSessionInfo(&info, clientIDs(quotas)...)
 	if err != nil {
 		log.Errorf("Unable to create session for %s: %v", id, err)
 		return s.replyCreateSession(
@@ -52,8 +52,8 @@ type Peer interface {
 type DB interface {
 	// InsertSessionInfo saves a newly agreed-upon session from a client.
 	// This method should fail if a session with the same session id already
-	// exists.
-	InsertSessionInfo(*wtdb.SessionInfo) error
+	// exists. The session is accounted to each of the given clients.
+	InsertSessionInfo(*wtdb.SessionInfo, ...wtdb.ClientID) error
 
 	// GetSessionInfo retrieves the SessionInfo associated with the session
 	// id, if it exists.
@@ -61,10 +61,20 @@ type DB interface {
 
 	// InsertStateUpdate persists a state update sent by a client, and
 	// validates the update against the current SessionInfo stored under the
-	// update's session id..
-	InsertStateUpdate(*wtdb.SessionStateUpdate) (uint16, error)
+	// update's session id.. Updates that extend the session are accounted
+	// to each of the given clients.
+	InsertStateUpdate(*wtdb.SessionStateUpdate, ...wtdb.ClientID) (uint16,
+		error)
 
 	// DeleteSession removes all data associated with a particular session
 	// id from the tower's database.
 	DeleteSession(wtdb.SessionID) error
+
+	// GetClientUsage retrieves the resources consumed by the client with
+	// the given id since its usage was last reset.
+	GetClientUsage(wtdb.ClientID) (*wtdb.ClientUsage, error)
+
+	// ResetClientUsage clears the resources accounted to the client with
+	// the given id.
+	ResetClientUsage(wtdb.ClientID) error
 }
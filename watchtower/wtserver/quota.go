@@ -0,0 +1,110 @@
+package wtserver
+
+import (
+	"errors"
+
+	"github.com/decred/dcrlnd/watchtower/wtdb"
+)
+
+var (
+	// errSessionQuotaExceeded signals that a client has created as many
+	// sessions as the tower is willing to store on its behalf.
+	errSessionQuotaExceeded = errors.New("client session quota exceeded")
+
+	// errUpdateQuotaExceeded signals that a client has stored as many
+	// state updates as the tower is willing to accept from it.
+	errUpdateQuotaExceeded = errors.New("client update quota exceeded")
+)
+
+// clientQuota is the limit on the resources a client, as identified by its key
+// or by its address, may consume on the tower.
+type clientQuota struct {
+	client      wtdb.ClientID
+	maxSessions uint32
+	maxUpdates  uint64
+}
+
+// clientQuotas returns the quotas that apply to a peer authenticating with the
+// given key: the quota of the key itself and, unless the peer connects through
+// a loopback address, the quota of its address.
+func (s *Server) clientQuotas(peer Peer, id *wtdb.SessionID) []clientQuota {
+	quotas := []clientQuota{{
+		client:      wtdb.NewClientIDFromKey(id),
+		maxSessions: s.cfg.MaxSessionsPerClient,
+		maxUpdates:  s.cfg.MaxUpdatesPerClient,
+	}}
+
+	if addrClient, ok := wtdb.NewClientIDFromAddr(peer.RemoteAddr()); ok {
+		quotas = append(quotas, clientQuota{
+			client:      addrClient,
+			maxSessions: s.cfg.MaxSessionsPerAddress,
+			maxUpdates:  s.cfg.MaxUpdatesPerAddress,
+		})
+	}
+
+	return quotas
+}
+
+// clientIDs returns the clients the given quotas apply to.
+func clientIDs(quotas []clientQuota) []wtdb.ClientID {
+	clients := make([]wtdb.ClientID, 0, len(quotas))
+	for _, quota := range quotas {
+		clients = append(clients, quota.client)
+	}
+
+	return clients
+}
+
+// checkSessionQuota returns errSessionQuotaExceeded if any of the given
+// clients has already created as many sessions as the tower is willing to
+// store on its behalf.
+func (s *Server) checkSessionQuota(id *wtdb.SessionID,
+	quotas []clientQuota) error {
+
+	for _, quota := range quotas {
+		if quota.maxSessions == 0 {
+			continue
+		}
+
+		usage, err := s.cfg.DB.GetClientUsage(quota.client)
+		if err != nil {
+			return err
+		}
+
+		if usage.NumSessions >= quota.maxSessions {
+			log.Infof("Rejecting CreateSession from %s, session "+
+				"quota of %d of client %s exhausted", id,
+				quota.maxSessions, quota.client)
+			return errSessionQuotaExceeded
+		}
+	}
+
+	return nil
+}
+
+// checkUpdateQuota returns errUpdateQuotaExceeded if any of the given clients
+// has already stored as many state updates as the tower is willing to accept
+// from it.
+func (s *Server) checkUpdateQuota(id *wtdb.SessionID,
+	quotas []clientQuota) error {
+
+	for _, quota := range quotas {
+		if quota.maxUpdates == 0 {
+			continue
+		}
+
+		usage, err := s.cfg.DB.GetClientUsage(quota.client)
+		if err != nil {
+			return err
+		}
+
+		if usage.NumUpdates >= quota.maxUpdates {
+			log.Infof("Rejecting StateUpdate from %s, update "+
+				"quota of %d of client %s exhausted", id,
+				quota.maxUpdates, quota.client)
+			return errUpdateQuotaExceeded
+		}
+	}
+
+	return nil
+}
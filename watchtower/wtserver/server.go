@@ -67,6 +67,30 @@ type Config struct {
 	// DisableReward causes the server to reject any session creation
 	// attempts that request rewards.
 	DisableReward bool
+
+	// MaxSessionsPerClient is the maximum number of sessions a single
+	// client key may create before its usage is reset. A value of zero
+	// means that the number of sessions is unlimited.
+	MaxSessionsPerClient uint32
+
+	// MaxUpdatesPerClient is the maximum number of state updates a single
+	// client key may store before its usage is reset. A value of zero
+	// means that the number of state updates is unlimited.
+	MaxUpdatesPerClient uint64
+
+	// MaxSessionsPerAddress is the maximum number of sessions the clients
+	// connecting from a single address may create before its usage is
+	// reset. Clients connecting through a loopback address, e.g. through
+	// the tower's onion service, are exempt. A value of zero means that
+	// the number of sessions is unlimited.
+	MaxSessionsPerAddress uint32
+
+	// MaxUpdatesPerAddress is the maximum number of state updates the
+	// clients connecting from a single address may store before its usage
+	// is reset. Clients connecting through a loopback address are exempt.
+	// A value of zero means that the number of state updates is
+	// unlimited.
+	MaxUpdatesPerAddress uint64
 }
 
 // Server houses the state required to handle watchtower peers. It's primary job
@@ -96,7 +120,10 @@ type Server struct {
 // sessions and send state updates.
 func New(cfg *Config) (*Server, error) {
 	localInit := wtwire.NewInitMessage(
-		lnwire.NewRawFeatureVector(wtwire.AltruistSessionsOptional),
+		lnwire.NewRawFeatureVector(
+			wtwire.AltruistSessionsOptional,
+			wtwire.AnchorCommitOptional,
+		),
 		cfg.ChainHash,
 	)
 
@@ -2,6 +2,7 @@ package wtserver_test
 
 import (
 	"bytes"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -811,6 +812,326 @@ func TestServerDeleteSession(t *testing.T) {
 	}
 }
 
+// TestServerClientQuotas asserts that the server rejects sessions and state
+// updates with CodeQuotaExceeded once a client key has consumed its quota, and
+// that the client can proceed once its usage has been reset.
+func TestServerClientQuotas(t *testing.T) {
+	t.Parallel()
+
+	const timeoutDuration = 100 * time.Millisecond
+
+	db := wtmock.NewTowerDB()
+	s, err := wtserver.New(&wtserver.Config{
+		DB:           db,
+		ReadTimeout:  timeoutDuration,
+		WriteTimeout: timeoutDuration,
+		NewAddress: func() (stdaddr.Address, error) {
+			return addr, nil
+		},
+		ChainHash:            testnetChainHash,
+		MaxSessionsPerClient: 2,
+		MaxUpdatesPerClient:  2,
+	})
+	if err != nil {
+		t.Fatalf("unable to create server: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start server: %v", err)
+	}
+	defer s.Stop()
+
+	localPub := randPubKey(t)
+	peerPub := randPubKey(t)
+	id := wtdb.NewSessionIDFromPubKey(peerPub)
+	clientID := wtdb.NewClientIDFromKey(&id)
+
+	initMsg := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(),
+		testnetChainHash,
+	)
+
+	createSession := &wtwire.CreateSession{
+		BlobType:     blob.TypeAltruistCommit,
+		MaxUpdates:   1000,
+		RewardBase:   0,
+		RewardRate:   0,
+		SweepFeeRate: 10000,
+	}
+
+	// sendCreateSession creates or recommits the client's session and
+	// asserts the code of the server's reply.
+	sendCreateSession := func(expCode wtwire.ErrorCode) {
+		t.Helper()
+
+		peer := wtmock.NewMockPeer(localPub, peerPub, nil, 0)
+		connect(t, s, peer, initMsg, timeoutDuration)
+		sendMsg(t, createSession, peer, timeoutDuration)
+
+		reply := recvReply(
+			t, "MsgCreateSessionReply", peer, timeoutDuration,
+		).(*wtwire.CreateSessionReply)
+		if reply.Code != expCode {
+			t.Fatalf("expected create session code %v, got %v",
+				expCode, reply.Code)
+		}
+
+		assertConnClosed(t, peer, 2*timeoutDuration)
+	}
+
+	// The unused session can be recommitted until the session quota is
+	// exhausted.
+	sendCreateSession(wtwire.CodeOK)
+	sendCreateSession(wtwire.CodeOK)
+	sendCreateSession(wtwire.CodeQuotaExceeded)
+
+	// The client may store updates until the update quota is exhausted,
+	// after which the server hangs up.
+	peer := wtmock.NewMockPeer(localPub, peerPub, nil, 0)
+	connect(t, s, peer, initMsg, timeoutDuration)
+
+	updates := []*wtwire.StateUpdate{
+		{SeqNum: 1, LastApplied: 0, EncryptedBlob: testBlob},
+		{SeqNum: 2, LastApplied: 1, EncryptedBlob: testBlob},
+		{SeqNum: 3, LastApplied: 2, EncryptedBlob: testBlob},
+	}
+	replies := []*wtwire.StateUpdateReply{
+		{Code: wtwire.CodeOK, LastApplied: 1},
+		{Code: wtwire.CodeOK, LastApplied: 2},
+		{Code: wtwire.CodeQuotaExceeded, LastApplied: 0},
+	}
+	for i, update := range updates {
+		sendMsg(t, update, peer, timeoutDuration)
+		reply := recvReply(
+			t, "MsgStateUpdateReply", peer, timeoutDuration,
+		)
+		if !reflect.DeepEqual(reply, replies[i]) {
+			t.Fatalf("[update %d] expected reply %v, got %v", i,
+				replies[i], reply)
+		}
+	}
+	assertConnClosed(t, peer, 2*timeoutDuration)
+
+	usage, err := db.GetClientUsage(clientID)
+	if err != nil {
+		t.Fatalf("unable to fetch client usage: %v", err)
+	}
+	expUsage := &wtdb.ClientUsage{NumSessions: 2, NumUpdates: 2}
+	if !reflect.DeepEqual(usage, expUsage) {
+		t.Fatalf("expected usage %v, got %v", expUsage, usage)
+	}
+
+	// After resetting the client's usage, it can continue where it left
+	// off.
+	if err := db.ResetClientUsage(clientID); err != nil {
+		t.Fatalf("unable to reset client usage: %v", err)
+	}
+
+	peer = wtmock.NewMockPeer(localPub, peerPub, nil, 0)
+	connect(t, s, peer, initMsg, timeoutDuration)
+
+	update := &wtwire.StateUpdate{
+		SeqNum:        3,
+		LastApplied:   2,
+		IsComplete:    1,
+		EncryptedBlob: testBlob,
+	}
+	sendMsg(t, update, peer, timeoutDuration)
+	reply := recvReply(
+		t, "MsgStateUpdateReply", peer, timeoutDuration,
+	).(*wtwire.StateUpdateReply)
+	if reply.Code != wtwire.CodeOK || reply.LastApplied != 3 {
+		t.Fatalf("expected update to be accepted, got %v", reply)
+	}
+	assertConnClosed(t, peer, 2*timeoutDuration)
+}
+
+// TestServerAddressQuotas asserts that the server rejects sessions and state
+// updates with CodeQuotaExceeded once the clients connecting from an address
+// have consumed its quota across all of their keys, that clients connecting
+// through a loopback address are exempt, and that the clients can proceed once
+// the usage of the address has been reset.
+func TestServerAddressQuotas(t *testing.T) {
+	t.Parallel()
+
+	const timeoutDuration = 100 * time.Millisecond
+
+	db := wtmock.NewTowerDB()
+	s, err := wtserver.New(&wtserver.Config{
+		DB:           db,
+		ReadTimeout:  timeoutDuration,
+		WriteTimeout: timeoutDuration,
+		NewAddress: func() (stdaddr.Address, error) {
+			return addr, nil
+		},
+		ChainHash:             testnetChainHash,
+		MaxSessionsPerAddress: 2,
+		MaxUpdatesPerAddress:  2,
+	})
+	if err != nil {
+		t.Fatalf("unable to create server: %v", err)
+	}
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start server: %v", err)
+	}
+	defer s.Stop()
+
+	localPub := randPubKey(t)
+
+	// The client negotiates every session with a fresh key, but always
+	// connects from the same address.
+	clientAddr := &net.TCPAddr{
+		IP:   net.ParseIP("203.0.113.1"),
+		Port: 9911,
+	}
+	clientID, _ := wtdb.NewClientIDFromAddr(clientAddr)
+
+	initMsg := wtwire.NewInitMessage(
+		lnwire.NewRawFeatureVector(),
+		testnetChainHash,
+	)
+
+	createSession := &wtwire.CreateSession{
+		BlobType:     blob.TypeAltruistCommit,
+		MaxUpdates:   1000,
+		RewardBase:   0,
+		RewardRate:   0,
+		SweepFeeRate: 10000,
+	}
+
+	// sendCreateSession creates a session for the given key from the given
+	// address and asserts the code of the server's reply.
+	sendCreateSession := func(peerPub *secp256k1.PublicKey,
+		peerAddr net.Addr, expCode wtwire.ErrorCode) {
+
+		t.Helper()
+
+		peer := wtmock.NewMockPeer(localPub, peerPub, peerAddr, 0)
+		connect(t, s, peer, initMsg, timeoutDuration)
+		sendMsg(t, createSession, peer, timeoutDuration)
+
+		reply := recvReply(
+			t, "MsgCreateSessionReply", peer, timeoutDuration,
+		).(*wtwire.CreateSessionReply)
+		if reply.Code != expCode {
+			t.Fatalf("expected create session code %v, got %v",
+				expCode, reply.Code)
+		}
+
+		assertConnClosed(t, peer, 2*timeoutDuration)
+	}
+
+	// The client can create sessions using distinct keys until the
+	// session quota is exhausted, after which sessions are rejected
+	// regardless of the key used.
+	peerPub1 := randPubKey(t)
+	peerPub2 := randPubKey(t)
+	sendCreateSession(peerPub1, clientAddr, wtwire.CodeOK)
+	sendCreateSession(peerPub2, clientAddr, wtwire.CodeOK)
+	sendCreateSession(randPubKey(t), clientAddr, wtwire.CodeQuotaExceeded)
+
+	// sendUpdate sends a state update for the session of the given key and
+	// asserts the server's reply.
+	sendUpdate := func(peerPub *secp256k1.PublicKey,
+		update *wtwire.StateUpdate, expReply *wtwire.StateUpdateReply) {
+
+		t.Helper()
+
+		peer := wtmock.NewMockPeer(localPub, peerPub, clientAddr, 0)
+		connect(t, s, peer, initMsg, timeoutDuration)
+		sendMsg(t, update, peer, timeoutDuration)
+
+		reply := recvReply(
+			t, "MsgStateUpdateReply", peer, timeoutDuration,
+		)
+		if !reflect.DeepEqual(reply, expReply) {
+			t.Fatalf("expected reply %v, got %v", expReply, reply)
+		}
+
+		assertConnClosed(t, peer, 2*timeoutDuration)
+	}
+
+	// The client may store updates across its sessions until the update
+	// quota is exhausted, after which the server hangs up.
+	sendUpdate(
+		peerPub1,
+		&wtwire.StateUpdate{
+			SeqNum:        1,
+			LastApplied:   0,
+			IsComplete:    1,
+			EncryptedBlob: testBlob,
+		},
+		&wtwire.StateUpdateReply{Code: wtwire.CodeOK, LastApplied: 1},
+	)
+	sendUpdate(
+		peerPub2,
+		&wtwire.StateUpdate{
+			SeqNum:        1,
+			LastApplied:   0,
+			IsComplete:    1,
+			EncryptedBlob: testBlob,
+		},
+		&wtwire.StateUpdateReply{Code: wtwire.CodeOK, LastApplied: 1},
+	)
+	sendUpdate(
+		peerPub1,
+		&wtwire.StateUpdate{
+			SeqNum:        2,
+			LastApplied:   1,
+			IsComplete:    1,
+			EncryptedBlob: testBlob,
+		},
+		&wtwire.StateUpdateReply{
+			Code: wtwire.CodeQuotaExceeded,
+		},
+	)
+
+	// Clients connecting from other addresses are not affected by the
+	// quotas of this client.
+	otherAddr := &net.TCPAddr{
+		IP:   net.ParseIP("203.0.113.2"),
+		Port: 9911,
+	}
+	sendCreateSession(randPubKey(t), otherAddr, wtwire.CodeOK)
+
+	// Clients connecting through a loopback address, e.g. through the
+	// tower's onion service, share that address, so they are exempt from
+	// the address quotas.
+	loopbackAddr := &net.TCPAddr{
+		IP:   net.ParseIP("127.0.0.1"),
+		Port: 9911,
+	}
+	for i := 0; i < 3; i++ {
+		sendCreateSession(randPubKey(t), loopbackAddr, wtwire.CodeOK)
+	}
+
+	usage, err := db.GetClientUsage(clientID)
+	if err != nil {
+		t.Fatalf("unable to fetch client usage: %v", err)
+	}
+	expUsage := &wtdb.ClientUsage{NumSessions: 2, NumUpdates: 2}
+	if !reflect.DeepEqual(usage, expUsage) {
+		t.Fatalf("expected usage %v, got %v", expUsage, usage)
+	}
+
+	// After resetting the client's usage, it can continue where it left
+	// off.
+	if err := db.ResetClientUsage(clientID); err != nil {
+		t.Fatalf("unable to reset client usage: %v", err)
+	}
+
+	sendUpdate(
+		peerPub1,
+		&wtwire.StateUpdate{
+			SeqNum:        2,
+			LastApplied:   1,
+			IsComplete:    1,
+			EncryptedBlob: testBlob,
+		},
+		&wtwire.StateUpdateReply{Code: wtwire.CodeOK, LastApplied: 2},
+	)
+	sendCreateSession(randPubKey(t), clientAddr, wtwire.CodeOK)
+}
+
 func connect(t *testing.T, s wtserver.Interface, peer *wtmock.MockPeer,
 	initMsg *wtwire.Init, timeout time.Duration) {
 
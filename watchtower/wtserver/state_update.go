@@ -81,7 +81,14 @@ func (s *Server) handleStateUpdate(peer Peer, id *wtdb.SessionID,
 		EncryptedBlob: update.EncryptedBlob,
 	}
 
-	lastApplied, err = s.cfg.DB.InsertStateUpdate(&sessionUpdate)
+	quotas := s.clientQuotas(peer, id)
+	err = s.checkUpdateQuota(id, quotas)
+	if err == nil {
+		lastApplied, err = s.cfg.DB.InsertStateUpdate(
+			&sessionUpdate, clientIDs(quotas)...,
+		)
+	}
+
 	switch {
 	case err == nil:
 		log.Debugf("State update %d accepted for %s",
@@ -110,6 +117,9 @@ func (s *Server) handleStateUpdate(peer Peer, id *wtdb.SessionID,
 	case err == wtdb.ErrUpdateOutOfOrder:
 		failCode = wtwire.StateUpdateCodeSeqNumOutOfOrder
 
+	case err == errUpdateQuotaExceeded:
+		failCode = wtwire.CodeQuotaExceeded
+
 	default:
 		failCode = wtwire.CodeTemporaryFailure
 	}
@@ -19,6 +19,12 @@ const (
 	// CodePermanentFailure alerts the client that the watchtower has
 	// permanently failed, and further communication should be avoided.
 	CodePermanentFailure ErrorCode = 50
+
+	// CodeQuotaExceeded signals that the client has consumed all sessions
+	// or state updates the watchtower is willing to store on its behalf.
+	// The client should not retry with this watchtower until its usage has
+	// been reset by the operator.
+	CodeQuotaExceeded ErrorCode = 90
 )
 
 // String returns a human-readable description of an ErrorCode.
@@ -30,6 +36,8 @@ func (c ErrorCode) String() string {
 		return "CodeTemporaryFailure"
 	case CodePermanentFailure:
 		return "CodePermanentFailure"
+	case CodeQuotaExceeded:
+		return "CodeQuotaExceeded"
 	case CreateSessionCodeAlreadyExists:
 		return "CreateSessionCodeAlreadyExists"
 	case CreateSessionCodeRejectMaxUpdates:
@@ -39,6 +39,19 @@ var checkRemoteInitTests = []checkRemoteInitTest{
 		rFeatures: lnwire.NewRawFeatureVector(wtwire.AltruistSessionsOptional),
 		rHash:     testnetChainHash,
 	},
+	{
+		name: "same chain, local-optional remote-required anchor",
+		lFeatures: lnwire.NewRawFeatureVector(
+			wtwire.AltruistSessionsOptional,
+			wtwire.AnchorCommitOptional,
+		),
+		lHash: testnetChainHash,
+		rFeatures: lnwire.NewRawFeatureVector(
+			wtwire.AltruistSessionsRequired,
+			wtwire.AnchorCommitRequired,
+		),
+		rHash: testnetChainHash,
+	},
 	{
 		name:      "different chain, local-optional remote-required",
 		lFeatures: lnwire.NewRawFeatureVector(wtwire.AltruistSessionsOptional),